@@ -9,6 +9,7 @@ package gen
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -111,7 +112,9 @@ type SlugSEO struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Slug     string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Preview  bool   `protobuf:"varint,2,opt,name=preview,proto3" json:"preview,omitempty"`
+	Strategy string `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
 }
 
 func (x *SlugSEO) Reset() {
@@ -153,19 +156,177 @@ func (x *SlugSEO) GetSlug() string {
 	return ""
 }
 
+func (x *SlugSEO) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *SlugSEO) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+type ListPagesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preview     bool                   `protobuf:"varint,1,opt,name=preview,proto3" json:"preview,omitempty"`
+	Page        int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size        int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor      string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Sort        string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order       string                 `protobuf:"bytes,6,opt,name=order,proto3" json:"order,omitempty"`
+	Title       string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Href        string                 `protobuf:"bytes,8,opt,name=href,proto3" json:"href,omitempty"`
+	Status      string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	UpdatedFrom *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	UpdatedTo   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+}
+
+func (x *ListPagesReq) Reset() {
+	*x = ListPagesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPagesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPagesReq) ProtoMessage() {}
+
+func (x *ListPagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPagesReq.ProtoReflect.Descriptor instead.
+func (*ListPagesReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPagesReq) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *ListPagesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPagesReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListPagesReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPagesReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListPagesReq) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListPagesReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListPagesReq) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+func (x *ListPagesReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPagesReq) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListPagesReq) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListPagesReq) GetUpdatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return nil
+}
+
+func (x *ListPagesReq) GetUpdatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return nil
+}
+
 type CreateSEOResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Pk   string `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pk     string `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	Locale string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *CreateSEOResponse) Reset() {
 	*x = CreateSEOResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[3]
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -178,7 +339,7 @@ func (x *CreateSEOResponse) String() string {
 func (*CreateSEOResponse) ProtoMessage() {}
 
 func (x *CreateSEOResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[3]
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +352,7 @@ func (x *CreateSEOResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSEOResponse.ProtoReflect.Descriptor instead.
 func (*CreateSEOResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{3}
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateSEOResponse) GetName() string {
@@ -208,28 +369,57 @@ func (x *CreateSEOResponse) GetPk() string {
 	return ""
 }
 
+func (x *CreateSEOResponse) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type SEOMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Keywords      string                 `protobuf:"bytes,4,opt,name=keywords,proto3" json:"keywords,omitempty"`
-	OGTitle       string                 `protobuf:"bytes,5,opt,name=OGTitle,proto3" json:"OGTitle,omitempty"`
-	OGDescription string                 `protobuf:"bytes,6,opt,name=OGDescription,proto3" json:"OGDescription,omitempty"`
-	OGImage       string                 `protobuf:"bytes,7,opt,name=OGImage,proto3" json:"OGImage,omitempty"`
-	ObjName       string                 `protobuf:"bytes,8,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
-	ObjPk         string                 `protobuf:"bytes,9,opt,name=obj_pk,json=objPk,proto3" json:"obj_pk,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id                   uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Keywords             string                 `protobuf:"bytes,4,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	OGTitle              string                 `protobuf:"bytes,5,opt,name=OGTitle,proto3" json:"OGTitle,omitempty"`
+	OGDescription        string                 `protobuf:"bytes,6,opt,name=OGDescription,proto3" json:"OGDescription,omitempty"`
+	OGImage              string                 `protobuf:"bytes,7,opt,name=OGImage,proto3" json:"OGImage,omitempty"`
+	ObjName              string                 `protobuf:"bytes,8,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
+	ObjPk                string                 `protobuf:"bytes,9,opt,name=obj_pk,json=objPk,proto3" json:"obj_pk,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Locale               string                 `protobuf:"bytes,12,opt,name=locale,proto3" json:"locale,omitempty"`
+	JsonLd               []*structpb.Struct     `protobuf:"bytes,13,rep,name=json_ld,json=jsonLd,proto3" json:"json_ld,omitempty"`
+	OGType               string                 `protobuf:"bytes,14,opt,name=OGType,proto3" json:"OGType,omitempty"`
+	OGURL                string                 `protobuf:"bytes,15,opt,name=OGURL,proto3" json:"OGURL,omitempty"`
+	OGLocale             string                 `protobuf:"bytes,16,opt,name=OGLocale,proto3" json:"OGLocale,omitempty"`
+	OGSiteName           string                 `protobuf:"bytes,17,opt,name=OGSiteName,proto3" json:"OGSiteName,omitempty"`
+	OGImageWidth         int32                  `protobuf:"varint,18,opt,name=OGImageWidth,proto3" json:"OGImageWidth,omitempty"`
+	OGImageHeight        int32                  `protobuf:"varint,19,opt,name=OGImageHeight,proto3" json:"OGImageHeight,omitempty"`
+	OGImageAlt           string                 `protobuf:"bytes,20,opt,name=OGImageAlt,proto3" json:"OGImageAlt,omitempty"`
+	OGImageType          string                 `protobuf:"bytes,21,opt,name=OGImageType,proto3" json:"OGImageType,omitempty"`
+	ArticlePublishedTime *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=article_published_time,json=articlePublishedTime,proto3" json:"article_published_time,omitempty"`
+	ArticleModifiedTime  *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=article_modified_time,json=articleModifiedTime,proto3" json:"article_modified_time,omitempty"`
+	ArticleAuthor        []string               `protobuf:"bytes,24,rep,name=article_author,json=articleAuthor,proto3" json:"article_author,omitempty"`
+	ArticleSection       string                 `protobuf:"bytes,25,opt,name=article_section,json=articleSection,proto3" json:"article_section,omitempty"`
+	ArticleTag           []string               `protobuf:"bytes,26,rep,name=article_tag,json=articleTag,proto3" json:"article_tag,omitempty"`
+	TwitterCard          string                 `protobuf:"bytes,27,opt,name=twitter_card,json=twitterCard,proto3" json:"twitter_card,omitempty"`
+	TwitterSite          string                 `protobuf:"bytes,28,opt,name=twitter_site,json=twitterSite,proto3" json:"twitter_site,omitempty"`
+	TwitterCreator       string                 `protobuf:"bytes,29,opt,name=twitter_creator,json=twitterCreator,proto3" json:"twitter_creator,omitempty"`
+	TwitterImageAlt      string                 `protobuf:"bytes,30,opt,name=twitter_image_alt,json=twitterImageAlt,proto3" json:"twitter_image_alt,omitempty"`
+	Status               string                 `protobuf:"bytes,31,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt            *timestamppb.Timestamp `protobuf:"bytes,32,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	Generated            bool                   `protobuf:"varint,33,opt,name=generated,proto3" json:"generated,omitempty"`
 }
 
 func (x *SEOMsg) Reset() {
 	*x = SEOMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[4]
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -242,7 +432,7 @@ func (x *SEOMsg) String() string {
 func (*SEOMsg) ProtoMessage() {}
 
 func (x *SEOMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[4]
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +445,7 @@ func (x *SEOMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SEOMsg.ProtoReflect.Descriptor instead.
 func (*SEOMsg) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{4}
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SEOMsg) GetId() uint64 {
@@ -335,71 +525,182 @@ func (x *SEOMsg) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type GetSEOReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SEOMsg) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Pk   string `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+func (x *SEOMsg) GetJsonLd() []*structpb.Struct {
+	if x != nil {
+		return x.JsonLd
+	}
+	return nil
 }
 
-func (x *GetSEOReq) Reset() {
-	*x = GetSEOReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SEOMsg) GetOGType() string {
+	if x != nil {
+		return x.OGType
 	}
+	return ""
 }
 
-func (x *GetSEOReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SEOMsg) GetOGURL() string {
+	if x != nil {
+		return x.OGURL
+	}
+	return ""
 }
 
-func (*GetSEOReq) ProtoMessage() {}
+func (x *SEOMsg) GetOGLocale() string {
+	if x != nil {
+		return x.OGLocale
+	}
+	return ""
+}
 
-func (x *GetSEOReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SEOMsg) GetOGSiteName() string {
+	if x != nil {
+		return x.OGSiteName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetSEOReq.ProtoReflect.Descriptor instead.
-func (*GetSEOReq) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{5}
+func (x *SEOMsg) GetOGImageWidth() int32 {
+	if x != nil {
+		return x.OGImageWidth
+	}
+	return 0
 }
 
-func (x *GetSEOReq) GetName() string {
+func (x *SEOMsg) GetOGImageHeight() int32 {
 	if x != nil {
-		return x.Name
+		return x.OGImageHeight
+	}
+	return 0
+}
+
+func (x *SEOMsg) GetOGImageAlt() string {
+	if x != nil {
+		return x.OGImageAlt
 	}
 	return ""
 }
 
-func (x *GetSEOReq) GetPk() string {
+func (x *SEOMsg) GetOGImageType() string {
 	if x != nil {
-		return x.Pk
+		return x.OGImageType
 	}
 	return ""
 }
 
-type ListPageRes struct {
+func (x *SEOMsg) GetArticlePublishedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArticlePublishedTime
+	}
+	return nil
+}
+
+func (x *SEOMsg) GetArticleModifiedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArticleModifiedTime
+	}
+	return nil
+}
+
+func (x *SEOMsg) GetArticleAuthor() []string {
+	if x != nil {
+		return x.ArticleAuthor
+	}
+	return nil
+}
+
+func (x *SEOMsg) GetArticleSection() string {
+	if x != nil {
+		return x.ArticleSection
+	}
+	return ""
+}
+
+func (x *SEOMsg) GetArticleTag() []string {
+	if x != nil {
+		return x.ArticleTag
+	}
+	return nil
+}
+
+func (x *SEOMsg) GetTwitterCard() string {
+	if x != nil {
+		return x.TwitterCard
+	}
+	return ""
+}
+
+func (x *SEOMsg) GetTwitterSite() string {
+	if x != nil {
+		return x.TwitterSite
+	}
+	return ""
+}
+
+func (x *SEOMsg) GetTwitterCreator() string {
+	if x != nil {
+		return x.TwitterCreator
+	}
+	return ""
+}
+
+func (x *SEOMsg) GetTwitterImageAlt() string {
+	if x != nil {
+		return x.TwitterImageAlt
+	}
+	return ""
+}
+
+func (x *SEOMsg) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SEOMsg) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *SEOMsg) GetGenerated() bool {
+	if x != nil {
+		return x.Generated
+	}
+	return false
+}
+
+type ListSEOReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pages []*PageMsg `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty"`
+	Preview     bool                   `protobuf:"varint,1,opt,name=preview,proto3" json:"preview,omitempty"`
+	Page        int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size        int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor      string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Sort        string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order       string                 `protobuf:"bytes,6,opt,name=order,proto3" json:"order,omitempty"`
+	ObjName     string                 `protobuf:"bytes,7,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
+	PkPrefix    string                 `protobuf:"bytes,8,opt,name=pk_prefix,json=pkPrefix,proto3" json:"pk_prefix,omitempty"`
+	Locale      string                 `protobuf:"bytes,9,opt,name=locale,proto3" json:"locale,omitempty"`
+	Status      string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	UpdatedFrom *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	UpdatedTo   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+	Missing     []string               `protobuf:"bytes,13,rep,name=missing,proto3" json:"missing,omitempty"`
 }
 
-func (x *ListPageRes) Reset() {
-	*x = ListPageRes{}
+func (x *ListSEOReq) Reset() {
+	*x = ListSEOReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,13 +708,13 @@ func (x *ListPageRes) Reset() {
 	}
 }
 
-func (x *ListPageRes) String() string {
+func (x *ListSEOReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPageRes) ProtoMessage() {}
+func (*ListSEOReq) ProtoMessage() {}
 
-func (x *ListPageRes) ProtoReflect() protoreflect.Message {
+func (x *ListSEOReq) ProtoReflect() protoreflect.Message {
 	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -425,32 +726,117 @@ func (x *ListPageRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPageRes.ProtoReflect.Descriptor instead.
-func (*ListPageRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSEOReq.ProtoReflect.Descriptor instead.
+func (*ListSEOReq) Descriptor() ([]byte, []int) {
 	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListPageRes) GetPages() []*PageMsg {
+func (x *ListSEOReq) GetPreview() bool {
 	if x != nil {
-		return x.Pages
+		return x.Preview
 	}
-	return nil
+	return false
 }
 
-type PageMsg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *ListSEOReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSEOReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListSEOReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSEOReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListSEOReq) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListSEOReq) GetObjName() string {
+	if x != nil {
+		return x.ObjName
+	}
+	return ""
+}
+
+func (x *ListSEOReq) GetPkPrefix() string {
+	if x != nil {
+		return x.PkPrefix
+	}
+	return ""
+}
+
+func (x *ListSEOReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ListSEOReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSEOReq) GetUpdatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return nil
+}
+
+func (x *ListSEOReq) GetUpdatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return nil
+}
+
+func (x *ListSEOReq) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+type ListSEORes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slug      string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Href      string                 `protobuf:"bytes,3,opt,name=href,proto3" json:"href,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Seo         []*SEOMsg `protobuf:"bytes,1,rep,name=seo,proto3" json:"seo,omitempty"`
+	Count       int64     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int32     `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int32     `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool      `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	NextCursor  string    `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *PageMsg) Reset() {
-	*x = PageMsg{}
+func (x *ListSEORes) Reset() {
+	*x = ListSEORes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -458,13 +844,13 @@ func (x *PageMsg) Reset() {
 	}
 }
 
-func (x *PageMsg) String() string {
+func (x *ListSEORes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageMsg) ProtoMessage() {}
+func (*ListSEORes) ProtoMessage() {}
 
-func (x *PageMsg) ProtoReflect() protoreflect.Message {
+func (x *ListSEORes) ProtoReflect() protoreflect.Message {
 	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -476,57 +862,65 @@ func (x *PageMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageMsg.ProtoReflect.Descriptor instead.
-func (*PageMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSEORes.ProtoReflect.Descriptor instead.
+func (*ListSEORes) Descriptor() ([]byte, []int) {
 	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PageMsg) GetSlug() string {
+func (x *ListSEORes) GetSeo() []*SEOMsg {
 	if x != nil {
-		return x.Slug
+		return x.Seo
 	}
-	return ""
+	return nil
 }
 
-func (x *PageMsg) GetTitle() string {
+func (x *ListSEORes) GetCount() int64 {
 	if x != nil {
-		return x.Title
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *PageMsg) GetHref() string {
+func (x *ListSEORes) GetTotalPages() int32 {
 	if x != nil {
-		return x.Href
+		return x.TotalPages
 	}
-	return ""
+	return 0
 }
 
-func (x *PageMsg) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListSEORes) GetCurrentPage() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.CurrentPage
 	}
-	return nil
+	return 0
 }
 
-func (x *PageMsg) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ListSEORes) GetHasNextPage() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.HasNextPage
 	}
-	return nil
+	return false
 }
 
-type PageWithSlugMsg struct {
+func (x *ListSEORes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ImportSEOReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slug string   `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Page *PageMsg `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Seo    *SEOMsg `protobuf:"bytes,1,opt,name=seo,proto3" json:"seo,omitempty"`
+	Mode   string  `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	DryRun bool    `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *PageWithSlugMsg) Reset() {
-	*x = PageWithSlugMsg{}
+func (x *ImportSEOReq) Reset() {
+	*x = ImportSEOReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,13 +928,13 @@ func (x *PageWithSlugMsg) Reset() {
 	}
 }
 
-func (x *PageWithSlugMsg) String() string {
+func (x *ImportSEOReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageWithSlugMsg) ProtoMessage() {}
+func (*ImportSEOReq) ProtoMessage() {}
 
-func (x *PageWithSlugMsg) ProtoReflect() protoreflect.Message {
+func (x *ImportSEOReq) ProtoReflect() protoreflect.Message {
 	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -552,242 +946,4082 @@ func (x *PageWithSlugMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageWithSlugMsg.ProtoReflect.Descriptor instead.
-func (*PageWithSlugMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportSEOReq.ProtoReflect.Descriptor instead.
+func (*ImportSEOReq) Descriptor() ([]byte, []int) {
 	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PageWithSlugMsg) GetSlug() string {
+func (x *ImportSEOReq) GetSeo() *SEOMsg {
 	if x != nil {
-		return x.Slug
+		return x.Seo
+	}
+	return nil
+}
+
+func (x *ImportSEOReq) GetMode() string {
+	if x != nil {
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *PageWithSlugMsg) GetPage() *PageMsg {
+func (x *ImportSEOReq) GetDryRun() bool {
 	if x != nil {
-		return x.Page
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-var File_api_grpc_v1_gen_seo_proto protoreflect.FileDescriptor
+type ImportRowErrorMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_grpc_v1_gen_seo_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65,
-	0x6e, 0x2f, 0x73, 0x65, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x67, 0x65, 0x6e,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x0a, 0x0a, 0x08, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x22, 0x1b, 0x0a,
-	0x09, 0x75, 0x75, 0x69, 0x64, 0x36, 0x34, 0x53, 0x45, 0x4f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x07, 0x73, 0x6c,
-	0x75, 0x67, 0x53, 0x45, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x37, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x70, 0x6b, 0x22, 0xee, 0x02, 0x0a, 0x06, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x47, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x4f, 0x47, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x4f,
-	0x47, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x4f, 0x47, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x47, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x4f, 0x47, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f,
-	0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x6b,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x62, 0x6a, 0x50, 0x6b, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x2f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x70, 0x6b, 0x22, 0x31, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x67,
-	0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x67, 0x65,
-	0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x72, 0x65,
-	0x66, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x47, 0x0a, 0x0f, 0x50, 0x61, 0x67, 0x65, 0x57,
-	0x69, 0x74, 0x68, 0x53, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
-	0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x20,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x32, 0xb3, 0x01, 0x0a, 0x03, 0x53, 0x45, 0x4f, 0x12, 0x25, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x53,
-	0x45, 0x4f, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x52,
-	0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67, 0x12,
-	0x30, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x45, 0x4f, 0x12, 0x0b, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x27, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x45, 0x4f, 0x12, 0x0b,
-	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x67, 0x65,
-	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x12, 0x2a, 0x0a, 0x09, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x45, 0x4f, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x32, 0xe3, 0x01, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12,
-	0x2c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x1a, 0x10, 0x2e, 0x67, 0x65,
-	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a,
-	0x07, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73,
-	0x6c, 0x75, 0x67, 0x53, 0x45, 0x4f, 0x1a, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x67, 0x65, 0x12, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x67,
-	0x1a, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x53, 0x45, 0x4f, 0x12, 0x31,
-	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6c, 0x75, 0x67, 0x4d,
-	0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45,
-	0x4f, 0x12, 0x29, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12,
-	0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x53, 0x45, 0x4f, 0x1a, 0x0d, 0x2e,
-	0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x42, 0x26, 0x5a, 0x24,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4a, 0x4d, 0x55, 0x52, 0x76,
-	0x2f, 0x73, 0x65, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31,
-	0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Row     int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Field   string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-var (
-	file_api_grpc_v1_gen_seo_proto_rawDescOnce sync.Once
-	file_api_grpc_v1_gen_seo_proto_rawDescData = file_api_grpc_v1_gen_seo_proto_rawDesc
-)
+func (x *ImportRowErrorMsg) Reset() {
+	*x = ImportRowErrorMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_grpc_v1_gen_seo_proto_rawDescGZIP() []byte {
-	file_api_grpc_v1_gen_seo_proto_rawDescOnce.Do(func() {
-		file_api_grpc_v1_gen_seo_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_grpc_v1_gen_seo_proto_rawDescData)
-	})
-	return file_api_grpc_v1_gen_seo_proto_rawDescData
+func (x *ImportRowErrorMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_grpc_v1_gen_seo_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_api_grpc_v1_gen_seo_proto_goTypes = []any{
-	(*EmptySEO)(nil),              // 0: gen.EmptySEO
-	(*Uuid64SEO)(nil),             // 1: gen.uuid64SEO
-	(*SlugSEO)(nil),               // 2: gen.slugSEO
-	(*CreateSEOResponse)(nil),     // 3: gen.CreateSEOResponse
-	(*SEOMsg)(nil),                // 4: gen.SEOMsg
-	(*GetSEOReq)(nil),             // 5: gen.GetSEOReq
-	(*ListPageRes)(nil),           // 6: gen.ListPageRes
-	(*PageMsg)(nil),               // 7: gen.PageMsg
-	(*PageWithSlugMsg)(nil),       // 8: gen.PageWithSlugMsg
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+func (*ImportRowErrorMsg) ProtoMessage() {}
+
+func (x *ImportRowErrorMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_grpc_v1_gen_seo_proto_depIdxs = []int32{
-	9,  // 0: gen.SEOMsg.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 1: gen.SEOMsg.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 2: gen.ListPageRes.pages:type_name -> gen.PageMsg
-	9,  // 3: gen.PageMsg.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 4: gen.PageMsg.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 5: gen.PageWithSlugMsg.page:type_name -> gen.PageMsg
-	5,  // 6: gen.SEO.GetSEO:input_type -> gen.GetSEOReq
-	4,  // 7: gen.SEO.CreateSEO:input_type -> gen.SEOMsg
-	4,  // 8: gen.SEO.UpdateSEO:input_type -> gen.SEOMsg
-	5,  // 9: gen.SEO.DeleteSEO:input_type -> gen.GetSEOReq
-	0,  // 10: gen.Page.ListPages:input_type -> gen.EmptySEO
-	2,  // 11: gen.Page.GetPage:input_type -> gen.slugSEO
-	7,  // 12: gen.Page.CreatePage:input_type -> gen.PageMsg
-	8,  // 13: gen.Page.UpdatePage:input_type -> gen.PageWithSlugMsg
-	2,  // 14: gen.Page.DeletePage:input_type -> gen.slugSEO
-	4,  // 15: gen.SEO.GetSEO:output_type -> gen.SEOMsg
-	3,  // 16: gen.SEO.CreateSEO:output_type -> gen.CreateSEOResponse
-	0,  // 17: gen.SEO.UpdateSEO:output_type -> gen.EmptySEO
-	0,  // 18: gen.SEO.DeleteSEO:output_type -> gen.EmptySEO
-	6,  // 19: gen.Page.ListPages:output_type -> gen.ListPageRes
-	7,  // 20: gen.Page.GetPage:output_type -> gen.PageMsg
-	2,  // 21: gen.Page.CreatePage:output_type -> gen.slugSEO
-	0,  // 22: gen.Page.UpdatePage:output_type -> gen.EmptySEO
-	0,  // 23: gen.Page.DeletePage:output_type -> gen.EmptySEO
-	15, // [15:24] is the sub-list for method output_type
-	6,  // [6:15] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+// Deprecated: Use ImportRowErrorMsg.ProtoReflect.Descriptor instead.
+func (*ImportRowErrorMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{9}
 }
 
-func init() { file_api_grpc_v1_gen_seo_proto_init() }
-func file_api_grpc_v1_gen_seo_proto_init() {
-	if File_api_grpc_v1_gen_seo_proto != nil {
-		return
+func (x *ImportRowErrorMsg) GetRow() int32 {
+	if x != nil {
+		return x.Row
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_grpc_v1_gen_seo_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*EmptySEO); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_grpc_v1_gen_seo_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*Uuid64SEO); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_grpc_v1_gen_seo_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*SlugSEO); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_grpc_v1_gen_seo_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateSEOResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+func (x *ImportRowErrorMsg) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportRowErrorMsg) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportSEORes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun  bool                 `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Total   int32                `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Created int32                `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32                `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Skipped int32                `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Errors  []*ImportRowErrorMsg `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportSEORes) Reset() {
+	*x = ImportSEORes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSEORes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSEORes) ProtoMessage() {}
+
+func (x *ImportSEORes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_grpc_v1_gen_seo_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*SEOMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSEORes.ProtoReflect.Descriptor instead.
+func (*ImportSEORes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportSEORes) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportSEORes) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportSEORes) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportSEORes) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportSEORes) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportSEORes) GetErrors() []*ImportRowErrorMsg {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type AuditViolationMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule    string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Field   string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Weight  int32  `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *AuditViolationMsg) Reset() {
+	*x = AuditViolationMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditViolationMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditViolationMsg) ProtoMessage() {}
+
+func (x *AuditViolationMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditViolationMsg.ProtoReflect.Descriptor instead.
+func (*AuditViolationMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AuditViolationMsg) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *AuditViolationMsg) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AuditViolationMsg) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuditViolationMsg) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type SEOAuditMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjName    string               `protobuf:"bytes,1,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
+	ObjPk      string               `protobuf:"bytes,2,opt,name=obj_pk,json=objPk,proto3" json:"obj_pk,omitempty"`
+	Locale     string               `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	Score      int32                `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Violations []*AuditViolationMsg `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *SEOAuditMsg) Reset() {
+	*x = SEOAuditMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SEOAuditMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SEOAuditMsg) ProtoMessage() {}
+
+func (x *SEOAuditMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SEOAuditMsg.ProtoReflect.Descriptor instead.
+func (*SEOAuditMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SEOAuditMsg) GetObjName() string {
+	if x != nil {
+		return x.ObjName
+	}
+	return ""
+}
+
+func (x *SEOAuditMsg) GetObjPk() string {
+	if x != nil {
+		return x.ObjPk
+	}
+	return ""
+}
+
+func (x *SEOAuditMsg) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SEOAuditMsg) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SEOAuditMsg) GetViolations() []*AuditViolationMsg {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type AuditReportReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjName string `protobuf:"bytes,1,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
+}
+
+func (x *AuditReportReq) Reset() {
+	*x = AuditReportReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditReportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditReportReq) ProtoMessage() {}
+
+func (x *AuditReportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditReportReq.ProtoReflect.Descriptor instead.
+func (*AuditReportReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuditReportReq) GetObjName() string {
+	if x != nil {
+		return x.ObjName
+	}
+	return ""
+}
+
+type AuditObjectReportMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjName    string           `protobuf:"bytes,1,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
+	Records    int32            `protobuf:"varint,2,opt,name=records,proto3" json:"records,omitempty"`
+	Score      float64          `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	MinScore   int32            `protobuf:"varint,4,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	Violations map[string]int32 `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *AuditObjectReportMsg) Reset() {
+	*x = AuditObjectReportMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditObjectReportMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditObjectReportMsg) ProtoMessage() {}
+
+func (x *AuditObjectReportMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditObjectReportMsg.ProtoReflect.Descriptor instead.
+func (*AuditObjectReportMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditObjectReportMsg) GetObjName() string {
+	if x != nil {
+		return x.ObjName
+	}
+	return ""
+}
+
+func (x *AuditObjectReportMsg) GetRecords() int32 {
+	if x != nil {
+		return x.Records
+	}
+	return 0
+}
+
+func (x *AuditObjectReportMsg) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *AuditObjectReportMsg) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *AuditObjectReportMsg) GetViolations() map[string]int32 {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type AuditReportRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records    int32                   `protobuf:"varint,1,opt,name=records,proto3" json:"records,omitempty"`
+	Score      float64                 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Violations map[string]int32        `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Objects    []*AuditObjectReportMsg `protobuf:"bytes,4,rep,name=objects,proto3" json:"objects,omitempty"`
+}
+
+func (x *AuditReportRes) Reset() {
+	*x = AuditReportRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditReportRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditReportRes) ProtoMessage() {}
+
+func (x *AuditReportRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditReportRes.ProtoReflect.Descriptor instead.
+func (*AuditReportRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuditReportRes) GetRecords() int32 {
+	if x != nil {
+		return x.Records
+	}
+	return 0
+}
+
+func (x *AuditReportRes) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *AuditReportRes) GetViolations() map[string]int32 {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *AuditReportRes) GetObjects() []*AuditObjectReportMsg {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type GetSEOReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pk       string            `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	Locale   string            `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	Preview  bool              `protobuf:"varint,4,opt,name=preview,proto3" json:"preview,omitempty"`
+	Vars     map[string]string `protobuf:"bytes,5,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Generate bool              `protobuf:"varint,6,opt,name=generate,proto3" json:"generate,omitempty"`
+}
+
+func (x *GetSEOReq) Reset() {
+	*x = GetSEOReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSEOReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSEOReq) ProtoMessage() {}
+
+func (x *GetSEOReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSEOReq.ProtoReflect.Descriptor instead.
+func (*GetSEOReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSEOReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSEOReq) GetPk() string {
+	if x != nil {
+		return x.Pk
+	}
+	return ""
+}
+
+func (x *GetSEOReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetSEOReq) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *GetSEOReq) GetVars() map[string]string {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *GetSEOReq) GetGenerate() bool {
+	if x != nil {
+		return x.Generate
+	}
+	return false
+}
+
+type HeadTagMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag      string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Property string `protobuf:"bytes,3,opt,name=property,proto3" json:"property,omitempty"`
+	Content  string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Rel      string `protobuf:"bytes,5,opt,name=rel,proto3" json:"rel,omitempty"`
+	Href     string `protobuf:"bytes,6,opt,name=href,proto3" json:"href,omitempty"`
+	Type     string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Text     string `protobuf:"bytes,8,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *HeadTagMsg) Reset() {
+	*x = HeadTagMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadTagMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadTagMsg) ProtoMessage() {}
+
+func (x *HeadTagMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadTagMsg.ProtoReflect.Descriptor instead.
+func (*HeadTagMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HeadTagMsg) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *HeadTagMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HeadTagMsg) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *HeadTagMsg) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *HeadTagMsg) GetRel() string {
+	if x != nil {
+		return x.Rel
+	}
+	return ""
+}
+
+func (x *HeadTagMsg) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+func (x *HeadTagMsg) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HeadTagMsg) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SEOHeadRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Html string        `protobuf:"bytes,1,opt,name=html,proto3" json:"html,omitempty"`
+	Tags []*HeadTagMsg `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *SEOHeadRes) Reset() {
+	*x = SEOHeadRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SEOHeadRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SEOHeadRes) ProtoMessage() {}
+
+func (x *SEOHeadRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SEOHeadRes.ProtoReflect.Descriptor instead.
+func (*SEOHeadRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SEOHeadRes) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *SEOHeadRes) GetTags() []*HeadTagMsg {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SEOTemplateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjName string `protobuf:"bytes,1,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
+	Locale  string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *SEOTemplateReq) Reset() {
+	*x = SEOTemplateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SEOTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SEOTemplateReq) ProtoMessage() {}
+
+func (x *SEOTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SEOTemplateReq.ProtoReflect.Descriptor instead.
+func (*SEOTemplateReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SEOTemplateReq) GetObjName() string {
+	if x != nil {
+		return x.ObjName
+	}
+	return ""
+}
+
+func (x *SEOTemplateReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type SEOTemplateMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjName       string                 `protobuf:"bytes,1,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Keywords      string                 `protobuf:"bytes,5,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	OGTitle       string                 `protobuf:"bytes,6,opt,name=OGTitle,proto3" json:"OGTitle,omitempty"`
+	OGDescription string                 `protobuf:"bytes,7,opt,name=OGDescription,proto3" json:"OGDescription,omitempty"`
+	OGImage       string                 `protobuf:"bytes,8,opt,name=OGImage,proto3" json:"OGImage,omitempty"`
+	OGType        string                 `protobuf:"bytes,9,opt,name=OGType,proto3" json:"OGType,omitempty"`
+	OGURL         string                 `protobuf:"bytes,10,opt,name=OGURL,proto3" json:"OGURL,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *SEOTemplateMsg) Reset() {
+	*x = SEOTemplateMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SEOTemplateMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SEOTemplateMsg) ProtoMessage() {}
+
+func (x *SEOTemplateMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SEOTemplateMsg.ProtoReflect.Descriptor instead.
+func (*SEOTemplateMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SEOTemplateMsg) GetObjName() string {
+	if x != nil {
+		return x.ObjName
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetOGTitle() string {
+	if x != nil {
+		return x.OGTitle
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetOGDescription() string {
+	if x != nil {
+		return x.OGDescription
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetOGImage() string {
+	if x != nil {
+		return x.OGImage
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetOGType() string {
+	if x != nil {
+		return x.OGType
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetOGURL() string {
+	if x != nil {
+		return x.OGURL
+	}
+	return ""
+}
+
+func (x *SEOTemplateMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SEOTemplateMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSEOTemplatesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*SEOTemplateMsg `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListSEOTemplatesRes) Reset() {
+	*x = ListSEOTemplatesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSEOTemplatesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSEOTemplatesRes) ProtoMessage() {}
+
+func (x *ListSEOTemplatesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSEOTemplatesRes.ProtoReflect.Descriptor instead.
+func (*ListSEOTemplatesRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSEOTemplatesRes) GetTemplates() []*SEOTemplateMsg {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type SaveSEOTemplateRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created bool `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *SaveSEOTemplateRes) Reset() {
+	*x = SaveSEOTemplateRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSEOTemplateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSEOTemplateRes) ProtoMessage() {}
+
+func (x *SaveSEOTemplateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSEOTemplateRes.ProtoReflect.Descriptor instead.
+func (*SaveSEOTemplateRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SaveSEOTemplateRes) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type AlternateMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hreflang string `protobuf:"bytes,1,opt,name=hreflang,proto3" json:"hreflang,omitempty"`
+	Href     string `protobuf:"bytes,2,opt,name=href,proto3" json:"href,omitempty"`
+}
+
+func (x *AlternateMsg) Reset() {
+	*x = AlternateMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlternateMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlternateMsg) ProtoMessage() {}
+
+func (x *AlternateMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlternateMsg.ProtoReflect.Descriptor instead.
+func (*AlternateMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AlternateMsg) GetHreflang() string {
+	if x != nil {
+		return x.Hreflang
+	}
+	return ""
+}
+
+func (x *AlternateMsg) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+type ListAlternatesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alternates []*AlternateMsg `protobuf:"bytes,1,rep,name=alternates,proto3" json:"alternates,omitempty"`
+}
+
+func (x *ListAlternatesRes) Reset() {
+	*x = ListAlternatesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAlternatesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlternatesRes) ProtoMessage() {}
+
+func (x *ListAlternatesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlternatesRes.ProtoReflect.Descriptor instead.
+func (*ListAlternatesRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAlternatesRes) GetAlternates() []*AlternateMsg {
+	if x != nil {
+		return x.Alternates
+	}
+	return nil
+}
+
+type SEORevisionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pk   string `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	Id   uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SEORevisionReq) Reset() {
+	*x = SEORevisionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SEORevisionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SEORevisionReq) ProtoMessage() {}
+
+func (x *SEORevisionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SEORevisionReq.ProtoReflect.Descriptor instead.
+func (*SEORevisionReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SEORevisionReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SEORevisionReq) GetPk() string {
+	if x != nil {
+		return x.Pk
+	}
+	return ""
+}
+
+func (x *SEORevisionReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SEORevisionMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ObjName   string                 `protobuf:"bytes,2,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
+	ObjPk     string                 `protobuf:"bytes,3,opt,name=obj_pk,json=objPk,proto3" json:"obj_pk,omitempty"`
+	Locale    string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	Action    string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Author    string                 `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	Data      *SEOMsg                `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *SEORevisionMsg) Reset() {
+	*x = SEORevisionMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SEORevisionMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SEORevisionMsg) ProtoMessage() {}
+
+func (x *SEORevisionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SEORevisionMsg.ProtoReflect.Descriptor instead.
+func (*SEORevisionMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SEORevisionMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SEORevisionMsg) GetObjName() string {
+	if x != nil {
+		return x.ObjName
+	}
+	return ""
+}
+
+func (x *SEORevisionMsg) GetObjPk() string {
+	if x != nil {
+		return x.ObjPk
+	}
+	return ""
+}
+
+func (x *SEORevisionMsg) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SEORevisionMsg) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SEORevisionMsg) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SEORevisionMsg) GetData() *SEOMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SEORevisionMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSEORevisionsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*SEORevisionMsg `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *ListSEORevisionsRes) Reset() {
+	*x = ListSEORevisionsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSEORevisionsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSEORevisionsRes) ProtoMessage() {}
+
+func (x *ListSEORevisionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSEORevisionsRes.ProtoReflect.Descriptor instead.
+func (*ListSEORevisionsRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSEORevisionsRes) GetRevisions() []*SEORevisionMsg {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type DiffSEORevisionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pk   string `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	From uint64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   uint64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DiffSEORevisionsReq) Reset() {
+	*x = DiffSEORevisionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSEORevisionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSEORevisionsReq) ProtoMessage() {}
+
+func (x *DiffSEORevisionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSEORevisionsReq.ProtoReflect.Descriptor instead.
+func (*DiffSEORevisionsReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DiffSEORevisionsReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffSEORevisionsReq) GetPk() string {
+	if x != nil {
+		return x.Pk
+	}
+	return ""
+}
+
+func (x *DiffSEORevisionsReq) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DiffSEORevisionsReq) GetTo() uint64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type FieldDiffMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string          `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From  *structpb.Value `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To    *structpb.Value `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *FieldDiffMsg) Reset() {
+	*x = FieldDiffMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldDiffMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiffMsg) ProtoMessage() {}
+
+func (x *FieldDiffMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiffMsg.ProtoReflect.Descriptor instead.
+func (*FieldDiffMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FieldDiffMsg) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldDiffMsg) GetFrom() *structpb.Value {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *FieldDiffMsg) GetTo() *structpb.Value {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type DiffSEORevisionsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*FieldDiffMsg `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *DiffSEORevisionsRes) Reset() {
+	*x = DiffSEORevisionsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSEORevisionsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSEORevisionsRes) ProtoMessage() {}
+
+func (x *DiffSEORevisionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSEORevisionsRes.ProtoReflect.Descriptor instead.
+func (*DiffSEORevisionsRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DiffSEORevisionsRes) GetChanges() []*FieldDiffMsg {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type WatchSEOReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjName     string `protobuf:"bytes,1,opt,name=obj_name,json=objName,proto3" json:"obj_name,omitempty"`
+	LastEventId uint64 `protobuf:"varint,2,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+}
+
+func (x *WatchSEOReq) Reset() {
+	*x = WatchSEOReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSEOReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSEOReq) ProtoMessage() {}
+
+func (x *WatchSEOReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSEOReq.ProtoReflect.Descriptor instead.
+func (*WatchSEOReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WatchSEOReq) GetObjName() string {
+	if x != nil {
+		return x.ObjName
+	}
+	return ""
+}
+
+func (x *WatchSEOReq) GetLastEventId() uint64 {
+	if x != nil {
+		return x.LastEventId
+	}
+	return 0
+}
+
+type SEOChangeMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event      string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Uid        string                 `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Before     *SEOMsg                `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After      *SEOMsg                `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *SEOChangeMsg) Reset() {
+	*x = SEOChangeMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SEOChangeMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SEOChangeMsg) ProtoMessage() {}
+
+func (x *SEOChangeMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SEOChangeMsg.ProtoReflect.Descriptor instead.
+func (*SEOChangeMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SEOChangeMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SEOChangeMsg) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *SEOChangeMsg) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SEOChangeMsg) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *SEOChangeMsg) GetBefore() *SEOMsg {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *SEOChangeMsg) GetAfter() *SEOMsg {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type WatchPagesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlugPrefix  string `protobuf:"bytes,1,opt,name=slug_prefix,json=slugPrefix,proto3" json:"slug_prefix,omitempty"`
+	LastEventId uint64 `protobuf:"varint,2,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+}
+
+func (x *WatchPagesReq) Reset() {
+	*x = WatchPagesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPagesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPagesReq) ProtoMessage() {}
+
+func (x *WatchPagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPagesReq.ProtoReflect.Descriptor instead.
+func (*WatchPagesReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchPagesReq) GetSlugPrefix() string {
+	if x != nil {
+		return x.SlugPrefix
+	}
+	return ""
+}
+
+func (x *WatchPagesReq) GetLastEventId() uint64 {
+	if x != nil {
+		return x.LastEventId
+	}
+	return 0
+}
+
+type PageChangeMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event      string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Uid        string                 `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Before     *PageMsg               `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After      *PageMsg               `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *PageChangeMsg) Reset() {
+	*x = PageChangeMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageChangeMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageChangeMsg) ProtoMessage() {}
+
+func (x *PageChangeMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageChangeMsg.ProtoReflect.Descriptor instead.
+func (*PageChangeMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PageChangeMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PageChangeMsg) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *PageChangeMsg) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PageChangeMsg) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *PageChangeMsg) GetBefore() *PageMsg {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PageChangeMsg) GetAfter() *PageMsg {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type ListPageRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pages       []*PageMsg `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty"`
+	Count       int64      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int32      `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int32      `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool       `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	NextCursor  string     `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListPageRes) Reset() {
+	*x = ListPageRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPageRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPageRes) ProtoMessage() {}
+
+func (x *ListPageRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPageRes.ProtoReflect.Descriptor instead.
+func (*ListPageRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListPageRes) GetPages() []*PageMsg {
+	if x != nil {
+		return x.Pages
+	}
+	return nil
+}
+
+func (x *ListPageRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ListPageRes) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListPageRes) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *ListPageRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListPageRes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PageMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug       string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Href       string                 `protobuf:"bytes,3,opt,name=href,proto3" json:"href,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Changefreq string                 `protobuf:"bytes,6,opt,name=changefreq,proto3" json:"changefreq,omitempty"`
+	Priority   float64                `protobuf:"fixed64,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Status     string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	ParentSlug string                 `protobuf:"bytes,10,opt,name=parent_slug,json=parentSlug,proto3" json:"parent_slug,omitempty"`
+	Position   int32                  `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *PageMsg) Reset() {
+	*x = PageMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageMsg) ProtoMessage() {}
+
+func (x *PageMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageMsg.ProtoReflect.Descriptor instead.
+func (*PageMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PageMsg) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PageMsg) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PageMsg) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+func (x *PageMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PageMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PageMsg) GetChangefreq() string {
+	if x != nil {
+		return x.Changefreq
+	}
+	return ""
+}
+
+func (x *PageMsg) GetPriority() float64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PageMsg) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PageMsg) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *PageMsg) GetParentSlug() string {
+	if x != nil {
+		return x.ParentSlug
+	}
+	return ""
+}
+
+func (x *PageMsg) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type PageWithSlugMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug string   `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Page *PageMsg `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *PageWithSlugMsg) Reset() {
+	*x = PageWithSlugMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageWithSlugMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageWithSlugMsg) ProtoMessage() {}
+
+func (x *PageWithSlugMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageWithSlugMsg.ProtoReflect.Descriptor instead.
+func (*PageWithSlugMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PageWithSlugMsg) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PageWithSlugMsg) GetPage() *PageMsg {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type PageTreeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preview bool `protobuf:"varint,1,opt,name=preview,proto3" json:"preview,omitempty"`
+}
+
+func (x *PageTreeReq) Reset() {
+	*x = PageTreeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageTreeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageTreeReq) ProtoMessage() {}
+
+func (x *PageTreeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageTreeReq.ProtoReflect.Descriptor instead.
+func (*PageTreeReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PageTreeReq) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+type PageNodeMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug     string         `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title    string         `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Href     string         `protobuf:"bytes,3,opt,name=href,proto3" json:"href,omitempty"`
+	Position int32          `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Status   string         `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Children []*PageNodeMsg `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *PageNodeMsg) Reset() {
+	*x = PageNodeMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageNodeMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageNodeMsg) ProtoMessage() {}
+
+func (x *PageNodeMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageNodeMsg.ProtoReflect.Descriptor instead.
+func (*PageNodeMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PageNodeMsg) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PageNodeMsg) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PageNodeMsg) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+func (x *PageNodeMsg) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PageNodeMsg) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PageNodeMsg) GetChildren() []*PageNodeMsg {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type PageTreeRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*PageNodeMsg `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *PageTreeRes) Reset() {
+	*x = PageTreeRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageTreeRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageTreeRes) ProtoMessage() {}
+
+func (x *PageTreeRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageTreeRes.ProtoReflect.Descriptor instead.
+func (*PageTreeRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PageTreeRes) GetNodes() []*PageNodeMsg {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type BreadcrumbMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug  string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Href  string `protobuf:"bytes,3,opt,name=href,proto3" json:"href,omitempty"`
+	Url   string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *BreadcrumbMsg) Reset() {
+	*x = BreadcrumbMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreadcrumbMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreadcrumbMsg) ProtoMessage() {}
+
+func (x *BreadcrumbMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreadcrumbMsg.ProtoReflect.Descriptor instead.
+func (*BreadcrumbMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BreadcrumbMsg) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *BreadcrumbMsg) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BreadcrumbMsg) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+func (x *BreadcrumbMsg) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type BreadcrumbsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items  []*BreadcrumbMsg `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	JsonLd *structpb.Struct `protobuf:"bytes,2,opt,name=json_ld,json=jsonLd,proto3" json:"json_ld,omitempty"`
+}
+
+func (x *BreadcrumbsRes) Reset() {
+	*x = BreadcrumbsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreadcrumbsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreadcrumbsRes) ProtoMessage() {}
+
+func (x *BreadcrumbsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreadcrumbsRes.ProtoReflect.Descriptor instead.
+func (*BreadcrumbsRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BreadcrumbsRes) GetItems() []*BreadcrumbMsg {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BreadcrumbsRes) GetJsonLd() *structpb.Struct {
+	if x != nil {
+		return x.JsonLd
+	}
+	return nil
+}
+
+type ResolvePathReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Locale  string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Preview bool   `protobuf:"varint,3,opt,name=preview,proto3" json:"preview,omitempty"`
+}
+
+func (x *ResolvePathReq) Reset() {
+	*x = ResolvePathReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolvePathReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePathReq) ProtoMessage() {}
+
+func (x *ResolvePathReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePathReq.ProtoReflect.Descriptor instead.
+func (*ResolvePathReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResolvePathReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ResolvePathReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ResolvePathReq) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+type ResolvePathRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page *PageMsg `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Seo  *SEOMsg  `protobuf:"bytes,2,opt,name=seo,proto3" json:"seo,omitempty"`
+}
+
+func (x *ResolvePathRes) Reset() {
+	*x = ResolvePathRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolvePathRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePathRes) ProtoMessage() {}
+
+func (x *ResolvePathRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePathRes.ProtoReflect.Descriptor instead.
+func (*ResolvePathRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ResolvePathRes) GetPage() *PageMsg {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ResolvePathRes) GetSeo() *SEOMsg {
+	if x != nil {
+		return x.Seo
+	}
+	return nil
+}
+
+type RedirectMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source    string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Target    string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Code      int32                  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Regex     bool                   `protobuf:"varint,5,opt,name=regex,proto3" json:"regex,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *RedirectMsg) Reset() {
+	*x = RedirectMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectMsg) ProtoMessage() {}
+
+func (x *RedirectMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectMsg.ProtoReflect.Descriptor instead.
+func (*RedirectMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RedirectMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RedirectMsg) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RedirectMsg) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *RedirectMsg) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RedirectMsg) GetRegex() bool {
+	if x != nil {
+		return x.Regex
+	}
+	return false
+}
+
+func (x *RedirectMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RedirectMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListRedirectRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Redirects []*RedirectMsg `protobuf:"bytes,1,rep,name=redirects,proto3" json:"redirects,omitempty"`
+}
+
+func (x *ListRedirectRes) Reset() {
+	*x = ListRedirectRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRedirectRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedirectRes) ProtoMessage() {}
+
+func (x *ListRedirectRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedirectRes.ProtoReflect.Descriptor instead.
+func (*ListRedirectRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListRedirectRes) GetRedirects() []*RedirectMsg {
+	if x != nil {
+		return x.Redirects
+	}
+	return nil
+}
+
+type CreateRedirectRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Chain []string `protobuf:"bytes,2,rep,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (x *CreateRedirectRes) Reset() {
+	*x = CreateRedirectRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRedirectRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRedirectRes) ProtoMessage() {}
+
+func (x *CreateRedirectRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRedirectRes.ProtoReflect.Descriptor instead.
+func (*CreateRedirectRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateRedirectRes) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateRedirectRes) GetChain() []string {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+type ResolveRedirectReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ResolveRedirectReq) Reset() {
+	*x = ResolveRedirectReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveRedirectReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRedirectReq) ProtoMessage() {}
+
+func (x *ResolveRedirectReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRedirectReq.ProtoReflect.Descriptor instead.
+func (*ResolveRedirectReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResolveRedirectReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ResolveRedirectRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source string   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target string   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Code   int32    `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Hops   int32    `protobuf:"varint,4,opt,name=hops,proto3" json:"hops,omitempty"`
+	Chain  []string `protobuf:"bytes,5,rep,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (x *ResolveRedirectRes) Reset() {
+	*x = ResolveRedirectRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveRedirectRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRedirectRes) ProtoMessage() {}
+
+func (x *ResolveRedirectRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRedirectRes.ProtoReflect.Descriptor instead.
+func (*ResolveRedirectRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ResolveRedirectRes) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ResolveRedirectRes) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ResolveRedirectRes) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ResolveRedirectRes) GetHops() int32 {
+	if x != nil {
+		return x.Hops
+	}
+	return 0
+}
+
+func (x *ResolveRedirectRes) GetChain() []string {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+type ExportRedirectsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExportRedirectsReq) Reset() {
+	*x = ExportRedirectsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRedirectsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRedirectsReq) ProtoMessage() {}
+
+func (x *ExportRedirectsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRedirectsReq.ProtoReflect.Descriptor instead.
+func (*ExportRedirectsReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportRedirectsReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportRedirectsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ExportRedirectsRes) Reset() {
+	*x = ExportRedirectsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRedirectsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRedirectsRes) ProtoMessage() {}
+
+func (x *ExportRedirectsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_seo_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRedirectsRes.ProtoReflect.Descriptor instead.
+func (*ExportRedirectsRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_seo_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExportRedirectsRes) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_api_grpc_v1_gen_seo_proto protoreflect.FileDescriptor
+
+var file_api_grpc_v1_gen_seo_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x73, 0x65, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x67, 0x65, 0x6e,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x0a, 0x0a, 0x08, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x22, 0x1b, 0x0a, 0x09, 0x75,
+	0x75, 0x69, 0x64, 0x36, 0x34, 0x53, 0x45, 0x4f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x07, 0x73, 0x6c, 0x75, 0x67,
+	0x53, 0x45, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0xc8, 0x03,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x72, 0x65, 0x66,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x72, 0x65, 0x66, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x3d,
+	0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x22, 0x4f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x70,
+	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xcd, 0x09, 0x0a, 0x06, 0x53, 0x45,
+	0x4f, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x47, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x47, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x4f, 0x47, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4f, 0x47, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x47, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x47, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x62, 0x6a, 0x50, 0x6b, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x64, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x6a, 0x73,
+	0x6f, 0x6e, 0x4c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x47, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x47, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x4f, 0x47, 0x55, 0x52, 0x4c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x47, 0x55,
+	0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x47, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x47, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4f, 0x47, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x4f, 0x47, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x4f, 0x47, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x4f, 0x47, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x4f, 0x47, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x4f, 0x47, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x47, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x41, 0x6c, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x47,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x47, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f,
+	0x47, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a, 0x16, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x15,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x18,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x1a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x61, 0x67, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x43, 0x61, 0x72, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x74, 0x65,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53,
+	0x69, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6c,
+	0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x22, 0x8c, 0x03, 0x0a, 0x0a, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6b, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6b, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0xca, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x65, 0x6f, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73,
+	0x67, 0x52, 0x03, 0x73, 0x65, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x45, 0x4f, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x65, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67, 0x52,
+	0x03, 0x73, 0x65, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x22, 0x55, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x6f, 0x0a, 0x11, 0x41, 0x75, 0x64, 0x69, 0x74, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x0b, 0x53, 0x45, 0x4f, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x62, 0x6a, 0x50, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x67, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x2b, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x88, 0x02, 0x0a,
+	0x14, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x49, 0x0a,
+	0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x2e, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x76, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x56, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf9, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x76, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x33, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xe4, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2c, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x45, 0x4f, 0x52, 0x65, 0x71, 0x2e, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x1a, 0x37, 0x0a, 0x09, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x48,
+	0x65, 0x61, 0x64, 0x54, 0x61, 0x67, 0x4d, 0x73, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x72, 0x65, 0x66, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x72, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0x45, 0x0a, 0x0a, 0x53, 0x45, 0x4f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x74, 0x6d, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x54, 0x61,
+	0x67, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x43, 0x0a, 0x0e, 0x53, 0x45,
+	0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x95, 0x03, 0x0a, 0x0e, 0x53, 0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d,
+	0x73, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x47, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x47, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x4f, 0x47, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4f, 0x47, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x47, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x47, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x47, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x47, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4f,
+	0x47, 0x55, 0x52, 0x4c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x47, 0x55, 0x52,
+	0x4c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0x2e, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x53, 0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x3e, 0x0a, 0x0c, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x4d, 0x73,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x72, 0x65, 0x66, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x72, 0x65, 0x66, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x72, 0x65,
+	0x66, 0x22, 0x46, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x0a, 0x61,
+	0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x0e, 0x53, 0x45, 0x4f,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x70, 0x6b, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xf6, 0x01, 0x0a, 0x0e, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x62, 0x6a, 0x50, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12,
+	0x31, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x5d, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74,
+	0x6f, 0x22, 0x78, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x73,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x26, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x13, 0x44,
+	0x69, 0x66, 0x66, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44,
+	0x69, 0x66, 0x66, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22,
+	0x4c, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x71, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xcb, 0x01,
+	0x0a, 0x0c, 0x53, 0x45, 0x4f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67,
+	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45,
+	0x4f, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x0d, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6c, 0x75, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x6c, 0x75, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x22, 0x0a,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0xce, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x22,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x22, 0xd0, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x52,
+	0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x89, 0x03, 0x0a, 0x07, 0x50, 0x61, 0x67, 0x65, 0x4d, 0x73,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x72, 0x65, 0x66, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x66,
+	0x72, 0x65, 0x71, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x66, 0x72, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x6c, 0x75, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x47, 0x0a, 0x0f, 0x50, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6c, 0x75,
+	0x67, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x72, 0x65,
+	0x66, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x22, 0x35, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x4d, 0x73, 0x67, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x0d, 0x42, 0x72,
+	0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x72, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x6c, 0x0a, 0x0e, 0x42,
+	0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x73, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x4d, 0x73, 0x67,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x06, 0x6a, 0x73, 0x6f, 0x6e, 0x4c, 0x64, 0x22, 0x56, 0x0a, 0x0e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x22, 0x51, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67, 0x52,
+	0x03, 0x73, 0x65, 0x6f, 0x22, 0xed, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x41, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x09, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x22, 0x28, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x82, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x22, 0x2c, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22,
+	0x2e, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x32,
+	0xad, 0x08, 0x0a, 0x03, 0x53, 0x45, 0x4f, 0x12, 0x2b, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x45, 0x4f, 0x12, 0x0f, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x45, 0x4f,
+	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x45,
+	0x4f, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x12, 0x0e,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x71, 0x1a, 0x0b,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67, 0x12, 0x30, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x45, 0x4f, 0x12, 0x0b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x45, 0x4f, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
+	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x45, 0x4f, 0x12, 0x0b, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x12, 0x2a, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x45, 0x4f, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f,
+	0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53,
+	0x45, 0x4f, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x41, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x45, 0x4f, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x48, 0x65, 0x61, 0x64, 0x12, 0x0e, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65,
+	0x71, 0x1a, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x45, 0x4f, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x46, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x53,
+	0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x45, 0x4f, 0x12, 0x13,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67,
+	0x12, 0x33, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x45, 0x4f, 0x12, 0x11, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x71,
+	0x1a, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x45, 0x4f,
+	0x52, 0x65, 0x73, 0x28, 0x01, 0x12, 0x2b, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x45, 0x4f, 0x12, 0x0f, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x45, 0x4f,
+	0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x4d, 0x73, 0x67,
+	0x30, 0x01, 0x12, 0x2c, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x45, 0x4f, 0x12, 0x0e,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67,
+	0x12, 0x37, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0d, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x1a, 0x18, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x45, 0x4f,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d,
+	0x73, 0x67, 0x12, 0x3f, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x53, 0x45, 0x4f, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x45, 0x4f, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x17, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x45, 0x4f,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x45, 0x4f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x12, 0x31, 0x0a, 0x08,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x45, 0x4f, 0x12, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x45, 0x4f, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x45, 0x4f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x30, 0x01, 0x32,
+	0xc0, 0x03, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x6c, 0x75, 0x67,
+	0x53, 0x45, 0x4f, 0x1a, 0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4d, 0x73,
+	0x67, 0x12, 0x28, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x0c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x0c, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x53, 0x45, 0x4f, 0x12, 0x31, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a,
+	0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x12, 0x29,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x53, 0x45, 0x4f, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x12, 0x31, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x72, 0x65, 0x65, 0x12, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x73, 0x12, 0x0c,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x53, 0x45, 0x4f, 0x1a, 0x13, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x73, 0x52, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67,
+	0x30, 0x01, 0x32, 0x9b, 0x03, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12,
+	0x34, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73,
+	0x12, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x1a,
+	0x14, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x36,
+	0x34, 0x53, 0x45, 0x4f, 0x1a, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x3a, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x12, 0x31, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x12, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x53, 0x45, 0x4f, 0x12, 0x2f, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x75, 0x75,
+	0x69, 0x64, 0x36, 0x34, 0x53, 0x45, 0x4f, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x53, 0x45, 0x4f, 0x12, 0x43, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x17, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12, 0x17,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4a,
+	0x4d, 0x55, 0x52, 0x76, 0x2f, 0x73, 0x65, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_grpc_v1_gen_seo_proto_rawDescOnce sync.Once
+	file_api_grpc_v1_gen_seo_proto_rawDescData = file_api_grpc_v1_gen_seo_proto_rawDesc
+)
+
+func file_api_grpc_v1_gen_seo_proto_rawDescGZIP() []byte {
+	file_api_grpc_v1_gen_seo_proto_rawDescOnce.Do(func() {
+		file_api_grpc_v1_gen_seo_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_grpc_v1_gen_seo_proto_rawDescData)
+	})
+	return file_api_grpc_v1_gen_seo_proto_rawDescData
+}
+
+var file_api_grpc_v1_gen_seo_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_api_grpc_v1_gen_seo_proto_goTypes = []any{
+	(*EmptySEO)(nil),              // 0: gen.EmptySEO
+	(*Uuid64SEO)(nil),             // 1: gen.uuid64SEO
+	(*SlugSEO)(nil),               // 2: gen.slugSEO
+	(*ListPagesReq)(nil),          // 3: gen.ListPagesReq
+	(*CreateSEOResponse)(nil),     // 4: gen.CreateSEOResponse
+	(*SEOMsg)(nil),                // 5: gen.SEOMsg
+	(*ListSEOReq)(nil),            // 6: gen.ListSEOReq
+	(*ListSEORes)(nil),            // 7: gen.ListSEORes
+	(*ImportSEOReq)(nil),          // 8: gen.ImportSEOReq
+	(*ImportRowErrorMsg)(nil),     // 9: gen.ImportRowErrorMsg
+	(*ImportSEORes)(nil),          // 10: gen.ImportSEORes
+	(*AuditViolationMsg)(nil),     // 11: gen.AuditViolationMsg
+	(*SEOAuditMsg)(nil),           // 12: gen.SEOAuditMsg
+	(*AuditReportReq)(nil),        // 13: gen.AuditReportReq
+	(*AuditObjectReportMsg)(nil),  // 14: gen.AuditObjectReportMsg
+	(*AuditReportRes)(nil),        // 15: gen.AuditReportRes
+	(*GetSEOReq)(nil),             // 16: gen.GetSEOReq
+	(*HeadTagMsg)(nil),            // 17: gen.HeadTagMsg
+	(*SEOHeadRes)(nil),            // 18: gen.SEOHeadRes
+	(*SEOTemplateReq)(nil),        // 19: gen.SEOTemplateReq
+	(*SEOTemplateMsg)(nil),        // 20: gen.SEOTemplateMsg
+	(*ListSEOTemplatesRes)(nil),   // 21: gen.ListSEOTemplatesRes
+	(*SaveSEOTemplateRes)(nil),    // 22: gen.SaveSEOTemplateRes
+	(*AlternateMsg)(nil),          // 23: gen.AlternateMsg
+	(*ListAlternatesRes)(nil),     // 24: gen.ListAlternatesRes
+	(*SEORevisionReq)(nil),        // 25: gen.SEORevisionReq
+	(*SEORevisionMsg)(nil),        // 26: gen.SEORevisionMsg
+	(*ListSEORevisionsRes)(nil),   // 27: gen.ListSEORevisionsRes
+	(*DiffSEORevisionsReq)(nil),   // 28: gen.DiffSEORevisionsReq
+	(*FieldDiffMsg)(nil),          // 29: gen.FieldDiffMsg
+	(*DiffSEORevisionsRes)(nil),   // 30: gen.DiffSEORevisionsRes
+	(*WatchSEOReq)(nil),           // 31: gen.WatchSEOReq
+	(*SEOChangeMsg)(nil),          // 32: gen.SEOChangeMsg
+	(*WatchPagesReq)(nil),         // 33: gen.WatchPagesReq
+	(*PageChangeMsg)(nil),         // 34: gen.PageChangeMsg
+	(*ListPageRes)(nil),           // 35: gen.ListPageRes
+	(*PageMsg)(nil),               // 36: gen.PageMsg
+	(*PageWithSlugMsg)(nil),       // 37: gen.PageWithSlugMsg
+	(*PageTreeReq)(nil),           // 38: gen.PageTreeReq
+	(*PageNodeMsg)(nil),           // 39: gen.PageNodeMsg
+	(*PageTreeRes)(nil),           // 40: gen.PageTreeRes
+	(*BreadcrumbMsg)(nil),         // 41: gen.BreadcrumbMsg
+	(*BreadcrumbsRes)(nil),        // 42: gen.BreadcrumbsRes
+	(*ResolvePathReq)(nil),        // 43: gen.ResolvePathReq
+	(*ResolvePathRes)(nil),        // 44: gen.ResolvePathRes
+	(*RedirectMsg)(nil),           // 45: gen.RedirectMsg
+	(*ListRedirectRes)(nil),       // 46: gen.ListRedirectRes
+	(*CreateRedirectRes)(nil),     // 47: gen.CreateRedirectRes
+	(*ResolveRedirectReq)(nil),    // 48: gen.ResolveRedirectReq
+	(*ResolveRedirectRes)(nil),    // 49: gen.ResolveRedirectRes
+	(*ExportRedirectsReq)(nil),    // 50: gen.ExportRedirectsReq
+	(*ExportRedirectsRes)(nil),    // 51: gen.ExportRedirectsRes
+	nil,                           // 52: gen.AuditObjectReportMsg.ViolationsEntry
+	nil,                           // 53: gen.AuditReportRes.ViolationsEntry
+	nil,                           // 54: gen.GetSEOReq.VarsEntry
+	(*timestamppb.Timestamp)(nil), // 55: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 56: google.protobuf.Struct
+	(*structpb.Value)(nil),        // 57: google.protobuf.Value
+}
+var file_api_grpc_v1_gen_seo_proto_depIdxs = []int32{
+	55, // 0: gen.ListPagesReq.created_from:type_name -> google.protobuf.Timestamp
+	55, // 1: gen.ListPagesReq.created_to:type_name -> google.protobuf.Timestamp
+	55, // 2: gen.ListPagesReq.updated_from:type_name -> google.protobuf.Timestamp
+	55, // 3: gen.ListPagesReq.updated_to:type_name -> google.protobuf.Timestamp
+	55, // 4: gen.SEOMsg.created_at:type_name -> google.protobuf.Timestamp
+	55, // 5: gen.SEOMsg.updated_at:type_name -> google.protobuf.Timestamp
+	56, // 6: gen.SEOMsg.json_ld:type_name -> google.protobuf.Struct
+	55, // 7: gen.SEOMsg.article_published_time:type_name -> google.protobuf.Timestamp
+	55, // 8: gen.SEOMsg.article_modified_time:type_name -> google.protobuf.Timestamp
+	55, // 9: gen.SEOMsg.publish_at:type_name -> google.protobuf.Timestamp
+	55, // 10: gen.ListSEOReq.updated_from:type_name -> google.protobuf.Timestamp
+	55, // 11: gen.ListSEOReq.updated_to:type_name -> google.protobuf.Timestamp
+	5,  // 12: gen.ListSEORes.seo:type_name -> gen.SEOMsg
+	5,  // 13: gen.ImportSEOReq.seo:type_name -> gen.SEOMsg
+	9,  // 14: gen.ImportSEORes.errors:type_name -> gen.ImportRowErrorMsg
+	11, // 15: gen.SEOAuditMsg.violations:type_name -> gen.AuditViolationMsg
+	52, // 16: gen.AuditObjectReportMsg.violations:type_name -> gen.AuditObjectReportMsg.ViolationsEntry
+	53, // 17: gen.AuditReportRes.violations:type_name -> gen.AuditReportRes.ViolationsEntry
+	14, // 18: gen.AuditReportRes.objects:type_name -> gen.AuditObjectReportMsg
+	54, // 19: gen.GetSEOReq.vars:type_name -> gen.GetSEOReq.VarsEntry
+	17, // 20: gen.SEOHeadRes.tags:type_name -> gen.HeadTagMsg
+	55, // 21: gen.SEOTemplateMsg.created_at:type_name -> google.protobuf.Timestamp
+	55, // 22: gen.SEOTemplateMsg.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 23: gen.ListSEOTemplatesRes.templates:type_name -> gen.SEOTemplateMsg
+	23, // 24: gen.ListAlternatesRes.alternates:type_name -> gen.AlternateMsg
+	5,  // 25: gen.SEORevisionMsg.data:type_name -> gen.SEOMsg
+	55, // 26: gen.SEORevisionMsg.created_at:type_name -> google.protobuf.Timestamp
+	26, // 27: gen.ListSEORevisionsRes.revisions:type_name -> gen.SEORevisionMsg
+	57, // 28: gen.FieldDiffMsg.from:type_name -> google.protobuf.Value
+	57, // 29: gen.FieldDiffMsg.to:type_name -> google.protobuf.Value
+	29, // 30: gen.DiffSEORevisionsRes.changes:type_name -> gen.FieldDiffMsg
+	55, // 31: gen.SEOChangeMsg.occurred_at:type_name -> google.protobuf.Timestamp
+	5,  // 32: gen.SEOChangeMsg.before:type_name -> gen.SEOMsg
+	5,  // 33: gen.SEOChangeMsg.after:type_name -> gen.SEOMsg
+	55, // 34: gen.PageChangeMsg.occurred_at:type_name -> google.protobuf.Timestamp
+	36, // 35: gen.PageChangeMsg.before:type_name -> gen.PageMsg
+	36, // 36: gen.PageChangeMsg.after:type_name -> gen.PageMsg
+	36, // 37: gen.ListPageRes.pages:type_name -> gen.PageMsg
+	55, // 38: gen.PageMsg.created_at:type_name -> google.protobuf.Timestamp
+	55, // 39: gen.PageMsg.updated_at:type_name -> google.protobuf.Timestamp
+	55, // 40: gen.PageMsg.publish_at:type_name -> google.protobuf.Timestamp
+	36, // 41: gen.PageWithSlugMsg.page:type_name -> gen.PageMsg
+	39, // 42: gen.PageNodeMsg.children:type_name -> gen.PageNodeMsg
+	39, // 43: gen.PageTreeRes.nodes:type_name -> gen.PageNodeMsg
+	41, // 44: gen.BreadcrumbsRes.items:type_name -> gen.BreadcrumbMsg
+	56, // 45: gen.BreadcrumbsRes.json_ld:type_name -> google.protobuf.Struct
+	36, // 46: gen.ResolvePathRes.page:type_name -> gen.PageMsg
+	5,  // 47: gen.ResolvePathRes.seo:type_name -> gen.SEOMsg
+	55, // 48: gen.RedirectMsg.created_at:type_name -> google.protobuf.Timestamp
+	55, // 49: gen.RedirectMsg.updated_at:type_name -> google.protobuf.Timestamp
+	45, // 50: gen.ListRedirectRes.redirects:type_name -> gen.RedirectMsg
+	6,  // 51: gen.SEO.ListSEO:input_type -> gen.ListSEOReq
+	16, // 52: gen.SEO.GetSEO:input_type -> gen.GetSEOReq
+	5,  // 53: gen.SEO.CreateSEO:input_type -> gen.SEOMsg
+	5,  // 54: gen.SEO.UpdateSEO:input_type -> gen.SEOMsg
+	16, // 55: gen.SEO.DeleteSEO:input_type -> gen.GetSEOReq
+	16, // 56: gen.SEO.GetSEOAlternates:input_type -> gen.GetSEOReq
+	16, // 57: gen.SEO.GetSEOHead:input_type -> gen.GetSEOReq
+	16, // 58: gen.SEO.ListSEORevisions:input_type -> gen.GetSEOReq
+	25, // 59: gen.SEO.GetSEORevision:input_type -> gen.SEORevisionReq
+	28, // 60: gen.SEO.DiffSEORevisions:input_type -> gen.DiffSEORevisionsReq
+	25, // 61: gen.SEO.RollbackSEO:input_type -> gen.SEORevisionReq
+	8,  // 62: gen.SEO.ImportSEO:input_type -> gen.ImportSEOReq
+	6,  // 63: gen.SEO.ExportSEO:input_type -> gen.ListSEOReq
+	16, // 64: gen.SEO.AuditSEO:input_type -> gen.GetSEOReq
+	13, // 65: gen.SEO.AuditReport:input_type -> gen.AuditReportReq
+	0,  // 66: gen.SEO.ListSEOTemplates:input_type -> gen.EmptySEO
+	19, // 67: gen.SEO.GetSEOTemplate:input_type -> gen.SEOTemplateReq
+	20, // 68: gen.SEO.SaveSEOTemplate:input_type -> gen.SEOTemplateMsg
+	19, // 69: gen.SEO.DeleteSEOTemplate:input_type -> gen.SEOTemplateReq
+	31, // 70: gen.SEO.WatchSEO:input_type -> gen.WatchSEOReq
+	3,  // 71: gen.Page.ListPages:input_type -> gen.ListPagesReq
+	2,  // 72: gen.Page.GetPage:input_type -> gen.slugSEO
+	36, // 73: gen.Page.CreatePage:input_type -> gen.PageMsg
+	37, // 74: gen.Page.UpdatePage:input_type -> gen.PageWithSlugMsg
+	2,  // 75: gen.Page.DeletePage:input_type -> gen.slugSEO
+	38, // 76: gen.Page.GetPageTree:input_type -> gen.PageTreeReq
+	2,  // 77: gen.Page.GetBreadcrumbs:input_type -> gen.slugSEO
+	43, // 78: gen.Page.ResolvePath:input_type -> gen.ResolvePathReq
+	33, // 79: gen.Page.WatchPages:input_type -> gen.WatchPagesReq
+	0,  // 80: gen.Redirect.ListRedirects:input_type -> gen.EmptySEO
+	1,  // 81: gen.Redirect.GetRedirect:input_type -> gen.uuid64SEO
+	45, // 82: gen.Redirect.CreateRedirect:input_type -> gen.RedirectMsg
+	45, // 83: gen.Redirect.UpdateRedirect:input_type -> gen.RedirectMsg
+	1,  // 84: gen.Redirect.DeleteRedirect:input_type -> gen.uuid64SEO
+	48, // 85: gen.Redirect.ResolveRedirect:input_type -> gen.ResolveRedirectReq
+	50, // 86: gen.Redirect.ExportRedirects:input_type -> gen.ExportRedirectsReq
+	7,  // 87: gen.SEO.ListSEO:output_type -> gen.ListSEORes
+	5,  // 88: gen.SEO.GetSEO:output_type -> gen.SEOMsg
+	4,  // 89: gen.SEO.CreateSEO:output_type -> gen.CreateSEOResponse
+	0,  // 90: gen.SEO.UpdateSEO:output_type -> gen.EmptySEO
+	0,  // 91: gen.SEO.DeleteSEO:output_type -> gen.EmptySEO
+	24, // 92: gen.SEO.GetSEOAlternates:output_type -> gen.ListAlternatesRes
+	18, // 93: gen.SEO.GetSEOHead:output_type -> gen.SEOHeadRes
+	27, // 94: gen.SEO.ListSEORevisions:output_type -> gen.ListSEORevisionsRes
+	26, // 95: gen.SEO.GetSEORevision:output_type -> gen.SEORevisionMsg
+	30, // 96: gen.SEO.DiffSEORevisions:output_type -> gen.DiffSEORevisionsRes
+	5,  // 97: gen.SEO.RollbackSEO:output_type -> gen.SEOMsg
+	10, // 98: gen.SEO.ImportSEO:output_type -> gen.ImportSEORes
+	5,  // 99: gen.SEO.ExportSEO:output_type -> gen.SEOMsg
+	12, // 100: gen.SEO.AuditSEO:output_type -> gen.SEOAuditMsg
+	15, // 101: gen.SEO.AuditReport:output_type -> gen.AuditReportRes
+	21, // 102: gen.SEO.ListSEOTemplates:output_type -> gen.ListSEOTemplatesRes
+	20, // 103: gen.SEO.GetSEOTemplate:output_type -> gen.SEOTemplateMsg
+	22, // 104: gen.SEO.SaveSEOTemplate:output_type -> gen.SaveSEOTemplateRes
+	0,  // 105: gen.SEO.DeleteSEOTemplate:output_type -> gen.EmptySEO
+	32, // 106: gen.SEO.WatchSEO:output_type -> gen.SEOChangeMsg
+	35, // 107: gen.Page.ListPages:output_type -> gen.ListPageRes
+	36, // 108: gen.Page.GetPage:output_type -> gen.PageMsg
+	2,  // 109: gen.Page.CreatePage:output_type -> gen.slugSEO
+	0,  // 110: gen.Page.UpdatePage:output_type -> gen.EmptySEO
+	0,  // 111: gen.Page.DeletePage:output_type -> gen.EmptySEO
+	40, // 112: gen.Page.GetPageTree:output_type -> gen.PageTreeRes
+	42, // 113: gen.Page.GetBreadcrumbs:output_type -> gen.BreadcrumbsRes
+	44, // 114: gen.Page.ResolvePath:output_type -> gen.ResolvePathRes
+	34, // 115: gen.Page.WatchPages:output_type -> gen.PageChangeMsg
+	46, // 116: gen.Redirect.ListRedirects:output_type -> gen.ListRedirectRes
+	45, // 117: gen.Redirect.GetRedirect:output_type -> gen.RedirectMsg
+	47, // 118: gen.Redirect.CreateRedirect:output_type -> gen.CreateRedirectRes
+	0,  // 119: gen.Redirect.UpdateRedirect:output_type -> gen.EmptySEO
+	0,  // 120: gen.Redirect.DeleteRedirect:output_type -> gen.EmptySEO
+	49, // 121: gen.Redirect.ResolveRedirect:output_type -> gen.ResolveRedirectRes
+	51, // 122: gen.Redirect.ExportRedirects:output_type -> gen.ExportRedirectsRes
+	87, // [87:123] is the sub-list for method output_type
+	51, // [51:87] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
+}
+
+func init() { file_api_grpc_v1_gen_seo_proto_init() }
+func file_api_grpc_v1_gen_seo_proto_init() {
+	if File_api_grpc_v1_gen_seo_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_grpc_v1_gen_seo_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*EmptySEO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Uuid64SEO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SlugSEO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPagesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateSEOResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SEOMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSEOReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSEORes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportSEOReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportRowErrorMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportSEORes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditViolationMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*SEOAuditMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditReportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditObjectReportMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditReportRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSEOReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*HeadTagMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*SEOHeadRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*SEOTemplateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*SEOTemplateMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSEOTemplatesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveSEOTemplateRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_api_grpc_v1_gen_seo_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSEOReq); i {
+		file_api_grpc_v1_gen_seo_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*AlternateMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -798,7 +5032,139 @@ func file_api_grpc_v1_gen_seo_proto_init() {
 				return nil
 			}
 		}
-		file_api_grpc_v1_gen_seo_proto_msgTypes[6].Exporter = func(v any, i int) any {
+		file_api_grpc_v1_gen_seo_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAlternatesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*SEORevisionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*SEORevisionMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSEORevisionsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*DiffSEORevisionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*FieldDiffMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*DiffSEORevisionsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchSEOReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*SEOChangeMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchPagesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*PageChangeMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[35].Exporter = func(v any, i int) any {
 			switch v := v.(*ListPageRes); i {
 			case 0:
 				return &v.state
@@ -810,7 +5176,7 @@ func file_api_grpc_v1_gen_seo_proto_init() {
 				return nil
 			}
 		}
-		file_api_grpc_v1_gen_seo_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_api_grpc_v1_gen_seo_proto_msgTypes[36].Exporter = func(v any, i int) any {
 			switch v := v.(*PageMsg); i {
 			case 0:
 				return &v.state
@@ -822,7 +5188,7 @@ func file_api_grpc_v1_gen_seo_proto_init() {
 				return nil
 			}
 		}
-		file_api_grpc_v1_gen_seo_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_api_grpc_v1_gen_seo_proto_msgTypes[37].Exporter = func(v any, i int) any {
 			switch v := v.(*PageWithSlugMsg); i {
 			case 0:
 				return &v.state
@@ -834,6 +5200,174 @@ func file_api_grpc_v1_gen_seo_proto_init() {
 				return nil
 			}
 		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*PageTreeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*PageNodeMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*PageTreeRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*BreadcrumbMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*BreadcrumbsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ResolvePathReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*ResolvePathRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*RedirectMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRedirectRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRedirectRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ResolveRedirectReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*ResolveRedirectRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportRedirectsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_seo_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportRedirectsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -841,9 +5375,9 @@ func file_api_grpc_v1_gen_seo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_v1_gen_seo_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   55,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_api_grpc_v1_gen_seo_proto_goTypes,
 		DependencyIndexes: file_api_grpc_v1_gen_seo_proto_depIdxs,
@@ -19,20 +19,52 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SEO_GetSEO_FullMethodName    = "/gen.SEO/GetSEO"
-	SEO_CreateSEO_FullMethodName = "/gen.SEO/CreateSEO"
-	SEO_UpdateSEO_FullMethodName = "/gen.SEO/UpdateSEO"
-	SEO_DeleteSEO_FullMethodName = "/gen.SEO/DeleteSEO"
+	SEO_ListSEO_FullMethodName           = "/gen.SEO/ListSEO"
+	SEO_GetSEO_FullMethodName            = "/gen.SEO/GetSEO"
+	SEO_CreateSEO_FullMethodName         = "/gen.SEO/CreateSEO"
+	SEO_UpdateSEO_FullMethodName         = "/gen.SEO/UpdateSEO"
+	SEO_DeleteSEO_FullMethodName         = "/gen.SEO/DeleteSEO"
+	SEO_GetSEOAlternates_FullMethodName  = "/gen.SEO/GetSEOAlternates"
+	SEO_GetSEOHead_FullMethodName        = "/gen.SEO/GetSEOHead"
+	SEO_ListSEORevisions_FullMethodName  = "/gen.SEO/ListSEORevisions"
+	SEO_GetSEORevision_FullMethodName    = "/gen.SEO/GetSEORevision"
+	SEO_DiffSEORevisions_FullMethodName  = "/gen.SEO/DiffSEORevisions"
+	SEO_RollbackSEO_FullMethodName       = "/gen.SEO/RollbackSEO"
+	SEO_ImportSEO_FullMethodName         = "/gen.SEO/ImportSEO"
+	SEO_ExportSEO_FullMethodName         = "/gen.SEO/ExportSEO"
+	SEO_AuditSEO_FullMethodName          = "/gen.SEO/AuditSEO"
+	SEO_AuditReport_FullMethodName       = "/gen.SEO/AuditReport"
+	SEO_ListSEOTemplates_FullMethodName  = "/gen.SEO/ListSEOTemplates"
+	SEO_GetSEOTemplate_FullMethodName    = "/gen.SEO/GetSEOTemplate"
+	SEO_SaveSEOTemplate_FullMethodName   = "/gen.SEO/SaveSEOTemplate"
+	SEO_DeleteSEOTemplate_FullMethodName = "/gen.SEO/DeleteSEOTemplate"
+	SEO_WatchSEO_FullMethodName          = "/gen.SEO/WatchSEO"
 )
 
 // SEOClient is the client API for SEO service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SEOClient interface {
+	ListSEO(ctx context.Context, in *ListSEOReq, opts ...grpc.CallOption) (*ListSEORes, error)
 	GetSEO(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*SEOMsg, error)
 	CreateSEO(ctx context.Context, in *SEOMsg, opts ...grpc.CallOption) (*CreateSEOResponse, error)
 	UpdateSEO(ctx context.Context, in *SEOMsg, opts ...grpc.CallOption) (*EmptySEO, error)
 	DeleteSEO(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*EmptySEO, error)
+	GetSEOAlternates(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*ListAlternatesRes, error)
+	GetSEOHead(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*SEOHeadRes, error)
+	ListSEORevisions(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*ListSEORevisionsRes, error)
+	GetSEORevision(ctx context.Context, in *SEORevisionReq, opts ...grpc.CallOption) (*SEORevisionMsg, error)
+	DiffSEORevisions(ctx context.Context, in *DiffSEORevisionsReq, opts ...grpc.CallOption) (*DiffSEORevisionsRes, error)
+	RollbackSEO(ctx context.Context, in *SEORevisionReq, opts ...grpc.CallOption) (*SEOMsg, error)
+	ImportSEO(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportSEOReq, ImportSEORes], error)
+	ExportSEO(ctx context.Context, in *ListSEOReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SEOMsg], error)
+	AuditSEO(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*SEOAuditMsg, error)
+	AuditReport(ctx context.Context, in *AuditReportReq, opts ...grpc.CallOption) (*AuditReportRes, error)
+	ListSEOTemplates(ctx context.Context, in *EmptySEO, opts ...grpc.CallOption) (*ListSEOTemplatesRes, error)
+	GetSEOTemplate(ctx context.Context, in *SEOTemplateReq, opts ...grpc.CallOption) (*SEOTemplateMsg, error)
+	SaveSEOTemplate(ctx context.Context, in *SEOTemplateMsg, opts ...grpc.CallOption) (*SaveSEOTemplateRes, error)
+	DeleteSEOTemplate(ctx context.Context, in *SEOTemplateReq, opts ...grpc.CallOption) (*EmptySEO, error)
+	WatchSEO(ctx context.Context, in *WatchSEOReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SEOChangeMsg], error)
 }
 
 type sEOClient struct {
@@ -43,6 +75,16 @@ func NewSEOClient(cc grpc.ClientConnInterface) SEOClient {
 	return &sEOClient{cc}
 }
 
+func (c *sEOClient) ListSEO(ctx context.Context, in *ListSEOReq, opts ...grpc.CallOption) (*ListSEORes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSEORes)
+	err := c.cc.Invoke(ctx, SEO_ListSEO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sEOClient) GetSEO(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*SEOMsg, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SEOMsg)
@@ -83,14 +125,201 @@ func (c *sEOClient) DeleteSEO(ctx context.Context, in *GetSEOReq, opts ...grpc.C
 	return out, nil
 }
 
+func (c *sEOClient) GetSEOAlternates(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*ListAlternatesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlternatesRes)
+	err := c.cc.Invoke(ctx, SEO_GetSEOAlternates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) GetSEOHead(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*SEOHeadRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SEOHeadRes)
+	err := c.cc.Invoke(ctx, SEO_GetSEOHead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) ListSEORevisions(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*ListSEORevisionsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSEORevisionsRes)
+	err := c.cc.Invoke(ctx, SEO_ListSEORevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) GetSEORevision(ctx context.Context, in *SEORevisionReq, opts ...grpc.CallOption) (*SEORevisionMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SEORevisionMsg)
+	err := c.cc.Invoke(ctx, SEO_GetSEORevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) DiffSEORevisions(ctx context.Context, in *DiffSEORevisionsReq, opts ...grpc.CallOption) (*DiffSEORevisionsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffSEORevisionsRes)
+	err := c.cc.Invoke(ctx, SEO_DiffSEORevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) RollbackSEO(ctx context.Context, in *SEORevisionReq, opts ...grpc.CallOption) (*SEOMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SEOMsg)
+	err := c.cc.Invoke(ctx, SEO_RollbackSEO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) ImportSEO(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportSEOReq, ImportSEORes], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SEO_ServiceDesc.Streams[0], SEO_ImportSEO_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportSEOReq, ImportSEORes]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SEO_ImportSEOClient = grpc.ClientStreamingClient[ImportSEOReq, ImportSEORes]
+
+func (c *sEOClient) ExportSEO(ctx context.Context, in *ListSEOReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SEOMsg], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SEO_ServiceDesc.Streams[1], SEO_ExportSEO_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListSEOReq, SEOMsg]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SEO_ExportSEOClient = grpc.ServerStreamingClient[SEOMsg]
+
+func (c *sEOClient) AuditSEO(ctx context.Context, in *GetSEOReq, opts ...grpc.CallOption) (*SEOAuditMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SEOAuditMsg)
+	err := c.cc.Invoke(ctx, SEO_AuditSEO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) AuditReport(ctx context.Context, in *AuditReportReq, opts ...grpc.CallOption) (*AuditReportRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditReportRes)
+	err := c.cc.Invoke(ctx, SEO_AuditReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) ListSEOTemplates(ctx context.Context, in *EmptySEO, opts ...grpc.CallOption) (*ListSEOTemplatesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSEOTemplatesRes)
+	err := c.cc.Invoke(ctx, SEO_ListSEOTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) GetSEOTemplate(ctx context.Context, in *SEOTemplateReq, opts ...grpc.CallOption) (*SEOTemplateMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SEOTemplateMsg)
+	err := c.cc.Invoke(ctx, SEO_GetSEOTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) SaveSEOTemplate(ctx context.Context, in *SEOTemplateMsg, opts ...grpc.CallOption) (*SaveSEOTemplateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveSEOTemplateRes)
+	err := c.cc.Invoke(ctx, SEO_SaveSEOTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) DeleteSEOTemplate(ctx context.Context, in *SEOTemplateReq, opts ...grpc.CallOption) (*EmptySEO, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptySEO)
+	err := c.cc.Invoke(ctx, SEO_DeleteSEOTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sEOClient) WatchSEO(ctx context.Context, in *WatchSEOReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SEOChangeMsg], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SEO_ServiceDesc.Streams[2], SEO_WatchSEO_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSEOReq, SEOChangeMsg]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SEO_WatchSEOClient = grpc.ServerStreamingClient[SEOChangeMsg]
+
 // SEOServer is the server API for SEO service.
 // All implementations must embed UnimplementedSEOServer
 // for forward compatibility.
 type SEOServer interface {
+	ListSEO(context.Context, *ListSEOReq) (*ListSEORes, error)
 	GetSEO(context.Context, *GetSEOReq) (*SEOMsg, error)
 	CreateSEO(context.Context, *SEOMsg) (*CreateSEOResponse, error)
 	UpdateSEO(context.Context, *SEOMsg) (*EmptySEO, error)
 	DeleteSEO(context.Context, *GetSEOReq) (*EmptySEO, error)
+	GetSEOAlternates(context.Context, *GetSEOReq) (*ListAlternatesRes, error)
+	GetSEOHead(context.Context, *GetSEOReq) (*SEOHeadRes, error)
+	ListSEORevisions(context.Context, *GetSEOReq) (*ListSEORevisionsRes, error)
+	GetSEORevision(context.Context, *SEORevisionReq) (*SEORevisionMsg, error)
+	DiffSEORevisions(context.Context, *DiffSEORevisionsReq) (*DiffSEORevisionsRes, error)
+	RollbackSEO(context.Context, *SEORevisionReq) (*SEOMsg, error)
+	ImportSEO(grpc.ClientStreamingServer[ImportSEOReq, ImportSEORes]) error
+	ExportSEO(*ListSEOReq, grpc.ServerStreamingServer[SEOMsg]) error
+	AuditSEO(context.Context, *GetSEOReq) (*SEOAuditMsg, error)
+	AuditReport(context.Context, *AuditReportReq) (*AuditReportRes, error)
+	ListSEOTemplates(context.Context, *EmptySEO) (*ListSEOTemplatesRes, error)
+	GetSEOTemplate(context.Context, *SEOTemplateReq) (*SEOTemplateMsg, error)
+	SaveSEOTemplate(context.Context, *SEOTemplateMsg) (*SaveSEOTemplateRes, error)
+	DeleteSEOTemplate(context.Context, *SEOTemplateReq) (*EmptySEO, error)
+	WatchSEO(*WatchSEOReq, grpc.ServerStreamingServer[SEOChangeMsg]) error
 	mustEmbedUnimplementedSEOServer()
 }
 
@@ -101,6 +330,9 @@ type SEOServer interface {
 // pointer dereference when methods are called.
 type UnimplementedSEOServer struct{}
 
+func (UnimplementedSEOServer) ListSEO(context.Context, *ListSEOReq) (*ListSEORes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSEO not implemented")
+}
 func (UnimplementedSEOServer) GetSEO(context.Context, *GetSEOReq) (*SEOMsg, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSEO not implemented")
 }
@@ -113,375 +345,1237 @@ func (UnimplementedSEOServer) UpdateSEO(context.Context, *SEOMsg) (*EmptySEO, er
 func (UnimplementedSEOServer) DeleteSEO(context.Context, *GetSEOReq) (*EmptySEO, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSEO not implemented")
 }
-func (UnimplementedSEOServer) mustEmbedUnimplementedSEOServer() {}
-func (UnimplementedSEOServer) testEmbeddedByValue()             {}
+func (UnimplementedSEOServer) GetSEOAlternates(context.Context, *GetSEOReq) (*ListAlternatesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSEOAlternates not implemented")
+}
+func (UnimplementedSEOServer) GetSEOHead(context.Context, *GetSEOReq) (*SEOHeadRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSEOHead not implemented")
+}
+func (UnimplementedSEOServer) ListSEORevisions(context.Context, *GetSEOReq) (*ListSEORevisionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSEORevisions not implemented")
+}
+func (UnimplementedSEOServer) GetSEORevision(context.Context, *SEORevisionReq) (*SEORevisionMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSEORevision not implemented")
+}
+func (UnimplementedSEOServer) DiffSEORevisions(context.Context, *DiffSEORevisionsReq) (*DiffSEORevisionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSEORevisions not implemented")
+}
+func (UnimplementedSEOServer) RollbackSEO(context.Context, *SEORevisionReq) (*SEOMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackSEO not implemented")
+}
+func (UnimplementedSEOServer) ImportSEO(grpc.ClientStreamingServer[ImportSEOReq, ImportSEORes]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSEO not implemented")
+}
+func (UnimplementedSEOServer) ExportSEO(*ListSEOReq, grpc.ServerStreamingServer[SEOMsg]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSEO not implemented")
+}
+func (UnimplementedSEOServer) AuditSEO(context.Context, *GetSEOReq) (*SEOAuditMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditSEO not implemented")
+}
+func (UnimplementedSEOServer) AuditReport(context.Context, *AuditReportReq) (*AuditReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditReport not implemented")
+}
+func (UnimplementedSEOServer) ListSEOTemplates(context.Context, *EmptySEO) (*ListSEOTemplatesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSEOTemplates not implemented")
+}
+func (UnimplementedSEOServer) GetSEOTemplate(context.Context, *SEOTemplateReq) (*SEOTemplateMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSEOTemplate not implemented")
+}
+func (UnimplementedSEOServer) SaveSEOTemplate(context.Context, *SEOTemplateMsg) (*SaveSEOTemplateRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSEOTemplate not implemented")
+}
+func (UnimplementedSEOServer) DeleteSEOTemplate(context.Context, *SEOTemplateReq) (*EmptySEO, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSEOTemplate not implemented")
+}
+func (UnimplementedSEOServer) WatchSEO(*WatchSEOReq, grpc.ServerStreamingServer[SEOChangeMsg]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSEO not implemented")
+}
+func (UnimplementedSEOServer) mustEmbedUnimplementedSEOServer() {}
+func (UnimplementedSEOServer) testEmbeddedByValue()             {}
+
+// UnsafeSEOServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SEOServer will
+// result in compilation errors.
+type UnsafeSEOServer interface {
+	mustEmbedUnimplementedSEOServer()
+}
+
+func RegisterSEOServer(s grpc.ServiceRegistrar, srv SEOServer) {
+	// If the following call pancis, it indicates UnimplementedSEOServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SEO_ServiceDesc, srv)
+}
+
+func _SEO_ListSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSEOReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).ListSEO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_ListSEO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).ListSEO(ctx, req.(*ListSEOReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_GetSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSEOReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).GetSEO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_GetSEO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).GetSEO(ctx, req.(*GetSEOReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_CreateSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SEOMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).CreateSEO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_CreateSEO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).CreateSEO(ctx, req.(*SEOMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_UpdateSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SEOMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).UpdateSEO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_UpdateSEO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).UpdateSEO(ctx, req.(*SEOMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_DeleteSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSEOReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).DeleteSEO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_DeleteSEO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).DeleteSEO(ctx, req.(*GetSEOReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_GetSEOAlternates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSEOReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).GetSEOAlternates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_GetSEOAlternates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).GetSEOAlternates(ctx, req.(*GetSEOReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_GetSEOHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSEOReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).GetSEOHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_GetSEOHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).GetSEOHead(ctx, req.(*GetSEOReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_ListSEORevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSEOReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).ListSEORevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_ListSEORevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).ListSEORevisions(ctx, req.(*GetSEOReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_GetSEORevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SEORevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).GetSEORevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_GetSEORevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).GetSEORevision(ctx, req.(*SEORevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_DiffSEORevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSEORevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).DiffSEORevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_DiffSEORevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).DiffSEORevisions(ctx, req.(*DiffSEORevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_RollbackSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SEORevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).RollbackSEO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_RollbackSEO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).RollbackSEO(ctx, req.(*SEORevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_ImportSEO_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SEOServer).ImportSEO(&grpc.GenericServerStream[ImportSEOReq, ImportSEORes]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SEO_ImportSEOServer = grpc.ClientStreamingServer[ImportSEOReq, ImportSEORes]
+
+func _SEO_ExportSEO_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListSEOReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SEOServer).ExportSEO(m, &grpc.GenericServerStream[ListSEOReq, SEOMsg]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SEO_ExportSEOServer = grpc.ServerStreamingServer[SEOMsg]
+
+func _SEO_AuditSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSEOReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).AuditSEO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_AuditSEO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).AuditSEO(ctx, req.(*GetSEOReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_AuditReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).AuditReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_AuditReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).AuditReport(ctx, req.(*AuditReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_ListSEOTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptySEO)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).ListSEOTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_ListSEOTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).ListSEOTemplates(ctx, req.(*EmptySEO))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_GetSEOTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SEOTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).GetSEOTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_GetSEOTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).GetSEOTemplate(ctx, req.(*SEOTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_SaveSEOTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SEOTemplateMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).SaveSEOTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_SaveSEOTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).SaveSEOTemplate(ctx, req.(*SEOTemplateMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_DeleteSEOTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SEOTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SEOServer).DeleteSEOTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SEO_DeleteSEOTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SEOServer).DeleteSEOTemplate(ctx, req.(*SEOTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SEO_WatchSEO_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSEOReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SEOServer).WatchSEO(m, &grpc.GenericServerStream[WatchSEOReq, SEOChangeMsg]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SEO_WatchSEOServer = grpc.ServerStreamingServer[SEOChangeMsg]
+
+// SEO_ServiceDesc is the grpc.ServiceDesc for SEO service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SEO_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gen.SEO",
+	HandlerType: (*SEOServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSEO",
+			Handler:    _SEO_ListSEO_Handler,
+		},
+		{
+			MethodName: "GetSEO",
+			Handler:    _SEO_GetSEO_Handler,
+		},
+		{
+			MethodName: "CreateSEO",
+			Handler:    _SEO_CreateSEO_Handler,
+		},
+		{
+			MethodName: "UpdateSEO",
+			Handler:    _SEO_UpdateSEO_Handler,
+		},
+		{
+			MethodName: "DeleteSEO",
+			Handler:    _SEO_DeleteSEO_Handler,
+		},
+		{
+			MethodName: "GetSEOAlternates",
+			Handler:    _SEO_GetSEOAlternates_Handler,
+		},
+		{
+			MethodName: "GetSEOHead",
+			Handler:    _SEO_GetSEOHead_Handler,
+		},
+		{
+			MethodName: "ListSEORevisions",
+			Handler:    _SEO_ListSEORevisions_Handler,
+		},
+		{
+			MethodName: "GetSEORevision",
+			Handler:    _SEO_GetSEORevision_Handler,
+		},
+		{
+			MethodName: "DiffSEORevisions",
+			Handler:    _SEO_DiffSEORevisions_Handler,
+		},
+		{
+			MethodName: "RollbackSEO",
+			Handler:    _SEO_RollbackSEO_Handler,
+		},
+		{
+			MethodName: "AuditSEO",
+			Handler:    _SEO_AuditSEO_Handler,
+		},
+		{
+			MethodName: "AuditReport",
+			Handler:    _SEO_AuditReport_Handler,
+		},
+		{
+			MethodName: "ListSEOTemplates",
+			Handler:    _SEO_ListSEOTemplates_Handler,
+		},
+		{
+			MethodName: "GetSEOTemplate",
+			Handler:    _SEO_GetSEOTemplate_Handler,
+		},
+		{
+			MethodName: "SaveSEOTemplate",
+			Handler:    _SEO_SaveSEOTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteSEOTemplate",
+			Handler:    _SEO_DeleteSEOTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportSEO",
+			Handler:       _SEO_ImportSEO_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportSEO",
+			Handler:       _SEO_ExportSEO_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchSEO",
+			Handler:       _SEO_WatchSEO_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/grpc/v1/gen/seo.proto",
+}
+
+const (
+	Page_ListPages_FullMethodName      = "/gen.Page/ListPages"
+	Page_GetPage_FullMethodName        = "/gen.Page/GetPage"
+	Page_CreatePage_FullMethodName     = "/gen.Page/CreatePage"
+	Page_UpdatePage_FullMethodName     = "/gen.Page/UpdatePage"
+	Page_DeletePage_FullMethodName     = "/gen.Page/DeletePage"
+	Page_GetPageTree_FullMethodName    = "/gen.Page/GetPageTree"
+	Page_GetBreadcrumbs_FullMethodName = "/gen.Page/GetBreadcrumbs"
+	Page_ResolvePath_FullMethodName    = "/gen.Page/ResolvePath"
+	Page_WatchPages_FullMethodName     = "/gen.Page/WatchPages"
+)
+
+// PageClient is the client API for Page service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PageClient interface {
+	ListPages(ctx context.Context, in *ListPagesReq, opts ...grpc.CallOption) (*ListPageRes, error)
+	GetPage(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*PageMsg, error)
+	CreatePage(ctx context.Context, in *PageMsg, opts ...grpc.CallOption) (*SlugSEO, error)
+	UpdatePage(ctx context.Context, in *PageWithSlugMsg, opts ...grpc.CallOption) (*EmptySEO, error)
+	DeletePage(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*EmptySEO, error)
+	GetPageTree(ctx context.Context, in *PageTreeReq, opts ...grpc.CallOption) (*PageTreeRes, error)
+	GetBreadcrumbs(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*BreadcrumbsRes, error)
+	ResolvePath(ctx context.Context, in *ResolvePathReq, opts ...grpc.CallOption) (*ResolvePathRes, error)
+	WatchPages(ctx context.Context, in *WatchPagesReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PageChangeMsg], error)
+}
+
+type pageClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPageClient(cc grpc.ClientConnInterface) PageClient {
+	return &pageClient{cc}
+}
+
+func (c *pageClient) ListPages(ctx context.Context, in *ListPagesReq, opts ...grpc.CallOption) (*ListPageRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPageRes)
+	err := c.cc.Invoke(ctx, Page_ListPages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pageClient) GetPage(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*PageMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PageMsg)
+	err := c.cc.Invoke(ctx, Page_GetPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pageClient) CreatePage(ctx context.Context, in *PageMsg, opts ...grpc.CallOption) (*SlugSEO, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlugSEO)
+	err := c.cc.Invoke(ctx, Page_CreatePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pageClient) UpdatePage(ctx context.Context, in *PageWithSlugMsg, opts ...grpc.CallOption) (*EmptySEO, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptySEO)
+	err := c.cc.Invoke(ctx, Page_UpdatePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pageClient) DeletePage(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*EmptySEO, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptySEO)
+	err := c.cc.Invoke(ctx, Page_DeletePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pageClient) GetPageTree(ctx context.Context, in *PageTreeReq, opts ...grpc.CallOption) (*PageTreeRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PageTreeRes)
+	err := c.cc.Invoke(ctx, Page_GetPageTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pageClient) GetBreadcrumbs(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*BreadcrumbsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BreadcrumbsRes)
+	err := c.cc.Invoke(ctx, Page_GetBreadcrumbs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pageClient) ResolvePath(ctx context.Context, in *ResolvePathReq, opts ...grpc.CallOption) (*ResolvePathRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolvePathRes)
+	err := c.cc.Invoke(ctx, Page_ResolvePath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pageClient) WatchPages(ctx context.Context, in *WatchPagesReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PageChangeMsg], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Page_ServiceDesc.Streams[0], Page_WatchPages_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPagesReq, PageChangeMsg]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Page_WatchPagesClient = grpc.ServerStreamingClient[PageChangeMsg]
+
+// PageServer is the server API for Page service.
+// All implementations must embed UnimplementedPageServer
+// for forward compatibility.
+type PageServer interface {
+	ListPages(context.Context, *ListPagesReq) (*ListPageRes, error)
+	GetPage(context.Context, *SlugSEO) (*PageMsg, error)
+	CreatePage(context.Context, *PageMsg) (*SlugSEO, error)
+	UpdatePage(context.Context, *PageWithSlugMsg) (*EmptySEO, error)
+	DeletePage(context.Context, *SlugSEO) (*EmptySEO, error)
+	GetPageTree(context.Context, *PageTreeReq) (*PageTreeRes, error)
+	GetBreadcrumbs(context.Context, *SlugSEO) (*BreadcrumbsRes, error)
+	ResolvePath(context.Context, *ResolvePathReq) (*ResolvePathRes, error)
+	WatchPages(*WatchPagesReq, grpc.ServerStreamingServer[PageChangeMsg]) error
+	mustEmbedUnimplementedPageServer()
+}
+
+// UnimplementedPageServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPageServer struct{}
+
+func (UnimplementedPageServer) ListPages(context.Context, *ListPagesReq) (*ListPageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPages not implemented")
+}
+func (UnimplementedPageServer) GetPage(context.Context, *SlugSEO) (*PageMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPage not implemented")
+}
+func (UnimplementedPageServer) CreatePage(context.Context, *PageMsg) (*SlugSEO, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePage not implemented")
+}
+func (UnimplementedPageServer) UpdatePage(context.Context, *PageWithSlugMsg) (*EmptySEO, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePage not implemented")
+}
+func (UnimplementedPageServer) DeletePage(context.Context, *SlugSEO) (*EmptySEO, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePage not implemented")
+}
+func (UnimplementedPageServer) GetPageTree(context.Context, *PageTreeReq) (*PageTreeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPageTree not implemented")
+}
+func (UnimplementedPageServer) GetBreadcrumbs(context.Context, *SlugSEO) (*BreadcrumbsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBreadcrumbs not implemented")
+}
+func (UnimplementedPageServer) ResolvePath(context.Context, *ResolvePathReq) (*ResolvePathRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePath not implemented")
+}
+func (UnimplementedPageServer) WatchPages(*WatchPagesReq, grpc.ServerStreamingServer[PageChangeMsg]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPages not implemented")
+}
+func (UnimplementedPageServer) mustEmbedUnimplementedPageServer() {}
+func (UnimplementedPageServer) testEmbeddedByValue()              {}
+
+// UnsafePageServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PageServer will
+// result in compilation errors.
+type UnsafePageServer interface {
+	mustEmbedUnimplementedPageServer()
+}
+
+func RegisterPageServer(s grpc.ServiceRegistrar, srv PageServer) {
+	// If the following call pancis, it indicates UnimplementedPageServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Page_ServiceDesc, srv)
+}
+
+func _Page_ListPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PageServer).ListPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Page_ListPages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PageServer).ListPages(ctx, req.(*ListPagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Page_GetPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugSEO)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PageServer).GetPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Page_GetPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PageServer).GetPage(ctx, req.(*SlugSEO))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UnsafeSEOServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SEOServer will
-// result in compilation errors.
-type UnsafeSEOServer interface {
-	mustEmbedUnimplementedSEOServer()
+func _Page_CreatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PageServer).CreatePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Page_CreatePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PageServer).CreatePage(ctx, req.(*PageMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSEOServer(s grpc.ServiceRegistrar, srv SEOServer) {
-	// If the following call pancis, it indicates UnimplementedSEOServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Page_UpdatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageWithSlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&SEO_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(PageServer).UpdatePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Page_UpdatePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PageServer).UpdatePage(ctx, req.(*PageWithSlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SEO_GetSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSEOReq)
+func _Page_DeletePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugSEO)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SEOServer).GetSEO(ctx, in)
+		return srv.(PageServer).DeletePage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SEO_GetSEO_FullMethodName,
+		FullMethod: Page_DeletePage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SEOServer).GetSEO(ctx, req.(*GetSEOReq))
+		return srv.(PageServer).DeletePage(ctx, req.(*SlugSEO))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SEO_CreateSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SEOMsg)
+func _Page_GetPageTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageTreeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SEOServer).CreateSEO(ctx, in)
+		return srv.(PageServer).GetPageTree(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SEO_CreateSEO_FullMethodName,
+		FullMethod: Page_GetPageTree_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SEOServer).CreateSEO(ctx, req.(*SEOMsg))
+		return srv.(PageServer).GetPageTree(ctx, req.(*PageTreeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SEO_UpdateSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SEOMsg)
+func _Page_GetBreadcrumbs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugSEO)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SEOServer).UpdateSEO(ctx, in)
+		return srv.(PageServer).GetBreadcrumbs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SEO_UpdateSEO_FullMethodName,
+		FullMethod: Page_GetBreadcrumbs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SEOServer).UpdateSEO(ctx, req.(*SEOMsg))
+		return srv.(PageServer).GetBreadcrumbs(ctx, req.(*SlugSEO))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SEO_DeleteSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSEOReq)
+func _Page_ResolvePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePathReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SEOServer).DeleteSEO(ctx, in)
+		return srv.(PageServer).ResolvePath(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SEO_DeleteSEO_FullMethodName,
+		FullMethod: Page_ResolvePath_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SEOServer).DeleteSEO(ctx, req.(*GetSEOReq))
+		return srv.(PageServer).ResolvePath(ctx, req.(*ResolvePathReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// SEO_ServiceDesc is the grpc.ServiceDesc for SEO service.
+func _Page_WatchPages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPagesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PageServer).WatchPages(m, &grpc.GenericServerStream[WatchPagesReq, PageChangeMsg]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Page_WatchPagesServer = grpc.ServerStreamingServer[PageChangeMsg]
+
+// Page_ServiceDesc is the grpc.ServiceDesc for Page service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var SEO_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gen.SEO",
-	HandlerType: (*SEOServer)(nil),
+var Page_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gen.Page",
+	HandlerType: (*PageServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetSEO",
-			Handler:    _SEO_GetSEO_Handler,
+			MethodName: "ListPages",
+			Handler:    _Page_ListPages_Handler,
 		},
 		{
-			MethodName: "CreateSEO",
-			Handler:    _SEO_CreateSEO_Handler,
+			MethodName: "GetPage",
+			Handler:    _Page_GetPage_Handler,
 		},
 		{
-			MethodName: "UpdateSEO",
-			Handler:    _SEO_UpdateSEO_Handler,
+			MethodName: "CreatePage",
+			Handler:    _Page_CreatePage_Handler,
 		},
 		{
-			MethodName: "DeleteSEO",
-			Handler:    _SEO_DeleteSEO_Handler,
+			MethodName: "UpdatePage",
+			Handler:    _Page_UpdatePage_Handler,
+		},
+		{
+			MethodName: "DeletePage",
+			Handler:    _Page_DeletePage_Handler,
+		},
+		{
+			MethodName: "GetPageTree",
+			Handler:    _Page_GetPageTree_Handler,
+		},
+		{
+			MethodName: "GetBreadcrumbs",
+			Handler:    _Page_GetBreadcrumbs_Handler,
+		},
+		{
+			MethodName: "ResolvePath",
+			Handler:    _Page_ResolvePath_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPages",
+			Handler:       _Page_WatchPages_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/v1/gen/seo.proto",
 }
 
 const (
-	Page_ListPages_FullMethodName  = "/gen.Page/ListPages"
-	Page_GetPage_FullMethodName    = "/gen.Page/GetPage"
-	Page_CreatePage_FullMethodName = "/gen.Page/CreatePage"
-	Page_UpdatePage_FullMethodName = "/gen.Page/UpdatePage"
-	Page_DeletePage_FullMethodName = "/gen.Page/DeletePage"
+	Redirect_ListRedirects_FullMethodName   = "/gen.Redirect/ListRedirects"
+	Redirect_GetRedirect_FullMethodName     = "/gen.Redirect/GetRedirect"
+	Redirect_CreateRedirect_FullMethodName  = "/gen.Redirect/CreateRedirect"
+	Redirect_UpdateRedirect_FullMethodName  = "/gen.Redirect/UpdateRedirect"
+	Redirect_DeleteRedirect_FullMethodName  = "/gen.Redirect/DeleteRedirect"
+	Redirect_ResolveRedirect_FullMethodName = "/gen.Redirect/ResolveRedirect"
+	Redirect_ExportRedirects_FullMethodName = "/gen.Redirect/ExportRedirects"
 )
 
-// PageClient is the client API for Page service.
+// RedirectClient is the client API for Redirect service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PageClient interface {
-	ListPages(ctx context.Context, in *EmptySEO, opts ...grpc.CallOption) (*ListPageRes, error)
-	GetPage(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*PageMsg, error)
-	CreatePage(ctx context.Context, in *PageMsg, opts ...grpc.CallOption) (*SlugSEO, error)
-	UpdatePage(ctx context.Context, in *PageWithSlugMsg, opts ...grpc.CallOption) (*EmptySEO, error)
-	DeletePage(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*EmptySEO, error)
+type RedirectClient interface {
+	ListRedirects(ctx context.Context, in *EmptySEO, opts ...grpc.CallOption) (*ListRedirectRes, error)
+	GetRedirect(ctx context.Context, in *Uuid64SEO, opts ...grpc.CallOption) (*RedirectMsg, error)
+	CreateRedirect(ctx context.Context, in *RedirectMsg, opts ...grpc.CallOption) (*CreateRedirectRes, error)
+	UpdateRedirect(ctx context.Context, in *RedirectMsg, opts ...grpc.CallOption) (*EmptySEO, error)
+	DeleteRedirect(ctx context.Context, in *Uuid64SEO, opts ...grpc.CallOption) (*EmptySEO, error)
+	ResolveRedirect(ctx context.Context, in *ResolveRedirectReq, opts ...grpc.CallOption) (*ResolveRedirectRes, error)
+	ExportRedirects(ctx context.Context, in *ExportRedirectsReq, opts ...grpc.CallOption) (*ExportRedirectsRes, error)
 }
 
-type pageClient struct {
+type redirectClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPageClient(cc grpc.ClientConnInterface) PageClient {
-	return &pageClient{cc}
+func NewRedirectClient(cc grpc.ClientConnInterface) RedirectClient {
+	return &redirectClient{cc}
 }
 
-func (c *pageClient) ListPages(ctx context.Context, in *EmptySEO, opts ...grpc.CallOption) (*ListPageRes, error) {
+func (c *redirectClient) ListRedirects(ctx context.Context, in *EmptySEO, opts ...grpc.CallOption) (*ListRedirectRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListPageRes)
-	err := c.cc.Invoke(ctx, Page_ListPages_FullMethodName, in, out, cOpts...)
+	out := new(ListRedirectRes)
+	err := c.cc.Invoke(ctx, Redirect_ListRedirects_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pageClient) GetPage(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*PageMsg, error) {
+func (c *redirectClient) GetRedirect(ctx context.Context, in *Uuid64SEO, opts ...grpc.CallOption) (*RedirectMsg, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PageMsg)
-	err := c.cc.Invoke(ctx, Page_GetPage_FullMethodName, in, out, cOpts...)
+	out := new(RedirectMsg)
+	err := c.cc.Invoke(ctx, Redirect_GetRedirect_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pageClient) CreatePage(ctx context.Context, in *PageMsg, opts ...grpc.CallOption) (*SlugSEO, error) {
+func (c *redirectClient) CreateRedirect(ctx context.Context, in *RedirectMsg, opts ...grpc.CallOption) (*CreateRedirectRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SlugSEO)
-	err := c.cc.Invoke(ctx, Page_CreatePage_FullMethodName, in, out, cOpts...)
+	out := new(CreateRedirectRes)
+	err := c.cc.Invoke(ctx, Redirect_CreateRedirect_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pageClient) UpdatePage(ctx context.Context, in *PageWithSlugMsg, opts ...grpc.CallOption) (*EmptySEO, error) {
+func (c *redirectClient) UpdateRedirect(ctx context.Context, in *RedirectMsg, opts ...grpc.CallOption) (*EmptySEO, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmptySEO)
-	err := c.cc.Invoke(ctx, Page_UpdatePage_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Redirect_UpdateRedirect_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pageClient) DeletePage(ctx context.Context, in *SlugSEO, opts ...grpc.CallOption) (*EmptySEO, error) {
+func (c *redirectClient) DeleteRedirect(ctx context.Context, in *Uuid64SEO, opts ...grpc.CallOption) (*EmptySEO, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmptySEO)
-	err := c.cc.Invoke(ctx, Page_DeletePage_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Redirect_DeleteRedirect_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PageServer is the server API for Page service.
-// All implementations must embed UnimplementedPageServer
+func (c *redirectClient) ResolveRedirect(ctx context.Context, in *ResolveRedirectReq, opts ...grpc.CallOption) (*ResolveRedirectRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveRedirectRes)
+	err := c.cc.Invoke(ctx, Redirect_ResolveRedirect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redirectClient) ExportRedirects(ctx context.Context, in *ExportRedirectsReq, opts ...grpc.CallOption) (*ExportRedirectsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportRedirectsRes)
+	err := c.cc.Invoke(ctx, Redirect_ExportRedirects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RedirectServer is the server API for Redirect service.
+// All implementations must embed UnimplementedRedirectServer
 // for forward compatibility.
-type PageServer interface {
-	ListPages(context.Context, *EmptySEO) (*ListPageRes, error)
-	GetPage(context.Context, *SlugSEO) (*PageMsg, error)
-	CreatePage(context.Context, *PageMsg) (*SlugSEO, error)
-	UpdatePage(context.Context, *PageWithSlugMsg) (*EmptySEO, error)
-	DeletePage(context.Context, *SlugSEO) (*EmptySEO, error)
-	mustEmbedUnimplementedPageServer()
+type RedirectServer interface {
+	ListRedirects(context.Context, *EmptySEO) (*ListRedirectRes, error)
+	GetRedirect(context.Context, *Uuid64SEO) (*RedirectMsg, error)
+	CreateRedirect(context.Context, *RedirectMsg) (*CreateRedirectRes, error)
+	UpdateRedirect(context.Context, *RedirectMsg) (*EmptySEO, error)
+	DeleteRedirect(context.Context, *Uuid64SEO) (*EmptySEO, error)
+	ResolveRedirect(context.Context, *ResolveRedirectReq) (*ResolveRedirectRes, error)
+	ExportRedirects(context.Context, *ExportRedirectsReq) (*ExportRedirectsRes, error)
+	mustEmbedUnimplementedRedirectServer()
 }
 
-// UnimplementedPageServer must be embedded to have
+// UnimplementedRedirectServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedPageServer struct{}
+type UnimplementedRedirectServer struct{}
 
-func (UnimplementedPageServer) ListPages(context.Context, *EmptySEO) (*ListPageRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPages not implemented")
+func (UnimplementedRedirectServer) ListRedirects(context.Context, *EmptySEO) (*ListRedirectRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRedirects not implemented")
 }
-func (UnimplementedPageServer) GetPage(context.Context, *SlugSEO) (*PageMsg, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPage not implemented")
+func (UnimplementedRedirectServer) GetRedirect(context.Context, *Uuid64SEO) (*RedirectMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedirect not implemented")
 }
-func (UnimplementedPageServer) CreatePage(context.Context, *PageMsg) (*SlugSEO, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePage not implemented")
+func (UnimplementedRedirectServer) CreateRedirect(context.Context, *RedirectMsg) (*CreateRedirectRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRedirect not implemented")
 }
-func (UnimplementedPageServer) UpdatePage(context.Context, *PageWithSlugMsg) (*EmptySEO, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePage not implemented")
+func (UnimplementedRedirectServer) UpdateRedirect(context.Context, *RedirectMsg) (*EmptySEO, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedirect not implemented")
 }
-func (UnimplementedPageServer) DeletePage(context.Context, *SlugSEO) (*EmptySEO, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePage not implemented")
+func (UnimplementedRedirectServer) DeleteRedirect(context.Context, *Uuid64SEO) (*EmptySEO, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRedirect not implemented")
 }
-func (UnimplementedPageServer) mustEmbedUnimplementedPageServer() {}
-func (UnimplementedPageServer) testEmbeddedByValue()              {}
+func (UnimplementedRedirectServer) ResolveRedirect(context.Context, *ResolveRedirectReq) (*ResolveRedirectRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveRedirect not implemented")
+}
+func (UnimplementedRedirectServer) ExportRedirects(context.Context, *ExportRedirectsReq) (*ExportRedirectsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRedirects not implemented")
+}
+func (UnimplementedRedirectServer) mustEmbedUnimplementedRedirectServer() {}
+func (UnimplementedRedirectServer) testEmbeddedByValue()                  {}
 
-// UnsafePageServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PageServer will
+// UnsafeRedirectServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RedirectServer will
 // result in compilation errors.
-type UnsafePageServer interface {
-	mustEmbedUnimplementedPageServer()
+type UnsafeRedirectServer interface {
+	mustEmbedUnimplementedRedirectServer()
 }
 
-func RegisterPageServer(s grpc.ServiceRegistrar, srv PageServer) {
-	// If the following call pancis, it indicates UnimplementedPageServer was
+func RegisterRedirectServer(s grpc.ServiceRegistrar, srv RedirectServer) {
+	// If the following call pancis, it indicates UnimplementedRedirectServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&Page_ServiceDesc, srv)
+	s.RegisterService(&Redirect_ServiceDesc, srv)
 }
 
-func _Page_ListPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Redirect_ListRedirects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptySEO)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PageServer).ListPages(ctx, in)
+		return srv.(RedirectServer).ListRedirects(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Page_ListPages_FullMethodName,
+		FullMethod: Redirect_ListRedirects_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PageServer).ListPages(ctx, req.(*EmptySEO))
+		return srv.(RedirectServer).ListRedirects(ctx, req.(*EmptySEO))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Page_GetPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SlugSEO)
+func _Redirect_GetRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Uuid64SEO)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PageServer).GetPage(ctx, in)
+		return srv.(RedirectServer).GetRedirect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Page_GetPage_FullMethodName,
+		FullMethod: Redirect_GetRedirect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PageServer).GetPage(ctx, req.(*SlugSEO))
+		return srv.(RedirectServer).GetRedirect(ctx, req.(*Uuid64SEO))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Page_CreatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageMsg)
+func _Redirect_CreateRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedirectMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PageServer).CreatePage(ctx, in)
+		return srv.(RedirectServer).CreateRedirect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Page_CreatePage_FullMethodName,
+		FullMethod: Redirect_CreateRedirect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PageServer).CreatePage(ctx, req.(*PageMsg))
+		return srv.(RedirectServer).CreateRedirect(ctx, req.(*RedirectMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Page_UpdatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageWithSlugMsg)
+func _Redirect_UpdateRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedirectMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PageServer).UpdatePage(ctx, in)
+		return srv.(RedirectServer).UpdateRedirect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Page_UpdatePage_FullMethodName,
+		FullMethod: Redirect_UpdateRedirect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PageServer).UpdatePage(ctx, req.(*PageWithSlugMsg))
+		return srv.(RedirectServer).UpdateRedirect(ctx, req.(*RedirectMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Page_DeletePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SlugSEO)
+func _Redirect_DeleteRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Uuid64SEO)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PageServer).DeletePage(ctx, in)
+		return srv.(RedirectServer).DeleteRedirect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Page_DeletePage_FullMethodName,
+		FullMethod: Redirect_DeleteRedirect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PageServer).DeletePage(ctx, req.(*SlugSEO))
+		return srv.(RedirectServer).DeleteRedirect(ctx, req.(*Uuid64SEO))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Page_ServiceDesc is the grpc.ServiceDesc for Page service.
+func _Redirect_ResolveRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRedirectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedirectServer).ResolveRedirect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redirect_ResolveRedirect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedirectServer).ResolveRedirect(ctx, req.(*ResolveRedirectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redirect_ExportRedirects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRedirectsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedirectServer).ExportRedirects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redirect_ExportRedirects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedirectServer).ExportRedirects(ctx, req.(*ExportRedirectsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Redirect_ServiceDesc is the grpc.ServiceDesc for Redirect service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Page_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gen.Page",
-	HandlerType: (*PageServer)(nil),
+var Redirect_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gen.Redirect",
+	HandlerType: (*RedirectServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "ListPages",
-			Handler:    _Page_ListPages_Handler,
+			MethodName: "ListRedirects",
+			Handler:    _Redirect_ListRedirects_Handler,
 		},
 		{
-			MethodName: "GetPage",
-			Handler:    _Page_GetPage_Handler,
+			MethodName: "GetRedirect",
+			Handler:    _Redirect_GetRedirect_Handler,
 		},
 		{
-			MethodName: "CreatePage",
-			Handler:    _Page_CreatePage_Handler,
+			MethodName: "CreateRedirect",
+			Handler:    _Redirect_CreateRedirect_Handler,
 		},
 		{
-			MethodName: "UpdatePage",
-			Handler:    _Page_UpdatePage_Handler,
+			MethodName: "UpdateRedirect",
+			Handler:    _Redirect_UpdateRedirect_Handler,
 		},
 		{
-			MethodName: "DeletePage",
-			Handler:    _Page_DeletePage_Handler,
+			MethodName: "DeleteRedirect",
+			Handler:    _Redirect_DeleteRedirect_Handler,
+		},
+		{
+			MethodName: "ResolveRedirect",
+			Handler:    _Redirect_ResolveRedirect_Handler,
+		},
+		{
+			MethodName: "ExportRedirects",
+			Handler:    _Redirect_ExportRedirects_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
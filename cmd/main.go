@@ -2,22 +2,36 @@ package main
 
 import (
 	"context"
-	"github.com/JMURv/seo/internal/cache/redis"
+	"errors"
+	"fmt"
 	"github.com/JMURv/seo/internal/config"
 	"github.com/JMURv/seo/internal/ctrl"
 	"github.com/JMURv/seo/internal/ctrl/sso"
+	"github.com/JMURv/seo/internal/hdl/grpc"
 	"github.com/JMURv/seo/internal/hdl/http"
 	"github.com/JMURv/seo/internal/observability/metrics/prometheus"
 	"github.com/JMURv/seo/internal/observability/tracing/jaeger"
+	"github.com/JMURv/seo/internal/publisher/kafka"
+	"github.com/JMURv/seo/internal/publisher/memory"
+	"github.com/JMURv/seo/internal/publisher/nats"
 	"github.com/JMURv/seo/internal/repo/db"
+	"github.com/JMURv/seo/internal/repo/migrator"
+	"github.com/JMURv/seo/internal/repo/sqlite"
+	"github.com/JMURv/seo/internal/storage"
+	"github.com/golang-migrate/migrate/v4"
 	"go.uber.org/zap"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 const configPath = "configs/local.config.yaml"
 
+// shutdownTimeout bounds how long in-flight requests and streams may take to
+// finish once a signal arrives.
+const shutdownTimeout = 15 * time.Second
+
 func mustRegisterLogger(mode string) {
 	switch mode {
 	case "prod":
@@ -27,6 +41,69 @@ func mustRegisterLogger(mode string) {
 	}
 }
 
+func mustRegisterPublisher(conf *config.OutboxConfig) ctrl.Publisher {
+	if conf == nil {
+		return memory.New(0)
+	}
+
+	switch conf.Publisher {
+	case "kafka":
+		if conf.Kafka == nil || len(conf.Kafka.Brokers) == 0 || conf.Kafka.Topic == "" {
+			panic("outbox.publisher is kafka but outbox.kafka.brokers or outbox.kafka.topic is not set")
+		}
+		return kafka.New(conf.Kafka)
+	case "nats":
+		if conf.NATS == nil || conf.NATS.URL == "" {
+			panic("outbox.publisher is nats but outbox.nats.url is not set")
+		}
+		return nats.New(conf.NATS)
+	case "", "memory":
+		return memory.New(0)
+	default:
+		panic("unknown outbox publisher: " + conf.Publisher)
+	}
+}
+
+func mustRegisterMigrate(conf *config.DBConfig) *migrate.Migrate {
+	var m *migrate.Migrate
+	var err error
+	switch conf.Driver {
+	case "", "postgres":
+		m, err = db.NewMigrate(conf)
+	case "sqlite":
+		m, err = sqlite.NewMigrate(conf)
+	default:
+		panic("unknown db driver: " + conf.Driver)
+	}
+
+	if err != nil {
+		zap.L().Fatal("Failed to prepare migrations", zap.Error(err))
+	}
+	return m
+}
+
+// runMigrate serves `main migrate <command>` and exits.
+func runMigrate(conf *config.Config, args []string) {
+	if conf.Storage == storage.Memory || conf.DB == nil {
+		zap.L().Fatal("migrate requires storage: db and a db section")
+	}
+
+	m := mustRegisterMigrate(conf.DB)
+	err := migrator.Run(m, args, os.Stdout)
+	if srcErr, dbErr := m.Close(); srcErr != nil || dbErr != nil {
+		zap.L().Warn("Error closing migrations", zap.NamedError("source", srcErr), zap.NamedError("db", dbErr))
+	}
+
+	if errors.Is(err, migrator.ErrUsage) {
+		fmt.Fprintln(os.Stderr, migrator.Usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		zap.L().Fatal("Failed to migrate", zap.Error(err))
+	}
+	os.Exit(0)
+}
+
 func main() {
 	defer func() {
 		if err := recover(); err != nil {
@@ -41,27 +118,61 @@ func main() {
 	conf := config.MustLoad(configPath)
 	mustRegisterLogger(conf.Mode)
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(conf, os.Args[2:])
+	}
+
 	go prometheus.New(conf.Server.Port + 5).Start(ctx)
 	go jaeger.Start(ctx, conf.ServiceName, conf.Jaeger)
 
-	cache := redis.New(conf.Redis)
-	repo := db.New(conf.DB)
-	svc := ctrl.New(repo, cache)
-	h := http.New(svc, sso.New(conf.Services))
+	repo, cache := storage.MustNew(conf)
+	pub := mustRegisterPublisher(conf.Outbox)
+	svc := ctrl.New(repo, cache, conf)
+	ssoSvc := sso.New(conf.Services)
+	h := http.New(svc, ssoSvc)
+
+	var g *grpc.Handler
+	if conf.GRPC != nil {
+		g = grpc.New(conf.ServiceName, svc, ssoSvc)
+		if conf.GRPC.Multiplex {
+			h.ServeGRPC(g)
+		} else {
+			go g.Start(conf.GRPC.Port)
+		}
+	}
 
 	go h.Start(conf.Server.Port)
+	go svc.RunScheduler(ctx)
+	go svc.RunWebhooks(ctx)
+	go svc.RunOutbox(ctx, pub)
+	go svc.RunEvents(ctx)
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 	<-c
 
 	zap.L().Info("Shutting down gracefully...")
-	if err := h.Close(ctx); err != nil {
+	sctx, scancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer scancel()
+
+	svc.CloseWatchers()
+	if err := h.Close(sctx); err != nil {
 		zap.L().Warn("Error closing handler", zap.Error(err))
 	}
 
+	if g != nil {
+		if err := g.Close(sctx); err != nil {
+			zap.L().Warn("Error closing gRPC handler", zap.Error(err))
+		}
+	}
+	cancel()
+
+	if err := pub.Close(); err != nil {
+		zap.L().Warn("Error closing outbox publisher", zap.Error(err))
+	}
+
 	if err := cache.Close(); err != nil {
-		zap.L().Warn("Failed to close connection to Redis: ", zap.Error(err))
+		zap.L().Warn("Error closing cache", zap.Error(err))
 	}
 
 	if err := repo.Close(); err != nil {
@@ -0,0 +1,85 @@
+package cache
+
+// Match reports whether key matches a Redis glob pattern as used by SCAN and
+// KEYS: * and ? match any run of bytes or any single byte, [abc], [^abc] and
+// [a-z] match byte classes and \ escapes the next byte. Unlike path.Match, /
+// is not special.
+func Match(pattern, key string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 1 && pattern[1] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(key); i++ {
+				if Match(pattern[1:], key[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(key) == 0 {
+				return false
+			}
+			key = key[1:]
+		case '[':
+			if len(key) == 0 {
+				return false
+			}
+			var ok bool
+			if pattern, ok = matchClass(pattern[1:], key[0]); !ok {
+				return false
+			}
+			key = key[1:]
+			continue
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(key) == 0 || pattern[0] != key[0] {
+				return false
+			}
+			key = key[1:]
+		}
+		pattern = pattern[1:]
+	}
+	return len(key) == 0
+}
+
+// matchClass matches b against the class that starts right after a [ and
+// returns the pattern following its closing ]. An unterminated class runs to
+// the end of the pattern, as in Redis.
+func matchClass(pattern string, b byte) (string, bool) {
+	not := len(pattern) > 0 && pattern[0] == '^'
+	if not {
+		pattern = pattern[1:]
+	}
+
+	var match bool
+	for len(pattern) > 0 && pattern[0] != ']' {
+		switch {
+		case pattern[0] == '\\' && len(pattern) > 1:
+			match = match || pattern[1] == b
+			pattern = pattern[2:]
+		case len(pattern) > 2 && pattern[1] == '-':
+			lo, hi := pattern[0], pattern[2]
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			match = match || (b >= lo && b <= hi)
+			pattern = pattern[3:]
+		default:
+			match = match || pattern[0] == b
+			pattern = pattern[1:]
+		}
+	}
+	if len(pattern) > 0 {
+		pattern = pattern[1:]
+	}
+	return pattern, match != not
+}
@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		key     string
+		match   bool
+	}{
+		{"SEO:product:1:*", "SEO:product:1:en", true},
+		{"SEO:product:1:*", "SEO:product:1:", true},
+		{"SEO:product:1:*", "SEO:product:10:en", false},
+		{"sitemap:*", "sitemap:0:true", true},
+		{"page:*", "page:blog/post", true},
+		{"breadcrumbs:*:en", "breadcrumbs:blog/post:en", true},
+		{"*", "", true},
+		{"h?llo", "hello", true},
+		{"h?llo", "hllo", false},
+		{"h[ae]llo", "hallo", true},
+		{"h[ae]llo", "hillo", false},
+		{"h[^e]llo", "hallo", true},
+		{"h[^e]llo", "hello", false},
+		{"h[a-c]llo", "hbllo", true},
+		{"h[a-c]llo", "hdllo", false},
+		{`h\*llo`, "h*llo", true},
+		{`h\*llo`, "hello", false},
+		{"a**b", "axyzb", true},
+		{"template:*:*", "template:product:en", true},
+		{"template:*:*", "template:product", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, Match(tt.pattern, tt.key), "%q ~ %q", tt.pattern, tt.key)
+	}
+}
@@ -0,0 +1,183 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/JMURv/seo/internal/cache"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often expired entries that nobody reads are dropped.
+const sweepInterval = time.Minute
+
+// Cache keeps everything in the process. It stands in for Redis in tests and
+// single instance setups; entries are not shared between replicas.
+type Cache struct {
+	mu      sync.Mutex
+	items   map[string]*entry
+	stop    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+}
+
+type entry struct {
+	val []byte
+	// expires is zero for entries without a TTL.
+	expires time.Time
+}
+
+func New() *Cache {
+	c := &Cache{
+		items:   make(map[string]*entry),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	go c.sweep()
+	return c
+}
+
+func (c *Cache) Close() error {
+	c.once.Do(
+		func() {
+			close(c.stop)
+			<-c.stopped
+		},
+	)
+	return nil
+}
+
+func (c *Cache) GetToStruct(ctx context.Context, key string, dest any) error {
+	const op = "cache.memory.GetToStruct"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	val, ok := c.get(key, time.Now())
+	if !ok {
+		zap.L().Debug(
+			cache.ErrNotFoundInCache.Error(),
+			zap.String("op", op), zap.String("key", key),
+		)
+		return cache.ErrNotFoundInCache
+	}
+
+	if err := json.Unmarshal(val, dest); err != nil {
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to unmarshal",
+			zap.String("op", op),
+			zap.String("key", key), zap.Any("dest", dest),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// Set stores val for t, or until deleted when t is not positive like Redis
+// does. []byte and string values are kept as is, anything else as JSON.
+func (c *Cache) Set(ctx context.Context, t time.Duration, key string, val any) {
+	const op = "cache.memory.Set"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var data []byte
+	switch v := val.(type) {
+	case []byte:
+		data = append([]byte(nil), v...)
+	case string:
+		data = []byte(v)
+	default:
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			span.SetTag("error", true)
+			zap.L().Debug(
+				"failed to set to cache",
+				zap.String("op", op),
+				zap.String("t", t.String()), zap.String("key", key), zap.Any("val", val),
+				zap.Error(err),
+			)
+			return
+		}
+	}
+
+	e := &entry{val: data}
+	if t > 0 {
+		e.expires = time.Now().Add(t)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = e
+}
+
+func (c *Cache) Delete(ctx context.Context, key string) {
+	const op = "cache.memory.Delete"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
+// InvalidateKeysByPattern drops the keys matching the Redis glob pattern.
+func (c *Cache) InvalidateKeysByPattern(ctx context.Context, pattern string) {
+	const op = "cache.memory.InvalidateKeysByPattern"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.items {
+		if cache.Match(pattern, key) {
+			delete(c.items, key)
+		}
+	}
+}
+
+func (c *Cache) get(key string, now time.Time) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	if e.expired(now) {
+		delete(c.items, key)
+		return nil, false
+	}
+	return e.val, true
+}
+
+func (e *entry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// sweep drops expired entries every sweepInterval until Close.
+func (c *Cache) sweep() {
+	defer close(c.stopped)
+
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case now := <-ticker.C:
+			c.mu.Lock()
+			for key, e := range c.items {
+				if e.expired(now) {
+					delete(c.items, key)
+				}
+			}
+			c.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"github.com/JMURv/seo/internal/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCache_GetToStruct(t *testing.T) {
+	ctx := context.Background()
+	c := New()
+	defer c.Close()
+
+	c.Set(ctx, time.Hour, "SEO:product:1:en", map[string]string{"title": "Oak"})
+	c.Set(ctx, time.Hour, "page:about", []byte(`{"slug":"about"}`))
+	c.Set(ctx, time.Hour, "page:contact", `{"slug":"contact"}`)
+
+	var seo map[string]string
+	require.NoError(t, c.GetToStruct(ctx, "SEO:product:1:en", &seo))
+	assert.Equal(t, "Oak", seo["title"])
+
+	var page map[string]string
+	require.NoError(t, c.GetToStruct(ctx, "page:about", &page))
+	assert.Equal(t, "about", page["slug"])
+	require.NoError(t, c.GetToStruct(ctx, "page:contact", &page))
+	assert.Equal(t, "contact", page["slug"])
+
+	assert.ErrorIs(t, c.GetToStruct(ctx, "SEO:product:2:en", &seo), cache.ErrNotFoundInCache)
+
+	c.Set(ctx, time.Hour, "page:broken", "not json")
+	assert.Error(t, c.GetToStruct(ctx, "page:broken", &page))
+}
+
+func TestCache_Expiry(t *testing.T) {
+	ctx := context.Background()
+	c := New()
+	defer c.Close()
+
+	c.Set(ctx, time.Minute, "page:about", "about")
+	c.Set(ctx, 0, "page:contact", "contact")
+
+	_, ok := c.get("page:about", time.Now().Add(59*time.Second))
+	assert.True(t, ok)
+	_, ok = c.get("page:about", time.Now().Add(61*time.Second))
+	assert.False(t, ok)
+
+	_, ok = c.get("page:contact", time.Now().Add(24*time.Hour))
+	assert.True(t, ok)
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	ctx := context.Background()
+	c := New()
+	defer c.Close()
+
+	for _, key := range []string{"SEO:product:1:en", "SEO:product:1:ru", "SEO:product:2:en", "page:about"} {
+		c.Set(ctx, time.Hour, key, "{}")
+	}
+
+	c.InvalidateKeysByPattern(ctx, "SEO:product:1:*")
+	c.Delete(ctx, "page:about")
+
+	_, ok := c.get("SEO:product:1:en", time.Now())
+	assert.False(t, ok)
+	_, ok = c.get("SEO:product:1:ru", time.Now())
+	assert.False(t, ok)
+	_, ok = c.get("page:about", time.Now())
+	assert.False(t, ok)
+	_, ok = c.get("SEO:product:2:en", time.Now())
+	assert.True(t, ok)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, c.Close())
+}
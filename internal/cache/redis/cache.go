@@ -41,20 +41,8 @@ func (c *Cache) GetToStruct(ctx context.Context, key string, dest any) error {
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	val, err := c.cli.Get(ctx, key).Bytes()
-	if err == redis.Nil {
-		zap.L().Debug(
-			cache.ErrNotFoundInCache.Error(),
-			zap.String("op", op), zap.String("key", key),
-		)
-		return cache.ErrNotFoundInCache
-	} else if err != nil {
-		span.SetTag("error", true)
-		zap.L().Debug(
-			"failed to get from cache",
-			zap.String("op", op), zap.String("key", key),
-			zap.Error(err),
-		)
+	val, err := c.Get(ctx, key)
+	if err != nil {
 		return err
 	}
 
@@ -73,6 +61,31 @@ func (c *Cache) GetToStruct(ctx context.Context, key string, dest any) error {
 	return nil
 }
 
+// Get returns the raw value stored under key.
+func (c *Cache) Get(ctx context.Context, key string) ([]byte, error) {
+	const op = "cache.Get"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	val, err := c.cli.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		zap.L().Debug(
+			cache.ErrNotFoundInCache.Error(),
+			zap.String("op", op), zap.String("key", key),
+		)
+		return nil, cache.ErrNotFoundInCache
+	} else if err != nil {
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to get from cache",
+			zap.String("op", op), zap.String("key", key),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return val, nil
+}
+
 func (c *Cache) Set(ctx context.Context, t time.Duration, key string, val any) {
 	const op = "SetToCache"
 	span, ctx := ot.StartSpanFromContext(ctx, op)
@@ -138,3 +151,36 @@ func (c *Cache) InvalidateKeysByPattern(ctx context.Context, pattern string) {
 		}
 	}
 }
+
+func (c *Cache) Publish(ctx context.Context, channel string, msg []byte) error {
+	return c.cli.Publish(ctx, channel, msg).Err()
+}
+
+// Subscribe returns the messages published to channel until ctx is done.
+// Messages sent while the connection is being re-established are lost.
+func (c *Cache) Subscribe(ctx context.Context, channel string) <-chan []byte {
+	ps := c.cli.Subscribe(ctx, channel)
+	out := make(chan []byte)
+	go func() {
+		defer close(out)
+		defer ps.Close()
+
+		ch := ps.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case out <- []byte(msg.Payload):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
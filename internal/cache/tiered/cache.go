@@ -0,0 +1,307 @@
+package tiered
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/JMURv/seo/internal/cache"
+	cfg "github.com/JMURv/seo/internal/config"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"io"
+	"sync"
+	"time"
+)
+
+const defaultMaxEntries = 10000
+const defaultMaxBytes = 64 << 20
+const defaultTTL = time.Minute
+const defaultChannel = "seo:cache:invalidate"
+
+// Remote is the shared cache behind the in-process one, implemented by the
+// Redis cache. Its pub/sub carries invalidations between replicas.
+type Remote interface {
+	io.Closer
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, t time.Duration, key string, val any)
+	Delete(ctx context.Context, key string)
+	InvalidateKeysByPattern(ctx context.Context, pattern string)
+	Publish(ctx context.Context, channel string, msg []byte) error
+	Subscribe(ctx context.Context, channel string) <-chan []byte
+}
+
+// Cache serves reads from a size-bounded LRU (L1) and falls back to Remote
+// (L2). L1 keeps the encoded values, so every reader decodes its own copy.
+type Cache struct {
+	l2      Remote
+	conf    cfg.L1CacheConfig
+	origin  string
+	cancel  context.CancelFunc
+	stopped chan struct{}
+
+	mu    sync.Mutex
+	lru   *list.List
+	items map[string]*list.Element
+	size  int64
+	// gen changes with every invalidation; a read that started before one
+	// does not fill L1 with what it got from L2.
+	gen uint64
+}
+
+type entry struct {
+	key     string
+	val     []byte
+	expires time.Time
+}
+
+// invalidation is published for every write, so that other replicas drop the
+// key or the keys matching the pattern from their L1.
+type invalidation struct {
+	Origin  string `json:"origin"`
+	Key     string `json:"key,omitempty"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+func New(l2 Remote, conf *cfg.L1CacheConfig) *Cache {
+	c := &Cache{
+		l2:      l2,
+		conf:    withDefaults(conf),
+		origin:  newOrigin(),
+		stopped: make(chan struct{}),
+		lru:     list.New(),
+		items:   make(map[string]*list.Element),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	go c.listen(c.l2.Subscribe(ctx, c.conf.Channel))
+	return c
+}
+
+func withDefaults(conf *cfg.L1CacheConfig) cfg.L1CacheConfig {
+	res := cfg.L1CacheConfig{
+		MaxEntries: defaultMaxEntries,
+		MaxBytes:   defaultMaxBytes,
+		TTL:        defaultTTL,
+		Channel:    defaultChannel,
+	}
+
+	if conf == nil {
+		return res
+	}
+	if conf.MaxEntries > 0 {
+		res.MaxEntries = conf.MaxEntries
+	}
+	if conf.MaxBytes > 0 {
+		res.MaxBytes = conf.MaxBytes
+	}
+	if conf.TTL > 0 {
+		res.TTL = conf.TTL
+	}
+	if conf.Channel != "" {
+		res.Channel = conf.Channel
+	}
+	return res
+}
+
+func newOrigin() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Close stops listening for invalidations and closes L2.
+func (c *Cache) Close() error {
+	c.cancel()
+	<-c.stopped
+	return c.l2.Close()
+}
+
+func (c *Cache) GetToStruct(ctx context.Context, key string, dest any) error {
+	const op = "cache.tiered.GetToStruct"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	val, gen, ok := c.get(key, time.Now())
+	if ok {
+		metrics.ObserveCacheTier("l1", "hit")
+		return json.Unmarshal(val, dest)
+	}
+	metrics.ObserveCacheTier("l1", "miss")
+
+	val, err := c.l2.Get(ctx, key)
+	if err != nil {
+		metrics.ObserveCacheTier("l2", "miss")
+		return err
+	}
+	metrics.ObserveCacheTier("l2", "hit")
+
+	if err = json.Unmarshal(val, dest); err != nil {
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to unmarshal",
+			zap.String("op", op),
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	c.fill(key, val, c.conf.TTL, gen)
+	return nil
+}
+
+// Set writes through to L2 and keeps the value in L1 for at most its TTL.
+// Only encoded values ([]byte or string) are kept in L1.
+func (c *Cache) Set(ctx context.Context, t time.Duration, key string, val any) {
+	c.l2.Set(ctx, t, key, val)
+	gen := c.evict(key)
+	c.publish(ctx, &invalidation{Key: key})
+
+	var data []byte
+	switch v := val.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return
+	}
+	c.fill(key, data, min(t, c.conf.TTL), gen)
+}
+
+func (c *Cache) Delete(ctx context.Context, key string) {
+	c.l2.Delete(ctx, key)
+	c.evict(key)
+	c.publish(ctx, &invalidation{Key: key})
+}
+
+func (c *Cache) InvalidateKeysByPattern(ctx context.Context, pattern string) {
+	c.l2.InvalidateKeysByPattern(ctx, pattern)
+	c.evictPattern(pattern)
+	c.publish(ctx, &invalidation{Pattern: pattern})
+}
+
+func (c *Cache) get(key string, now time.Time) ([]byte, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, c.gen, false
+	}
+
+	e := el.Value.(*entry)
+	if !now.Before(e.expires) {
+		c.remove(el)
+		return nil, c.gen, false
+	}
+
+	c.lru.MoveToFront(el)
+	return e.val, c.gen, true
+}
+
+// fill stores val unless an invalidation happened since gen was read, then
+// evicts the least recently used entries beyond the limits.
+func (c *Cache) fill(key string, val []byte, ttl time.Duration, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gen || ttl <= 0 || int64(len(key)+len(val)) > c.conf.MaxBytes {
+		return
+	}
+
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+
+	e := &entry{key: key, val: val, expires: time.Now().Add(ttl)}
+	c.items[key] = c.lru.PushFront(e)
+	c.size += e.cost()
+
+	for c.lru.Len() > c.conf.MaxEntries || c.size > c.conf.MaxBytes {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *Cache) evict(key string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+	return c.gen
+}
+
+func (c *Cache) evictPattern(pattern string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	for key, el := range c.items {
+		if cache.Match(pattern, key) {
+			c.remove(el)
+		}
+	}
+}
+
+func (c *Cache) remove(el *list.Element) {
+	e := el.Value.(*entry)
+	c.lru.Remove(el)
+	delete(c.items, e.key)
+	c.size -= e.cost()
+}
+
+func (e *entry) cost() int64 {
+	return int64(len(e.key) + len(e.val))
+}
+
+func (c *Cache) publish(ctx context.Context, msg *invalidation) {
+	const op = "cache.tiered.publish"
+
+	msg.Origin = c.origin
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+
+	// Replicas would keep a stale copy for up to the L1 TTL if the request
+	// went away before the announcement.
+	if err = c.l2.Publish(context.WithoutCancel(ctx), c.conf.Channel, data); err != nil {
+		zap.L().Debug(
+			"failed to publish invalidation",
+			zap.String("op", op),
+			zap.String("key", msg.Key), zap.String("pattern", msg.Pattern),
+			zap.Error(err),
+		)
+	}
+}
+
+// listen applies the invalidations of other replicas until msgs is closed.
+func (c *Cache) listen(msgs <-chan []byte) {
+	const op = "cache.tiered.listen"
+	defer close(c.stopped)
+
+	for data := range msgs {
+		msg := &invalidation{}
+		if err := json.Unmarshal(data, msg); err != nil {
+			zap.L().Debug("failed to decode invalidation", zap.String("op", op), zap.Error(err))
+			continue
+		}
+		if msg.Origin == c.origin {
+			continue
+		}
+
+		switch {
+		case msg.Key != "":
+			c.evict(msg.Key)
+		case msg.Pattern != "":
+			c.evictPattern(msg.Pattern)
+		}
+	}
+}
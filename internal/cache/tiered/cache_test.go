@@ -0,0 +1,232 @@
+package tiered
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/JMURv/seo/internal/cache"
+	cfg "github.com/JMURv/seo/internal/config"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// remote is an in-memory Remote; every cache built on it gets its own
+// subscription, like replicas sharing one Redis.
+type remote struct {
+	mu   sync.Mutex
+	data map[string][]byte
+	gets int
+	bus  *bus
+}
+
+type bus struct {
+	mu   sync.Mutex
+	subs []chan []byte
+}
+
+func newRemote(b *bus) *remote {
+	return &remote{data: make(map[string][]byte), bus: b}
+}
+
+func (r *remote) Close() error { return nil }
+
+func (r *remote) Get(_ context.Context, key string) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.gets++
+	val, ok := r.data[key]
+	if !ok {
+		return nil, cache.ErrNotFoundInCache
+	}
+	return val, nil
+}
+
+func (r *remote) Set(_ context.Context, _ time.Duration, key string, val any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.data[key] = val.([]byte)
+}
+
+func (r *remote) Delete(_ context.Context, key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.data, key)
+}
+
+func (r *remote) InvalidateKeysByPattern(_ context.Context, pattern string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key := range r.data {
+		if cache.Match(pattern, key) {
+			delete(r.data, key)
+		}
+	}
+}
+
+func (r *remote) Publish(_ context.Context, _ string, msg []byte) error {
+	r.bus.mu.Lock()
+	defer r.bus.mu.Unlock()
+	for _, ch := range r.bus.subs {
+		ch <- msg
+	}
+	return nil
+}
+
+func (r *remote) Subscribe(ctx context.Context, _ string) <-chan []byte {
+	ch := make(chan []byte, 16)
+	r.bus.mu.Lock()
+	r.bus.subs = append(r.bus.subs, ch)
+	r.bus.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		r.bus.mu.Lock()
+		defer r.bus.mu.Unlock()
+		r.bus.subs = slices.DeleteFunc(
+			r.bus.subs, func(sub chan []byte) bool {
+				return sub == ch
+			},
+		)
+		close(ch)
+	}()
+	return ch
+}
+
+func (r *remote) getCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.gets
+}
+
+func encode(t *testing.T, v any) []byte {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
+
+func TestCache_GetToStruct(t *testing.T) {
+	ctx := context.Background()
+	l2 := newRemote(&bus{})
+	c := New(l2, &cfg.L1CacheConfig{TTL: time.Minute})
+	defer c.Close()
+
+	l1Hits := testutil.ToFloat64(metrics.CacheTierMetrics.WithLabelValues("l1", "hit"))
+	l2Hits := testutil.ToFloat64(metrics.CacheTierMetrics.WithLabelValues("l2", "hit"))
+
+	l2.data["SEO:product:1:en"] = encode(t, map[string]string{"title": "Oak"})
+
+	var dest map[string]string
+	require.NoError(t, c.GetToStruct(ctx, "SEO:product:1:en", &dest))
+	assert.Equal(t, "Oak", dest["title"])
+
+	dest = nil
+	require.NoError(t, c.GetToStruct(ctx, "SEO:product:1:en", &dest))
+	assert.Equal(t, "Oak", dest["title"])
+	assert.Equal(t, 1, l2.getCount())
+
+	assert.Equal(t, l1Hits+1, testutil.ToFloat64(metrics.CacheTierMetrics.WithLabelValues("l1", "hit")))
+	assert.Equal(t, l2Hits+1, testutil.ToFloat64(metrics.CacheTierMetrics.WithLabelValues("l2", "hit")))
+
+	assert.ErrorIs(t, c.GetToStruct(ctx, "SEO:product:2:en", &dest), cache.ErrNotFoundInCache)
+}
+
+func TestCache_Expiry(t *testing.T) {
+	ctx := context.Background()
+	l2 := newRemote(&bus{})
+	c := New(l2, &cfg.L1CacheConfig{TTL: time.Minute})
+	defer c.Close()
+
+	c.Set(ctx, time.Hour, "page:about", encode(t, "about"))
+	_, _, ok := c.get("page:about", time.Now().Add(59*time.Second))
+	assert.True(t, ok)
+	_, _, ok = c.get("page:about", time.Now().Add(61*time.Second))
+	assert.False(t, ok)
+
+	// A shorter TTL of the entry itself wins over the L1 TTL.
+	c.Set(ctx, time.Second, "page:contact", encode(t, "contact"))
+	_, _, ok = c.get("page:contact", time.Now().Add(2*time.Second))
+	assert.False(t, ok)
+}
+
+func TestCache_Bounds(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run(
+		"Entries", func(t *testing.T) {
+			c := New(newRemote(&bus{}), &cfg.L1CacheConfig{MaxEntries: 2})
+			defer c.Close()
+
+			c.Set(ctx, time.Hour, "a", []byte(`1`))
+			c.Set(ctx, time.Hour, "b", []byte(`2`))
+			_, _, _ = c.get("a", time.Now())
+			c.Set(ctx, time.Hour, "c", []byte(`3`))
+
+			_, _, ok := c.get("b", time.Now())
+			assert.False(t, ok, "least recently used entry is evicted")
+			_, _, ok = c.get("a", time.Now())
+			assert.True(t, ok)
+			_, _, ok = c.get("c", time.Now())
+			assert.True(t, ok)
+		},
+	)
+
+	t.Run(
+		"Bytes", func(t *testing.T) {
+			c := New(newRemote(&bus{}), &cfg.L1CacheConfig{MaxBytes: 10})
+			defer c.Close()
+
+			c.Set(ctx, time.Hour, "a", []byte(`1234`))
+			c.Set(ctx, time.Hour, "b", []byte(`1234`))
+			c.Set(ctx, time.Hour, "c", []byte(`1234`))
+			c.Set(ctx, time.Hour, "big", []byte(`12345678901`))
+
+			_, _, ok := c.get("a", time.Now())
+			assert.False(t, ok)
+			_, _, ok = c.get("c", time.Now())
+			assert.True(t, ok)
+			_, _, ok = c.get("big", time.Now())
+			assert.False(t, ok, "values larger than the cache are not kept")
+			assert.LessOrEqual(t, c.size, int64(10))
+		},
+	)
+}
+
+func TestCache_CrossReplicaInvalidation(t *testing.T) {
+	ctx := context.Background()
+	l2 := newRemote(&bus{})
+	a, other := New(l2, nil), New(l2, nil)
+	defer a.Close()
+	defer other.Close()
+
+	l2.data["SEO:product:1:en"] = encode(t, "v1")
+	l2.data["SEO:product:1:de"] = encode(t, "v1")
+	l2.data["page:about"] = encode(t, "v1")
+
+	var dest string
+	for _, key := range []string{"SEO:product:1:en", "SEO:product:1:de", "page:about"} {
+		require.NoError(t, other.GetToStruct(ctx, key, &dest))
+	}
+
+	cached := func(key string) func() bool {
+		return func() bool {
+			_, _, ok := other.get(key, time.Now())
+			return ok
+		}
+	}
+
+	a.Delete(ctx, "page:about")
+	assert.Eventually(t, func() bool { return !cached("page:about")() }, time.Second, time.Millisecond)
+
+	a.InvalidateKeysByPattern(ctx, "SEO:product:1:*")
+	assert.Eventually(
+		t, func() bool {
+			return !cached("SEO:product:1:en")() && !cached("SEO:product:1:de")()
+		}, time.Second, time.Millisecond,
+	)
+}
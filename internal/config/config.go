@@ -3,16 +3,27 @@ package config
 import (
 	"gopkg.in/yaml.v3"
 	"os"
+	"time"
 )
 
 type Config struct {
-	Mode        string          `yaml:"mode" env-default:"dev"`
-	ServiceName string          `yaml:"serviceName" env-required:"true"`
-	Services    *ServicesConfig `yaml:"services"`
-	Server      *ServerConfig   `yaml:"server"`
-	DB          *DBConfig       `yaml:"db"`
-	Redis       *RedisConfig    `yaml:"redis"`
-	Jaeger      *JaegerConfig   `yaml:"jaeger"`
+	Mode        string           `yaml:"mode" env-default:"dev"`
+	ServiceName string           `yaml:"serviceName" env-required:"true"`
+	Storage     string           `yaml:"storage" env-default:"db"`
+	Services    *ServicesConfig  `yaml:"services"`
+	Server      *ServerConfig    `yaml:"server"`
+	GRPC        *GRPCConfig      `yaml:"grpc"`
+	DB          *DBConfig        `yaml:"db"`
+	Redis       *RedisConfig     `yaml:"redis"`
+	Cache       *CacheConfig     `yaml:"cache"`
+	Jaeger      *JaegerConfig    `yaml:"jaeger"`
+	Sitemap     *SitemapConfig   `yaml:"sitemap"`
+	Locales     *LocalesConfig   `yaml:"locales"`
+	Scheduler   *SchedulerConfig `yaml:"scheduler"`
+	Audit       *AuditConfig     `yaml:"audit"`
+	Webhooks    *WebhooksConfig  `yaml:"webhooks"`
+	Outbox      *OutboxConfig    `yaml:"outbox"`
+	Events      *EventsConfig    `yaml:"events"`
 }
 
 type ServicesConfig struct {
@@ -25,12 +36,25 @@ type ServerConfig struct {
 	Domain string `yaml:"domain" env-default:"localhost"`
 }
 
+// GRPCConfig enables the gRPC server. With Multiplex it shares server.port
+// with the REST API over cleartext HTTP/2 and Port is ignored.
+type GRPCConfig struct {
+	Port      int  `yaml:"port"`
+	Multiplex bool `yaml:"multiplex"`
+}
+
+// DBConfig points at the database. Driver is postgres or sqlite; SQLite keeps
+// everything in the file named by Database and ignores the other fields.
+// SkipMigrations leaves the schema to the migrate subcommand instead of
+// migrating up on startup.
 type DBConfig struct {
-	Host     string `yaml:"host" env-default:"localhost"`
-	Port     int    `yaml:"port" env-default:"5432"`
-	User     string `yaml:"user" env-default:"postgres"`
-	Password string `yaml:"password" env-default:"postgres"`
-	Database string `yaml:"database" env-default:"db"`
+	Driver         string `yaml:"driver" env-default:"postgres"`
+	Host           string `yaml:"host" env-default:"localhost"`
+	Port           int    `yaml:"port" env-default:"5432"`
+	User           string `yaml:"user" env-default:"postgres"`
+	Password       string `yaml:"password" env-default:"postgres"`
+	Database       string `yaml:"database" env-default:"db"`
+	SkipMigrations bool   `yaml:"skipMigrations"`
 }
 
 type RedisConfig struct {
@@ -38,6 +62,109 @@ type RedisConfig struct {
 	Pass string `yaml:"pass" env-default:""`
 }
 
+type SitemapConfig struct {
+	Host    string            `yaml:"host"`
+	Objects map[string]string `yaml:"objects"`
+}
+
+type LocalesConfig struct {
+	Default string `yaml:"default"`
+	Path    string `yaml:"path"`
+}
+
+type SchedulerConfig struct {
+	Interval time.Duration `yaml:"interval"`
+}
+
+// AuditConfig tunes audit rules by name. Rules missing from the map keep
+// their defaults.
+type AuditConfig struct {
+	Rules map[string]*AuditRuleConfig `yaml:"rules"`
+}
+
+// AuditRuleConfig overrides a rule's defaults; omitted fields are left as is,
+// so zero can be set explicitly, e.g. weight: 0 to report a rule without
+// scoring it. Min/Max bound lengths or counts (max: 0 means no upper bound)
+// and Ratio is used by ratio based rules.
+type AuditRuleConfig struct {
+	Enabled *bool    `yaml:"enabled"`
+	Min     *int     `yaml:"min"`
+	Max     *int     `yaml:"max"`
+	Ratio   *float64 `yaml:"ratio"`
+	Weight  *int     `yaml:"weight"`
+}
+
+// WebhooksConfig tunes the delivery worker; zero fields keep their defaults.
+// Failed attempts are retried after Backoff, doubled per attempt up to
+// MaxBackoff, and given up after MaxAttempts.
+type WebhooksConfig struct {
+	Interval    time.Duration `yaml:"interval"`
+	Timeout     time.Duration `yaml:"timeout"`
+	Backoff     time.Duration `yaml:"backoff"`
+	MaxBackoff  time.Duration `yaml:"maxBackoff"`
+	MaxAttempts int           `yaml:"maxAttempts"`
+	Batch       int           `yaml:"batch"`
+}
+
+// OutboxConfig tunes the relay that publishes committed changes; zero fields
+// keep their defaults. Publisher is one of kafka, nats or memory, the last
+// one only logging events. Published events are purged after Retention.
+type OutboxConfig struct {
+	Publisher string        `yaml:"publisher"`
+	Interval  time.Duration `yaml:"interval"`
+	Lease     time.Duration `yaml:"lease"`
+	Batch     int           `yaml:"batch"`
+	Retention time.Duration `yaml:"retention"`
+	Kafka     *KafkaConfig  `yaml:"kafka"`
+	NATS      *NATSConfig   `yaml:"nats"`
+}
+
+type KafkaConfig struct {
+	Brokers []string `yaml:"brokers"`
+	Topic   string   `yaml:"topic"`
+}
+
+// NATSConfig publishes every event to Subject followed by the event name,
+// e.g. seo.events.page.updated.
+type NATSConfig struct {
+	URL     string `yaml:"url"`
+	Subject string `yaml:"subject"`
+}
+
+// EventsConfig tunes the change feed behind the Watch RPCs and /api/events;
+// zero fields keep their defaults. The feed reads the outbox every Interval
+// and waits up to GapTimeout for a missing id to commit before skipping it.
+// A watcher that falls Buffer events behind is disconnected.
+type EventsConfig struct {
+	Interval   time.Duration `yaml:"interval"`
+	GapTimeout time.Duration `yaml:"gapTimeout"`
+	Buffer     int           `yaml:"buffer"`
+}
+
+// CacheConfig tunes the cached SEO and page reads; zero fields keep their
+// defaults. Entries are served as fresh for SoftTTL, then served stale while
+// one background load refreshes them, and dropped after TTL. Both are scaled
+// by a random factor within ±Jitter per entry so that hot keys written
+// together do not expire together.
+type CacheConfig struct {
+	TTL     time.Duration  `yaml:"ttl"`
+	SoftTTL time.Duration  `yaml:"softTTL"`
+	Jitter  float64        `yaml:"jitter"`
+	L1      *L1CacheConfig `yaml:"l1"`
+}
+
+// L1CacheConfig puts an in-process LRU in front of Redis; zero fields keep
+// their defaults. Entries live for at most TTL and the least recently used
+// ones are evicted beyond MaxEntries or MaxBytes. Writes and invalidations
+// are announced on the Redis pub/sub Channel so that every replica drops its
+// copy.
+type L1CacheConfig struct {
+	MaxEntries int           `yaml:"maxEntries"`
+	MaxBytes   int64         `yaml:"maxBytes"`
+	TTL        time.Duration `yaml:"ttl"`
+	Channel    string        `yaml:"channel"`
+}
+
 type JaegerConfig struct {
 	Sampler struct {
 		Type  string  `yaml:"type"`
@@ -0,0 +1,431 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const auditMaxScore = 100
+
+// auditRule holds a rule's thresholds once config overrides are applied.
+type auditRule struct {
+	Min    int
+	Max    int
+	Ratio  float64
+	Weight int
+}
+
+// auditDefaults holds every rule with its default thresholds. audit.rules in
+// the config overrides them field by field.
+var auditDefaults = map[string]auditRule{
+	md.AuditTitleLength:        {Min: 30, Max: 60, Weight: 15},
+	md.AuditDescriptionLength:  {Min: 70, Max: 160, Weight: 15},
+	md.AuditKeywordStuffing:    {Max: 3, Weight: 15},
+	md.AuditTitleEqualsOGTitle: {Weight: 5},
+	md.AuditMissingOGImage:     {Weight: 20},
+	md.AuditDuplicateTitle:     {Weight: 15},
+	md.AuditDuplicateDesc:      {Weight: 10},
+	md.AuditUppercaseAbuse:     {Min: 10, Ratio: 0.5, Weight: 10},
+}
+
+// AuditSEO checks a single record against the enabled rules. Duplicates are
+// looked up among all non-archived records of the same locale.
+func (c *Controller) AuditSEO(ctx context.Context, name, pk, locale string) (*dto.SEOAudit, error) {
+	const op = "audit.AuditSEO.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	locale = md.NormalizeLocale(locale)
+	seo, err := c.repo.GetSEO(ctx, name, pk, locale)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	rules := c.auditRules()
+	violations := auditViolations(seo, rules)
+
+	_, dupTitle := rules[md.AuditDuplicateTitle]
+	_, dupDesc := rules[md.AuditDuplicateDesc]
+	if dupTitle || dupDesc {
+		titles, descriptions, err := c.repo.CountSEODuplicates(ctx, seo)
+		if err != nil {
+			zap.L().Debug(
+				ErrInternal.Error(),
+				zap.String("op", op),
+				zap.String("name", name), zap.String("pk", pk), zap.String("locale", locale),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		violations = append(violations, duplicateViolations(seo, titles, descriptions, rules)...)
+	}
+
+	return newSEOAudit(seo, violations), nil
+}
+
+// AuditReport audits every non-archived record, optionally of one obj_name,
+// and aggregates scores per obj_name. Duplicates are counted among all
+// non-archived records of the same locale, as in AuditSEO.
+func (c *Controller) AuditReport(ctx context.Context, name string) (*dto.AuditReport, error) {
+	const op = "audit.AuditReport.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	type entry struct {
+		seo   *md.SEO
+		audit *dto.SEOAudit
+	}
+
+	rules := c.auditRules()
+	titles := make(map[[2]string]int)
+	descriptions := make(map[[2]string]int)
+
+	var entries []entry
+	f := &md.SEOFilter{Size: exportBatch, Sort: md.SortOBJPK, Order: md.OrderAsc}
+	for {
+		list, err := c.repo.ListSEO(ctx, f)
+		if err != nil {
+			zap.L().Debug(
+				ErrInternal.Error(),
+				zap.String("op", op),
+				zap.String("name", name),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+
+		for _, seo := range list.SEO {
+			if seo.Status == md.StatusArchived {
+				continue
+			}
+
+			titles[[2]string{seo.Locale, seo.Title}]++
+			descriptions[[2]string{seo.Locale, seo.Description}]++
+			if name != "" && seo.OBJName != name {
+				continue
+			}
+			entries = append(entries, entry{seo, newSEOAudit(seo, auditViolations(seo, rules))})
+		}
+
+		if list.NextCursor == "" {
+			break
+		}
+		f.Cursor = list.NextCursor
+	}
+
+	res := &dto.AuditReport{Violations: make(map[string]int), Objects: make([]*dto.AuditObjectReport, 0)}
+	objects := make(map[string]*dto.AuditObjectReport)
+	var total int
+	for _, e := range entries {
+		dups := duplicateViolations(
+			e.seo,
+			titles[[2]string{e.seo.Locale, e.seo.Title}]-1,
+			descriptions[[2]string{e.seo.Locale, e.seo.Description}]-1,
+			rules,
+		)
+		if len(dups) > 0 {
+			e.audit = newSEOAudit(e.seo, append(e.audit.Violations, dups...))
+		}
+
+		obj, ok := objects[e.seo.OBJName]
+		if !ok {
+			obj = &dto.AuditObjectReport{OBJName: e.seo.OBJName, MinScore: auditMaxScore, Violations: make(map[string]int)}
+			objects[e.seo.OBJName] = obj
+			res.Objects = append(res.Objects, obj)
+		}
+
+		obj.Records++
+		obj.Score += float64(e.audit.Score)
+		obj.MinScore = min(obj.MinScore, e.audit.Score)
+		for _, rule := range violatedRules(e.audit.Violations) {
+			obj.Violations[rule]++
+			res.Violations[rule]++
+		}
+		total += e.audit.Score
+	}
+
+	for _, obj := range res.Objects {
+		obj.Score = roundScore(obj.Score / float64(obj.Records))
+	}
+	sort.Slice(res.Objects, func(i, j int) bool { return res.Objects[i].OBJName < res.Objects[j].OBJName })
+
+	res.Records = len(entries)
+	if res.Records > 0 {
+		res.Score = roundScore(float64(total) / float64(res.Records))
+	}
+	return res, nil
+}
+
+// auditRules returns the enabled rules with config overrides applied.
+func (c *Controller) auditRules() map[string]auditRule {
+	res := make(map[string]auditRule, len(auditDefaults))
+	for name, rule := range auditDefaults {
+		if c.conf != nil && c.conf.Audit != nil {
+			if o := c.conf.Audit.Rules[name]; o != nil {
+				if o.Enabled != nil && !*o.Enabled {
+					continue
+				}
+				if o.Min != nil {
+					rule.Min = *o.Min
+				}
+				if o.Max != nil {
+					rule.Max = *o.Max
+				}
+				if o.Ratio != nil {
+					rule.Ratio = *o.Ratio
+				}
+				if o.Weight != nil {
+					rule.Weight = *o.Weight
+				}
+			}
+		}
+		res[name] = rule
+	}
+	return res
+}
+
+// auditViolations runs every enabled rule that needs only the record itself.
+func auditViolations(seo *md.SEO, rules map[string]auditRule) []*dto.AuditViolation {
+	res := make([]*dto.AuditViolation, 0)
+	if rule, ok := rules[md.AuditTitleLength]; ok {
+		if v := lengthViolation(md.AuditTitleLength, "title", seo.Title, rule); v != nil {
+			res = append(res, v)
+		}
+	}
+
+	if rule, ok := rules[md.AuditDescriptionLength]; ok {
+		if v := lengthViolation(md.AuditDescriptionLength, "description", seo.Description, rule); v != nil {
+			res = append(res, v)
+		}
+	}
+
+	if rule, ok := rules[md.AuditKeywordStuffing]; ok {
+		if stuffed := stuffedKeywords(seo, rule.Max); len(stuffed) > 0 {
+			res = append(
+				res, &dto.AuditViolation{
+					Rule:  md.AuditKeywordStuffing,
+					Field: "keywords",
+					Message: fmt.Sprintf(
+						"%s repeated more than %d times in title and description",
+						strings.Join(stuffed, ", "), rule.Max,
+					),
+					Weight: rule.Weight,
+				},
+			)
+		}
+	}
+
+	if rule, ok := rules[md.AuditTitleEqualsOGTitle]; ok && seo.Title != "" &&
+		strings.EqualFold(strings.TrimSpace(seo.Title), strings.TrimSpace(seo.OGTitle)) {
+		res = append(
+			res, &dto.AuditViolation{
+				Rule:    md.AuditTitleEqualsOGTitle,
+				Field:   "OGTitle",
+				Message: "OG title repeats the title",
+				Weight:  rule.Weight,
+			},
+		)
+	}
+
+	if rule, ok := rules[md.AuditMissingOGImage]; ok && strings.TrimSpace(seo.OGImage) == "" {
+		res = append(
+			res, &dto.AuditViolation{
+				Rule:    md.AuditMissingOGImage,
+				Field:   "OGImage",
+				Message: "OG image is missing",
+				Weight:  rule.Weight,
+			},
+		)
+	}
+
+	if rule, ok := rules[md.AuditUppercaseAbuse]; ok {
+		for _, field := range [][2]string{{"title", seo.Title}, {"description", seo.Description}} {
+			if upperRatio(field[1], rule.Min) > rule.Ratio {
+				res = append(
+					res, &dto.AuditViolation{
+						Rule:    md.AuditUppercaseAbuse,
+						Field:   field[0],
+						Message: fmt.Sprintf("more than %.0f%% of letters are uppercase", rule.Ratio*100),
+						Weight:  rule.Weight,
+					},
+				)
+			}
+		}
+	}
+	return res
+}
+
+// duplicateViolations reports titles and descriptions shared with the given
+// number of other records.
+func duplicateViolations(seo *md.SEO, titles, descriptions int, rules map[string]auditRule) []*dto.AuditViolation {
+	var res []*dto.AuditViolation
+	if rule, ok := rules[md.AuditDuplicateTitle]; ok && titles > 0 && seo.Title != "" {
+		res = append(
+			res, &dto.AuditViolation{
+				Rule:    md.AuditDuplicateTitle,
+				Field:   "title",
+				Message: fmt.Sprintf("title is shared with %d other record(s)", titles),
+				Weight:  rule.Weight,
+			},
+		)
+	}
+
+	if rule, ok := rules[md.AuditDuplicateDesc]; ok && descriptions > 0 && seo.Description != "" {
+		res = append(
+			res, &dto.AuditViolation{
+				Rule:    md.AuditDuplicateDesc,
+				Field:   "description",
+				Message: fmt.Sprintf("description is shared with %d other record(s)", descriptions),
+				Weight:  rule.Weight,
+			},
+		)
+	}
+	return res
+}
+
+// newSEOAudit scores a record. A rule costs its weight once, however many
+// fields it flags.
+func newSEOAudit(seo *md.SEO, violations []*dto.AuditViolation) *dto.SEOAudit {
+	score := auditMaxScore
+	seen := make(map[string]struct{}, len(violations))
+	for _, v := range violations {
+		if _, ok := seen[v.Rule]; ok {
+			continue
+		}
+		seen[v.Rule] = struct{}{}
+		score -= v.Weight
+	}
+
+	return &dto.SEOAudit{
+		OBJName:    seo.OBJName,
+		OBJPK:      seo.OBJPK,
+		Locale:     seo.Locale,
+		Score:      max(score, 0),
+		Violations: violations,
+	}
+}
+
+func violatedRules(violations []*dto.AuditViolation) []string {
+	var res []string
+	for _, v := range violations {
+		if len(res) == 0 || res[len(res)-1] != v.Rule {
+			res = append(res, v.Rule)
+		}
+	}
+	return res
+}
+
+func lengthViolation(name, field, value string, rule auditRule) *dto.AuditViolation {
+	n := utf8.RuneCountInString(strings.TrimSpace(value))
+	if n >= rule.Min && (rule.Max == 0 || n <= rule.Max) {
+		return nil
+	}
+
+	expected := fmt.Sprintf("%d-%d", rule.Min, rule.Max)
+	if rule.Max == 0 {
+		expected = fmt.Sprintf("at least %d", rule.Min)
+	}
+
+	return &dto.AuditViolation{
+		Rule:    name,
+		Field:   field,
+		Message: fmt.Sprintf("%s is %d characters, expected %s", field, n, expected),
+		Weight:  rule.Weight,
+	}
+}
+
+// stuffedKeywords returns keywords occurring more than limit times in the
+// title and description combined, case-insensitively. Only whole words
+// count, so "art" does not match "start".
+func stuffedKeywords(seo *md.SEO, limit int) []string {
+	title, description := splitWords(seo.Title), splitWords(seo.Description)
+
+	var res []string
+	seen := make(map[string]struct{})
+	for _, kw := range strings.Split(seo.Keywords, ",") {
+		kw = strings.ToLower(strings.TrimSpace(kw))
+		if _, ok := seen[kw]; ok || kw == "" {
+			continue
+		}
+		seen[kw] = struct{}{}
+
+		words := splitWords(kw)
+		if countWords(title, words)+countWords(description, words) > limit {
+			res = append(res, kw)
+		}
+	}
+	return res
+}
+
+// splitWords lowercases s and splits it into runs of letters and digits.
+func splitWords(s string) []string {
+	return strings.FieldsFunc(
+		strings.ToLower(s), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		},
+	)
+}
+
+// countWords counts the occurrences of the word sequence sub in words.
+func countWords(words, sub []string) int {
+	if len(sub) == 0 {
+		return 0
+	}
+
+	var n int
+	for i := 0; i+len(sub) <= len(words); i++ {
+		if slices.Equal(words[i:i+len(sub)], sub) {
+			n++
+		}
+	}
+	return n
+}
+
+// upperRatio is the share of uppercase letters in s, or 0 when s has fewer
+// than minLetters letters.
+func upperRatio(s string, minLetters int) float64 {
+	var letters, upper int
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+
+	if letters == 0 || letters < minLetters {
+		return 0
+	}
+	return float64(upper) / float64(letters)
+}
+
+func roundScore(v float64) float64 {
+	return math.Round(v*10) / 10
+}
@@ -0,0 +1,257 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"strings"
+	"testing"
+)
+
+func goodSEO(pk string) *model.SEO {
+	return &model.SEO{
+		OBJName:     "product",
+		OBJPK:       pk,
+		Title:       "Handmade oak dining table for six " + pk,
+		Description: "Solid oak dining table with oiled finish, seats six people comfortably. Item " + pk,
+		Keywords:    "table, oak",
+		OGTitle:     "Oak table " + pk,
+		OGImage:     "https://example.com/" + pk + ".jpg",
+		Status:      model.StatusPublished,
+	}
+}
+
+func rules(violations []*dto.AuditViolation) []string {
+	res := make([]string, 0, len(violations))
+	for _, v := range violations {
+		res = append(res, v.Rule)
+	}
+	return res
+}
+
+func TestController_AuditSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Clean record", func(t *testing.T) {
+			seo := goodSEO("1")
+			mockRepo.EXPECT().GetSEO(gomock.Any(), "product", "1", "").Return(seo, nil).Times(1)
+			mockRepo.EXPECT().CountSEODuplicates(gomock.Any(), seo).Return(0, 0, nil).Times(1)
+
+			res, err := ctrl.AuditSEO(ctx, "product", "1", "")
+			require.Nil(t, err)
+			assert.Equal(t, 100, res.Score)
+			assert.Empty(t, res.Violations)
+		},
+	)
+
+	t.Run(
+		"Violations", func(t *testing.T) {
+			seo := &model.SEO{
+				OBJName:     "product",
+				OBJPK:       "2",
+				Locale:      "en",
+				Title:       "CHEAP TABLE CHEAP TABLE",
+				Description: "cheap table cheap table",
+				Keywords:    "table",
+				OGTitle:     "cheap table cheap table",
+			}
+			mockRepo.EXPECT().GetSEO(gomock.Any(), "product", "2", "en").Return(seo, nil).Times(1)
+			mockRepo.EXPECT().CountSEODuplicates(gomock.Any(), seo).Return(1, 3, nil).Times(1)
+
+			res, err := ctrl.AuditSEO(ctx, "product", "2", "EN")
+			require.Nil(t, err)
+			assert.Equal(
+				t, []string{
+					model.AuditTitleLength,
+					model.AuditDescriptionLength,
+					model.AuditKeywordStuffing,
+					model.AuditTitleEqualsOGTitle,
+					model.AuditMissingOGImage,
+					model.AuditUppercaseAbuse,
+					model.AuditDuplicateTitle,
+					model.AuditDuplicateDesc,
+				}, rules(res.Violations),
+			)
+			assert.Equal(t, 0, res.Score)
+		},
+	)
+
+	t.Run(
+		"Config disables and tunes rules", func(t *testing.T) {
+			disabled, minLen, weight := false, 50, 40
+			ctrl := New(
+				mockRepo, mockCache, &config.Config{
+					Audit: &config.AuditConfig{
+						Rules: map[string]*config.AuditRuleConfig{
+							model.AuditDuplicateTitle: {Enabled: &disabled},
+							model.AuditDuplicateDesc:  {Enabled: &disabled},
+							model.AuditTitleLength:    {Min: &minLen, Weight: &weight},
+						},
+					},
+				},
+			)
+			seo := goodSEO("3")
+			mockRepo.EXPECT().GetSEO(gomock.Any(), "product", "3", "").Return(seo, nil).Times(1)
+
+			res, err := ctrl.AuditSEO(ctx, "product", "3", "")
+			require.Nil(t, err)
+			assert.Equal(t, []string{model.AuditTitleLength}, rules(res.Violations))
+			assert.Equal(t, 60, res.Score)
+		},
+	)
+
+	t.Run(
+		"Config sets zero", func(t *testing.T) {
+			disabled, zero := false, 0
+			ctrl := New(
+				mockRepo, mockCache, &config.Config{
+					Audit: &config.AuditConfig{
+						Rules: map[string]*config.AuditRuleConfig{
+							model.AuditDuplicateTitle: {Enabled: &disabled},
+							model.AuditDuplicateDesc:  {Enabled: &disabled},
+							model.AuditTitleLength:    {Max: &zero},
+							model.AuditMissingOGImage: {Weight: &zero},
+						},
+					},
+				},
+			)
+			seo := goodSEO("6")
+			seo.Title += " with a title far longer than sixty characters"
+			seo.OGImage = ""
+			mockRepo.EXPECT().GetSEO(gomock.Any(), "product", "6", "").Return(seo, nil).Times(1)
+
+			res, err := ctrl.AuditSEO(ctx, "product", "6", "")
+			require.Nil(t, err)
+			assert.Equal(t, []string{model.AuditMissingOGImage}, rules(res.Violations))
+			assert.Equal(t, 0, res.Violations[0].Weight)
+			assert.Equal(t, 100, res.Score)
+		},
+	)
+
+	t.Run(
+		"Not found", func(t *testing.T) {
+			mockRepo.EXPECT().GetSEO(gomock.Any(), "product", "4", "").Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.AuditSEO(ctx, "product", "4", "")
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"Duplicates error", func(t *testing.T) {
+			testErr := errors.New("repo error")
+			mockRepo.EXPECT().GetSEO(gomock.Any(), "product", "5", "").Return(goodSEO("5"), nil).Times(1)
+			mockRepo.EXPECT().CountSEODuplicates(gomock.Any(), gomock.Any()).Return(0, 0, testErr).Times(1)
+
+			res, err := ctrl.AuditSEO(ctx, "product", "5", "")
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+		},
+	)
+}
+
+func TestController_AuditReport(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Aggregates per object", func(t *testing.T) {
+			dup := goodSEO("2")
+			dup.Title = goodSEO("1").Title
+			noImage := goodSEO("3")
+			noImage.OBJName, noImage.OGImage = "category", ""
+			archived := goodSEO("4")
+			archived.Status = model.StatusArchived
+
+			gomock.InOrder(
+				mockRepo.EXPECT().
+					ListSEO(gomock.Any(), gomock.Cond(func(x any) bool { return x.(*model.SEOFilter).Cursor == "" })).
+					Return(&model.SEOList{SEO: []*model.SEO{goodSEO("1"), dup}, NextCursor: "next"}, nil),
+				mockRepo.EXPECT().
+					ListSEO(gomock.Any(), gomock.Cond(func(x any) bool { return x.(*model.SEOFilter).Cursor == "next" })).
+					Return(&model.SEOList{SEO: []*model.SEO{noImage, archived}}, nil),
+			)
+
+			res, err := ctrl.AuditReport(ctx, "")
+			require.Nil(t, err)
+			assert.Equal(t, 3, res.Records)
+			assert.Equal(t, 83.3, res.Score)
+			assert.Equal(t, map[string]int{model.AuditDuplicateTitle: 2, model.AuditMissingOGImage: 1}, res.Violations)
+
+			require.Len(t, res.Objects, 2)
+			assert.Equal(t, "category", res.Objects[0].OBJName)
+			assert.Equal(t, 80.0, res.Objects[0].Score)
+			assert.Equal(t, "product", res.Objects[1].OBJName)
+			assert.Equal(t, 2, res.Objects[1].Records)
+			assert.Equal(t, 85.0, res.Objects[1].Score)
+			assert.Equal(t, 85, res.Objects[1].MinScore)
+		},
+	)
+
+	t.Run(
+		"Duplicates across objects", func(t *testing.T) {
+			category := goodSEO("5")
+			category.OBJName, category.Title = "category", goodSEO("1").Title
+
+			mockRepo.EXPECT().
+				ListSEO(gomock.Any(), gomock.Cond(func(x any) bool { return x.(*model.SEOFilter).OBJName == "" })).
+				Return(&model.SEOList{SEO: []*model.SEO{goodSEO("1"), category}}, nil).
+				Times(1)
+
+			res, err := ctrl.AuditReport(ctx, "product")
+			require.Nil(t, err)
+			assert.Equal(t, 1, res.Records)
+			assert.Equal(t, map[string]int{model.AuditDuplicateTitle: 1}, res.Violations)
+			require.Len(t, res.Objects, 1)
+			assert.Equal(t, "product", res.Objects[0].OBJName)
+		},
+	)
+
+	t.Run(
+		"Repo error", func(t *testing.T) {
+			testErr := errors.New("repo error")
+			mockRepo.EXPECT().
+				ListSEO(gomock.Any(), gomock.Any()).
+				Return(nil, testErr).
+				Times(1)
+
+			res, err := ctrl.AuditReport(ctx, "product")
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+		},
+	)
+}
+
+func TestAuditHelpers(t *testing.T) {
+	assert.Equal(t, 0.0, upperRatio("SHORT", 10))
+	assert.Equal(t, 1.0, upperRatio("ALL CAPS TITLE", 10))
+	assert.Equal(t, []string{"oak"}, stuffedKeywords(&model.SEO{Title: strings.Repeat("oak ", 4), Keywords: "oak, OAK, pine"}, 3))
+	assert.Empty(t, stuffedKeywords(&model.SEO{Title: "Start art: smart charts", Description: "Departure", Keywords: "art"}, 1))
+	assert.Equal(
+		t, []string{"oak table"}, stuffedKeywords(
+			&model.SEO{Title: "Oak table, oak-table", Description: "An oak table. Oak tables.", Keywords: "oak table"}, 2,
+		),
+	)
+}
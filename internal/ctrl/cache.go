@@ -0,0 +1,188 @@
+package ctrl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	"go.uber.org/zap"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+const defaultCacheJitter = 0.1
+
+// cacheEntry is what cachedLoad stores: the value and the moment it turns
+// stale. Entries written before soft TTLs existed have no Value and count as
+// misses.
+type cacheEntry[T any] struct {
+	Value   *T        `json:"v"`
+	StaleAt time.Time `json:"stale_at"`
+}
+
+// genCache counts invalidations so a load that started before one can tell
+// its result may be out of date. The count is shared by all keys since
+// patterns may drop any of them.
+type genCache struct {
+	CacheService
+	mu  sync.RWMutex
+	gen uint64
+}
+
+func (c *genCache) Delete(ctx context.Context, key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	c.CacheService.Delete(ctx, key)
+}
+
+func (c *genCache) InvalidateKeysByPattern(ctx context.Context, pattern string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	c.CacheService.InvalidateKeysByPattern(ctx, pattern)
+}
+
+// generation returns the number of invalidations so far.
+func (c *genCache) generation() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.gen
+}
+
+// setIfCurrent stores val unless something was invalidated after gen was
+// read. It reports whether val was stored.
+func (c *genCache) setIfCurrent(ctx context.Context, gen uint64, t time.Duration, key string, val any) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.gen != gen {
+		return false
+	}
+	c.CacheService.Set(ctx, t, key, val)
+	return true
+}
+
+// cacheConf returns the cache settings with defaults filled in. The soft TTL
+// defaults to three quarters of the TTL.
+func (c *Controller) cacheConf() config.CacheConfig {
+	res := config.CacheConfig{
+		TTL:    config.DefaultCacheTime,
+		Jitter: defaultCacheJitter,
+	}
+
+	if c.conf != nil && c.conf.Cache != nil {
+		conf := c.conf.Cache
+		if conf.TTL > 0 {
+			res.TTL = conf.TTL
+		}
+		if conf.SoftTTL > 0 {
+			res.SoftTTL = conf.SoftTTL
+		}
+		if conf.Jitter > 0 && conf.Jitter < 1 {
+			res.Jitter = conf.Jitter
+		}
+	}
+
+	if res.SoftTTL <= 0 || res.SoftTTL > res.TTL {
+		res.SoftTTL = res.TTL * 3 / 4
+	}
+	return res
+}
+
+// cacheTTLs returns the soft and hard TTL of a new entry, both scaled by the
+// same random factor.
+func (c *Controller) cacheTTLs() (time.Duration, time.Duration) {
+	conf := c.cacheConf()
+	f := 1 + conf.Jitter*(2*rand.Float64()-1)
+	return time.Duration(float64(conf.SoftTTL) * f), time.Duration(float64(conf.TTL) * f)
+}
+
+// cachedLoad returns the value cached under key, calling load on a miss.
+// Concurrent misses of one key share a single load, and a stale entry is
+// returned as is while one background load replaces it. Loads run without
+// the caller's cancellation since other callers may be waiting on them.
+// name labels the cache metrics.
+func cachedLoad[T any](ctx context.Context, c *Controller, name, key string, load func(context.Context) (*T, error)) (*T, error) {
+	const op = "cache.cachedLoad.ctrl"
+
+	entry := &cacheEntry[T]{}
+	if err := c.cache.GetToStruct(ctx, key, entry); err == nil && entry.Value != nil {
+		if time.Now().Before(entry.StaleAt) {
+			metrics.ObserveCache(name, metrics.CacheHit)
+			return entry.Value, nil
+		}
+
+		metrics.ObserveCache(name, metrics.CacheStale)
+		// DoChan joins a load that is already running instead of starting one.
+		c.flight.DoChan(
+			key, func() (any, error) {
+				data, err := fillCache(context.WithoutCancel(ctx), c, key, load)
+				if err != nil {
+					zap.L().Debug(
+						"failed to refresh cache entry",
+						zap.String("op", op),
+						zap.String("key", key),
+						zap.Error(err),
+					)
+				}
+				if errors.Is(err, ErrNotFound) {
+					c.cache.Delete(context.WithoutCancel(ctx), key)
+				}
+				return data, err
+			},
+		)
+		return entry.Value, nil
+	}
+
+	metrics.ObserveCache(name, metrics.CacheMiss)
+	var loaded bool
+	data, err, _ := c.flight.Do(
+		key, func() (any, error) {
+			loaded = true
+			return fillCache(context.WithoutCancel(ctx), c, key, load)
+		},
+	)
+	if !loaded {
+		metrics.ObserveCache(name, metrics.CacheCoalesced)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Every caller decodes its own copy, so none can change another's value.
+	entry = &cacheEntry[T]{}
+	if err = json.Unmarshal(data.([]byte), entry); err != nil {
+		return nil, err
+	}
+	return entry.Value, nil
+}
+
+// fillCache loads the value and stores it under key with fresh TTLs. It
+// returns the encoded entry. A value loaded while a write invalidated the
+// cache may predate that write, so it is returned but not stored.
+func fillCache[T any](ctx context.Context, c *Controller, key string, load func(context.Context) (*T, error)) ([]byte, error) {
+	const op = "cache.fillCache.ctrl"
+
+	gen := c.cache.generation()
+	res, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	soft, hard := c.cacheTTLs()
+	data, err := json.Marshal(&cacheEntry[T]{Value: res, StaleAt: time.Now().Add(soft)})
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.cache.setIfCurrent(ctx, gen, hard, key, data) {
+		zap.L().Debug(
+			"cache invalidated during load",
+			zap.String("op", op),
+			zap.String("key", key),
+		)
+	}
+	return data, nil
+}
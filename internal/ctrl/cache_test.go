@@ -0,0 +1,213 @@
+package ctrl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestController_cachedLoad(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	name, pk := "name", "pk"
+	key := fmt.Sprintf(SEOKey, name, pk, "")
+	stale := func(_ context.Context, _ string, dest *cacheEntry[model.SEO]) error {
+		dest.Value = &model.SEO{Title: "old", Status: model.StatusPublished}
+		dest.StaleAt = time.Now().Add(-time.Minute)
+		return nil
+	}
+
+	t.Run(
+		"Stale entry is served and refreshed", func(t *testing.T) {
+			staleServes := testutil.ToFloat64(metrics.CacheMetrics.WithLabelValues("seo", metrics.CacheStale))
+			refreshed := make(chan *cacheEntry[model.SEO], 1)
+
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(stale).Times(1)
+			mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "").
+				Return(&model.SEO{Title: "new", Status: model.StatusPublished}, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), key, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ time.Duration, _ string, val any) {
+					entry := &cacheEntry[model.SEO]{}
+					assert.NoError(t, json.Unmarshal(val.([]byte), entry))
+					refreshed <- entry
+				},
+			).Times(1)
+
+			res, err := ctrl.GetSEO(ctx, name, pk, "")
+			assert.NoError(t, err)
+			assert.Equal(t, "old", res.Title)
+
+			entry := <-refreshed
+			assert.Equal(t, "new", entry.Value.Title)
+			assert.True(t, entry.StaleAt.After(time.Now()))
+			assert.Equal(t, staleServes+1, testutil.ToFloat64(metrics.CacheMetrics.WithLabelValues("seo", metrics.CacheStale)))
+		},
+	)
+
+	t.Run(
+		"Refresh of a removed record drops the entry", func(t *testing.T) {
+			deleted := make(chan struct{})
+
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(stale).Times(1)
+			mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(nil, repo.ErrNotFound).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), key).Do(
+				func(context.Context, string) {
+					close(deleted)
+				},
+			).Times(1)
+
+			res, err := ctrl.GetSEO(ctx, name, pk, "")
+			assert.NoError(t, err)
+			assert.Equal(t, "old", res.Title)
+			<-deleted
+		},
+	)
+}
+
+func TestController_cachedLoad_Invalidated(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	name, pk := "name", "pk"
+	key := fmt.Sprintf(SEOKey, name, pk, "")
+	req := &model.SEO{OBJName: name, OBJPK: pk, Title: "new"}
+
+	loading := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, dest *cacheEntry[model.SEO]) error {
+			dest.Value = &model.SEO{Title: "old", Status: model.StatusPublished}
+			dest.StaleAt = time.Now().Add(-time.Minute)
+			return nil
+		},
+	).Times(1)
+	mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "").DoAndReturn(
+		func(context.Context, string, string, string) (*model.SEO, error) {
+			defer close(done)
+			close(loading)
+			<-release
+			return &model.SEO{Title: "old", Status: model.StatusPublished}, nil
+		},
+	).Times(1)
+	mockRepo.EXPECT().UpdateSEO(gomock.Any(), req).Return(nil).Times(1)
+	mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(seoPattern, name, pk)).Times(1)
+	mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), sitemapPattern).Times(1)
+	mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), key, gomock.Any()).Times(0)
+
+	res, err := ctrl.GetSEO(ctx, name, pk, "")
+	require.NoError(t, err)
+	assert.Equal(t, "old", res.Title)
+
+	<-loading
+	require.NoError(t, ctrl.UpdateSEO(ctx, req))
+	close(release)
+	<-done
+
+	// The refresh returns before the flight ends; wait for it to settle.
+	_, _, _ = ctrl.flight.Do(key, func() (any, error) { return nil, nil })
+}
+
+func TestController_cachedLoad_Coalesced(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	const callers = 10
+	slug := "slug"
+	key := fmt.Sprintf(pageKey, slug)
+	coalesced := testutil.ToFloat64(metrics.CacheMetrics.WithLabelValues("page", metrics.CacheCoalesced))
+
+	var missed atomic.Int32
+	release := make(chan struct{})
+	mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
+		func(context.Context, string, any) error {
+			missed.Add(1)
+			return errors.New("cache miss")
+		},
+	).Times(callers)
+	mockRepo.EXPECT().GetPage(gomock.Any(), slug).DoAndReturn(
+		func(context.Context, string) (*model.Page, error) {
+			<-release
+			return &model.Page{Slug: slug, Status: model.StatusPublished}, nil
+		},
+	).Times(1)
+	mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), key, gomock.Any()).Times(1)
+
+	var wg sync.WaitGroup
+	res := make([]*model.Page, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			page, err := ctrl.GetPage(ctx, slug)
+			assert.NoError(t, err)
+			res[i] = page
+		}()
+	}
+
+	require.Eventually(
+		t, func() bool {
+			return missed.Load() == callers
+		}, time.Second, time.Millisecond,
+	)
+	// Let the last caller join the load after its cache miss.
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for _, page := range res[1:] {
+		assert.Equal(t, res[0], page)
+		assert.NotSame(t, res[0], page)
+	}
+	assert.Equal(t, coalesced+callers-1, testutil.ToFloat64(metrics.CacheMetrics.WithLabelValues("page", metrics.CacheCoalesced)))
+}
+
+func TestController_cacheTTLs(t *testing.T) {
+	ctrl := New(nil, nil, &config.Config{Cache: &config.CacheConfig{TTL: time.Hour, SoftTTL: 30 * time.Minute, Jitter: 0.2}})
+
+	for range 100 {
+		soft, hard := ctrl.cacheTTLs()
+		assert.GreaterOrEqual(t, hard, 48*time.Minute)
+		assert.LessOrEqual(t, hard, 72*time.Minute)
+		assert.InDelta(t, float64(hard)/2, float64(soft), float64(time.Millisecond))
+	}
+
+	for _, conf := range []*config.Config{{}, nil} {
+		soft, hard := New(nil, nil, conf).cacheTTLs()
+		assert.InDelta(t, float64(config.DefaultCacheTime)*3/4, float64(soft), float64(config.DefaultCacheTime)*0.1)
+		assert.InDelta(t, float64(config.DefaultCacheTime), float64(hard), float64(config.DefaultCacheTime)*0.1)
+	}
+}
@@ -2,36 +2,144 @@ package ctrl
 
 import (
 	"context"
+	"github.com/JMURv/seo/internal/config"
 	"github.com/JMURv/seo/internal/dto"
 	md "github.com/JMURv/seo/internal/models"
+	"golang.org/x/sync/singleflight"
 	"io"
+	"net/http"
 	"time"
 )
 
 type AppRepo interface {
-	GetSEO(ctx context.Context, name, pk string) (*md.SEO, error)
+	GetSEO(ctx context.Context, name, pk, locale string) (*md.SEO, error)
 	CreateSEO(ctx context.Context, req *md.SEO) (string, string, error)
 	UpdateSEO(ctx context.Context, req *md.SEO) error
-	DeleteSEO(ctx context.Context, name, pk string) error
+	DeleteSEO(ctx context.Context, name, pk, locale string) error
+	ListSEOLocales(ctx context.Context, name, pk string) ([]*md.SEO, error)
+	ListSEO(ctx context.Context, f *md.SEOFilter) (*md.SEOList, error)
+	ImportSEO(ctx context.Context, rows []*md.SEO, upsert bool) (int, int, error)
+	CountSEODuplicates(ctx context.Context, req *md.SEO) (int, int, error)
+	ListSEOForSitemap(ctx context.Context, names []string) ([]*md.SEO, error)
+	PublishScheduledSEO(ctx context.Context, now time.Time) ([]*md.SEO, error)
 
-	ListPages(ctx context.Context) ([]*md.Page, error)
+	ListSEORevisions(ctx context.Context, name, pk string) ([]*md.SEORevision, error)
+	GetSEORevision(ctx context.Context, name, pk string, id uint64) (*md.SEORevision, error)
+	RollbackSEO(ctx context.Context, name, pk string, id uint64) (*md.SEO, error)
+
+	ListSEOTemplates(ctx context.Context) ([]*md.SEOTemplate, error)
+	GetSEOTemplate(ctx context.Context, name, locale string) (*md.SEOTemplate, error)
+	SaveSEOTemplate(ctx context.Context, req *md.SEOTemplate) (bool, error)
+	DeleteSEOTemplate(ctx context.Context, name, locale string) error
+
+	ListPages(ctx context.Context, f *md.PageFilter) (*md.PageList, error)
 	GetPage(ctx context.Context, slug string) (*md.Page, error)
+	GetPageByHref(ctx context.Context, path string) (*md.Page, error)
 	CreatePage(ctx context.Context, req *md.Page) (string, error)
 	UpdatePage(ctx context.Context, slug string, req *md.Page) error
-	DeletePage(ctx context.Context, slug string) error
+	DeletePage(ctx context.Context, slug, strategy string) ([]string, error)
+	ListPageTree(ctx context.Context) ([]*md.Page, error)
+	ListPageAncestors(ctx context.Context, slug string) ([]*md.Page, error)
+	PublishScheduledPages(ctx context.Context, now time.Time) ([]string, error)
+
+	ListRobotsGroups(ctx context.Context) ([]*md.RobotsGroup, error)
+	GetRobotsGroup(ctx context.Context, id uint64) (*md.RobotsGroup, error)
+	CreateRobotsGroup(ctx context.Context, req *md.RobotsGroup) (uint64, error)
+	UpdateRobotsGroup(ctx context.Context, id uint64, req *md.RobotsGroup) error
+	DeleteRobotsGroup(ctx context.Context, id uint64) error
+	ListRobotsSitemaps(ctx context.Context) ([]*md.RobotsSitemap, error)
+	CreateRobotsSitemap(ctx context.Context, url string) (uint64, error)
+	DeleteRobotsSitemap(ctx context.Context, id uint64) error
+
+	ListRedirects(ctx context.Context) ([]*md.Redirect, error)
+	GetRedirect(ctx context.Context, id uint64) (*md.Redirect, error)
+	// CreateRedirect and UpdateRedirect pass the stored redirects to check
+	// before writing and give up with its error. The redirects cannot change
+	// until the write is done.
+	CreateRedirect(ctx context.Context, req *md.Redirect, check func([]*md.Redirect) error) (uint64, error)
+	UpdateRedirect(ctx context.Context, id uint64, req *md.Redirect, check func([]*md.Redirect) error) error
+	DeleteRedirect(ctx context.Context, id uint64) error
+
+	ListWebhooks(ctx context.Context) ([]*md.Webhook, error)
+	GetWebhook(ctx context.Context, id uint64) (*md.Webhook, error)
+	CreateWebhook(ctx context.Context, req *md.Webhook) (uint64, error)
+	UpdateWebhook(ctx context.Context, id uint64, req *md.Webhook) error
+	DeleteWebhook(ctx context.Context, id uint64) error
+	ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*md.WebhookDelivery, error)
+	FinishWebhookDelivery(ctx context.Context, d *md.WebhookDelivery) error
+	RetryWebhookDelivery(ctx context.Context, id uint64) error
+	ListWebhookDeliveries(ctx context.Context, f *md.WebhookDeliveryFilter) (*md.WebhookDeliveryList, error)
+
+	ClaimOutboxEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*md.OutboxEvent, error)
+	MarkOutboxPublished(ctx context.Context, ids []uint64, now time.Time) error
+	PurgeOutbox(ctx context.Context, before time.Time) (int64, error)
+	ListOutboxEvents(ctx context.Context, after uint64, limit int) ([]*md.OutboxEvent, error)
+	LastOutboxEventID(ctx context.Context) (uint64, error)
 }
 
 type AppCtrl interface {
-	GetSEO(ctx context.Context, name, pk string) (*md.SEO, error)
+	ListSEO(ctx context.Context, f *md.SEOFilter) (*dto.PaginatedSEO, error)
+	ImportSEO(ctx context.Context, rows []*md.SEO, mode string) (*dto.ImportSEOResponse, error)
+	ExportSEO(ctx context.Context, f *md.SEOFilter, fn func(*md.SEO) error) error
+	GetSEO(ctx context.Context, name, pk, locale string) (*md.SEO, error)
+	GetSEOWithVars(ctx context.Context, name, pk, locale string, vars map[string]string) (*md.SEO, error)
 	CreateSEO(ctx context.Context, req *md.SEO) (*dto.CreateSEOResponse, error)
 	UpdateSEO(ctx context.Context, req *md.SEO) error
-	DeleteSEO(ctx context.Context, name, pk string) error
+	DeleteSEO(ctx context.Context, name, pk, locale string) error
+	GetSEOAlternates(ctx context.Context, name, pk string) ([]*dto.SEOAlternate, error)
+	GetSEOHead(ctx context.Context, name, pk, locale string, vars map[string]string) ([]md.HeadTag, error)
+	AuditSEO(ctx context.Context, name, pk, locale string) (*dto.SEOAudit, error)
+	AuditReport(ctx context.Context, name string) (*dto.AuditReport, error)
+
+	ListSEORevisions(ctx context.Context, name, pk string) ([]*md.SEORevision, error)
+	GetSEORevision(ctx context.Context, name, pk string, id uint64) (*md.SEORevision, error)
+	DiffSEORevisions(ctx context.Context, name, pk string, from, to uint64) ([]*dto.FieldDiff, error)
+	RollbackSEO(ctx context.Context, name, pk string, id uint64) (*md.SEO, error)
+
+	ListSEOTemplates(ctx context.Context) ([]*md.SEOTemplate, error)
+	GetSEOTemplate(ctx context.Context, name, locale string) (*md.SEOTemplate, error)
+	SaveSEOTemplate(ctx context.Context, req *md.SEOTemplate) (*dto.SaveSEOTemplateResponse, error)
+	DeleteSEOTemplate(ctx context.Context, name, locale string) error
 
-	ListPages(ctx context.Context) ([]*md.Page, error)
+	ListPages(ctx context.Context, f *md.PageFilter) (*dto.PaginatedPages, error)
 	GetPage(ctx context.Context, slug string) (*md.Page, error)
 	CreatePage(ctx context.Context, req *md.Page) (*dto.CreatePageResponse, error)
 	UpdatePage(ctx context.Context, slug string, req *md.Page) error
-	DeletePage(ctx context.Context, slug string) error
+	DeletePage(ctx context.Context, slug, strategy string) error
+	GetPageTree(ctx context.Context) ([]*dto.PageNode, error)
+	GetBreadcrumbs(ctx context.Context, slug string) (*dto.Breadcrumbs, error)
+	ResolvePath(ctx context.Context, path, locale string) (*dto.ResolvePathResponse, error)
+
+	GetSitemap(ctx context.Context, idx int, gz bool) ([]byte, error)
+
+	GetRobotsTxt(ctx context.Context) (string, error)
+	TestRobots(ctx context.Context, agent, path string) (*dto.RobotsTestResponse, error)
+	ListRobotsGroups(ctx context.Context) ([]*md.RobotsGroup, error)
+	GetRobotsGroup(ctx context.Context, id uint64) (*md.RobotsGroup, error)
+	CreateRobotsGroup(ctx context.Context, req *md.RobotsGroup) (*dto.CreateRobotsGroupResponse, error)
+	UpdateRobotsGroup(ctx context.Context, id uint64, req *md.RobotsGroup) error
+	DeleteRobotsGroup(ctx context.Context, id uint64) error
+	ListRobotsSitemaps(ctx context.Context) ([]*md.RobotsSitemap, error)
+	CreateRobotsSitemap(ctx context.Context, url string) (*dto.CreateRobotsSitemapResponse, error)
+	DeleteRobotsSitemap(ctx context.Context, id uint64) error
+
+	ListRedirects(ctx context.Context) ([]*md.Redirect, error)
+	GetRedirect(ctx context.Context, id uint64) (*md.Redirect, error)
+	CreateRedirect(ctx context.Context, req *md.Redirect) (*dto.CreateRedirectResponse, error)
+	UpdateRedirect(ctx context.Context, id uint64, req *md.Redirect) error
+	DeleteRedirect(ctx context.Context, id uint64) error
+	ResolveRedirect(ctx context.Context, path string) (*dto.ResolveRedirectResponse, error)
+	ExportRedirects(ctx context.Context, format string) ([]byte, error)
+
+	ListWebhooks(ctx context.Context) ([]*md.Webhook, error)
+	GetWebhook(ctx context.Context, id uint64) (*md.Webhook, error)
+	CreateWebhook(ctx context.Context, req *md.Webhook) (*dto.CreateWebhookResponse, error)
+	UpdateWebhook(ctx context.Context, id uint64, req *md.Webhook) error
+	DeleteWebhook(ctx context.Context, id uint64) error
+	ListWebhookDeliveries(ctx context.Context, f *md.WebhookDeliveryFilter) (*dto.PaginatedWebhookDeliveries, error)
+	RetryWebhookDelivery(ctx context.Context, id uint64) error
+
+	Watch(ctx context.Context, f *md.ChangeFilter, after uint64, fn func(*md.OutboxEvent) error) error
 }
 
 type CacheService interface {
@@ -42,14 +150,28 @@ type CacheService interface {
 	InvalidateKeysByPattern(ctx context.Context, pattern string)
 }
 
+// Publisher hands outbox events to a message broker. Publish returns once the
+// broker has accepted the event.
+type Publisher interface {
+	io.Closer
+	Publish(ctx context.Context, e *md.OutboxEvent) error
+}
+
 type Controller struct {
-	repo  AppRepo
-	cache CacheService
+	repo   AppRepo
+	cache  *genCache
+	conf   *config.Config
+	client *http.Client
+	feed   *changeFeed
+	flight singleflight.Group
 }
 
-func New(repo AppRepo, cache CacheService) *Controller {
+func New(repo AppRepo, cache CacheService, conf *config.Config) *Controller {
 	return &Controller{
-		repo:  repo,
-		cache: cache,
+		repo:   repo,
+		cache:  &genCache{CacheService: cache},
+		conf:   conf,
+		client: &http.Client{},
+		feed:   newChangeFeed(),
 	}
 }
@@ -7,3 +7,13 @@ var ErrAlreadyExists = errors.New("already exists")
 
 var ErrCreateClient = errors.New("failed to create client")
 var ErrInternal = errors.New("internal error")
+
+var ErrRedirectLoop = errors.New("redirect loop detected")
+var ErrUnsupportedFormat = errors.New("unsupported format")
+var ErrInvalidCursor = errors.New("invalid cursor")
+var ErrUnknownObject = errors.New("no url pattern configured for object")
+var ErrParentNotFound = errors.New("parent page not found")
+var ErrPageCycle = errors.New("page cannot be placed under itself or its descendants")
+var ErrHasChildren = errors.New("page has children, delete it with strategy cascade or reparent")
+var ErrDuplicateHref = errors.New("another page already uses this href")
+var ErrWatcherLagging = errors.New("watcher fell too far behind, resume from the last event id")
@@ -0,0 +1,36 @@
+package ctrl
+
+import (
+	"context"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+// GetSEOHead returns the head tags for the record GetSEO resolves, or the one
+// GetSEOWithVars renders when vars is not nil. The canonical URL comes from
+// sitemap.objects and the record locale; without a pattern it is left out.
+func (c *Controller) GetSEOHead(ctx context.Context, name, pk, locale string, vars map[string]string) ([]md.HeadTag, error) {
+	const op = "seo.GetSEOHead.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var res *md.SEO
+	var err error
+	if vars != nil {
+		res, err = c.GetSEOWithVars(ctx, name, pk, locale, vars)
+	} else {
+		res, err = c.GetSEO(ctx, name, pk, locale)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var canonical string
+	if path, ok := c.objectPath(name, pk); ok {
+		if res.Locale != "" {
+			path = c.localizedPath(res.Locale, path)
+		}
+		canonical = c.absURL(path)
+	}
+	return res.HeadTags(canonical), nil
+}
@@ -0,0 +1,114 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_GetSEOHead(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := WithPreview(context.Background())
+	ctrl := New(
+		mockRepo, mockCache, &config.Config{
+			Sitemap: &config.SitemapConfig{
+				Host:    "https://example.com",
+				Objects: map[string]string{"product": "/product/{pk}"},
+			},
+		},
+	)
+
+	t.Run(
+		"Canonical from object pattern and locale", func(t *testing.T) {
+			mockRepo.EXPECT().
+				GetSEO(gomock.Any(), "product", "42", "ru").
+				Return(&model.SEO{Title: "Chair", Locale: "ru", Status: model.StatusPublished}, nil).
+				Times(1)
+
+			res, err := ctrl.GetSEOHead(ctx, "product", "42", "ru", nil)
+			assert.Nil(t, err)
+			assert.Contains(t, res, model.HeadTag{Tag: "link", Rel: "canonical", Href: "https://example.com/ru/product/42"})
+			assert.Contains(t, res, model.HeadTag{Tag: "meta", Property: "og:url", Content: "https://example.com/ru/product/42"})
+			assert.Contains(t, res, model.HeadTag{Tag: "meta", Property: "og:locale", Content: "ru"})
+		},
+	)
+
+	t.Run(
+		"No pattern, no canonical", func(t *testing.T) {
+			mockRepo.EXPECT().
+				GetSEO(gomock.Any(), "article", "1", "").
+				Return(&model.SEO{Title: "Post", Status: model.StatusPublished}, nil).
+				Times(1)
+
+			res, err := ctrl.GetSEOHead(ctx, "article", "1", "", nil)
+			assert.Nil(t, err)
+			assert.Equal(
+				t, []model.HeadTag{
+					{Tag: "title", Text: "Post"},
+					{Tag: "meta", Property: "og:title", Content: "Post"},
+				}, res,
+			)
+		},
+	)
+
+	t.Run(
+		"Rendered template is escaped once", func(t *testing.T) {
+			mockRepo.EXPECT().GetSEO(gomock.Any(), "article", "7", "").Return(nil, repo.ErrNotFound).Times(1)
+			mockCache.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(templateKey, "article", ""), gomock.Any()).Return(errors.New("miss"))
+			mockRepo.EXPECT().
+				GetSEOTemplate(gomock.Any(), "article", "").
+				Return(&model.SEOTemplate{Title: "{{.name}}", OGURL: "https://example.com/a?id={{.id}}"}, nil).
+				Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), fmt.Sprintf(templateKey, "article", ""), gomock.Any()).Times(1)
+
+			res, err := ctrl.GetSEOHead(ctx, "article", "7", "", map[string]string{"name": "Tom & Jerry's <3", "id": "1&x=2"})
+			assert.Nil(t, err)
+			assert.Contains(t, res, model.HeadTag{Tag: "title", Text: "Tom & Jerry's <3"})
+			assert.Equal(
+				t, "<title>Tom &amp; Jerry&#39;s &lt;3</title>\n"+
+					`<meta property="og:title" content="Tom &amp; Jerry&#39;s &lt;3">`+"\n"+
+					`<meta property="og:url" content="https://example.com/a?id=1&amp;x=2">`+"\n",
+				model.RenderHead(res),
+			)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().
+				GetSEO(gomock.Any(), "article", "1", "").
+				Return(nil, repo.ErrNotFound).
+				Times(1)
+
+			res, err := ctrl.GetSEOHead(ctx, "article", "1", "", nil)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockRepo.EXPECT().
+				GetSEO(gomock.Any(), "article", "1", "").
+				Return(nil, newErr).
+				Times(1)
+
+			res, err := ctrl.GetSEOHead(ctx, "article", "1", "", nil)
+			assert.Nil(t, res)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
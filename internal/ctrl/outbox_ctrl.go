@@ -0,0 +1,193 @@
+package ctrl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const defaultOutboxInterval = time.Second
+const defaultOutboxLease = 30 * time.Second
+const defaultOutboxBatch = 100
+const defaultOutboxRetention = 24 * time.Hour
+
+// outboxConf returns the relay settings with defaults filled in.
+func (c *Controller) outboxConf() config.OutboxConfig {
+	res := config.OutboxConfig{
+		Interval:  defaultOutboxInterval,
+		Lease:     defaultOutboxLease,
+		Batch:     defaultOutboxBatch,
+		Retention: defaultOutboxRetention,
+	}
+
+	if c.conf == nil || c.conf.Outbox == nil {
+		return res
+	}
+	conf := c.conf.Outbox
+	if conf.Interval > 0 {
+		res.Interval = conf.Interval
+	}
+	if conf.Lease > 0 {
+		res.Lease = conf.Lease
+	}
+	if conf.Batch > 0 {
+		res.Batch = conf.Batch
+	}
+	if conf.Retention > 0 {
+		res.Retention = conf.Retention
+	}
+	return res
+}
+
+// RunOutbox relays committed changes to pub every interval until ctx is
+// cancelled. A full batch is followed by another one straight away, and
+// published events older than the retention are purged once per tick.
+func (c *Controller) RunOutbox(ctx context.Context, pub Publisher) {
+	conf := c.outboxConf()
+	ticker := time.NewTicker(conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				n, err := c.RelayOutbox(ctx, pub)
+				if err != nil {
+					zap.L().Warn("failed to relay outbox", zap.Error(err))
+				}
+				if err != nil || n < conf.Batch || ctx.Err() != nil {
+					break
+				}
+			}
+
+			if _, err := c.repo.PurgeOutbox(ctx, time.Now().Add(-conf.Retention)); err != nil {
+				zap.L().Warn("failed to purge outbox", zap.Error(err))
+			}
+		}
+	}
+}
+
+// RelayOutbox claims one batch of unpublished events, drops the cache entries
+// they touch and publishes them in commit order. It stops at the first
+// failure so that later changes of a record never overtake earlier ones; the
+// rest of the batch is retried once its lease runs out. It returns the number
+// of events published.
+func (c *Controller) RelayOutbox(ctx context.Context, pub Publisher) (int, error) {
+	const op = "outbox.RelayOutbox.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	conf := c.outboxConf()
+	events, err := c.repo.ClaimOutboxEvents(ctx, time.Now(), conf.Lease, conf.Batch)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	// The request that made a change already dropped these entries; doing it
+	// again here covers a process that died between its commit and the cache.
+	c.invalidateOutbox(ctx, events)
+
+	ids := make([]uint64, 0, len(events))
+	for _, e := range events {
+		if err = pub.Publish(ctx, e); err != nil {
+			zap.L().Debug(
+				"failed to publish outbox event",
+				zap.String("op", op),
+				zap.Uint64("id", e.ID), zap.String("event", e.Event),
+				zap.Error(err),
+			)
+			break
+		}
+		ids = append(ids, e.ID)
+	}
+
+	if mErr := c.repo.MarkOutboxPublished(ctx, ids, time.Now()); mErr != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Int("count", len(ids)),
+			zap.Error(mErr),
+		)
+		return len(ids), mErr
+	}
+
+	return len(ids), err
+}
+
+// invalidateOutbox drops the cached entries of every record in events.
+func (c *Controller) invalidateOutbox(ctx context.Context, events []*md.OutboxEvent) {
+	const op = "outbox.invalidateOutbox.ctrl"
+
+	var pages bool
+	for _, e := range events {
+		var err error
+		switch {
+		case strings.HasPrefix(e.Event, "seo."):
+			ch := &md.SEOChange{}
+			if err = json.Unmarshal(e.Payload, ch); err != nil {
+				break
+			}
+			for _, v := range []*md.SEO{ch.Before, ch.After} {
+				if v != nil {
+					c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(seoPattern, v.OBJName, v.OBJPK))
+				}
+			}
+		case strings.HasPrefix(e.Event, "page."):
+			ch := &md.PageChange{}
+			if err = json.Unmarshal(e.Payload, ch); err != nil {
+				break
+			}
+			for _, v := range []*md.Page{ch.Before, ch.After} {
+				if v != nil {
+					c.cache.Delete(ctx, fmt.Sprintf(pageKey, v.Slug))
+				}
+			}
+			pages = true
+		case strings.HasPrefix(e.Event, "robots_"):
+			c.cache.Delete(ctx, robotsKey)
+		case strings.HasPrefix(e.Event, "redirect."):
+			c.cache.Delete(ctx, redirectsKey)
+		case strings.HasPrefix(e.Event, "seo_template."):
+			ch := &md.SEOTemplateChange{}
+			if err = json.Unmarshal(e.Payload, ch); err != nil {
+				break
+			}
+			for _, v := range []*md.SEOTemplate{ch.Before, ch.After} {
+				if v != nil {
+					c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(templatePattern, v.OBJName))
+				}
+			}
+		}
+
+		if err != nil {
+			zap.L().Warn(
+				"failed to decode outbox event",
+				zap.String("op", op),
+				zap.Uint64("id", e.ID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	if pages {
+		c.invalidatePageTree(ctx)
+	}
+	c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
+}
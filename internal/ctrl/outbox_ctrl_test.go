@@ -0,0 +1,123 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/publisher/memory"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_RelayOutbox(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+	mockPub := mocks.NewMockPublisher(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{Outbox: &config.OutboxConfig{Lease: time.Minute, Batch: 10}})
+
+	events := []*model.OutboxEvent{
+		{
+			ID: 1, Event: model.EventSEOUpdated, Key: "seo:product:1:en",
+			Payload: []byte(`{"event":"seo.updated","before":{"obj_name":"product","obj_pk":"1"},"after":{"obj_name":"product","obj_pk":"1"}}`),
+		},
+		{
+			ID: 2, Event: model.EventPageDeleted, Key: "page:about",
+			Payload: []byte(`{"event":"page.deleted","before":{"slug":"about"},"after":null}`),
+		},
+	}
+
+	expectInvalidate := func() {
+		mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), "SEO:product:1:*").Times(2)
+		mockCache.EXPECT().Delete(gomock.Any(), "page:about").Times(1)
+		mockCache.EXPECT().Delete(gomock.Any(), pageTreeKey).Times(1)
+		mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), breadcrumbsPattern).Times(1)
+		mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), sitemapPattern).Times(1)
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			pub := memory.New(10)
+			mockRepo.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), time.Minute, 10).Return(events, nil).Times(1)
+			expectInvalidate()
+			mockRepo.EXPECT().MarkOutboxPublished(gomock.Any(), []uint64{1, 2}, gomock.Any()).Return(nil).Times(1)
+
+			n, err := ctrl.RelayOutbox(ctx, pub)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, n)
+			require.Len(t, pub.Events(), 2)
+			assert.Equal(t, model.EventPageDeleted, pub.Events()[1].Event)
+		},
+	)
+
+	t.Run(
+		"Invalidates robots, redirects and templates", func(t *testing.T) {
+			pub := memory.New(10)
+			settings := []*model.OutboxEvent{
+				{ID: 3, Event: model.EventRobotsSitemapDeleted, Key: "robots_sitemap:1", Payload: []byte(`{"before":{"id":1},"after":null}`)},
+				{ID: 4, Event: model.EventRedirectUpdated, Key: "redirect:1", Payload: []byte(`{"before":{"id":1},"after":{"id":1}}`)},
+				{
+					ID: 5, Event: model.EventSEOTemplateCreated, Key: "seo_template:product:en",
+					Payload: []byte(`{"before":null,"after":{"obj_name":"product","locale":"en"}}`),
+				},
+			}
+			mockRepo.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), time.Minute, 10).Return(settings, nil).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), robotsKey).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), redirectsKey).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), "SEO_TEMPLATE:product:*").Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), sitemapPattern).Times(1)
+			mockRepo.EXPECT().MarkOutboxPublished(gomock.Any(), []uint64{3, 4, 5}, gomock.Any()).Return(nil).Times(1)
+
+			n, err := ctrl.RelayOutbox(ctx, pub)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, n)
+		},
+	)
+
+	t.Run(
+		"Nothing to relay", func(t *testing.T) {
+			mockRepo.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), time.Minute, 10).Return([]*model.OutboxEvent{}, nil).Times(1)
+
+			n, err := ctrl.RelayOutbox(ctx, mockPub)
+			assert.NoError(t, err)
+			assert.Equal(t, 0, n)
+		},
+	)
+
+	t.Run(
+		"Stops at the first failure", func(t *testing.T) {
+			testErr := errors.New("broker unavailable")
+			mockRepo.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), time.Minute, 10).Return(events, nil).Times(1)
+			expectInvalidate()
+			gomock.InOrder(
+				mockPub.EXPECT().Publish(gomock.Any(), events[0]).Return(nil).Times(1),
+				mockPub.EXPECT().Publish(gomock.Any(), events[1]).Return(testErr).Times(1),
+			)
+			mockRepo.EXPECT().MarkOutboxPublished(gomock.Any(), []uint64{1}, gomock.Any()).Return(nil).Times(1)
+
+			n, err := ctrl.RelayOutbox(ctx, mockPub)
+			assert.Equal(t, testErr, err)
+			assert.Equal(t, 1, n)
+		},
+	)
+
+	t.Run(
+		"Claim error", func(t *testing.T) {
+			testErr := errors.New("db error")
+			mockRepo.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), time.Minute, 10).Return(nil, testErr).Times(1)
+
+			n, err := ctrl.RelayOutbox(ctx, mockPub)
+			assert.Equal(t, testErr, err)
+			assert.Equal(t, 0, n)
+		},
+	)
+}
@@ -2,7 +2,6 @@ package ctrl
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/JMURv/seo/internal/config"
@@ -15,13 +14,31 @@ import (
 
 const pageKey = "page:%v"
 
-func (c *Controller) ListPages(ctx context.Context) ([]*models.Page, error) {
+func (c *Controller) ListPages(ctx context.Context, f *models.PageFilter) (*dto.PaginatedPages, error) {
 	const op = "page.ListPages.ctrl"
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	res, err := c.repo.ListPages(ctx)
-	if err != nil {
+	if f.Page <= 0 {
+		f.Page = config.DefaultPage
+	}
+	if f.Size <= 0 {
+		f.Size = config.DefaultSize
+	}
+	if !IsPreview(ctx) {
+		f.Status = models.StatusPublished
+	}
+
+	res, err := c.repo.ListPages(ctx, f)
+	if err != nil && errors.Is(err, models.ErrInvalidCursor) {
+		zap.L().Debug(
+			ErrInvalidCursor.Error(),
+			zap.String("op", op),
+			zap.String("cursor", f.Cursor),
+			zap.Error(err),
+		)
+		return nil, ErrInvalidCursor
+	} else if err != nil {
 		zap.L().Debug(
 			ErrInternal.Error(),
 			zap.String("op", op),
@@ -30,7 +47,15 @@ func (c *Controller) ListPages(ctx context.Context) ([]*models.Page, error) {
 		return nil, err
 	}
 
-	return res, nil
+	totalPages := int((res.Total + int64(f.Size) - 1) / int64(f.Size))
+	return &dto.PaginatedPages{
+		Data:        res.Pages,
+		Count:       res.Total,
+		TotalPages:  totalPages,
+		CurrentPage: f.Page,
+		HasNextPage: res.NextCursor != "" || (f.Cursor == "" && f.Page < totalPages),
+		NextCursor:  res.NextCursor,
+	}, nil
 }
 
 func (c *Controller) GetPage(ctx context.Context, slug string) (*models.Page, error) {
@@ -38,13 +63,24 @@ func (c *Controller) GetPage(ctx context.Context, slug string) (*models.Page, er
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	cached := &models.Page{}
-	key := fmt.Sprintf(pageKey, slug)
-	if err := c.cache.GetToStruct(ctx, key, cached); err == nil {
-		return cached, nil
+	if IsPreview(ctx) {
+		return c.loadPage(ctx, slug)
 	}
 
+	return cachedLoad(
+		ctx, c, "page", fmt.Sprintf(pageKey, slug), func(ctx context.Context) (*models.Page, error) {
+			return c.loadPage(ctx, slug)
+		},
+	)
+}
+
+func (c *Controller) loadPage(ctx context.Context, slug string) (*models.Page, error) {
+	const op = "page.loadPage.ctrl"
+
 	res, err := c.repo.GetPage(ctx, slug)
+	if err == nil && !visible(ctx, res.Status) {
+		res, err = nil, repo.ErrNotFound
+	}
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		zap.L().Debug(
 			ErrNotFound.Error(),
@@ -62,10 +98,6 @@ func (c *Controller) GetPage(ctx context.Context, slug string) (*models.Page, er
 		)
 		return nil, err
 	}
-
-	if bytes, err := json.Marshal(res); err == nil {
-		c.cache.Set(ctx, config.DefaultCacheTime, key, bytes)
-	}
 	return res, nil
 }
 
@@ -74,6 +106,7 @@ func (c *Controller) CreatePage(ctx context.Context, req *models.Page) (*dto.Cre
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
+	req.Status = defaultStatus(req.Status)
 	res, err := c.repo.CreatePage(ctx, req)
 	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
 		zap.L().Debug(
@@ -83,6 +116,22 @@ func (c *Controller) CreatePage(ctx context.Context, req *models.Page) (*dto.Cre
 			zap.Error(err),
 		)
 		return nil, ErrAlreadyExists
+	} else if err != nil && errors.Is(err, repo.ErrParentNotFound) {
+		zap.L().Debug(
+			ErrParentNotFound.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		return nil, ErrParentNotFound
+	} else if err != nil && errors.Is(err, repo.ErrDuplicateHref) {
+		zap.L().Debug(
+			ErrDuplicateHref.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		return nil, ErrDuplicateHref
 	} else if err != nil {
 		zap.L().Debug(
 			ErrInternal.Error(),
@@ -93,6 +142,8 @@ func (c *Controller) CreatePage(ctx context.Context, req *models.Page) (*dto.Cre
 		return nil, err
 	}
 
+	c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
+	c.invalidatePageTree(ctx)
 	return &dto.CreatePageResponse{
 		Slug: res,
 	}, nil
@@ -103,8 +154,33 @@ func (c *Controller) UpdatePage(ctx context.Context, slug string, req *models.Pa
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
+	req.Status = defaultStatus(req.Status)
 	err := c.repo.UpdatePage(ctx, slug, req)
-	if err != nil && errors.Is(err, repo.ErrNotFound) {
+	if err != nil && errors.Is(err, repo.ErrPageCycle) {
+		zap.L().Debug(
+			ErrPageCycle.Error(),
+			zap.String("op", op),
+			zap.String("slug", slug), zap.Any("req", req),
+			zap.Error(err),
+		)
+		return ErrPageCycle
+	} else if err != nil && errors.Is(err, repo.ErrParentNotFound) {
+		zap.L().Debug(
+			ErrParentNotFound.Error(),
+			zap.String("op", op),
+			zap.String("slug", slug), zap.Any("req", req),
+			zap.Error(err),
+		)
+		return ErrParentNotFound
+	} else if err != nil && errors.Is(err, repo.ErrDuplicateHref) {
+		zap.L().Debug(
+			ErrDuplicateHref.Error(),
+			zap.String("op", op),
+			zap.String("slug", slug), zap.Any("req", req),
+			zap.Error(err),
+		)
+		return ErrDuplicateHref
+	} else if err != nil && errors.Is(err, repo.ErrNotFound) {
 		zap.L().Debug(
 			ErrNotFound.Error(),
 			zap.String("op", op),
@@ -123,16 +199,28 @@ func (c *Controller) UpdatePage(ctx context.Context, slug string, req *models.Pa
 	}
 
 	c.cache.Delete(ctx, fmt.Sprintf(pageKey, slug))
+	c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
+	c.invalidatePageTree(ctx)
 	return nil
 }
 
-func (c *Controller) DeletePage(ctx context.Context, slug string) error {
+// DeletePage removes slug using one of the md.PageDelete* strategies; an
+// empty strategy rejects pages that still have children.
+func (c *Controller) DeletePage(ctx context.Context, slug, strategy string) error {
 	const op = "page.DeletePage.ctrl"
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	err := c.repo.DeletePage(ctx, slug)
-	if err != nil && errors.Is(err, repo.ErrNotFound) {
+	slugs, err := c.repo.DeletePage(ctx, slug, strategy)
+	if err != nil && errors.Is(err, repo.ErrHasChildren) {
+		zap.L().Debug(
+			ErrHasChildren.Error(),
+			zap.String("op", op),
+			zap.String("slug", slug), zap.String("strategy", strategy),
+			zap.Error(err),
+		)
+		return ErrHasChildren
+	} else if err != nil && errors.Is(err, repo.ErrNotFound) {
 		zap.L().Debug(
 			ErrNotFound.Error(),
 			zap.String("op", op),
@@ -150,6 +238,10 @@ func (c *Controller) DeletePage(ctx context.Context, slug string) error {
 		return err
 	}
 
-	c.cache.Delete(ctx, fmt.Sprintf(pageKey, slug))
+	for _, v := range slugs {
+		c.cache.Delete(ctx, fmt.Sprintf(pageKey, v))
+	}
+	c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
+	c.invalidatePageTree(ctx)
 	return nil
 }
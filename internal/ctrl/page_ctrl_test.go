@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"testing"
+	"time"
 )
 
 func TestController_ListPages(t *testing.T) {
@@ -22,26 +23,60 @@ func TestController_ListPages(t *testing.T) {
 	mockCache := mocks.NewMockCacheService(ctrlMock)
 
 	ctx := context.Background()
-	ctrl := New(mockRepo, mockCache)
+	ctrl := New(mockRepo, mockCache, &config.Config{})
 
-	var expected []*model.Page
+	expected := []*model.Page{{Slug: "slug", Status: model.StatusPublished}}
 
 	t.Run(
 		"Success", func(t *testing.T) {
-			mockRepo.EXPECT().ListPages(gomock.Any()).Return(expected, nil).Times(1)
+			mockRepo.EXPECT().
+				ListPages(gomock.Any(), &model.PageFilter{Page: 2, Size: 1, Status: model.StatusPublished}).
+				Return(&model.PageList{Pages: expected, Total: 3}, nil).
+				Times(1)
 
-			res, err := ctrl.ListPages(ctx)
+			res, err := ctrl.ListPages(ctx, &model.PageFilter{Page: 2, Size: 1})
 			assert.Nil(t, err)
-			assert.Equal(t, expected, res)
+			assert.Equal(
+				t, &dto.PaginatedPages{
+					Data: expected, Count: 3, TotalPages: 3, CurrentPage: 2, HasNextPage: true,
+				}, res,
+			)
+		},
+	)
+
+	t.Run(
+		"Defaults and cursor", func(t *testing.T) {
+			f := &model.PageFilter{Cursor: "c"}
+			mockRepo.EXPECT().
+				ListPages(gomock.Any(), f).
+				Return(&model.PageList{Pages: expected, Total: 100, NextCursor: "next"}, nil).
+				Times(1)
+
+			res, err := ctrl.ListPages(ctx, f)
+			assert.Nil(t, err)
+			assert.Equal(t, config.DefaultPage, f.Page)
+			assert.Equal(t, config.DefaultSize, f.Size)
+			assert.True(t, res.HasNextPage)
+			assert.Equal(t, "next", res.NextCursor)
+		},
+	)
+
+	t.Run(
+		"ErrInvalidCursor", func(t *testing.T) {
+			mockRepo.EXPECT().ListPages(gomock.Any(), gomock.Any()).Return(nil, model.ErrInvalidCursor).Times(1)
+
+			res, err := ctrl.ListPages(ctx, &model.PageFilter{Cursor: "c"})
+			assert.Equal(t, ErrInvalidCursor, err)
+			assert.Nil(t, res)
 		},
 	)
 
 	t.Run(
 		"ErrInternal", func(t *testing.T) {
 			newErr := errors.New("err")
-			mockRepo.EXPECT().ListPages(gomock.Any()).Return(nil, newErr).Times(1)
+			mockRepo.EXPECT().ListPages(gomock.Any(), gomock.Any()).Return(nil, newErr).Times(1)
 
-			res, err := ctrl.ListPages(ctx)
+			res, err := ctrl.ListPages(ctx, &model.PageFilter{})
 			assert.IsType(t, newErr, err)
 			assert.Nil(t, res)
 		},
@@ -56,17 +91,17 @@ func TestController_GetPage(t *testing.T) {
 	mockCache := mocks.NewMockCacheService(ctrlMock)
 
 	ctx := context.Background()
-	ctrl := New(mockRepo, mockCache)
+	ctrl := New(mockRepo, mockCache, &config.Config{})
 
 	slug := "slug"
 	key := fmt.Sprintf(pageKey, slug)
-	expected := &model.Page{}
+	expected := &model.Page{Status: model.StatusPublished}
 
 	t.Run(
 		"Cache hit", func(t *testing.T) {
 			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
-				func(_ context.Context, _ string, dest *model.Page) error {
-					*dest = *expected
+				func(_ context.Context, _ string, dest *cacheEntry[model.Page]) error {
+					dest.Value, dest.StaleAt = expected, time.Now().Add(time.Hour)
 					return nil
 				},
 			).Times(1)
@@ -88,7 +123,7 @@ func TestController_GetPage(t *testing.T) {
 				Return(expected, nil).
 				Times(1)
 			mockCache.EXPECT().
-				Set(gomock.Any(), config.DefaultCacheTime, key, gomock.Any()).
+				Set(gomock.Any(), gomock.Any(), key, gomock.Any()).
 				Return().
 				Times(1)
 
@@ -141,7 +176,7 @@ func TestController_CreatePage(t *testing.T) {
 	mockCache := mocks.NewMockCacheService(ctrlMock)
 
 	ctx := context.Background()
-	ctrl := New(mockRepo, mockCache)
+	ctrl := New(mockRepo, mockCache, &config.Config{})
 
 	expected := &dto.CreatePageResponse{Slug: "slug"}
 	req := &model.Page{
@@ -156,6 +191,18 @@ func TestController_CreatePage(t *testing.T) {
 				CreatePage(gomock.Any(), req).
 				Return("slug", nil).
 				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), sitemapPattern).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				Delete(gomock.Any(), pageTreeKey).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), breadcrumbsPattern).
+				Return().
+				Times(1)
 
 			res, err := ctrl.CreatePage(ctx, req)
 			assert.Nil(t, err)
@@ -176,6 +223,19 @@ func TestController_CreatePage(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"ErrParentNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().
+				CreatePage(gomock.Any(), req).
+				Return("", repo.ErrParentNotFound).
+				Times(1)
+
+			res, err := ctrl.CreatePage(ctx, req)
+			assert.Equal(t, ErrParentNotFound, err)
+			assert.Nil(t, res)
+		},
+	)
+
 	t.Run(
 		"ErrInternal", func(t *testing.T) {
 			newErr := errors.New("some error")
@@ -199,7 +259,7 @@ func TestController_UpdatePage(t *testing.T) {
 	mockCache := mocks.NewMockCacheService(ctrlMock)
 
 	ctx := context.Background()
-	ctrl := New(mockRepo, mockCache)
+	ctrl := New(mockRepo, mockCache, &config.Config{})
 
 	slug := "slug"
 	req := &model.Page{
@@ -218,6 +278,18 @@ func TestController_UpdatePage(t *testing.T) {
 				Delete(gomock.Any(), fmt.Sprintf(pageKey, slug)).
 				Return().
 				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), sitemapPattern).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				Delete(gomock.Any(), pageTreeKey).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), breadcrumbsPattern).
+				Return().
+				Times(1)
 
 			err := ctrl.UpdatePage(ctx, slug, req)
 			assert.Nil(t, err)
@@ -236,6 +308,46 @@ func TestController_UpdatePage(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"Moves under parent", func(t *testing.T) {
+			moved := &model.Page{Slug: slug, Title: "title", Href: "href", ParentSlug: "parent"}
+			mockRepo.EXPECT().
+				UpdatePage(gomock.Any(), slug, moved).
+				Return(nil).
+				Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(pageKey, slug)).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), sitemapPattern).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), pageTreeKey).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), breadcrumbsPattern).Times(1)
+
+			assert.Nil(t, ctrl.UpdatePage(ctx, slug, moved))
+		},
+	)
+
+	t.Run(
+		"ErrPageCycle", func(t *testing.T) {
+			moved := &model.Page{Slug: slug, Title: "title", Href: "href", ParentSlug: "child"}
+			mockRepo.EXPECT().
+				UpdatePage(gomock.Any(), slug, moved).
+				Return(repo.ErrPageCycle).
+				Times(1)
+
+			assert.Equal(t, ErrPageCycle, ctrl.UpdatePage(ctx, slug, moved))
+		},
+	)
+
+	t.Run(
+		"ErrParentNotFound", func(t *testing.T) {
+			moved := &model.Page{Slug: slug, Title: "title", Href: "href", ParentSlug: "missing"}
+			mockRepo.EXPECT().
+				UpdatePage(gomock.Any(), slug, moved).
+				Return(repo.ErrParentNotFound).
+				Times(1)
+
+			assert.Equal(t, ErrParentNotFound, ctrl.UpdatePage(ctx, slug, moved))
+		},
+	)
+
 	t.Run(
 		"ErrInternal", func(t *testing.T) {
 			newErr := errors.New("some error")
@@ -258,33 +370,73 @@ func TestController_DeletePage(t *testing.T) {
 	mockCache := mocks.NewMockCacheService(ctrlMock)
 
 	ctx := context.Background()
-	ctrl := New(mockRepo, mockCache)
+	ctrl := New(mockRepo, mockCache, &config.Config{})
 
 	slug := "slug"
 	t.Run(
 		"Success", func(t *testing.T) {
 			mockRepo.EXPECT().
-				DeletePage(gomock.Any(), slug).
-				Return(nil).
+				DeletePage(gomock.Any(), slug, "").
+				Return([]string{slug}, nil).
 				Times(1)
 			mockCache.EXPECT().
 				Delete(gomock.Any(), fmt.Sprintf(pageKey, slug)).
 				Return().
 				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), sitemapPattern).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				Delete(gomock.Any(), pageTreeKey).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), breadcrumbsPattern).
+				Return().
+				Times(1)
 
-			err := ctrl.DeletePage(ctx, slug)
+			err := ctrl.DeletePage(ctx, slug, "")
 			assert.Nil(t, err)
 		},
 	)
 
+	t.Run(
+		"Cascade invalidates subtree", func(t *testing.T) {
+			mockRepo.EXPECT().
+				DeletePage(gomock.Any(), slug, model.PageDeleteCascade).
+				Return([]string{slug, "child"}, nil).
+				Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(pageKey, slug)).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(pageKey, "child")).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), sitemapPattern).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), pageTreeKey).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), breadcrumbsPattern).Times(1)
+
+			assert.Nil(t, ctrl.DeletePage(ctx, slug, model.PageDeleteCascade))
+		},
+	)
+
+	t.Run(
+		"ErrHasChildren", func(t *testing.T) {
+			mockRepo.EXPECT().
+				DeletePage(gomock.Any(), slug, model.PageDeleteReject).
+				Return(nil, repo.ErrHasChildren).
+				Times(1)
+
+			err := ctrl.DeletePage(ctx, slug, model.PageDeleteReject)
+			assert.Equal(t, ErrHasChildren, err)
+		},
+	)
+
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
 			mockRepo.EXPECT().
-				DeletePage(gomock.Any(), slug).
-				Return(repo.ErrNotFound).
+				DeletePage(gomock.Any(), slug, "").
+				Return(nil, repo.ErrNotFound).
 				Times(1)
 
-			err := ctrl.DeletePage(ctx, slug)
+			err := ctrl.DeletePage(ctx, slug, "")
 			assert.IsType(t, ErrNotFound, err)
 		},
 	)
@@ -293,11 +445,11 @@ func TestController_DeletePage(t *testing.T) {
 		"ErrInternal", func(t *testing.T) {
 			newErr := errors.New("some error")
 			mockRepo.EXPECT().
-				DeletePage(gomock.Any(), slug).
-				Return(newErr).
+				DeletePage(gomock.Any(), slug, "").
+				Return(nil, newErr).
 				Times(1)
 
-			err := ctrl.DeletePage(ctx, slug)
+			err := ctrl.DeletePage(ctx, slug, "")
 			assert.IsType(t, newErr, err)
 		},
 	)
@@ -0,0 +1,162 @@
+package ctrl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const pageTreeKey = "page_tree"
+const breadcrumbsKey = "page_breadcrumbs:%v"
+const breadcrumbsPattern = "page_breadcrumbs:*"
+
+// GetPageTree returns root pages with their descendants nested under them.
+// Pages the caller may not see are left out together with their subtrees.
+func (c *Controller) GetPageTree(ctx context.Context) ([]*dto.PageNode, error) {
+	const op = "page.GetPageTree.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	preview := IsPreview(ctx)
+	var cached []*dto.PageNode
+	if !preview {
+		if err := c.cache.GetToStruct(ctx, pageTreeKey, &cached); err == nil {
+			return cached, nil
+		}
+	}
+
+	pages, err := c.repo.ListPageTree(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	children := make(map[string][]*md.Page, len(pages))
+	for _, v := range pages {
+		children[v.ParentSlug] = append(children[v.ParentSlug], v)
+	}
+
+	var build func(parent string) []*dto.PageNode
+	build = func(parent string) []*dto.PageNode {
+		res := make([]*dto.PageNode, 0, len(children[parent]))
+		for _, v := range children[parent] {
+			if !visible(ctx, v.Status) {
+				continue
+			}
+			res = append(
+				res, &dto.PageNode{
+					Slug:     v.Slug,
+					Title:    v.Title,
+					Href:     v.Href,
+					Position: v.Position,
+					Status:   v.Status,
+					Children: build(v.Slug),
+				},
+			)
+		}
+		return res
+	}
+
+	res := build("")
+	if preview {
+		return res, nil
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		c.cache.Set(ctx, config.DefaultCacheTime, pageTreeKey, bytes)
+	}
+	return res, nil
+}
+
+// GetBreadcrumbs returns the ancestor chain of slug, root first, and the
+// matching BreadcrumbList JSON-LD with absolute URLs. A page under a hidden
+// ancestor is treated as missing, as it is in the tree.
+func (c *Controller) GetBreadcrumbs(ctx context.Context, slug string) (*dto.Breadcrumbs, error) {
+	const op = "page.GetBreadcrumbs.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	preview := IsPreview(ctx)
+	cached := &dto.Breadcrumbs{}
+	key := fmt.Sprintf(breadcrumbsKey, slug)
+	if !preview {
+		if err := c.cache.GetToStruct(ctx, key, cached); err == nil {
+			return cached, nil
+		}
+	}
+
+	chain, err := c.repo.ListPageAncestors(ctx, slug)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("slug", slug),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if len(chain) == 0 {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.String("slug", slug),
+		)
+		return nil, ErrNotFound
+	}
+
+	res := &dto.Breadcrumbs{Items: make([]*dto.Breadcrumb, 0, len(chain))}
+	elements := make([]any, 0, len(chain))
+	for i, v := range chain {
+		if !visible(ctx, v.Status) {
+			zap.L().Debug(
+				ErrNotFound.Error(),
+				zap.String("op", op),
+				zap.String("slug", slug), zap.String("hidden", v.Slug),
+			)
+			return nil, ErrNotFound
+		}
+
+		item := &dto.Breadcrumb{Slug: v.Slug, Title: v.Title, Href: v.Href, URL: c.absURL(v.Href)}
+		res.Items = append(res.Items, item)
+		elements = append(
+			elements, map[string]any{
+				"@type":    "ListItem",
+				"position": i + 1,
+				"name":     item.Title,
+				"item":     item.URL,
+			},
+		)
+	}
+
+	res.JSONLD = map[string]any{
+		"@context":        "https://schema.org",
+		"@type":           "BreadcrumbList",
+		"itemListElement": elements,
+	}
+
+	if preview {
+		return res, nil
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		c.cache.Set(ctx, config.DefaultCacheTime, key, bytes)
+	}
+	return res, nil
+}
+
+// invalidatePageTree drops cached navigation; any page write may move a
+// branch or change a title shown in it.
+func (c *Controller) invalidatePageTree(ctx context.Context) {
+	c.cache.Delete(ctx, pageTreeKey)
+	c.cache.InvalidateKeysByPattern(ctx, breadcrumbsPattern)
+}
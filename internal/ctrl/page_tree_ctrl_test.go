@@ -0,0 +1,158 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_GetPageTree(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	pages := []*model.Page{
+		{Slug: "home", Title: "Home", Href: "/", Status: model.StatusPublished},
+		{Slug: "catalog", Title: "Catalog", Href: "/catalog", ParentSlug: "home", Position: 1, Status: model.StatusPublished},
+		{Slug: "drafts", Title: "Drafts", Href: "/drafts", ParentSlug: "home", Position: 2, Status: model.StatusDraft},
+		{Slug: "hidden", Title: "Hidden", Href: "/drafts/hidden", ParentSlug: "drafts", Status: model.StatusPublished},
+		{Slug: "chairs", Title: "Chairs", Href: "/catalog/chairs", ParentSlug: "catalog", Status: model.StatusPublished},
+	}
+
+	t.Run(
+		"Builds visible tree", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), pageTreeKey, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListPageTree(gomock.Any()).Return(pages, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), config.DefaultCacheTime, pageTreeKey, gomock.Any()).Times(1)
+
+			res, err := ctrl.GetPageTree(ctx)
+			assert.Nil(t, err)
+			assert.Len(t, res, 1)
+			assert.Equal(t, "home", res[0].Slug)
+			assert.Len(t, res[0].Children, 1)
+			assert.Equal(t, "catalog", res[0].Children[0].Slug)
+			assert.Equal(t, "chairs", res[0].Children[0].Children[0].Slug)
+			assert.Empty(t, res[0].Children[0].Children[0].Children)
+		},
+	)
+
+	t.Run(
+		"Preview includes drafts", func(t *testing.T) {
+			mockRepo.EXPECT().ListPageTree(gomock.Any()).Return(pages, nil).Times(1)
+
+			res, err := ctrl.GetPageTree(WithPreview(ctx))
+			assert.Nil(t, err)
+			assert.Len(t, res[0].Children, 2)
+			assert.Equal(t, "drafts", res[0].Children[1].Slug)
+			assert.Equal(t, "hidden", res[0].Children[1].Children[0].Slug)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockCache.EXPECT().GetToStruct(gomock.Any(), pageTreeKey, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListPageTree(gomock.Any()).Return(nil, newErr).Times(1)
+
+			res, err := ctrl.GetPageTree(ctx)
+			assert.Nil(t, res)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
+
+func TestController_GetBreadcrumbs(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(
+		mockRepo, mockCache, &config.Config{
+			Sitemap: &config.SitemapConfig{Host: "https://example.com/"},
+		},
+	)
+
+	slug := "chairs"
+	key := fmt.Sprintf(breadcrumbsKey, slug)
+	chain := []*model.Page{
+		{Slug: "home", Title: "Home", Href: "/", Status: model.StatusPublished},
+		{Slug: "catalog", Title: "Catalog", Href: "/catalog", ParentSlug: "home", Status: model.StatusPublished},
+		{Slug: slug, Title: "Chairs", Href: "/catalog/chairs", ParentSlug: "catalog", Status: model.StatusPublished},
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListPageAncestors(gomock.Any(), slug).Return(chain, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), config.DefaultCacheTime, key, gomock.Any()).Times(1)
+
+			res, err := ctrl.GetBreadcrumbs(ctx, slug)
+			assert.Nil(t, err)
+			assert.Len(t, res.Items, 3)
+			assert.Equal(t, "https://example.com/catalog/chairs", res.Items[2].URL)
+			assert.Equal(t, "BreadcrumbList", res.JSONLD["@type"])
+
+			elements := res.JSONLD["itemListElement"].([]any)
+			assert.Equal(
+				t, map[string]any{
+					"@type":    "ListItem",
+					"position": 2,
+					"name":     "Catalog",
+					"item":     "https://example.com/catalog",
+				}, elements[1],
+			)
+		},
+	)
+
+	t.Run(
+		"Hidden ancestor", func(t *testing.T) {
+			hidden := []*model.Page{
+				{Slug: "home", Title: "Home", Href: "/", Status: model.StatusDraft},
+				chain[2],
+			}
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListPageAncestors(gomock.Any(), slug).Return(hidden, nil).Times(1)
+
+			res, err := ctrl.GetBreadcrumbs(ctx, slug)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListPageAncestors(gomock.Any(), slug).Return([]*model.Page{}, nil).Times(1)
+
+			res, err := ctrl.GetBreadcrumbs(ctx, slug)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListPageAncestors(gomock.Any(), slug).Return(nil, newErr).Times(1)
+
+			res, err := ctrl.GetBreadcrumbs(ctx, slug)
+			assert.Nil(t, res)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
@@ -0,0 +1,108 @@
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"time"
+)
+
+const defaultSchedulerInterval = 30 * time.Second
+
+type previewKey struct{}
+
+// WithPreview marks ctx as coming from an authenticated editor who asked to see
+// unpublished content. Preview reads bypass the cache.
+func WithPreview(ctx context.Context) context.Context {
+	return context.WithValue(ctx, previewKey{}, true)
+}
+
+// IsPreview reports whether ctx was marked with WithPreview.
+func IsPreview(ctx context.Context) bool {
+	preview, _ := ctx.Value(previewKey{}).(bool)
+	return preview
+}
+
+// visible reports whether content with status may be returned to the caller.
+func visible(ctx context.Context, status string) bool {
+	return status == md.StatusPublished || IsPreview(ctx)
+}
+
+// defaultStatus keeps writes from clients unaware of the workflow published.
+func defaultStatus(status string) string {
+	if status == "" {
+		return md.StatusPublished
+	}
+	return status
+}
+
+// RunScheduler promotes scheduled SEO records and pages every interval until
+// ctx is cancelled.
+func (c *Controller) RunScheduler(ctx context.Context) {
+	interval := defaultSchedulerInterval
+	if c.conf != nil && c.conf.Scheduler != nil && c.conf.Scheduler.Interval > 0 {
+		interval = c.conf.Scheduler.Interval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.PublishScheduled(ctx); err != nil {
+				zap.L().Warn("failed to publish scheduled content", zap.Error(err))
+			}
+		}
+	}
+}
+
+// PublishScheduled promotes everything due by now and drops the cached entries
+// of promoted records so readers see them immediately.
+func (c *Controller) PublishScheduled(ctx context.Context) error {
+	const op = "publish.PublishScheduled.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	now := time.Now()
+	seos, err := c.repo.PublishScheduledSEO(ctx, now)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	for _, seo := range seos {
+		c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(seoPattern, seo.OBJName, seo.OBJPK))
+	}
+
+	slugs, err := c.repo.PublishScheduledPages(ctx, now)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	for _, slug := range slugs {
+		c.cache.Delete(ctx, fmt.Sprintf(pageKey, slug))
+	}
+	if len(slugs) > 0 {
+		c.invalidatePageTree(ctx)
+	}
+
+	if len(seos) > 0 || len(slugs) > 0 {
+		c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
+		zap.L().Info("published scheduled content", zap.Int("seo", len(seos)), zap.Int("pages", len(slugs)))
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_GetSEO_Publishing(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	name, pk := "name", "pk"
+	key := fmt.Sprintf(SEOKey, name, pk, "")
+	draft := &model.SEO{OBJName: name, OBJPK: pk, Status: model.StatusDraft}
+
+	t.Run(
+		"Draft is hidden", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(draft, nil).Times(1)
+
+			res, err := ctrl.GetSEO(ctx, name, pk, "")
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"Preview returns draft and skips cache", func(t *testing.T) {
+			mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(draft, nil).Times(1)
+
+			res, err := ctrl.GetSEO(WithPreview(ctx), name, pk, "")
+			assert.Nil(t, err)
+			assert.Equal(t, draft, res)
+		},
+	)
+}
+
+func TestController_GetPage_Publishing(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	slug := "slug"
+	key := fmt.Sprintf(pageKey, slug)
+	scheduled := &model.Page{Slug: slug, Status: model.StatusScheduled}
+
+	t.Run(
+		"Scheduled is hidden", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().GetPage(gomock.Any(), slug).Return(scheduled, nil).Times(1)
+
+			res, err := ctrl.GetPage(ctx, slug)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"Preview returns scheduled", func(t *testing.T) {
+			mockRepo.EXPECT().GetPage(gomock.Any(), slug).Return(scheduled, nil).Times(1)
+
+			res, err := ctrl.GetPage(WithPreview(ctx), slug)
+			assert.Nil(t, err)
+			assert.Equal(t, scheduled, res)
+		},
+	)
+}
+
+func TestController_ListPages_Publishing(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	status := func(s string) gomock.Matcher {
+		return gomock.Cond(func(x any) bool { return x.(*model.PageFilter).Status == s })
+	}
+
+	t.Run(
+		"Published only", func(t *testing.T) {
+			mockRepo.EXPECT().ListPages(gomock.Any(), status(model.StatusPublished)).Return(&model.PageList{}, nil).Times(1)
+
+			_, err := ctrl.ListPages(ctx, &model.PageFilter{Status: model.StatusDraft})
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"Preview keeps status filter", func(t *testing.T) {
+			mockRepo.EXPECT().ListPages(gomock.Any(), status(model.StatusDraft)).Return(&model.PageList{}, nil).Times(1)
+
+			_, err := ctrl.ListPages(WithPreview(ctx), &model.PageFilter{Status: model.StatusDraft})
+			assert.Nil(t, err)
+		},
+	)
+}
+
+func TestController_PublishScheduled(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Promotes and invalidates", func(t *testing.T) {
+			mockRepo.EXPECT().PublishScheduledSEO(gomock.Any(), gomock.Any()).
+				Return([]*model.SEO{{OBJName: "name", OBJPK: "pk"}}, nil).Times(1)
+			mockRepo.EXPECT().PublishScheduledPages(gomock.Any(), gomock.Any()).Return([]string{"slug"}, nil).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(seoPattern, "name", "pk")).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(pageKey, "slug")).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), pageTreeKey).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), breadcrumbsPattern).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), sitemapPattern).Times(1)
+
+			assert.Nil(t, ctrl.PublishScheduled(ctx))
+		},
+	)
+
+	t.Run(
+		"Nothing due", func(t *testing.T) {
+			mockRepo.EXPECT().PublishScheduledSEO(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			mockRepo.EXPECT().PublishScheduledPages(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+			assert.Nil(t, ctrl.PublishScheduled(ctx))
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("err")
+			mockRepo.EXPECT().PublishScheduledSEO(gomock.Any(), gomock.Any()).Return(nil, newErr).Times(1)
+
+			assert.Equal(t, newErr, ctrl.PublishScheduled(ctx))
+		},
+	)
+}
@@ -0,0 +1,484 @@
+package ctrl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const redirectsKey = "redirects"
+const maxRedirectHops = 10
+
+var redirectCapture = regexp.MustCompile(`\$\{?\d+\}?`)
+
+type redirectRule struct {
+	*md.Redirect
+	re *regexp.Regexp
+}
+
+func (c *Controller) ListRedirects(ctx context.Context) ([]*md.Redirect, error) {
+	const op = "redirect.ListRedirects.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.ListRedirects(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetRedirect(ctx context.Context, id uint64) (*md.Redirect, error) {
+	const op = "redirect.GetRedirect.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.GetRedirect(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreateRedirect(ctx context.Context, req *md.Redirect) (*dto.CreateRedirectResponse, error) {
+	const op = "redirect.CreateRedirect.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if req.Code == 0 {
+		req.Code = http.StatusMovedPermanently
+	}
+
+	var chain []string
+	id, err := c.repo.CreateRedirect(
+		ctx, req, func(list []*md.Redirect) (err error) {
+			chain, err = checkRedirect(list, req)
+			return err
+		},
+	)
+	if err != nil && errors.Is(err, ErrRedirectLoop) {
+		zap.L().Debug(
+			ErrRedirectLoop.Error(),
+			zap.String("op", op),
+			zap.Any("req", req), zap.Strings("chain", chain),
+			zap.Error(err),
+		)
+		return nil, ErrRedirectLoop
+	} else if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug(
+			ErrAlreadyExists.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		return nil, ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	c.cache.Delete(ctx, redirectsKey)
+	res := &dto.CreateRedirectResponse{ID: id}
+	if len(chain) > 2 {
+		res.Chain = chain
+	}
+	return res, nil
+}
+
+func (c *Controller) UpdateRedirect(ctx context.Context, id uint64, req *md.Redirect) error {
+	const op = "redirect.UpdateRedirect.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if req.Code == 0 {
+		req.Code = http.StatusMovedPermanently
+	}
+
+	req.ID = id
+	var chain []string
+	err := c.repo.UpdateRedirect(
+		ctx, id, req, func(list []*md.Redirect) (err error) {
+			chain, err = checkRedirect(list, req)
+			return err
+		},
+	)
+	if err != nil && errors.Is(err, ErrRedirectLoop) {
+		zap.L().Debug(
+			ErrRedirectLoop.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id), zap.Any("req", req), zap.Strings("chain", chain),
+			zap.Error(err),
+		)
+		return ErrRedirectLoop
+	} else if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id), zap.Any("req", req),
+			zap.Error(err),
+		)
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug(
+			ErrAlreadyExists.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id), zap.Any("req", req),
+			zap.Error(err),
+		)
+		return ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id), zap.Any("req", req),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	c.cache.Delete(ctx, redirectsKey)
+	return nil
+}
+
+func (c *Controller) DeleteRedirect(ctx context.Context, id uint64) error {
+	const op = "redirect.DeleteRedirect.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.DeleteRedirect(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	c.cache.Delete(ctx, redirectsKey)
+	return nil
+}
+
+func (c *Controller) ResolveRedirect(ctx context.Context, path string) (*dto.ResolveRedirectResponse, error) {
+	const op = "redirect.ResolveRedirect.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	list, err := c.cachedRedirects(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("path", path),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	chain, first, err := followRedirects(compileRedirects(list), path)
+	if err != nil {
+		zap.L().Debug(
+			err.Error(),
+			zap.String("op", op),
+			zap.String("path", path), zap.Strings("chain", chain),
+		)
+		return nil, err
+	}
+
+	if first == nil {
+		return nil, ErrNotFound
+	}
+
+	return &dto.ResolveRedirectResponse{
+		Source: path,
+		Target: chain[len(chain)-1],
+		Code:   first.Code,
+		Hops:   len(chain) - 1,
+		Chain:  chain,
+	}, nil
+}
+
+func (c *Controller) ExportRedirects(ctx context.Context, format string) ([]byte, error) {
+	const op = "redirect.ExportRedirects.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if format != md.RedirectFormatNginx && format != md.RedirectFormatApache {
+		return nil, ErrUnsupportedFormat
+	}
+
+	list, err := c.cachedRedirects(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("format", format),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	exact, regex := splitRedirects(list)
+	if format == md.RedirectFormatNginx {
+		return renderNginxMap(exact, regex), nil
+	}
+	return renderApacheRules(exact, regex), nil
+}
+
+func (c *Controller) cachedRedirects(ctx context.Context) ([]*md.Redirect, error) {
+	var cached []*md.Redirect
+	if err := c.cache.GetToStruct(ctx, redirectsKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListRedirects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		c.cache.Set(ctx, config.DefaultCacheTime, redirectsKey, bytes)
+	}
+	return res, nil
+}
+
+// checkRedirect follows the chains req would take part in against list and
+// rejects req when one of them loops. Regex targets have their captures
+// replaced with a placeholder so self-matching patterns are caught too. The
+// longest chain through req is returned so callers can report multi-hop
+// redirects.
+func checkRedirect(list []*md.Redirect, req *md.Redirect) ([]string, error) {
+	merged := make([]*md.Redirect, 0, len(list)+1)
+	replaced := false
+	for _, v := range list {
+		if req.ID != 0 && v.ID == req.ID {
+			merged, replaced = append(merged, req), true
+			continue
+		}
+		merged = append(merged, v)
+	}
+
+	if !replaced {
+		merged = append(merged, req)
+	}
+
+	rules := compileRedirects(merged)
+	chain, err := redirectChain(rules, req)
+	if err != nil {
+		return chain, err
+	}
+
+	// Any rule, exact or regex, may lead into req. Chains that loop without
+	// passing req are left alone: req did not cause them.
+	for _, v := range merged {
+		if v == req {
+			continue
+		}
+
+		upstream, err := redirectChain(rules, v)
+		if !takesRule(rules, upstream, req) {
+			continue
+		}
+		if err != nil {
+			return upstream, err
+		}
+
+		if len(upstream) > len(chain) {
+			chain = upstream
+		}
+	}
+	return chain, nil
+}
+
+// redirectChain follows the chain starting with rd. A regex rule starts at its
+// target with the captures replaced by a placeholder.
+func redirectChain(rules []redirectRule, rd *md.Redirect) ([]string, error) {
+	if rd.Regex {
+		chain, _, err := followRedirects(rules, redirectCapture.ReplaceAllString(rd.Target, "x"))
+		return append([]string{rd.Source}, chain...), err
+	}
+
+	chain, _, err := followRedirects(rules, rd.Source)
+	return chain, err
+}
+
+// takesRule reports whether rd is one of the hops of chain.
+func takesRule(rules []redirectRule, chain []string, rd *md.Redirect) bool {
+	for _, path := range chain {
+		if v, _, ok := matchRedirect(rules, path); ok && v == rd {
+			return true
+		}
+	}
+	return false
+}
+
+func compileRedirects(list []*md.Redirect) []redirectRule {
+	res := make([]redirectRule, 0, len(list))
+	for _, v := range list {
+		rule := redirectRule{Redirect: v}
+		if v.Regex {
+			re, err := regexp.Compile(v.Source)
+			if err != nil {
+				zap.L().Debug("skipping invalid redirect regex", zap.Uint64("id", v.ID), zap.Error(err))
+				continue
+			}
+			rule.re = re
+		}
+		res = append(res, rule)
+	}
+	return res
+}
+
+// matchRedirect prefers exact sources over regex ones, regex rules are tried in
+// order. The returned target has regex captures expanded.
+func matchRedirect(rules []redirectRule, path string) (*md.Redirect, string, bool) {
+	for _, r := range rules {
+		if r.re == nil && r.Source == path {
+			return r.Redirect, r.Target, true
+		}
+	}
+
+	for _, r := range rules {
+		if r.re == nil {
+			continue
+		}
+
+		if m := r.re.FindStringSubmatchIndex(path); m != nil {
+			return r.Redirect, string(r.re.ExpandString(nil, r.Target, path, m)), true
+		}
+	}
+	return nil, "", false
+}
+
+// followRedirects walks redirects starting at path until no rule matches or an
+// external target is reached. The returned chain starts with path.
+func followRedirects(rules []redirectRule, path string) ([]string, *md.Redirect, error) {
+	chain := []string{path}
+	seen := map[string]struct{}{path: {}}
+
+	var first *md.Redirect
+	for cur := path; !strings.Contains(cur, "://"); {
+		rd, next, ok := matchRedirect(rules, cur)
+		if !ok {
+			break
+		}
+
+		if first == nil {
+			first = rd
+		}
+
+		chain = append(chain, next)
+		if _, ok := seen[next]; ok || len(chain) > maxRedirectHops+1 {
+			return chain, first, ErrRedirectLoop
+		}
+
+		seen[next] = struct{}{}
+		cur = next
+	}
+
+	return chain, first, nil
+}
+
+func splitRedirects(list []*md.Redirect) ([]*md.Redirect, []*md.Redirect) {
+	exact, regex := make([]*md.Redirect, 0, len(list)), make([]*md.Redirect, 0)
+	for _, v := range list {
+		if v.Regex {
+			regex = append(regex, v)
+			continue
+		}
+		exact = append(exact, v)
+	}
+	return exact, regex
+}
+
+// renderNginxMap emits two maps keyed by $uri: the target and the status code.
+// Usage: if ($redirect_uri) { return $redirect_code $redirect_uri; }
+func renderNginxMap(exact, regex []*md.Redirect) []byte {
+	all := append(append([]*md.Redirect{}, exact...), regex...)
+	key := func(r *md.Redirect) string {
+		if r.Regex {
+			return quoteDirective("~" + r.Source)
+		}
+		return quoteDirective(r.Source)
+	}
+
+	sb := &strings.Builder{}
+	sb.WriteString("map $uri $redirect_uri {\n    default \"\";\n")
+	for _, r := range all {
+		sb.WriteString(fmt.Sprintf("    %s %s;\n", key(r), quoteDirective(r.Target)))
+	}
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("map $uri $redirect_code {\n    default %d;\n", http.StatusMovedPermanently))
+	for _, r := range all {
+		sb.WriteString(fmt.Sprintf("    %s %d;\n", key(r), r.Code))
+	}
+	sb.WriteString("}\n")
+	return []byte(sb.String())
+}
+
+// renderApacheRules emits mod_rewrite rules. Exact sources are anchored with an
+// optional leading slash so they work in both server and .htaccess context.
+func renderApacheRules(exact, regex []*md.Redirect) []byte {
+	sb := &strings.Builder{}
+	sb.WriteString("RewriteEngine On\n")
+	for _, r := range exact {
+		pattern := "^/?" + regexp.QuoteMeta(strings.TrimPrefix(r.Source, "/")) + "$"
+		sb.WriteString(fmt.Sprintf("RewriteRule %s %s [R=%d,L]\n", quoteDirective(pattern), quoteDirective(r.Target), r.Code))
+	}
+
+	for _, r := range regex {
+		sb.WriteString(fmt.Sprintf("RewriteRule %s %s [R=%d,L]\n", quoteDirective(r.Source), quoteDirective(r.Target), r.Code))
+	}
+	return []byte(sb.String())
+}
+
+// quoteDirective wraps s in double quotes, which both nginx and Apache accept.
+func quoteDirective(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
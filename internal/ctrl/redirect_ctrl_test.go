@@ -0,0 +1,341 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_CreateRedirect(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	existing := []*md.Redirect{
+		{ID: 1, Source: "/a", Target: "/b", Code: 301},
+		{ID: 2, Source: "/c", Target: "/d", Code: 301},
+	}
+	blog := &md.Redirect{ID: 3, Source: "^/blog/(.*)$", Target: "/news/$1", Code: 301, Regex: true}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			req := &md.Redirect{Source: "/x", Target: "/y"}
+			mockRepo.EXPECT().CreateRedirect(gomock.Any(), req, gomock.Any()).
+				DoAndReturn(createRedirectWith(existing, 3, nil)).
+				Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), redirectsKey).Return().Times(1)
+
+			res, err := ctrl.CreateRedirect(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, &dto.CreateRedirectResponse{ID: 3}, res)
+			assert.Equal(t, 301, req.Code)
+		},
+	)
+
+	t.Run(
+		"Chain reported", func(t *testing.T) {
+			req := &md.Redirect{Source: "/b", Target: "/c", Code: 302}
+			mockRepo.EXPECT().CreateRedirect(gomock.Any(), req, gomock.Any()).
+				DoAndReturn(createRedirectWith(existing, 3, nil)).
+				Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), redirectsKey).Return().Times(1)
+
+			res, err := ctrl.CreateRedirect(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"/a", "/b", "/c", "/d"}, res.Chain)
+		},
+	)
+
+	t.Run(
+		"Chain through regex upstream", func(t *testing.T) {
+			req := &md.Redirect{Source: "/news/x", Target: "/final", Code: 301}
+			mockRepo.EXPECT().CreateRedirect(gomock.Any(), req, gomock.Any()).
+				DoAndReturn(createRedirectWith(append(existing, blog), 4, nil)).
+				Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), redirectsKey).Return().Times(1)
+
+			res, err := ctrl.CreateRedirect(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{blog.Source, "/news/x", "/final"}, res.Chain)
+		},
+	)
+
+	t.Run(
+		"ErrRedirectLoop", func(t *testing.T) {
+			req := &md.Redirect{Source: "/b", Target: "/a"}
+			mockRepo.EXPECT().CreateRedirect(gomock.Any(), req, gomock.Any()).
+				DoAndReturn(createRedirectWith(existing, 3, nil)).
+				Times(1)
+
+			res, err := ctrl.CreateRedirect(ctx, req)
+			assert.Equal(t, ErrRedirectLoop, err)
+			assert.Nil(t, res)
+		},
+	)
+
+	t.Run(
+		"ErrRedirectLoop regex", func(t *testing.T) {
+			req := &md.Redirect{Source: "^/blog/(.*)$", Target: "/blog/$1", Regex: true}
+			mockRepo.EXPECT().CreateRedirect(gomock.Any(), req, gomock.Any()).
+				DoAndReturn(createRedirectWith(existing, 3, nil)).
+				Times(1)
+
+			res, err := ctrl.CreateRedirect(ctx, req)
+			assert.Equal(t, ErrRedirectLoop, err)
+			assert.Nil(t, res)
+		},
+	)
+
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			req := &md.Redirect{Source: "/a", Target: "/z"}
+			mockRepo.EXPECT().CreateRedirect(gomock.Any(), req, gomock.Any()).
+				DoAndReturn(createRedirectWith(existing, 0, repo.ErrAlreadyExists)).
+				Times(1)
+
+			res, err := ctrl.CreateRedirect(ctx, req)
+			assert.Equal(t, ErrAlreadyExists, err)
+			assert.Nil(t, res)
+		},
+	)
+}
+
+func TestController_UpdateRedirect(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	existing := []*md.Redirect{
+		{ID: 1, Source: "/a", Target: "/b", Code: 301},
+		{ID: 2, Source: "/b", Target: "/c", Code: 301},
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			req := &md.Redirect{Source: "/b", Target: "/d", Code: 307}
+			mockRepo.EXPECT().UpdateRedirect(gomock.Any(), uint64(2), req, gomock.Any()).
+				DoAndReturn(updateRedirectWith(existing, nil)).
+				Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), redirectsKey).Return().Times(1)
+
+			assert.Nil(t, ctrl.UpdateRedirect(ctx, 2, req))
+		},
+	)
+
+	t.Run(
+		"ErrRedirectLoop", func(t *testing.T) {
+			req := &md.Redirect{Source: "/b", Target: "/a", Code: 301}
+			mockRepo.EXPECT().UpdateRedirect(gomock.Any(), uint64(2), req, gomock.Any()).
+				DoAndReturn(updateRedirectWith(existing, nil)).
+				Times(1)
+
+			assert.Equal(t, ErrRedirectLoop, ctrl.UpdateRedirect(ctx, 2, req))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			req := &md.Redirect{Source: "/x", Target: "/y", Code: 301}
+			mockRepo.EXPECT().UpdateRedirect(gomock.Any(), uint64(5), req, gomock.Any()).
+				DoAndReturn(updateRedirectWith(existing, repo.ErrNotFound)).
+				Times(1)
+
+			assert.Equal(t, ErrNotFound, ctrl.UpdateRedirect(ctx, 5, req))
+		},
+	)
+}
+
+// createRedirectWith runs the check against list as the repo would before
+// returning id and err.
+func createRedirectWith(list []*md.Redirect, id uint64, err error) func(context.Context, *md.Redirect, func([]*md.Redirect) error) (uint64, error) {
+	return func(_ context.Context, _ *md.Redirect, check func([]*md.Redirect) error) (uint64, error) {
+		if checkErr := check(list); checkErr != nil {
+			return 0, checkErr
+		}
+		return id, err
+	}
+}
+
+func updateRedirectWith(list []*md.Redirect, err error) func(context.Context, uint64, *md.Redirect, func([]*md.Redirect) error) error {
+	return func(_ context.Context, _ uint64, _ *md.Redirect, check func([]*md.Redirect) error) error {
+		if checkErr := check(list); checkErr != nil {
+			return checkErr
+		}
+		return err
+	}
+}
+
+func TestController_DeleteRedirect(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().DeleteRedirect(gomock.Any(), uint64(1)).Return(nil).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), redirectsKey).Return().Times(1)
+
+			assert.Nil(t, ctrl.DeleteRedirect(ctx, 1))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().DeleteRedirect(gomock.Any(), uint64(1)).Return(repo.ErrNotFound).Times(1)
+
+			assert.Equal(t, ErrNotFound, ctrl.DeleteRedirect(ctx, 1))
+		},
+	)
+}
+
+func TestController_ResolveRedirect(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	list := []*md.Redirect{
+		{ID: 1, Source: "/a", Target: "/b", Code: 302},
+		{ID: 2, Source: "^/blog/(\\d+)$", Target: "/news/$1", Code: 301, Regex: true},
+		{ID: 3, Source: "/b", Target: "/blog/7", Code: 301},
+		{ID: 4, Source: "/news/7", Target: "https://example.com/7", Code: 308},
+	}
+
+	t.Run(
+		"Cache miss, follows chain", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), redirectsKey, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListRedirects(gomock.Any()).Return(list, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), config.DefaultCacheTime, redirectsKey, gomock.Any()).Return().Times(1)
+
+			res, err := ctrl.ResolveRedirect(ctx, "/a")
+			assert.Nil(t, err)
+			assert.Equal(
+				t, &dto.ResolveRedirectResponse{
+					Source: "/a",
+					Target: "https://example.com/7",
+					Code:   302,
+					Hops:   4,
+					Chain:  []string{"/a", "/b", "/blog/7", "/news/7", "https://example.com/7"},
+				}, res,
+			)
+		},
+	)
+
+	t.Run(
+		"Cache hit, ErrNotFound", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), redirectsKey, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, dest *[]*md.Redirect) error {
+					*dest = list
+					return nil
+				},
+			).Times(1)
+
+			res, err := ctrl.ResolveRedirect(ctx, "/missing")
+			assert.Equal(t, ErrNotFound, err)
+			assert.Nil(t, res)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockCache.EXPECT().GetToStruct(gomock.Any(), redirectsKey, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListRedirects(gomock.Any()).Return(nil, newErr).Times(1)
+
+			res, err := ctrl.ResolveRedirect(ctx, "/a")
+			assert.Equal(t, newErr, err)
+			assert.Nil(t, res)
+		},
+	)
+}
+
+func TestController_ExportRedirects(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	list := []*md.Redirect{
+		{ID: 1, Source: "^/blog/(.*)$", Target: "/news/$1", Code: 301, Regex: true},
+		{ID: 2, Source: "/old.html", Target: "/new", Code: 302},
+	}
+
+	t.Run(
+		"Nginx", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), redirectsKey, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListRedirects(gomock.Any()).Return(list, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), config.DefaultCacheTime, redirectsKey, gomock.Any()).Return().Times(1)
+
+			res, err := ctrl.ExportRedirects(ctx, md.RedirectFormatNginx)
+			assert.Nil(t, err)
+			assert.Equal(
+				t, "map $uri $redirect_uri {\n"+
+					"    default \"\";\n"+
+					"    \"/old.html\" \"/new\";\n"+
+					"    \"~^/blog/(.*)$\" \"/news/$1\";\n"+
+					"}\n\n"+
+					"map $uri $redirect_code {\n"+
+					"    default 301;\n"+
+					"    \"/old.html\" 302;\n"+
+					"    \"~^/blog/(.*)$\" 301;\n"+
+					"}\n",
+				string(res),
+			)
+		},
+	)
+
+	t.Run(
+		"Apache", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), redirectsKey, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListRedirects(gomock.Any()).Return(list, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), config.DefaultCacheTime, redirectsKey, gomock.Any()).Return().Times(1)
+
+			res, err := ctrl.ExportRedirects(ctx, md.RedirectFormatApache)
+			assert.Nil(t, err)
+			assert.Equal(
+				t, "RewriteEngine On\n"+
+					"RewriteRule \"^/?old\\.html$\" \"/new\" [R=302,L]\n"+
+					"RewriteRule \"^/blog/(.*)$\" \"/news/$1\" [R=301,L]\n",
+				string(res),
+			)
+		},
+	)
+
+	t.Run(
+		"ErrUnsupportedFormat", func(t *testing.T) {
+			res, err := ctrl.ExportRedirects(ctx, "caddy")
+			assert.Equal(t, ErrUnsupportedFormat, err)
+			assert.Nil(t, res)
+		},
+	)
+}
@@ -0,0 +1,49 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// ResolvePath finds the page served at path and the SEO record stored for it
+// under md.PageOBJName, resolved for locale like GetSEO. A page without a
+// record is returned with a nil SEO.
+func (c *Controller) ResolvePath(ctx context.Context, path, locale string) (*dto.ResolvePathResponse, error) {
+	const op = "page.ResolvePath.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	page, err := c.repo.GetPageByHref(ctx, path)
+	if err == nil && !visible(ctx, page.Status) {
+		page, err = nil, repo.ErrNotFound
+	}
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.String("path", path),
+			zap.Error(err),
+		)
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("path", path),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	seo, err := c.GetSEO(ctx, md.PageOBJName, page.Slug, locale)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	return &dto.ResolvePathResponse{Page: page, SEO: seo}, nil
+}
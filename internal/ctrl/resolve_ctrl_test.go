@@ -0,0 +1,93 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_ResolvePath(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	path := "/catalog/shoes/"
+	page := &model.Page{Slug: "shoes", Href: "/catalog/shoes", Status: model.StatusPublished}
+	seo := &model.SEO{Title: "Shoes", OBJName: model.PageOBJName, OBJPK: "shoes", Status: model.StatusPublished}
+	key := fmt.Sprintf(SEOKey, model.PageOBJName, "shoes", "")
+
+	t.Run(
+		"Page with SEO", func(t *testing.T) {
+			mockRepo.EXPECT().GetPageByHref(gomock.Any(), path).Return(page, nil).Times(1)
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().GetSEO(gomock.Any(), model.PageOBJName, "shoes", "").Return(seo, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), key, gomock.Any()).Times(1)
+
+			res, err := ctrl.ResolvePath(ctx, path, "")
+			assert.Nil(t, err)
+			assert.Equal(t, page, res.Page)
+			assert.Equal(t, seo, res.SEO)
+		},
+	)
+
+	t.Run(
+		"Page without SEO", func(t *testing.T) {
+			mockRepo.EXPECT().GetPageByHref(gomock.Any(), path).Return(page, nil).Times(1)
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().GetSEO(gomock.Any(), model.PageOBJName, "shoes", "").Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.ResolvePath(ctx, path, "")
+			assert.Nil(t, err)
+			assert.Equal(t, page, res.Page)
+			assert.Nil(t, res.SEO)
+		},
+	)
+
+	t.Run(
+		"Draft page", func(t *testing.T) {
+			mockRepo.EXPECT().
+				GetPageByHref(gomock.Any(), path).
+				Return(&model.Page{Slug: "shoes", Status: model.StatusDraft}, nil).
+				Times(1)
+
+			res, err := ctrl.ResolvePath(ctx, path, "")
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().GetPageByHref(gomock.Any(), path).Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.ResolvePath(ctx, path, "")
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"SEO error", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockRepo.EXPECT().GetPageByHref(gomock.Any(), path).Return(page, nil).Times(1)
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().GetSEO(gomock.Any(), model.PageOBJName, "shoes", "").Return(nil, newErr).Times(1)
+
+			res, err := ctrl.ResolvePath(ctx, path, "")
+			assert.Nil(t, res)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
@@ -0,0 +1,180 @@
+package ctrl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"reflect"
+	"sort"
+)
+
+// diffIgnored are snapshot fields that change on every write and carry no content.
+var diffIgnored = map[string]struct{}{
+	"id":         {},
+	"created_at": {},
+	"updated_at": {},
+}
+
+func (c *Controller) ListSEORevisions(ctx context.Context, name, pk string) ([]*md.SEORevision, error) {
+	const op = "revision.ListSEORevisions.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.ListSEORevisions(ctx, name, pk)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetSEORevision(ctx context.Context, name, pk string, id uint64) (*md.SEORevision, error) {
+	const op = "revision.GetSEORevision.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.GetSEORevision(ctx, name, pk, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk), zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk), zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DiffSEORevisions lists the fields whose values differ between two snapshots.
+func (c *Controller) DiffSEORevisions(ctx context.Context, name, pk string, from, to uint64) ([]*dto.FieldDiff, error) {
+	const op = "revision.DiffSEORevisions.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	a, err := c.GetSEORevision(ctx, name, pk, from)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := c.GetSEORevision(ctx, name, pk, to)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := diffSEO(a.Data, b.Data)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("from", from), zap.Uint64("to", to),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) RollbackSEO(ctx context.Context, name, pk string, id uint64) (*md.SEO, error) {
+	const op = "revision.RollbackSEO.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.RollbackSEO(ctx, name, pk, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk), zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk), zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(seoPattern, name, pk))
+	c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
+	return res, nil
+}
+
+// diffSEO compares two snapshots by their JSON representation so that every
+// field of md.SEO, present and future, is covered.
+func diffSEO(from, to *md.SEO) ([]*dto.FieldDiff, error) {
+	a, err := toFieldMap(from)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := toFieldMap(to)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		fields = append(fields, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+
+	res := make([]*dto.FieldDiff, 0)
+	for _, f := range fields {
+		if _, ok := diffIgnored[f]; ok {
+			continue
+		}
+		if !reflect.DeepEqual(a[f], b[f]) {
+			res = append(res, &dto.FieldDiff{Field: f, From: a[f], To: b[f]})
+		}
+	}
+	return res, nil
+}
+
+func toFieldMap(seo *md.SEO) (map[string]any, error) {
+	res := make(map[string]any)
+	if seo == nil {
+		return res, nil
+	}
+
+	data, err := json.Marshal(seo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
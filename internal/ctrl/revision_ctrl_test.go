@@ -0,0 +1,181 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_ListSEORevisions(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	name, pk := "name", "pk"
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			expected := []*model.SEORevision{{ID: 1}}
+			mockRepo.EXPECT().ListSEORevisions(gomock.Any(), name, pk).Return(expected, nil).Times(1)
+
+			res, err := ctrl.ListSEORevisions(ctx, name, pk)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("err")
+			mockRepo.EXPECT().ListSEORevisions(gomock.Any(), name, pk).Return(nil, newErr).Times(1)
+
+			res, err := ctrl.ListSEORevisions(ctx, name, pk)
+			assert.Nil(t, res)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
+
+func TestController_GetSEORevision(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	name, pk, id := "name", "pk", uint64(1)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			expected := &model.SEORevision{ID: id}
+			mockRepo.EXPECT().GetSEORevision(gomock.Any(), name, pk, id).Return(expected, nil).Times(1)
+
+			res, err := ctrl.GetSEORevision(ctx, name, pk, id)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().GetSEORevision(gomock.Any(), name, pk, id).Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.GetSEORevision(ctx, name, pk, id)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+}
+
+func TestController_DiffSEORevisions(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	name, pk := "name", "pk"
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			from := &model.SEORevision{
+				ID: 1,
+				Data: &model.SEO{
+					Title: "old", Keywords: "k", OBJName: name, OBJPK: pk,
+					UpdatedAt: time.Now().Add(-time.Hour),
+				},
+			}
+			to := &model.SEORevision{
+				ID: 2,
+				Data: &model.SEO{
+					Title: "new", Keywords: "k", OBJName: name, OBJPK: pk,
+					ArticleTag: []string{"go"}, UpdatedAt: time.Now(),
+				},
+			}
+			mockRepo.EXPECT().GetSEORevision(gomock.Any(), name, pk, uint64(1)).Return(from, nil).Times(1)
+			mockRepo.EXPECT().GetSEORevision(gomock.Any(), name, pk, uint64(2)).Return(to, nil).Times(1)
+
+			res, err := ctrl.DiffSEORevisions(ctx, name, pk, 1, 2)
+			assert.Nil(t, err)
+			assert.Equal(
+				t, []*dto.FieldDiff{
+					{Field: "ArticleTag", From: nil, To: []any{"go"}},
+					{Field: "title", From: "old", To: "new"},
+				}, res,
+			)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().GetSEORevision(gomock.Any(), name, pk, uint64(1)).Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.DiffSEORevisions(ctx, name, pk, 1, 2)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+}
+
+func TestController_RollbackSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	name, pk, id := "name", "pk", uint64(1)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			expected := &model.SEO{Title: "old", OBJName: name, OBJPK: pk}
+			mockRepo.EXPECT().RollbackSEO(gomock.Any(), name, pk, id).Return(expected, nil).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(seoPattern, name, pk)).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), sitemapPattern).Times(1)
+
+			res, err := ctrl.RollbackSEO(ctx, name, pk, id)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().RollbackSEO(gomock.Any(), name, pk, id).Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.RollbackSEO(ctx, name, pk, id)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("err")
+			mockRepo.EXPECT().RollbackSEO(gomock.Any(), name, pk, id).Return(nil, newErr).Times(1)
+
+			res, err := ctrl.RollbackSEO(ctx, name, pk, id)
+			assert.Nil(t, res)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
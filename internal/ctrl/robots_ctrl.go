@@ -0,0 +1,422 @@
+package ctrl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"strconv"
+	"strings"
+)
+
+const robotsKey = "robots"
+const robotsWildcard = "*"
+
+func (c *Controller) GetRobotsTxt(ctx context.Context) (string, error) {
+	const op = "robots.GetRobotsTxt.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var cached string
+	if err := c.cache.GetToStruct(ctx, robotsKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	groups, err := c.repo.ListRobotsGroups(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return "", err
+	}
+
+	sitemaps, err := c.repo.ListRobotsSitemaps(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return "", err
+	}
+
+	res := renderRobots(groups, sitemaps)
+	if bytes, err := json.Marshal(res); err == nil {
+		c.cache.Set(ctx, config.DefaultCacheTime, robotsKey, bytes)
+	}
+	return res, nil
+}
+
+func (c *Controller) TestRobots(ctx context.Context, agent, path string) (*dto.RobotsTestResponse, error) {
+	const op = "robots.TestRobots.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	groups, err := c.repo.ListRobotsGroups(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("agent", agent), zap.String("path", path),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	group := matchRobotsGroup(groups, agent)
+	if group == nil {
+		return &dto.RobotsTestResponse{Allowed: true}, nil
+	}
+
+	allowed, rule := evaluateRobots(group, path)
+	return &dto.RobotsTestResponse{
+		Allowed:   allowed,
+		UserAgent: group.UserAgent,
+		Rule:      rule,
+	}, nil
+}
+
+func (c *Controller) ListRobotsGroups(ctx context.Context) ([]*md.RobotsGroup, error) {
+	const op = "robots.ListRobotsGroups.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.ListRobotsGroups(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetRobotsGroup(ctx context.Context, id uint64) (*md.RobotsGroup, error) {
+	const op = "robots.GetRobotsGroup.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.GetRobotsGroup(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreateRobotsGroup(ctx context.Context, req *md.RobotsGroup) (*dto.CreateRobotsGroupResponse, error) {
+	const op = "robots.CreateRobotsGroup.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	id, err := c.repo.CreateRobotsGroup(ctx, req)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug(
+			ErrAlreadyExists.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		return nil, ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	c.cache.Delete(ctx, robotsKey)
+	return &dto.CreateRobotsGroupResponse{
+		ID: id,
+	}, nil
+}
+
+func (c *Controller) UpdateRobotsGroup(ctx context.Context, id uint64, req *md.RobotsGroup) error {
+	const op = "robots.UpdateRobotsGroup.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.UpdateRobotsGroup(ctx, id, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id), zap.Any("req", req),
+			zap.Error(err),
+		)
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug(
+			ErrAlreadyExists.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id), zap.Any("req", req),
+			zap.Error(err),
+		)
+		return ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id), zap.Any("req", req),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	c.cache.Delete(ctx, robotsKey)
+	return nil
+}
+
+func (c *Controller) DeleteRobotsGroup(ctx context.Context, id uint64) error {
+	const op = "robots.DeleteRobotsGroup.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.DeleteRobotsGroup(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	c.cache.Delete(ctx, robotsKey)
+	return nil
+}
+
+func (c *Controller) ListRobotsSitemaps(ctx context.Context) ([]*md.RobotsSitemap, error) {
+	const op = "robots.ListRobotsSitemaps.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.ListRobotsSitemaps(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreateRobotsSitemap(ctx context.Context, url string) (*dto.CreateRobotsSitemapResponse, error) {
+	const op = "robots.CreateRobotsSitemap.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	id, err := c.repo.CreateRobotsSitemap(ctx, url)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug(
+			ErrAlreadyExists.Error(),
+			zap.String("op", op),
+			zap.String("url", url),
+			zap.Error(err),
+		)
+		return nil, ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("url", url),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	c.cache.Delete(ctx, robotsKey)
+	return &dto.CreateRobotsSitemapResponse{
+		ID: id,
+	}, nil
+}
+
+func (c *Controller) DeleteRobotsSitemap(ctx context.Context, id uint64) error {
+	const op = "robots.DeleteRobotsSitemap.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.DeleteRobotsSitemap(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	c.cache.Delete(ctx, robotsKey)
+	return nil
+}
+
+func renderRobots(groups []*md.RobotsGroup, sitemaps []*md.RobotsSitemap) string {
+	sb := &strings.Builder{}
+	for i, g := range groups {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		sb.WriteString("User-agent: " + g.UserAgent + "\n")
+		for _, r := range g.Rules {
+			switch r.Type {
+			case md.RobotsAllow:
+				sb.WriteString("Allow: " + r.Path + "\n")
+			case md.RobotsDisallow:
+				sb.WriteString("Disallow: " + r.Path + "\n")
+			}
+		}
+
+		if g.CrawlDelay > 0 {
+			sb.WriteString("Crawl-delay: " + strconv.FormatFloat(g.CrawlDelay, 'f', -1, 64) + "\n")
+		}
+	}
+
+	if len(sitemaps) > 0 && len(groups) > 0 {
+		sb.WriteString("\n")
+	}
+
+	for _, s := range sitemaps {
+		sb.WriteString("Sitemap: " + s.URL + "\n")
+	}
+	return sb.String()
+}
+
+// matchRobotsGroup picks the group whose user agent equals a product token of
+// agent, case-insensitively as RFC 9309 asks, falling back to the "*" group.
+// agent may be a bare token or a full User-Agent header; its tokens are tried
+// in order.
+func matchRobotsGroup(groups []*md.RobotsGroup, agent string) *md.RobotsGroup {
+	var wildcard *md.RobotsGroup
+	for _, g := range groups {
+		if strings.TrimSpace(g.UserAgent) == robotsWildcard {
+			wildcard = g
+			break
+		}
+	}
+
+	for _, token := range robotsProductTokens(agent) {
+		for _, g := range groups {
+			if strings.EqualFold(strings.TrimSpace(g.UserAgent), token) {
+				return g
+			}
+		}
+	}
+	return wildcard
+}
+
+// robotsProductTokens splits agent into the runs of characters RFC 9309
+// allows in a product token: letters, "_" and "-".
+func robotsProductTokens(agent string) []string {
+	return strings.FieldsFunc(
+		agent, func(r rune) bool {
+			return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '_' || r == '-')
+		},
+	)
+}
+
+// evaluateRobots applies RFC 9309 precedence: the matching rule with the longest
+// path wins and allow beats disallow on ties. Unmatched paths are allowed.
+func evaluateRobots(group *md.RobotsGroup, path string) (bool, *md.RobotsRule) {
+	if path == "/robots.txt" {
+		return true, nil
+	}
+
+	var res *md.RobotsRule
+	for i := range group.Rules {
+		r := &group.Rules[i]
+		if r.Path == "" || !robotsPathMatch(r.Path, path) {
+			continue
+		}
+
+		if res == nil || len(r.Path) > len(res.Path) ||
+			(len(r.Path) == len(res.Path) && r.Type == md.RobotsAllow) {
+			res = r
+		}
+	}
+
+	if res == nil {
+		return true, nil
+	}
+	return res.Type == md.RobotsAllow, res
+}
+
+// robotsPathMatch matches path against a robots.txt pattern supporting the
+// "*" wildcard and the "$" end anchor. The literal parts between wildcards are
+// matched left to right at their first occurrence, which finds a match
+// whenever one exists.
+func robotsPathMatch(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	parts := strings.Split(pattern, robotsWildcard)
+	rest, ok := strings.CutPrefix(path, parts[0])
+	if !ok {
+		return false
+	}
+	if len(parts) == 1 {
+		return !anchored || rest == ""
+	}
+
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(rest, part)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+len(part):]
+	}
+
+	last := parts[len(parts)-1]
+	if anchored {
+		return strings.HasSuffix(rest, last)
+	}
+	return strings.Contains(rest, last)
+}
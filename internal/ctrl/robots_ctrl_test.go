@@ -0,0 +1,314 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_GetRobotsTxt(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	groups := []*md.RobotsGroup{
+		{
+			UserAgent:  "*",
+			Rules:      []md.RobotsRule{{Type: md.RobotsDisallow, Path: "/admin"}, {Type: md.RobotsAllow, Path: "/admin/public"}},
+			CrawlDelay: 1.5,
+		},
+	}
+	sitemaps := []*md.RobotsSitemap{{URL: "https://example.com/sitemap.xml"}}
+	expected := "User-agent: *\nDisallow: /admin\nAllow: /admin/public\nCrawl-delay: 1.5\n\nSitemap: https://example.com/sitemap.xml\n"
+
+	t.Run(
+		"Cache hit", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), robotsKey, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, dest *string) error {
+					*dest = expected
+					return nil
+				},
+			).Times(1)
+
+			res, err := ctrl.GetRobotsTxt(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"Cache miss", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), robotsKey, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListRobotsGroups(gomock.Any()).Return(groups, nil).Times(1)
+			mockRepo.EXPECT().ListRobotsSitemaps(gomock.Any()).Return(sitemaps, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), config.DefaultCacheTime, robotsKey, gomock.Any()).Return().Times(1)
+
+			res, err := ctrl.GetRobotsTxt(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockCache.EXPECT().GetToStruct(gomock.Any(), robotsKey, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().ListRobotsGroups(gomock.Any()).Return(nil, newErr).Times(1)
+
+			res, err := ctrl.GetRobotsTxt(ctx)
+			assert.Equal(t, newErr, err)
+			assert.Empty(t, res)
+		},
+	)
+}
+
+func TestController_TestRobots(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	groups := []*md.RobotsGroup{
+		{UserAgent: "*", Rules: []md.RobotsRule{{Type: md.RobotsDisallow, Path: "/"}}},
+		{UserAgent: "Googlebot", Rules: []md.RobotsRule{{Type: md.RobotsDisallow, Path: "/private"}}},
+	}
+
+	t.Run(
+		"Specific agent", func(t *testing.T) {
+			mockRepo.EXPECT().ListRobotsGroups(gomock.Any()).Return(groups, nil).Times(1)
+
+			res, err := ctrl.TestRobots(ctx, "Mozilla/5.0 (compatible; Googlebot/2.1)", "/private/page")
+			assert.Nil(t, err)
+			assert.Equal(
+				t, &dto.RobotsTestResponse{
+					Allowed:   false,
+					UserAgent: "Googlebot",
+					Rule:      &groups[1].Rules[0],
+				}, res,
+			)
+		},
+	)
+
+	t.Run(
+		"Wildcard fallback", func(t *testing.T) {
+			mockRepo.EXPECT().ListRobotsGroups(gomock.Any()).Return(groups, nil).Times(1)
+
+			res, err := ctrl.TestRobots(ctx, "Bingbot", "/page")
+			assert.Nil(t, err)
+			assert.False(t, res.Allowed)
+			assert.Equal(t, "*", res.UserAgent)
+		},
+	)
+
+	t.Run(
+		"No groups", func(t *testing.T) {
+			mockRepo.EXPECT().ListRobotsGroups(gomock.Any()).Return(nil, nil).Times(1)
+
+			res, err := ctrl.TestRobots(ctx, "Bingbot", "/page")
+			assert.Nil(t, err)
+			assert.Equal(t, &dto.RobotsTestResponse{Allowed: true}, res)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockRepo.EXPECT().ListRobotsGroups(gomock.Any()).Return(nil, newErr).Times(1)
+
+			res, err := ctrl.TestRobots(ctx, "Bingbot", "/page")
+			assert.Equal(t, newErr, err)
+			assert.Nil(t, res)
+		},
+	)
+}
+
+func TestController_CreateRobotsGroup(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	req := &md.RobotsGroup{UserAgent: "*"}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().CreateRobotsGroup(gomock.Any(), req).Return(uint64(1), nil).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), robotsKey).Return().Times(1)
+
+			res, err := ctrl.CreateRobotsGroup(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, &dto.CreateRobotsGroupResponse{ID: 1}, res)
+		},
+	)
+
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			mockRepo.EXPECT().CreateRobotsGroup(gomock.Any(), req).Return(uint64(0), repo.ErrAlreadyExists).Times(1)
+
+			res, err := ctrl.CreateRobotsGroup(ctx, req)
+			assert.Equal(t, ErrAlreadyExists, err)
+			assert.Nil(t, res)
+		},
+	)
+}
+
+func TestController_UpdateRobotsGroup(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	id, req := uint64(1), &md.RobotsGroup{UserAgent: "*"}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().UpdateRobotsGroup(gomock.Any(), id, req).Return(nil).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), robotsKey).Return().Times(1)
+
+			assert.Nil(t, ctrl.UpdateRobotsGroup(ctx, id, req))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().UpdateRobotsGroup(gomock.Any(), id, req).Return(repo.ErrNotFound).Times(1)
+
+			assert.Equal(t, ErrNotFound, ctrl.UpdateRobotsGroup(ctx, id, req))
+		},
+	)
+
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			mockRepo.EXPECT().UpdateRobotsGroup(gomock.Any(), id, req).Return(repo.ErrAlreadyExists).Times(1)
+
+			assert.Equal(t, ErrAlreadyExists, ctrl.UpdateRobotsGroup(ctx, id, req))
+		},
+	)
+}
+
+func TestController_DeleteRobotsSitemap(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().DeleteRobotsSitemap(gomock.Any(), uint64(1)).Return(nil).Times(1)
+			mockCache.EXPECT().Delete(gomock.Any(), robotsKey).Return().Times(1)
+
+			assert.Nil(t, ctrl.DeleteRobotsSitemap(ctx, 1))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().DeleteRobotsSitemap(gomock.Any(), uint64(1)).Return(repo.ErrNotFound).Times(1)
+
+			assert.Equal(t, ErrNotFound, ctrl.DeleteRobotsSitemap(ctx, 1))
+		},
+	)
+}
+
+func TestEvaluateRobots(t *testing.T) {
+	group := &md.RobotsGroup{
+		UserAgent: "*",
+		Rules: []md.RobotsRule{
+			{Type: md.RobotsDisallow, Path: "/admin"},
+			{Type: md.RobotsAllow, Path: "/admin/public"},
+			{Type: md.RobotsDisallow, Path: "/*.pdf$"},
+			{Type: md.RobotsDisallow, Path: "/page"},
+			{Type: md.RobotsAllow, Path: "/page"},
+		},
+	}
+
+	tests := []struct {
+		path    string
+		allowed bool
+	}{
+		{path: "/", allowed: true},
+		{path: "/admin", allowed: false},
+		{path: "/admin/settings", allowed: false},
+		{path: "/admin/public/index", allowed: true},
+		{path: "/files/doc.pdf", allowed: false},
+		{path: "/files/doc.pdf?x=1", allowed: true},
+		{path: "/page", allowed: true},
+		{path: "/robots.txt", allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.path, func(t *testing.T) {
+				allowed, _ := evaluateRobots(group, tt.path)
+				assert.Equal(t, tt.allowed, allowed)
+			},
+		)
+	}
+}
+
+func TestMatchRobotsGroup(t *testing.T) {
+	wildcard := &md.RobotsGroup{UserAgent: "*"}
+	google := &md.RobotsGroup{UserAgent: "Googlebot"}
+	images := &md.RobotsGroup{UserAgent: "Googlebot-Image"}
+	groups := []*md.RobotsGroup{wildcard, google, images}
+
+	assert.Equal(t, google, matchRobotsGroup(groups, "googlebot/2.1"))
+	assert.Equal(t, google, matchRobotsGroup(groups, "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"))
+	assert.Equal(t, images, matchRobotsGroup(groups, "Googlebot-Image/1.0"))
+	assert.Equal(t, wildcard, matchRobotsGroup(groups, "Bingbot"))
+	assert.Equal(t, wildcard, matchRobotsGroup(groups, "NotGooglebot/1.0"))
+	assert.Equal(t, wildcard, matchRobotsGroup(groups, "Googlebot-News"))
+	assert.Nil(t, matchRobotsGroup([]*md.RobotsGroup{google}, "Bingbot"))
+}
+
+func TestRobotsPathMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{pattern: "/", path: "/anything", match: true},
+		{pattern: "/fish", path: "/fish.html", match: true},
+		{pattern: "/fish", path: "/Fish", match: false},
+		{pattern: "/fish$", path: "/fish", match: true},
+		{pattern: "/fish$", path: "/fish/", match: false},
+		{pattern: "/*.php", path: "/index.php?x=1", match: true},
+		{pattern: "/*.php$", path: "/index.php?x=1", match: false},
+		{pattern: "/*.php$", path: "/a.php/b.php", match: true},
+		{pattern: "/a*b*c", path: "/a-c-b-c", match: true},
+		{pattern: "/a*b*c$", path: "/a-bc-c", match: true},
+		{pattern: "/a*b*c", path: "/a-c", match: false},
+		{pattern: "/*", path: "/", match: true},
+		{pattern: "/a.b", path: "/axb", match: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, robotsPathMatch(tt.pattern, tt.path), "%s %s", tt.pattern, tt.path)
+	}
+}
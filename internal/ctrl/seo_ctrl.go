@@ -2,7 +2,6 @@ package ctrl
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/JMURv/seo/internal/config"
@@ -11,27 +10,101 @@ import (
 	"github.com/JMURv/seo/internal/repo"
 	ot "github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
+	"strings"
 )
 
-const SEOKey = "SEO:%v:%v"
+const SEOKey = "SEO:%v:%v:%v"
+const seoPattern = "SEO:%v:%v:*"
+const xDefault = "x-default"
+const defaultLocalePath = "/{locale}{path}"
 
-func (c *Controller) GetSEO(ctx context.Context, name, pk string) (*md.SEO, error) {
+func (c *Controller) ListSEO(ctx context.Context, f *md.SEOFilter) (*dto.PaginatedSEO, error) {
+	const op = "seo.ListSEO.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if f.Page <= 0 {
+		f.Page = config.DefaultPage
+	}
+	if f.Size <= 0 {
+		f.Size = config.DefaultSize
+	}
+	if f.Locale != "" {
+		f.Locale = md.NormalizeLocale(f.Locale)
+	}
+	if !IsPreview(ctx) {
+		f.Status = md.StatusPublished
+	}
+
+	res, err := c.repo.ListSEO(ctx, f)
+	if err != nil && errors.Is(err, md.ErrInvalidCursor) {
+		zap.L().Debug(
+			ErrInvalidCursor.Error(),
+			zap.String("op", op),
+			zap.String("cursor", f.Cursor),
+			zap.Error(err),
+		)
+		return nil, ErrInvalidCursor
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Any("filter", f),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	totalPages := int((res.Total + int64(f.Size) - 1) / int64(f.Size))
+	return &dto.PaginatedSEO{
+		Data:        res.SEO,
+		Count:       res.Total,
+		TotalPages:  totalPages,
+		CurrentPage: f.Page,
+		HasNextPage: res.NextCursor != "" || (f.Cursor == "" && f.Page < totalPages),
+		NextCursor:  res.NextCursor,
+	}, nil
+}
+
+// GetSEO returns the record for locale, falling back to less specific locales,
+// the configured default locale and finally the locale-less record.
+func (c *Controller) GetSEO(ctx context.Context, name, pk, locale string) (*md.SEO, error) {
 	const op = "seo.GetSEO.ctrl"
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	cached := &md.SEO{}
-	key := fmt.Sprintf(SEOKey, name, pk)
-	if err := c.cache.GetToStruct(ctx, key, cached); err == nil {
-		return cached, nil
+	locale = md.NormalizeLocale(locale)
+	if IsPreview(ctx) {
+		return c.loadSEO(ctx, name, pk, locale)
+	}
+
+	return cachedLoad(
+		ctx, c, "seo", fmt.Sprintf(SEOKey, name, pk, locale), func(ctx context.Context) (*md.SEO, error) {
+			return c.loadSEO(ctx, name, pk, locale)
+		},
+	)
+}
+
+func (c *Controller) loadSEO(ctx context.Context, name, pk, locale string) (*md.SEO, error) {
+	const op = "seo.loadSEO.ctrl"
+
+	var res *md.SEO
+	var err error
+	for _, l := range c.localeChain(locale) {
+		res, err = c.repo.GetSEO(ctx, name, pk, l)
+		if err == nil && !visible(ctx, res.Status) {
+			res, err = nil, repo.ErrNotFound
+		}
+		if err == nil || !errors.Is(err, repo.ErrNotFound) {
+			break
+		}
 	}
 
-	res, err := c.repo.GetSEO(ctx, name, pk)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		zap.L().Debug(
 			ErrNotFound.Error(),
 			zap.String("op", op),
-			zap.String("name", name), zap.String("pk", pk),
+			zap.String("name", name), zap.String("pk", pk), zap.String("locale", locale),
 			zap.Error(err),
 		)
 		return nil, ErrNotFound
@@ -39,15 +112,11 @@ func (c *Controller) GetSEO(ctx context.Context, name, pk string) (*md.SEO, erro
 		zap.L().Debug(
 			ErrInternal.Error(),
 			zap.String("op", op),
-			zap.String("name", name), zap.String("pk", pk),
+			zap.String("name", name), zap.String("pk", pk), zap.String("locale", locale),
 			zap.Error(err),
 		)
 		return nil, err
 	}
-
-	if bytes, err := json.Marshal(res); err == nil {
-		c.cache.Set(ctx, config.DefaultCacheTime, key, bytes)
-	}
 	return res, nil
 }
 
@@ -56,6 +125,8 @@ func (c *Controller) CreateSEO(ctx context.Context, req *md.SEO) (*dto.CreateSEO
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
+	req.Locale = md.NormalizeLocale(req.Locale)
+	req.Status = defaultStatus(req.Status)
 	name, pk, err := c.repo.CreateSEO(ctx, req)
 	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
 		zap.L().Debug(
@@ -75,9 +146,12 @@ func (c *Controller) CreateSEO(ctx context.Context, req *md.SEO) (*dto.CreateSEO
 		return nil, err
 	}
 
+	c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(seoPattern, name, pk))
+	c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
 	return &dto.CreateSEOResponse{
-		Name: name,
-		PK:   pk,
+		Name:   name,
+		PK:     pk,
+		Locale: req.Locale,
 	}, nil
 }
 
@@ -86,6 +160,8 @@ func (c *Controller) UpdateSEO(ctx context.Context, req *md.SEO) error {
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
+	req.Locale = md.NormalizeLocale(req.Locale)
+	req.Status = defaultStatus(req.Status)
 	err := c.repo.UpdateSEO(ctx, req)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		zap.L().Debug(
@@ -105,21 +181,22 @@ func (c *Controller) UpdateSEO(ctx context.Context, req *md.SEO) error {
 		return err
 	}
 
-	c.cache.Delete(ctx, fmt.Sprintf(SEOKey, req.OBJName, req.OBJPK))
+	c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(seoPattern, req.OBJName, req.OBJPK))
+	c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
 	return nil
 }
 
-func (c *Controller) DeleteSEO(ctx context.Context, name, pk string) error {
+func (c *Controller) DeleteSEO(ctx context.Context, name, pk, locale string) error {
 	const op = "seo.DeleteSEO.ctrl"
 	span, ctx := ot.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	key := fmt.Sprintf(SEOKey, name, pk)
-	if err := c.repo.DeleteSEO(ctx, name, pk); err != nil && errors.Is(err, repo.ErrNotFound) {
+	locale = md.NormalizeLocale(locale)
+	if err := c.repo.DeleteSEO(ctx, name, pk, locale); err != nil && errors.Is(err, repo.ErrNotFound) {
 		zap.L().Debug(
 			ErrNotFound.Error(),
 			zap.String("op", op),
-			zap.String("name", name), zap.String("pk", pk),
+			zap.String("name", name), zap.String("pk", pk), zap.String("locale", locale),
 			zap.Error(err),
 		)
 		return ErrNotFound
@@ -127,12 +204,111 @@ func (c *Controller) DeleteSEO(ctx context.Context, name, pk string) error {
 		zap.L().Debug(
 			ErrInternal.Error(),
 			zap.String("op", op),
-			zap.String("name", name), zap.String("pk", pk),
+			zap.String("name", name), zap.String("pk", pk), zap.String("locale", locale),
 			zap.Error(err),
 		)
 		return err
 	}
 
-	c.cache.Delete(ctx, key)
+	c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(seoPattern, name, pk))
+	c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
 	return nil
 }
+
+// GetSEOAlternates lists hreflang alternates for every stored locale of the object
+// plus x-default pointing at the unprefixed object URL.
+func (c *Controller) GetSEOAlternates(ctx context.Context, name, pk string) ([]*dto.SEOAlternate, error) {
+	const op = "seo.GetSEOAlternates.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	locales, err := c.repo.ListSEOLocales(ctx, name, pk)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if len(locales) == 0 {
+		return nil, ErrNotFound
+	}
+
+	path, ok := c.objectPath(name, pk)
+	if !ok {
+		zap.L().Debug(
+			ErrUnknownObject.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk),
+		)
+		return nil, ErrUnknownObject
+	}
+
+	def := c.defaultLocale()
+	seen := make(map[string]struct{}, len(locales))
+	for _, l := range locales {
+		seen[l.Locale] = struct{}{}
+	}
+
+	res := make([]*dto.SEOAlternate, 0, len(locales)+1)
+	for _, l := range locales {
+		if l.Locale != "" {
+			res = append(res, &dto.SEOAlternate{Hreflang: l.Locale, Href: c.absURL(c.localizedPath(l.Locale, path))})
+			continue
+		}
+
+		// The locale-less record is served on the plain URL in the default locale.
+		if _, ok := seen[def]; def != "" && !ok {
+			res = append(res, &dto.SEOAlternate{Hreflang: def, Href: c.absURL(path)})
+		}
+	}
+
+	return append(res, &dto.SEOAlternate{Hreflang: xDefault, Href: c.absURL(path)}), nil
+}
+
+// localeChain lists lookup candidates from the most specific tag down to the
+// locale-less record, e.g. "ru-RU" -> "ru-RU", "ru", default, "".
+func (c *Controller) localeChain(locale string) []string {
+	res := make([]string, 0, 4)
+	add := func(l string) {
+		for _, v := range res {
+			if v == l {
+				return
+			}
+		}
+		res = append(res, l)
+	}
+
+	for l := locale; l != ""; {
+		add(l)
+		idx := strings.LastIndex(l, "-")
+		if idx < 0 {
+			break
+		}
+		l = l[:idx]
+	}
+
+	if def := c.defaultLocale(); def != "" {
+		add(def)
+	}
+	add("")
+	return res
+}
+
+func (c *Controller) defaultLocale() string {
+	if c.conf == nil || c.conf.Locales == nil {
+		return ""
+	}
+	return md.NormalizeLocale(c.conf.Locales.Default)
+}
+
+func (c *Controller) localizedPath(locale, path string) string {
+	pattern := defaultLocalePath
+	if c.conf != nil && c.conf.Locales != nil && c.conf.Locales.Path != "" {
+		pattern = c.conf.Locales.Path
+	}
+	return strings.NewReplacer("{locale}", locale, "{path}", path).Replace(pattern)
+}
@@ -12,8 +12,76 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"testing"
+	"time"
 )
 
+func TestController_ListSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	expected := []*model.SEO{{OBJName: "product", OBJPK: "1", Status: model.StatusPublished}}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().
+				ListSEO(
+					gomock.Any(), &model.SEOFilter{
+						Page: 1, Size: config.DefaultSize, OBJName: "product", Locale: "ru-RU",
+						Status: model.StatusPublished,
+					},
+				).
+				Return(&model.SEOList{SEO: expected, Total: 41, NextCursor: "next"}, nil).
+				Times(1)
+
+			res, err := ctrl.ListSEO(ctx, &model.SEOFilter{OBJName: "product", Locale: "ru_ru"})
+			assert.Nil(t, err)
+			assert.Equal(
+				t, &dto.PaginatedSEO{
+					Data: expected, Count: 41, TotalPages: 2, CurrentPage: 1, HasNextPage: true, NextCursor: "next",
+				}, res,
+			)
+		},
+	)
+
+	t.Run(
+		"Preview keeps status filter", func(t *testing.T) {
+			mockRepo.EXPECT().
+				ListSEO(gomock.Any(), gomock.Cond(func(x any) bool { return x.(*model.SEOFilter).Status == "" })).
+				Return(&model.SEOList{}, nil).
+				Times(1)
+
+			_, err := ctrl.ListSEO(WithPreview(ctx), &model.SEOFilter{})
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"ErrInvalidCursor", func(t *testing.T) {
+			mockRepo.EXPECT().ListSEO(gomock.Any(), gomock.Any()).Return(nil, model.ErrInvalidCursor).Times(1)
+
+			res, err := ctrl.ListSEO(ctx, &model.SEOFilter{Cursor: "c"})
+			assert.Nil(t, res)
+			assert.Equal(t, ErrInvalidCursor, err)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("err")
+			mockRepo.EXPECT().ListSEO(gomock.Any(), gomock.Any()).Return(nil, newErr).Times(1)
+
+			res, err := ctrl.ListSEO(ctx, &model.SEOFilter{})
+			assert.Nil(t, res)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
+
 func TestController_GetSEO(t *testing.T) {
 	ctrlMock := gomock.NewController(t)
 	defer ctrlMock.Finish()
@@ -22,22 +90,22 @@ func TestController_GetSEO(t *testing.T) {
 	mockCache := mocks.NewMockCacheService(ctrlMock)
 
 	ctx := context.Background()
-	ctrl := New(mockRepo, mockCache)
+	ctrl := New(mockRepo, mockCache, &config.Config{})
 
 	name, pk := "name", "pk"
-	key := fmt.Sprintf(SEOKey, name, pk)
-	expected := &model.SEO{}
+	key := fmt.Sprintf(SEOKey, name, pk, "")
+	expected := &model.SEO{Status: model.StatusPublished}
 
 	t.Run(
 		"Cache hit", func(t *testing.T) {
 			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
-				func(_ context.Context, _ string, dest *model.SEO) error {
-					*dest = *expected
+				func(_ context.Context, _ string, dest *cacheEntry[model.SEO]) error {
+					dest.Value, dest.StaleAt = expected, time.Now().Add(time.Hour)
 					return nil
 				},
 			).Times(1)
 
-			user, err := ctrl.GetSEO(ctx, name, pk)
+			user, err := ctrl.GetSEO(ctx, name, pk, "")
 			assert.Nil(t, err)
 			assert.Equal(t, expected, user)
 		},
@@ -50,15 +118,15 @@ func TestController_GetSEO(t *testing.T) {
 				Return(errors.New("cache miss")).
 				Times(1)
 			mockRepo.EXPECT().
-				GetSEO(gomock.Any(), name, pk).
+				GetSEO(gomock.Any(), name, pk, "").
 				Return(expected, nil).
 				Times(1)
 			mockCache.EXPECT().
-				Set(gomock.Any(), config.DefaultCacheTime, key, gomock.Any()).
+				Set(gomock.Any(), gomock.Any(), key, gomock.Any()).
 				Return().
 				Times(1)
 
-			res, err := ctrl.GetSEO(ctx, name, pk)
+			res, err := ctrl.GetSEO(ctx, name, pk, "")
 			assert.Nil(t, err)
 			assert.Equal(t, expected, res)
 		},
@@ -71,11 +139,11 @@ func TestController_GetSEO(t *testing.T) {
 				Return(errors.New("cache miss")).
 				Times(1)
 			mockRepo.EXPECT().
-				GetSEO(gomock.Any(), name, pk).
+				GetSEO(gomock.Any(), name, pk, "").
 				Return(nil, repo.ErrNotFound).
 				Times(1)
 
-			res, err := ctrl.GetSEO(ctx, name, pk)
+			res, err := ctrl.GetSEO(ctx, name, pk, "")
 			assert.Nil(t, res)
 			assert.Equal(t, ErrNotFound, err)
 		},
@@ -88,11 +156,11 @@ func TestController_GetSEO(t *testing.T) {
 				Return(errors.New("cache miss")).
 				Times(1)
 			mockRepo.EXPECT().
-				GetSEO(gomock.Any(), name, pk).
+				GetSEO(gomock.Any(), name, pk, "").
 				Return(nil, errors.New("some repo error")).
 				Times(1)
 
-			res, err := ctrl.GetSEO(ctx, name, pk)
+			res, err := ctrl.GetSEO(ctx, name, pk, "")
 			assert.Nil(t, res)
 			assert.NotNil(t, err)
 		},
@@ -105,15 +173,15 @@ func TestController_GetSEO(t *testing.T) {
 				Return(errors.New("cache miss")).
 				Times(1)
 			mockRepo.EXPECT().
-				GetSEO(gomock.Any(), name, pk).
+				GetSEO(gomock.Any(), name, pk, "").
 				Return(expected, nil).
 				Times(1)
 			mockCache.EXPECT().
-				Set(gomock.Any(), config.DefaultCacheTime, key, gomock.Any()).
+				Set(gomock.Any(), gomock.Any(), key, gomock.Any()).
 				Return().
 				Times(1)
 
-			res, err := ctrl.GetSEO(ctx, name, pk)
+			res, err := ctrl.GetSEO(ctx, name, pk, "")
 			assert.Nil(t, err)
 			assert.Equal(t, expected, res)
 		},
@@ -128,7 +196,7 @@ func TestController_CreateSEO(t *testing.T) {
 	mockCache := mocks.NewMockCacheService(ctrlMock)
 
 	ctx := context.Background()
-	ctrl := New(mockRepo, mockCache)
+	ctrl := New(mockRepo, mockCache, &config.Config{})
 	name, pk := "name", "pk"
 
 	req := &model.SEO{
@@ -148,6 +216,14 @@ func TestController_CreateSEO(t *testing.T) {
 				CreateSEO(gomock.Any(), req).
 				Return(name, pk, nil).
 				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(seoPattern, name, pk)).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), sitemapPattern).
+				Return().
+				Times(1)
 
 			res, err := ctrl.CreateSEO(ctx, req)
 			assert.Nil(t, err)
@@ -196,7 +272,7 @@ func TestController_UpdateSEO(t *testing.T) {
 	mockCache := mocks.NewMockCacheService(ctrlMock)
 
 	ctx := context.Background()
-	ctrl := New(mockRepo, mockCache)
+	ctrl := New(mockRepo, mockCache, &config.Config{})
 
 	name, pk := "name", "pk"
 	req := &model.SEO{
@@ -217,7 +293,11 @@ func TestController_UpdateSEO(t *testing.T) {
 				Return(nil).
 				Times(1)
 			mockCache.EXPECT().
-				Delete(gomock.Any(), fmt.Sprintf(SEOKey, name, pk)).
+				InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(seoPattern, name, pk)).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), sitemapPattern).
 				Return().
 				Times(1)
 
@@ -260,21 +340,25 @@ func TestController_DeleteSEO(t *testing.T) {
 	mockCache := mocks.NewMockCacheService(ctrlMock)
 
 	ctx := context.Background()
-	ctrl := New(mockRepo, mockCache)
+	ctrl := New(mockRepo, mockCache, &config.Config{})
 
 	name, pk := "name", "pk"
 	t.Run(
 		"Success", func(t *testing.T) {
 			mockRepo.EXPECT().
-				DeleteSEO(gomock.Any(), name, pk).
+				DeleteSEO(gomock.Any(), name, pk, "").
 				Return(nil).
 				Times(1)
 			mockCache.EXPECT().
-				Delete(gomock.Any(), fmt.Sprintf(SEOKey, name, pk)).
+				InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(seoPattern, name, pk)).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				InvalidateKeysByPattern(gomock.Any(), sitemapPattern).
 				Return().
 				Times(1)
 
-			err := ctrl.DeleteSEO(ctx, name, pk)
+			err := ctrl.DeleteSEO(ctx, name, pk, "")
 			assert.Nil(t, err)
 		},
 	)
@@ -282,11 +366,11 @@ func TestController_DeleteSEO(t *testing.T) {
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
 			mockRepo.EXPECT().
-				DeleteSEO(gomock.Any(), name, pk).
+				DeleteSEO(gomock.Any(), name, pk, "").
 				Return(repo.ErrNotFound).
 				Times(1)
 
-			err := ctrl.DeleteSEO(ctx, name, pk)
+			err := ctrl.DeleteSEO(ctx, name, pk, "")
 			assert.IsType(t, ErrNotFound, err)
 		},
 	)
@@ -295,13 +379,141 @@ func TestController_DeleteSEO(t *testing.T) {
 		"ErrInternal", func(t *testing.T) {
 			newErr := errors.New("some error")
 			mockRepo.EXPECT().
-				DeleteSEO(gomock.Any(), name, pk).
+				DeleteSEO(gomock.Any(), name, pk, "").
 				Return(newErr).
 				Times(1)
 
-			err := ctrl.DeleteSEO(ctx, name, pk)
+			err := ctrl.DeleteSEO(ctx, name, pk, "")
 			assert.IsType(t, newErr, err)
 		},
 	)
 
 }
+
+func TestController_GetSEO_LocaleFallback(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{Locales: &config.LocalesConfig{Default: "en"}})
+
+	name, pk := "name", "pk"
+	key := fmt.Sprintf(SEOKey, name, pk, "ru-RU")
+
+	t.Run(
+		"Falls back to language", func(t *testing.T) {
+			expected := &model.SEO{OBJName: name, OBJPK: pk, Locale: "ru", Status: model.StatusPublished}
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			gomock.InOrder(
+				mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "ru-RU").Return(nil, repo.ErrNotFound),
+				mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "ru").Return(expected, nil),
+			)
+			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), key, gomock.Any()).Return().Times(1)
+
+			res, err := ctrl.GetSEO(ctx, name, pk, "ru_ru")
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"Falls back to default record", func(t *testing.T) {
+			expected := &model.SEO{OBJName: name, OBJPK: pk, Status: model.StatusPublished}
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			gomock.InOrder(
+				mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "ru-RU").Return(nil, repo.ErrNotFound),
+				mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "ru").Return(nil, repo.ErrNotFound),
+				mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "en").Return(nil, repo.ErrNotFound),
+				mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(expected, nil),
+			)
+			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), key, gomock.Any()).Return().Times(1)
+
+			res, err := ctrl.GetSEO(ctx, name, pk, "ru-RU")
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"Stops on repo error", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockCache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss")).Times(1)
+			mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "ru-RU").Return(nil, newErr).Times(1)
+
+			res, err := ctrl.GetSEO(ctx, name, pk, "ru-RU")
+			assert.Equal(t, newErr, err)
+			assert.Nil(t, res)
+		},
+	)
+}
+
+func TestController_GetSEOAlternates(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(
+		mockRepo, mockCache, &config.Config{
+			Sitemap: &config.SitemapConfig{
+				Host:    "https://example.com/",
+				Objects: map[string]string{"product": "/product/{pk}"},
+			},
+			Locales: &config.LocalesConfig{Default: "en"},
+		},
+	)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().ListSEOLocales(gomock.Any(), "product", "1").Return(
+				[]*model.SEO{{Locale: ""}, {Locale: "de"}, {Locale: "ru-RU"}}, nil,
+			).Times(1)
+
+			res, err := ctrl.GetSEOAlternates(ctx, "product", "1")
+			assert.Nil(t, err)
+			assert.Equal(
+				t, []*dto.SEOAlternate{
+					{Hreflang: "en", Href: "https://example.com/product/1"},
+					{Hreflang: "de", Href: "https://example.com/de/product/1"},
+					{Hreflang: "ru-RU", Href: "https://example.com/ru-RU/product/1"},
+					{Hreflang: "x-default", Href: "https://example.com/product/1"},
+				}, res,
+			)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().ListSEOLocales(gomock.Any(), "product", "2").Return([]*model.SEO{}, nil).Times(1)
+
+			res, err := ctrl.GetSEOAlternates(ctx, "product", "2")
+			assert.Equal(t, ErrNotFound, err)
+			assert.Nil(t, res)
+		},
+	)
+
+	t.Run(
+		"ErrUnknownObject", func(t *testing.T) {
+			mockRepo.EXPECT().ListSEOLocales(gomock.Any(), "post", "1").Return([]*model.SEO{{Locale: ""}}, nil).Times(1)
+
+			res, err := ctrl.GetSEOAlternates(ctx, "post", "1")
+			assert.Equal(t, ErrUnknownObject, err)
+			assert.Nil(t, res)
+		},
+	)
+}
+
+func TestController_LocaleChain(t *testing.T) {
+	ctrl := New(nil, nil, &config.Config{Locales: &config.LocalesConfig{Default: "en"}})
+
+	assert.Equal(t, []string{"ru-RU", "ru", "en", ""}, ctrl.localeChain("ru-RU"))
+	assert.Equal(t, []string{"zh-Hant-TW", "zh-Hant", "zh", "en", ""}, ctrl.localeChain("zh-Hant-TW"))
+	assert.Equal(t, []string{"en-GB", "en", ""}, ctrl.localeChain("en-GB"))
+	assert.Equal(t, []string{"en", ""}, ctrl.localeChain(""))
+	assert.Equal(t, []string{""}, New(nil, nil, &config.Config{}).localeChain(""))
+}
@@ -0,0 +1,318 @@
+package ctrl
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sitemapKey = "sitemap:%v:%v"
+const sitemapCountKey = "sitemap:count:%v"
+const sitemapPattern = "sitemap:*"
+
+// Limits from the sitemaps.org protocol, applied to uncompressed documents.
+const sitemapMaxURLs = 50000
+const sitemapMaxBytes = 50 * 1024 * 1024
+
+// sitemapOverhead is a generous estimate of the XML header and <urlset> wrapper size.
+const sitemapOverhead = 256
+
+// sitemapPageBatch is how many pages are read from the repository per query.
+const sitemapPageBatch = 1000
+
+// GetSitemap returns the sitemap document with the given index. Index 0 is the root
+// document: a plain <urlset> when everything fits into one file, otherwise a
+// <sitemapindex> referencing child documents 1..N.
+func (c *Controller) GetSitemap(ctx context.Context, idx int, gz bool) ([]byte, error) {
+	const op = "sitemap.GetSitemap.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var cached []byte
+	key := fmt.Sprintf(sitemapKey, idx, gz)
+	if err := c.cache.GetToStruct(ctx, key, &cached); err == nil {
+		return cached, nil
+	}
+
+	// A known document count rejects indexes past the end before anything is
+	// rebuilt. Document 0 always exists.
+	var count int
+	countKey := fmt.Sprintf(sitemapCountKey, gz)
+	if idx != 0 && (idx < 0 || c.cache.GetToStruct(ctx, countKey, &count) == nil && idx >= count) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Int("idx", idx), zap.Bool("gz", gz),
+		)
+		return nil, ErrNotFound
+	}
+
+	docs, err := c.buildSitemaps(ctx, gz)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Int("idx", idx), zap.Bool("gz", gz),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	for i, doc := range docs {
+		if bytes, err := json.Marshal(doc); err == nil {
+			c.cache.Set(ctx, config.DefaultCacheTime, fmt.Sprintf(sitemapKey, i, gz), bytes)
+		}
+	}
+	c.cache.Set(ctx, config.DefaultCacheTime, countKey, len(docs))
+
+	if idx >= len(docs) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Int("idx", idx), zap.Bool("gz", gz),
+		)
+		return nil, ErrNotFound
+	}
+	return docs[idx], nil
+}
+
+func (c *Controller) buildSitemaps(ctx context.Context, gz bool) ([][]byte, error) {
+	urls, err := c.sitemapURLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := splitSitemap(urls, sitemapMaxURLs, sitemapMaxBytes)
+	docs := make([][]byte, 0, len(chunks)+1)
+	if len(chunks) > 1 {
+		index := &md.SitemapIndex{XMLNS: md.SitemapNS}
+		for i, chunk := range chunks {
+			index.Sitemaps = append(
+				index.Sitemaps, md.SitemapRef{
+					Loc:     c.sitemapLoc(i+1, gz),
+					LastMod: latestLastMod(chunk),
+				},
+			)
+		}
+
+		doc, err := encodeSitemap(index, gz)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	for _, chunk := range chunks {
+		doc, err := encodeSitemap(&md.SitemapURLSet{XMLNS: md.SitemapNS, URLs: chunk}, gz)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+// listPublishedPages walks all published pages with a keyset cursor so that
+// large tables are read in bounded batches.
+func (c *Controller) listPublishedPages(ctx context.Context) ([]*md.Page, error) {
+	f := &md.PageFilter{Size: sitemapPageBatch, Sort: md.SortSlug, Status: md.StatusPublished}
+
+	var res []*md.Page
+	for {
+		list, err := c.repo.ListPages(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, list.Pages...)
+		if list.NextCursor == "" {
+			return res, nil
+		}
+		f.Cursor = list.NextCursor
+	}
+}
+
+func (c *Controller) sitemapURLs(ctx context.Context) ([]md.SitemapURL, error) {
+	pages, err := c.listPublishedPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(pages))
+	res := make([]md.SitemapURL, 0, len(pages))
+	add := func(u md.SitemapURL) {
+		if _, ok := seen[u.Loc]; ok {
+			return
+		}
+		seen[u.Loc] = struct{}{}
+		res = append(res, u)
+	}
+
+	for _, p := range pages {
+		if p.Href == "" {
+			continue
+		}
+
+		u := md.SitemapURL{
+			Loc:        c.absURL(p.Href),
+			LastMod:    p.UpdatedAt.UTC().Format(time.RFC3339),
+			ChangeFreq: p.ChangeFreq,
+		}
+		if p.Priority > 0 {
+			u.Priority = strconv.FormatFloat(p.Priority, 'f', 1, 64)
+		}
+		add(u)
+	}
+
+	objects := c.sitemapObjects()
+	if len(objects) == 0 {
+		return res, nil
+	}
+
+	names := make([]string, 0, len(objects))
+	for name := range objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	seos, err := c.repo.ListSEOForSitemap(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range seos {
+		path, ok := c.objectPath(s.OBJName, s.OBJPK)
+		if !ok {
+			continue
+		}
+
+		add(
+			md.SitemapURL{
+				Loc:     c.absURL(path),
+				LastMod: s.UpdatedAt.UTC().Format(time.RFC3339),
+			},
+		)
+	}
+
+	return res, nil
+}
+
+func (c *Controller) sitemapObjects() map[string]string {
+	if c.conf == nil || c.conf.Sitemap == nil {
+		return nil
+	}
+	return c.conf.Sitemap.Objects
+}
+
+// objectPath renders the configured URL pattern of an SEO object.
+func (c *Controller) objectPath(name, pk string) (string, bool) {
+	pattern, ok := c.sitemapObjects()[name]
+	if !ok {
+		return "", false
+	}
+	return strings.ReplaceAll(pattern, "{pk}", url.PathEscape(pk)), true
+}
+
+func (c *Controller) sitemapHost() string {
+	if c.conf == nil || c.conf.Sitemap == nil {
+		return ""
+	}
+	return strings.TrimSuffix(c.conf.Sitemap.Host, "/")
+}
+
+func (c *Controller) sitemapLoc(idx int, gz bool) string {
+	loc := fmt.Sprintf("%v/sitemaps/%d.xml", c.sitemapHost(), idx)
+	if gz {
+		loc += ".gz"
+	}
+	return loc
+}
+
+// absURL prefixes relative hrefs with the configured sitemap host.
+func (c *Controller) absURL(href string) string {
+	if strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://") {
+		return href
+	}
+
+	if !strings.HasPrefix(href, "/") {
+		href = "/" + href
+	}
+	return c.sitemapHost() + href
+}
+
+// splitSitemap groups urls into chunks that respect both the URL count and the
+// uncompressed size limits. It always returns at least one (possibly empty) chunk.
+func splitSitemap(urls []md.SitemapURL, maxURLs, maxBytes int) [][]md.SitemapURL {
+	res := make([][]md.SitemapURL, 0, len(urls)/maxURLs+1)
+	cur := make([]md.SitemapURL, 0)
+	size := sitemapOverhead
+	for _, u := range urls {
+		n := sitemapURLSize(u)
+		if len(cur) > 0 && (len(cur) >= maxURLs || size+n > maxBytes) {
+			res = append(res, cur)
+			cur, size = make([]md.SitemapURL, 0), sitemapOverhead
+		}
+		cur = append(cur, u)
+		size += n
+	}
+	return append(res, cur)
+}
+
+func sitemapURLSize(u md.SitemapURL) int {
+	b, err := xml.Marshal(u)
+	if err != nil {
+		return 0
+	}
+	return len(b)
+}
+
+func latestLastMod(urls []md.SitemapURL) string {
+	var res string
+	for _, u := range urls {
+		// All lastmod values are RFC3339 in UTC, so they compare lexicographically.
+		if u.LastMod > res {
+			res = u.LastMod
+		}
+	}
+	return res
+}
+
+func encodeSitemap(v any, gz bool) ([]byte, error) {
+	buf := &bytes.Buffer{}
+
+	var w io.Writer = buf
+	var zw *gzip.Writer
+	if gz {
+		zw = gzip.NewWriter(buf)
+		w = zw
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return nil, err
+	}
+
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		return nil, err
+	}
+
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,275 @@
+package ctrl
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestController_GetSitemap(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(
+		mockRepo, mockCache, &config.Config{
+			Sitemap: &config.SitemapConfig{
+				Host:    "https://example.com/",
+				Objects: map[string]string{"product": "/product/{pk}"},
+			},
+		},
+	)
+
+	updated := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	pages := []*md.Page{
+		{Slug: "home", Href: "/", ChangeFreq: "daily", Priority: 1, Status: md.StatusPublished, UpdatedAt: updated},
+		{Slug: "about", Href: "about", Status: md.StatusPublished, UpdatedAt: updated},
+		{Slug: "empty", Status: md.StatusPublished},
+	}
+	seos := []*md.SEO{
+		{OBJName: "product", OBJPK: "a b", UpdatedAt: updated},
+	}
+
+	t.Run(
+		"Cache hit", func(t *testing.T) {
+			expected := []byte("<urlset/>")
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapKey, 0, false), gomock.Any()).
+				DoAndReturn(
+					func(_ context.Context, _ string, dest *[]byte) error {
+						*dest = expected
+						return nil
+					},
+				).Times(1)
+
+			res, err := ctrl.GetSitemap(ctx, 0, false)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"Cache miss, single urlset", func(t *testing.T) {
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapKey, 0, false), gomock.Any()).
+				Return(errors.New("cache miss")).
+				Times(1)
+			mockRepo.EXPECT().ListPages(gomock.Any(), gomock.Any()).Return(&md.PageList{Pages: pages}, nil).Times(1)
+			mockRepo.EXPECT().ListSEOForSitemap(gomock.Any(), []string{"product"}).Return(seos, nil).Times(1)
+			mockCache.EXPECT().
+				Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(sitemapKey, 0, false), gomock.Any()).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(sitemapCountKey, false), 1).
+				Return().
+				Times(1)
+
+			res, err := ctrl.GetSitemap(ctx, 0, false)
+			assert.Nil(t, err)
+
+			doc := string(res)
+			assert.Contains(t, doc, "<urlset xmlns=\""+md.SitemapNS+"\">")
+			assert.Contains(t, doc, "<loc>https://example.com/</loc>")
+			assert.Contains(t, doc, "<loc>https://example.com/about</loc>")
+			assert.Contains(t, doc, "<loc>https://example.com/product/a%20b</loc>")
+			assert.Contains(t, doc, "<lastmod>2024-01-02T03:04:05Z</lastmod>")
+			assert.Contains(t, doc, "<changefreq>daily</changefreq><priority>1.0</priority>")
+			assert.NotContains(t, doc, "empty")
+		},
+	)
+
+	t.Run(
+		"Cache miss, gzip", func(t *testing.T) {
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapKey, 0, true), gomock.Any()).
+				Return(errors.New("cache miss")).
+				Times(1)
+			mockRepo.EXPECT().ListPages(gomock.Any(), gomock.Any()).Return(&md.PageList{Pages: pages}, nil).Times(1)
+			mockRepo.EXPECT().ListSEOForSitemap(gomock.Any(), []string{"product"}).Return(seos, nil).Times(1)
+			mockCache.EXPECT().
+				Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(sitemapKey, 0, true), gomock.Any()).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(sitemapCountKey, true), 1).
+				Return().
+				Times(1)
+
+			res, err := ctrl.GetSitemap(ctx, 0, true)
+			assert.Nil(t, err)
+
+			zr, err := gzip.NewReader(bytes.NewReader(res))
+			require.NoError(t, err)
+			plain, err := io.ReadAll(zr)
+			require.NoError(t, err)
+			assert.Contains(t, string(plain), "<loc>https://example.com/about</loc>")
+		},
+	)
+
+	t.Run(
+		"Index out of range, known count", func(t *testing.T) {
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapKey, 999999, false), gomock.Any()).
+				Return(errors.New("cache miss")).
+				Times(1)
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapCountKey, false), gomock.Any()).
+				DoAndReturn(
+					func(_ context.Context, _ string, dest *int) error {
+						*dest = 1
+						return nil
+					},
+				).Times(1)
+
+			res, err := ctrl.GetSitemap(ctx, 999999, false)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"Index out of range, unknown count", func(t *testing.T) {
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapKey, 2, false), gomock.Any()).
+				Return(errors.New("cache miss")).
+				Times(1)
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapCountKey, false), gomock.Any()).
+				Return(errors.New("cache miss")).
+				Times(1)
+			mockRepo.EXPECT().ListPages(gomock.Any(), gomock.Any()).Return(&md.PageList{Pages: pages}, nil).Times(1)
+			mockRepo.EXPECT().ListSEOForSitemap(gomock.Any(), []string{"product"}).Return(seos, nil).Times(1)
+			mockCache.EXPECT().
+				Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(sitemapKey, 0, false), gomock.Any()).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(sitemapCountKey, false), 1).
+				Return().
+				Times(1)
+
+			res, err := ctrl.GetSitemap(ctx, 2, false)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"Negative index", func(t *testing.T) {
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapKey, -1, false), gomock.Any()).
+				Return(errors.New("cache miss")).
+				Times(1)
+
+			res, err := ctrl.GetSitemap(ctx, -1, false)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"Pages read in batches", func(t *testing.T) {
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapKey, 0, false), gomock.Any()).
+				Return(errors.New("cache miss")).
+				Times(1)
+			gomock.InOrder(
+				mockRepo.EXPECT().
+					ListPages(gomock.Any(), gomock.Cond(func(x any) bool { return x.(*md.PageFilter).Cursor == "" })).
+					Return(&md.PageList{Pages: pages[:1], NextCursor: "next"}, nil),
+				mockRepo.EXPECT().
+					ListPages(gomock.Any(), gomock.Cond(func(x any) bool { return x.(*md.PageFilter).Cursor == "next" })).
+					Return(&md.PageList{Pages: pages[1:]}, nil),
+			)
+			mockRepo.EXPECT().ListSEOForSitemap(gomock.Any(), []string{"product"}).Return(nil, nil).Times(1)
+			mockCache.EXPECT().
+				Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(sitemapKey, 0, false), gomock.Any()).
+				Return().
+				Times(1)
+			mockCache.EXPECT().
+				Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(sitemapCountKey, false), 1).
+				Return().
+				Times(1)
+
+			res, err := ctrl.GetSitemap(ctx, 0, false)
+			assert.Nil(t, err)
+			assert.Contains(t, string(res), "<loc>https://example.com/</loc>")
+			assert.Contains(t, string(res), "<loc>https://example.com/about</loc>")
+		},
+	)
+
+	t.Run(
+		"Repo error", func(t *testing.T) {
+			testErr := errors.New("repo error")
+			mockCache.EXPECT().
+				GetToStruct(gomock.Any(), fmt.Sprintf(sitemapKey, 0, false), gomock.Any()).
+				Return(errors.New("cache miss")).
+				Times(1)
+			mockRepo.EXPECT().ListPages(gomock.Any(), gomock.Any()).Return(nil, testErr).Times(1)
+
+			res, err := ctrl.GetSitemap(ctx, 0, false)
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+		},
+	)
+}
+
+func TestSplitSitemap(t *testing.T) {
+	urls := make([]md.SitemapURL, 0, 5)
+	for i := 0; i < 5; i++ {
+		urls = append(urls, md.SitemapURL{Loc: fmt.Sprintf("https://example.com/%d", i)})
+	}
+
+	t.Run(
+		"Empty", func(t *testing.T) {
+			res := splitSitemap(nil, 2, sitemapMaxBytes)
+			assert.Len(t, res, 1)
+			assert.Len(t, res[0], 0)
+		},
+	)
+
+	t.Run(
+		"By count", func(t *testing.T) {
+			res := splitSitemap(urls, 2, sitemapMaxBytes)
+			assert.Len(t, res, 3)
+			assert.Len(t, res[2], 1)
+		},
+	)
+
+	t.Run(
+		"By size", func(t *testing.T) {
+			size := sitemapURLSize(urls[0])
+			res := splitSitemap(urls, sitemapMaxURLs, sitemapOverhead+2*size)
+			assert.Len(t, res, 3)
+			assert.Len(t, res[0], 2)
+		},
+	)
+}
+
+func TestSitemapHelpers(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+	ctrl := New(mockRepo, mockCache, &config.Config{Sitemap: &config.SitemapConfig{Host: "https://example.com"}})
+
+	chunk := []md.SitemapURL{{Loc: "https://example.com/a", LastMod: "2024-01-02T03:04:05Z"}}
+	assert.Equal(t, "https://example.com/sitemaps/3.xml.gz", ctrl.sitemapLoc(3, true))
+	assert.Equal(t, "2024-01-02T03:04:05Z", latestLastMod(append(chunk, md.SitemapURL{LastMod: "2023-01-01T00:00:00Z"})))
+}
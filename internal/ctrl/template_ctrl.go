@@ -0,0 +1,190 @@
+package ctrl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const templateKey = "SEO_TEMPLATE:%v:%v"
+const templatePattern = "SEO_TEMPLATE:%v:*"
+
+// GetSEOWithVars behaves like GetSEO but, when no record exists, renders the
+// obj_name template with vars and returns it marked as generated. Templates
+// use the same locale fallback as records.
+func (c *Controller) GetSEOWithVars(ctx context.Context, name, pk, locale string, vars map[string]string) (*md.SEO, error) {
+	const op = "template.GetSEOWithVars.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.GetSEO(ctx, name, pk, locale)
+	if err == nil || !errors.Is(err, ErrNotFound) {
+		return res, err
+	}
+
+	locale = md.NormalizeLocale(locale)
+	tpl, err := c.seoTemplate(ctx, name, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = tpl.Render(name, pk, tpl.Locale, vars)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("pk", pk), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// seoTemplate finds the template for name along the locale chain, caching it
+// under the requested locale.
+func (c *Controller) seoTemplate(ctx context.Context, name, locale string) (*md.SEOTemplate, error) {
+	const op = "template.seoTemplate.ctrl"
+
+	cached := &md.SEOTemplate{}
+	key := fmt.Sprintf(templateKey, name, locale)
+	if err := c.cache.GetToStruct(ctx, key, cached); err == nil {
+		return cached, nil
+	}
+
+	var res *md.SEOTemplate
+	var err error
+	for _, l := range c.localeChain(locale) {
+		res, err = c.repo.GetSEOTemplate(ctx, name, l)
+		if err == nil || !errors.Is(err, repo.ErrNotFound) {
+			break
+		}
+	}
+
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		c.cache.Set(ctx, config.DefaultCacheTime, key, bytes)
+	}
+	return res, nil
+}
+
+func (c *Controller) ListSEOTemplates(ctx context.Context) ([]*md.SEOTemplate, error) {
+	const op = "template.ListSEOTemplates.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.ListSEOTemplates(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetSEOTemplate(ctx context.Context, name, locale string) (*md.SEOTemplate, error) {
+	const op = "template.GetSEOTemplate.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	locale = md.NormalizeLocale(locale)
+	res, err := c.repo.GetSEOTemplate(ctx, name, locale)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) SaveSEOTemplate(ctx context.Context, req *md.SEOTemplate) (*dto.SaveSEOTemplateResponse, error) {
+	const op = "template.SaveSEOTemplate.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	req.Locale = md.NormalizeLocale(req.Locale)
+	created, err := c.repo.SaveSEOTemplate(ctx, req)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(templatePattern, req.OBJName))
+	return &dto.SaveSEOTemplateResponse{Created: created}, nil
+}
+
+func (c *Controller) DeleteSEOTemplate(ctx context.Context, name, locale string) error {
+	const op = "template.DeleteSEOTemplate.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	locale = md.NormalizeLocale(locale)
+	err := c.repo.DeleteSEOTemplate(ctx, name, locale)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("name", name), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(templatePattern, name))
+	return nil
+}
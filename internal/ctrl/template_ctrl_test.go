@@ -0,0 +1,191 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_GetSEOWithVars(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	name, pk := "product", "1"
+	vars := map[string]string{"name": `Tom & Jerry's <b>"table"</b>`, "city": "Berlin", "id": "1&x=2"}
+	tpl := &model.SEOTemplate{
+		OBJName:     name,
+		Title:       "{{.name}} — buy in {{.city}} | Shop",
+		Description: "Buy {{.name}}{{.missing}}",
+		OGType:      "product",
+		OGURL:       "https://example.com/p?id={{.id}}",
+	}
+
+	t.Run(
+		"Stored record wins", func(t *testing.T) {
+			stored := &model.SEO{OBJName: name, OBJPK: pk, Title: "stored", Status: model.StatusPublished}
+			mockCache.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(SEOKey, name, pk, ""), gomock.Any()).Return(errors.New("miss"))
+			mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(stored, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+
+			res, err := ctrl.GetSEOWithVars(ctx, name, pk, "", vars)
+			require.Nil(t, err)
+			assert.Equal(t, stored, res)
+			assert.False(t, res.Generated)
+		},
+	)
+
+	t.Run(
+		"Renders template as plain text", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(SEOKey, name, pk, ""), gomock.Any()).Return(errors.New("miss"))
+			mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(nil, repo.ErrNotFound).Times(1)
+			mockCache.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(templateKey, name, ""), gomock.Any()).Return(errors.New("miss"))
+			mockRepo.EXPECT().GetSEOTemplate(gomock.Any(), name, "").Return(tpl, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(templateKey, name, ""), gomock.Any()).Times(1)
+
+			res, err := ctrl.GetSEOWithVars(ctx, name, pk, "", vars)
+			require.Nil(t, err)
+			assert.True(t, res.Generated)
+			assert.Equal(t, pk, res.OBJPK)
+			assert.Equal(t, `Tom & Jerry's <b>"table"</b> — buy in Berlin | Shop`, res.Title)
+			assert.Equal(t, `Buy Tom & Jerry's <b>"table"</b>`, res.Description)
+			assert.Equal(t, "https://example.com/p?id=1&x=2", res.OGURL)
+			assert.Equal(t, "product", res.OGType)
+		},
+	)
+
+	t.Run(
+		"Template falls back by locale", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(SEOKey, name, pk, "ru-RU"), gomock.Any()).Return(errors.New("miss"))
+			mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, gomock.Any()).Return(nil, repo.ErrNotFound).Times(3)
+			mockCache.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(templateKey, name, "ru-RU"), gomock.Any()).Return(errors.New("miss"))
+			mockRepo.EXPECT().GetSEOTemplate(gomock.Any(), name, "ru-RU").Return(nil, repo.ErrNotFound).Times(1)
+			mockRepo.EXPECT().GetSEOTemplate(gomock.Any(), name, "ru").Return(&model.SEOTemplate{Locale: "ru", Title: "{{.name}}"}, nil).Times(1)
+			mockCache.EXPECT().Set(gomock.Any(), config.DefaultCacheTime, fmt.Sprintf(templateKey, name, "ru-RU"), gomock.Any()).Times(1)
+
+			res, err := ctrl.GetSEOWithVars(ctx, name, pk, "ru-RU", map[string]string{"name": "Стол"})
+			require.Nil(t, err)
+			assert.Equal(t, "ru", res.Locale)
+			assert.Equal(t, "Стол", res.Title)
+		},
+	)
+
+	t.Run(
+		"No template", func(t *testing.T) {
+			mockCache.EXPECT().GetToStruct(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("miss")).Times(2)
+			mockRepo.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(nil, repo.ErrNotFound).Times(1)
+			mockRepo.EXPECT().GetSEOTemplate(gomock.Any(), name, "").Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.GetSEOWithVars(ctx, name, pk, "", nil)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+}
+
+func TestController_SaveSEOTemplate(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			req := &model.SEOTemplate{OBJName: "product", Locale: "EN_us", Title: "{{.name}}"}
+			mockRepo.EXPECT().SaveSEOTemplate(gomock.Any(), req).Return(true, nil).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(templatePattern, "product")).Times(1)
+
+			res, err := ctrl.SaveSEOTemplate(ctx, req)
+			require.Nil(t, err)
+			assert.True(t, res.Created)
+			assert.Equal(t, "en-US", req.Locale)
+		},
+	)
+
+	t.Run(
+		"Repo error", func(t *testing.T) {
+			testErr := errors.New("repo error")
+			mockRepo.EXPECT().SaveSEOTemplate(gomock.Any(), gomock.Any()).Return(false, testErr).Times(1)
+
+			res, err := ctrl.SaveSEOTemplate(ctx, &model.SEOTemplate{OBJName: "product"})
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+		},
+	)
+}
+
+func TestController_GetSEOTemplate(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			tpl := &model.SEOTemplate{OBJName: "product", Locale: "en"}
+			mockRepo.EXPECT().GetSEOTemplate(gomock.Any(), "product", "en").Return(tpl, nil).Times(1)
+
+			res, err := ctrl.GetSEOTemplate(ctx, "product", "EN")
+			assert.Nil(t, err)
+			assert.Equal(t, tpl, res)
+		},
+	)
+
+	t.Run(
+		"Not found", func(t *testing.T) {
+			mockRepo.EXPECT().GetSEOTemplate(gomock.Any(), "product", "").Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.GetSEOTemplate(ctx, "product", "")
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+}
+
+func TestController_DeleteSEOTemplate(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().DeleteSEOTemplate(gomock.Any(), "product", "").Return(nil).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(templatePattern, "product")).Times(1)
+
+			assert.Nil(t, ctrl.DeleteSEOTemplate(ctx, "product", ""))
+		},
+	)
+
+	t.Run(
+		"Not found", func(t *testing.T) {
+			mockRepo.EXPECT().DeleteSEOTemplate(gomock.Any(), "product", "").Return(repo.ErrNotFound).Times(1)
+
+			assert.Equal(t, ErrNotFound, ctrl.DeleteSEOTemplate(ctx, "product", ""))
+		},
+	)
+}
@@ -0,0 +1,91 @@
+package ctrl
+
+import (
+	"context"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const seoAllPattern = "SEO:*"
+
+// exportBatch is how many SEO records are read from the repository per query.
+const exportBatch = 1000
+
+// ImportSEO writes already validated rows in a single transaction. Rows must
+// have unique (obj_name, obj_pk, locale) keys.
+func (c *Controller) ImportSEO(ctx context.Context, rows []*md.SEO, mode string) (*dto.ImportSEOResponse, error) {
+	const op = "seo.ImportSEO.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	for _, row := range rows {
+		row.Locale = md.NormalizeLocale(row.Locale)
+		row.Status = defaultStatus(row.Status)
+	}
+
+	created, updated, err := c.repo.ImportSEO(ctx, rows, mode != md.ImportModeInsert)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Int("rows", len(rows)),
+			zap.String("mode", mode),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if created+updated > 0 {
+		c.cache.InvalidateKeysByPattern(ctx, seoAllPattern)
+		c.cache.InvalidateKeysByPattern(ctx, sitemapPattern)
+	}
+
+	return &dto.ImportSEOResponse{
+		Total:   len(rows),
+		Created: created,
+		Updated: updated,
+		Skipped: len(rows) - created - updated,
+	}, nil
+}
+
+// ExportSEO streams every record matching f to fn in obj_pk order, reading the
+// repository in keyset batches. Pagination fields of f are ignored.
+func (c *Controller) ExportSEO(ctx context.Context, f *md.SEOFilter, fn func(*md.SEO) error) error {
+	const op = "seo.ExportSEO.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	f.Page, f.Size, f.Cursor, f.Sort, f.Order = 0, exportBatch, "", md.SortOBJPK, md.OrderAsc
+	if f.Locale != "" {
+		f.Locale = md.NormalizeLocale(f.Locale)
+	}
+	if !IsPreview(ctx) {
+		f.Status = md.StatusPublished
+	}
+
+	for {
+		list, err := c.repo.ListSEO(ctx, f)
+		if err != nil {
+			zap.L().Debug(
+				ErrInternal.Error(),
+				zap.String("op", op),
+				zap.Any("filter", f),
+				zap.Error(err),
+			)
+			return err
+		}
+
+		for _, seo := range list.SEO {
+			if err = fn(seo); err != nil {
+				return err
+			}
+		}
+
+		if list.NextCursor == "" {
+			return nil
+		}
+		f.Cursor = list.NextCursor
+	}
+}
@@ -0,0 +1,143 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_ImportSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			rows := []*model.SEO{
+				{OBJName: "name", OBJPK: "1", Locale: "EN_us"},
+				{OBJName: "name", OBJPK: "2"},
+				{OBJName: "name", OBJPK: "3"},
+			}
+			mockRepo.EXPECT().ImportSEO(gomock.Any(), rows, true).Return(1, 1, nil).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), seoAllPattern).Times(1)
+			mockCache.EXPECT().InvalidateKeysByPattern(gomock.Any(), sitemapPattern).Times(1)
+
+			res, err := ctrl.ImportSEO(ctx, rows, model.ImportModeUpsert)
+			assert.Nil(t, err)
+			assert.Equal(t, 3, res.Total)
+			assert.Equal(t, 1, res.Created)
+			assert.Equal(t, 1, res.Updated)
+			assert.Equal(t, 1, res.Skipped)
+			assert.Equal(t, "en-US", rows[0].Locale)
+			assert.Equal(t, model.StatusPublished, rows[1].Status)
+		},
+	)
+
+	t.Run(
+		"Nothing written keeps cache", func(t *testing.T) {
+			rows := []*model.SEO{{OBJName: "name", OBJPK: "1"}}
+			mockRepo.EXPECT().ImportSEO(gomock.Any(), rows, false).Return(0, 0, nil).Times(1)
+
+			res, err := ctrl.ImportSEO(ctx, rows, model.ImportModeInsert)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, res.Skipped)
+		},
+	)
+
+	t.Run(
+		"Repo error", func(t *testing.T) {
+			testErr := errors.New("repo error")
+			mockRepo.EXPECT().ImportSEO(gomock.Any(), gomock.Any(), true).Return(0, 0, testErr).Times(1)
+
+			res, err := ctrl.ImportSEO(ctx, []*model.SEO{{OBJName: "name", OBJPK: "1"}}, "")
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+		},
+	)
+}
+
+func TestController_ExportSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+
+	t.Run(
+		"Reads every batch", func(t *testing.T) {
+			gomock.InOrder(
+				mockRepo.EXPECT().
+					ListSEO(
+						gomock.Any(), gomock.Cond(
+							func(x any) bool {
+								f := x.(*model.SEOFilter)
+								return f.Cursor == "" && f.Size == exportBatch && f.Status == model.StatusPublished
+							},
+						),
+					).
+					Return(&model.SEOList{SEO: []*model.SEO{{OBJPK: "1"}}, NextCursor: "next"}, nil),
+				mockRepo.EXPECT().
+					ListSEO(gomock.Any(), gomock.Cond(func(x any) bool { return x.(*model.SEOFilter).Cursor == "next" })).
+					Return(&model.SEOList{SEO: []*model.SEO{{OBJPK: "2"}}}, nil),
+			)
+
+			var pks []string
+			err := ctrl.ExportSEO(
+				ctx, &model.SEOFilter{Page: 3}, func(seo *model.SEO) error {
+					pks = append(pks, seo.OBJPK)
+					return nil
+				},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"1", "2"}, pks)
+		},
+	)
+
+	t.Run(
+		"Preview keeps status filter", func(t *testing.T) {
+			mockRepo.EXPECT().
+				ListSEO(gomock.Any(), gomock.Cond(func(x any) bool { return x.(*model.SEOFilter).Status == "" })).
+				Return(&model.SEOList{}, nil).
+				Times(1)
+
+			err := ctrl.ExportSEO(WithPreview(ctx), &model.SEOFilter{}, func(*model.SEO) error { return nil })
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"Callback error stops export", func(t *testing.T) {
+			testErr := errors.New("write error")
+			mockRepo.EXPECT().
+				ListSEO(gomock.Any(), gomock.Any()).
+				Return(&model.SEOList{SEO: []*model.SEO{{OBJPK: "1"}}, NextCursor: "next"}, nil).
+				Times(1)
+
+			err := ctrl.ExportSEO(ctx, &model.SEOFilter{}, func(*model.SEO) error { return testErr })
+			assert.Equal(t, testErr, err)
+		},
+	)
+
+	t.Run(
+		"Repo error", func(t *testing.T) {
+			testErr := errors.New("repo error")
+			mockRepo.EXPECT().ListSEO(gomock.Any(), gomock.Any()).Return(nil, testErr).Times(1)
+
+			err := ctrl.ExportSEO(ctx, &model.SEOFilter{}, func(*model.SEO) error { return nil })
+			assert.Equal(t, testErr, err)
+		},
+	)
+}
@@ -0,0 +1,284 @@
+package ctrl
+
+import (
+	"context"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+const defaultEventsInterval = 500 * time.Millisecond
+const defaultEventsGapTimeout = 5 * time.Second
+const defaultEventsBuffer = 256
+const eventsBatch = 500
+
+// changeFeed fans outbox events out to the watchers of this process. Every
+// instance tails the outbox table itself, so a watcher sees the changes made
+// through any of them.
+type changeFeed struct {
+	mu       sync.Mutex
+	started  bool
+	cursor   uint64
+	watchers map[*watcher]struct{}
+	done     chan struct{}
+	once     sync.Once
+
+	// gapID is the first id missing from the last read and gapAt is when it
+	// was first noticed. Ids are taken before commit, so a later id can become
+	// visible before an earlier one; a rolled back write never fills its gap.
+	gapID uint64
+	gapAt time.Time
+}
+
+type watcher struct {
+	ch chan *md.OutboxEvent
+}
+
+func newChangeFeed() *changeFeed {
+	return &changeFeed{
+		watchers: make(map[*watcher]struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// subscribe registers a watcher and returns it with the id of the last event
+// broadcast before it, so that everything after that id reaches it.
+func (f *changeFeed) subscribe(buffer int) (*watcher, uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w := &watcher{ch: make(chan *md.OutboxEvent, buffer)}
+	f.watchers[w] = struct{}{}
+	return w, f.cursor
+}
+
+func (f *changeFeed) unsubscribe(w *watcher) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.watchers[w]; ok {
+		delete(f.watchers, w)
+		close(w.ch)
+	}
+}
+
+// broadcast advances the cursor past events and hands them to every watcher.
+// A watcher whose buffer is full is dropped instead of stalling the others.
+func (f *changeFeed) broadcast(events []*md.OutboxEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, e := range events {
+		f.cursor = e.ID
+		for w := range f.watchers {
+			select {
+			case w.ch <- e:
+			default:
+				delete(f.watchers, w)
+				close(w.ch)
+			}
+		}
+	}
+}
+
+// CloseWatchers ends every running and future Watch call. Watches never end on
+// their own, so this is done before the servers are drained on shutdown.
+func (c *Controller) CloseWatchers() {
+	c.feed.once.Do(
+		func() {
+			close(c.feed.done)
+		},
+	)
+}
+
+// eventsConf returns the change feed settings with defaults filled in.
+func (c *Controller) eventsConf() config.EventsConfig {
+	res := config.EventsConfig{
+		Interval:   defaultEventsInterval,
+		GapTimeout: defaultEventsGapTimeout,
+		Buffer:     defaultEventsBuffer,
+	}
+
+	if c.conf == nil || c.conf.Events == nil {
+		return res
+	}
+	conf := c.conf.Events
+	if conf.Interval > 0 {
+		res.Interval = conf.Interval
+	}
+	if conf.GapTimeout > 0 {
+		res.GapTimeout = conf.GapTimeout
+	}
+	if conf.Buffer > 0 {
+		res.Buffer = conf.Buffer
+	}
+	return res
+}
+
+// RunEvents feeds the watchers of this process every interval until ctx is
+// cancelled.
+func (c *Controller) RunEvents(ctx context.Context) {
+	ticker := time.NewTicker(c.eventsConf().Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				n, err := c.PollEvents(ctx, time.Now())
+				if err != nil {
+					zap.L().Warn("failed to poll change events", zap.Error(err))
+				}
+				if err != nil || n < eventsBatch || ctx.Err() != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+// PollEvents broadcasts the events committed since the last call and returns
+// how many it read. The first call only positions the feed at the newest
+// event; older ones are replayed by Watch on request. Events behind a missing
+// id are held back until it commits or GapTimeout has passed, so a slow
+// transaction is not skipped.
+func (c *Controller) PollEvents(ctx context.Context, now time.Time) (int, error) {
+	const op = "events.PollEvents.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	f := c.feed
+	if !f.started {
+		id, err := c.repo.LastOutboxEventID(ctx)
+		if err != nil {
+			zap.L().Debug(
+				ErrInternal.Error(),
+				zap.String("op", op),
+				zap.Error(err),
+			)
+			return 0, err
+		}
+
+		f.mu.Lock()
+		f.started, f.cursor = true, id
+		f.mu.Unlock()
+		return 0, nil
+	}
+
+	events, err := c.repo.ListOutboxEvents(ctx, f.cursor, eventsBatch)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("after", f.cursor),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	gapTimeout := c.eventsConf().GapTimeout
+	next, ready := f.cursor+1, 0
+	for _, e := range events {
+		if e.ID != next {
+			if f.gapID != next {
+				f.gapID, f.gapAt = next, now
+			}
+			if now.Sub(f.gapAt) < gapTimeout {
+				break
+			}
+			zap.L().Debug(
+				"skipping missing change events",
+				zap.String("op", op),
+				zap.Uint64("from", next), zap.Uint64("to", e.ID-1),
+			)
+		}
+		next = e.ID + 1
+		ready++
+	}
+
+	f.broadcast(events[:ready])
+	// A read cut short by a gap is not full, so RunEvents waits for the next tick.
+	if ready < len(events) {
+		return ready, nil
+	}
+	return len(events), nil
+}
+
+// Watch calls fn for every event matching f until ctx is cancelled. With after
+// set, the events following that id are replayed from the outbox first, as far
+// back as its retention allows. It returns ErrWatcherLagging when fn cannot
+// keep up with the feed; the caller may resume from the last id it saw.
+func (c *Controller) Watch(ctx context.Context, f *md.ChangeFilter, after uint64, fn func(*md.OutboxEvent) error) error {
+	const op = "events.Watch.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	w, cursor := c.feed.subscribe(c.eventsConf().Buffer)
+	defer c.feed.unsubscribe(w)
+
+	last := after
+replay:
+	for after > 0 && last < cursor {
+		events, err := c.repo.ListOutboxEvents(ctx, last, eventsBatch)
+		if err != nil {
+			zap.L().Debug(
+				ErrInternal.Error(),
+				zap.String("op", op),
+				zap.Uint64("after", last),
+				zap.Error(err),
+			)
+			return err
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		for _, e := range events {
+			// Newer events arrive through w.
+			if e.ID > cursor {
+				break replay
+			}
+			if f.Match(e) {
+				if err = fn(e); err != nil {
+					return err
+				}
+			}
+			last = e.ID
+		}
+		if len(events) < eventsBatch {
+			break
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.feed.done:
+			return nil
+		case e, ok := <-w.ch:
+			if !ok {
+				zap.L().Debug(
+					ErrWatcherLagging.Error(),
+					zap.String("op", op),
+					zap.Uint64("last", last),
+				)
+				return ErrWatcherLagging
+			}
+			if e.ID <= last {
+				continue
+			}
+			if f.Match(e) {
+				if err := fn(e); err != nil {
+					return err
+				}
+			}
+			last = e.ID
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package ctrl
+
+import (
+	"context"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_PollEvents(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	now := time.Now()
+	ctrl := New(mockRepo, mockCache, &config.Config{Events: &config.EventsConfig{GapTimeout: 5 * time.Second}})
+	w, _ := ctrl.feed.subscribe(10)
+
+	received := func() []uint64 {
+		var ids []uint64
+		for len(w.ch) > 0 {
+			ids = append(ids, (<-w.ch).ID)
+		}
+		return ids
+	}
+
+	t.Run(
+		"First poll starts at the newest event", func(t *testing.T) {
+			mockRepo.EXPECT().LastOutboxEventID(gomock.Any()).Return(uint64(10), nil).Times(1)
+
+			n, err := ctrl.PollEvents(ctx, now)
+			assert.NoError(t, err)
+			assert.Equal(t, 0, n)
+			assert.Empty(t, received())
+		},
+	)
+
+	t.Run(
+		"Holds back events behind a gap", func(t *testing.T) {
+			mockRepo.EXPECT().ListOutboxEvents(gomock.Any(), uint64(10), eventsBatch).
+				Return([]*model.OutboxEvent{{ID: 11}, {ID: 13}}, nil).Times(1)
+
+			n, err := ctrl.PollEvents(ctx, now)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+			assert.Equal(t, []uint64{11}, received())
+		},
+	)
+
+	t.Run(
+		"Gap filled in time", func(t *testing.T) {
+			mockRepo.EXPECT().ListOutboxEvents(gomock.Any(), uint64(11), eventsBatch).
+				Return([]*model.OutboxEvent{{ID: 12}, {ID: 13}, {ID: 15}}, nil).Times(1)
+
+			n, err := ctrl.PollEvents(ctx, now.Add(time.Second))
+			assert.NoError(t, err)
+			assert.Equal(t, 2, n)
+			assert.Equal(t, []uint64{12, 13}, received())
+		},
+	)
+
+	t.Run(
+		"Gap skipped after the timeout", func(t *testing.T) {
+			mockRepo.EXPECT().ListOutboxEvents(gomock.Any(), uint64(13), eventsBatch).
+				Return([]*model.OutboxEvent{{ID: 15}}, nil).Times(1)
+
+			n, err := ctrl.PollEvents(ctx, now.Add(2*time.Second))
+			assert.NoError(t, err)
+			assert.Equal(t, 0, n)
+
+			mockRepo.EXPECT().ListOutboxEvents(gomock.Any(), uint64(13), eventsBatch).
+				Return([]*model.OutboxEvent{{ID: 15}, {ID: 16}}, nil).Times(1)
+
+			n, err = ctrl.PollEvents(ctx, now.Add(8*time.Second))
+			assert.NoError(t, err)
+			assert.Equal(t, 2, n)
+			assert.Equal(t, []uint64{15, 16}, received())
+		},
+	)
+}
+
+func TestController_Watch(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	filter := &model.ChangeFilter{SEO: true, OBJName: "product"}
+	product := func(id uint64) *model.OutboxEvent {
+		return &model.OutboxEvent{ID: id, Event: model.EventSEOUpdated, Key: "seo:product:1:en"}
+	}
+
+	t.Run(
+		"Replays then follows the feed", func(t *testing.T) {
+			ctrl := New(mockRepo, mockCache, &config.Config{})
+			mockRepo.EXPECT().LastOutboxEventID(gomock.Any()).Return(uint64(5), nil).Times(1)
+			_, err := ctrl.PollEvents(ctx, time.Now())
+			require.NoError(t, err)
+
+			mockRepo.EXPECT().ListOutboxEvents(gomock.Any(), uint64(3), eventsBatch).
+				Return(
+					[]*model.OutboxEvent{
+						product(4),
+						{ID: 5, Event: model.EventPageUpdated, Key: "page:about"},
+						product(6),
+					}, nil,
+				).Times(1)
+
+			got := make(chan uint64, 10)
+			done := make(chan error, 1)
+			go func() {
+				done <- ctrl.Watch(
+					ctx, filter, 3, func(e *model.OutboxEvent) error {
+						got <- e.ID
+						return nil
+					},
+				)
+			}()
+
+			assert.Equal(t, uint64(4), <-got)
+			ctrl.feed.broadcast(
+				[]*model.OutboxEvent{
+					product(6),
+					{ID: 7, Event: model.EventSEOUpdated, Key: "seo:category:1:en"},
+					product(8),
+				},
+			)
+			assert.Equal(t, uint64(6), <-got)
+			assert.Equal(t, uint64(8), <-got)
+
+			ctrl.CloseWatchers()
+			assert.NoError(t, <-done)
+			assert.Empty(t, got)
+		},
+	)
+
+	t.Run(
+		"Lagging watcher is dropped", func(t *testing.T) {
+			ctrl := New(mockRepo, mockCache, &config.Config{Events: &config.EventsConfig{Buffer: 1}})
+
+			unblock := make(chan struct{})
+			done := make(chan error, 1)
+			go func() {
+				done <- ctrl.Watch(
+					ctx, filter, 0, func(e *model.OutboxEvent) error {
+						<-unblock
+						return nil
+					},
+				)
+			}()
+
+			require.Eventually(
+				t, func() bool {
+					ctrl.feed.mu.Lock()
+					defer ctrl.feed.mu.Unlock()
+					return len(ctrl.feed.watchers) == 1
+				}, time.Second, time.Millisecond,
+			)
+
+			ctrl.feed.broadcast([]*model.OutboxEvent{product(1)})
+			require.Eventually(
+				t, func() bool {
+					ctrl.feed.mu.Lock()
+					defer ctrl.feed.mu.Unlock()
+					for w := range ctrl.feed.watchers {
+						return len(w.ch) == 0
+					}
+					return false
+				}, time.Second, time.Millisecond,
+			)
+			ctrl.feed.broadcast([]*model.OutboxEvent{product(2), product(3)})
+			close(unblock)
+
+			assert.ErrorIs(t, <-done, ErrWatcherLagging)
+		},
+	)
+}
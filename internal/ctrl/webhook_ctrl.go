@@ -0,0 +1,196 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+func (c *Controller) ListWebhooks(ctx context.Context) ([]*md.Webhook, error) {
+	const op = "webhook.ListWebhooks.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.ListWebhooks(ctx)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetWebhook(ctx context.Context, id uint64) (*md.Webhook, error) {
+	const op = "webhook.GetWebhook.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.GetWebhook(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreateWebhook(ctx context.Context, req *md.Webhook) (*dto.CreateWebhookResponse, error) {
+	const op = "webhook.CreateWebhook.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	id, err := c.repo.CreateWebhook(ctx, req)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.String("url", req.URL),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &dto.CreateWebhookResponse{ID: id}, nil
+}
+
+func (c *Controller) UpdateWebhook(ctx context.Context, id uint64, req *md.Webhook) error {
+	const op = "webhook.UpdateWebhook.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.UpdateWebhook(ctx, id, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id), zap.String("url", req.URL),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (c *Controller) DeleteWebhook(ctx context.Context, id uint64) error {
+	const op = "webhook.DeleteWebhook.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.DeleteWebhook(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries returns a page of the webhook's delivery log, newest
+// first. An unknown webhook is reported as missing rather than as empty.
+func (c *Controller) ListWebhookDeliveries(ctx context.Context, f *md.WebhookDeliveryFilter) (*dto.PaginatedWebhookDeliveries, error) {
+	const op = "webhook.ListWebhookDeliveries.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if _, err := c.GetWebhook(ctx, f.WebhookID); err != nil {
+		return nil, err
+	}
+
+	if f.Page <= 0 {
+		f.Page = config.DefaultPage
+	}
+	if f.Size <= 0 {
+		f.Size = config.DefaultSize
+	}
+
+	res, err := c.repo.ListWebhookDeliveries(ctx, f)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", f.WebhookID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	totalPages := int((res.Total + int64(f.Size) - 1) / int64(f.Size))
+	return &dto.PaginatedWebhookDeliveries{
+		Data:        res.Deliveries,
+		Count:       res.Total,
+		TotalPages:  totalPages,
+		CurrentPage: f.Page,
+		HasNextPage: f.Page < totalPages,
+	}, nil
+}
+
+func (c *Controller) RetryWebhookDelivery(ctx context.Context, id uint64) error {
+	const op = "webhook.RetryWebhookDelivery.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.RetryWebhookDelivery(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug(
+			ErrNotFound.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Uint64("id", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/dto"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_GetWebhook(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	id := uint64(1)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			expected := &model.Webhook{ID: id, URL: "https://example.com", Events: []string{model.EventAll}}
+			mockRepo.EXPECT().GetWebhook(gomock.Any(), id).Return(expected, nil).Times(1)
+
+			res, err := ctrl.GetWebhook(ctx, id)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().GetWebhook(gomock.Any(), id).Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.GetWebhook(ctx, id)
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockRepo.EXPECT().GetWebhook(gomock.Any(), id).Return(nil, newErr).Times(1)
+
+			res, err := ctrl.GetWebhook(ctx, id)
+			assert.Nil(t, res)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
+
+func TestController_CreateWebhook(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	req := &model.Webhook{URL: "https://example.com", Secret: "0123456789abcdef", Events: []string{model.EventSEOUpdated}}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().CreateWebhook(gomock.Any(), req).Return(uint64(3), nil).Times(1)
+
+			res, err := ctrl.CreateWebhook(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, &dto.CreateWebhookResponse{ID: 3}, res)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockRepo.EXPECT().CreateWebhook(gomock.Any(), req).Return(uint64(0), newErr).Times(1)
+
+			res, err := ctrl.CreateWebhook(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
+
+func TestController_DeleteWebhook(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	id := uint64(1)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().DeleteWebhook(gomock.Any(), id).Return(nil).Times(1)
+			assert.Nil(t, ctrl.DeleteWebhook(ctx, id))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().DeleteWebhook(gomock.Any(), id).Return(repo.ErrNotFound).Times(1)
+			assert.Equal(t, ErrNotFound, ctrl.DeleteWebhook(ctx, id))
+		},
+	)
+}
+
+func TestController_ListWebhookDeliveries(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	id := uint64(1)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			f := &model.WebhookDeliveryFilter{WebhookID: id, Size: 2}
+			mockRepo.EXPECT().GetWebhook(gomock.Any(), id).Return(&model.Webhook{ID: id}, nil).Times(1)
+			mockRepo.EXPECT().
+				ListWebhookDeliveries(gomock.Any(), f).
+				Return(&model.WebhookDeliveryList{Deliveries: []*model.WebhookDelivery{{ID: 2}, {ID: 1}}, Total: 3}, nil).
+				Times(1)
+
+			res, err := ctrl.ListWebhookDeliveries(ctx, f)
+			assert.Nil(t, err)
+			assert.Len(t, res.Data, 2)
+			assert.Equal(t, 2, res.TotalPages)
+			assert.Equal(t, 1, res.CurrentPage)
+			assert.True(t, res.HasNextPage)
+		},
+	)
+
+	t.Run(
+		"Unknown webhook", func(t *testing.T) {
+			mockRepo.EXPECT().GetWebhook(gomock.Any(), id).Return(nil, repo.ErrNotFound).Times(1)
+
+			res, err := ctrl.ListWebhookDeliveries(ctx, &model.WebhookDeliveryFilter{WebhookID: id})
+			assert.Nil(t, res)
+			assert.Equal(t, ErrNotFound, err)
+		},
+	)
+}
+
+func TestController_RetryWebhookDelivery(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(mockRepo, mockCache, &config.Config{})
+	id := uint64(5)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockRepo.EXPECT().RetryWebhookDelivery(gomock.Any(), id).Return(nil).Times(1)
+			assert.Nil(t, ctrl.RetryWebhookDelivery(ctx, id))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockRepo.EXPECT().RetryWebhookDelivery(gomock.Any(), id).Return(repo.ErrNotFound).Times(1)
+			assert.Equal(t, ErrNotFound, ctrl.RetryWebhookDelivery(ctx, id))
+		},
+	)
+}
@@ -0,0 +1,202 @@
+package ctrl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const defaultWebhookInterval = 5 * time.Second
+const defaultWebhookTimeout = 10 * time.Second
+const defaultWebhookBackoff = 30 * time.Second
+const defaultWebhookMaxBackoff = 6 * time.Hour
+const defaultWebhookMaxAttempts = 10
+const defaultWebhookBatch = 50
+
+const maxWebhookError = 1024
+const maxWebhookResponse = 64 << 10
+
+// webhookConf returns the delivery settings with defaults filled in.
+func (c *Controller) webhookConf() config.WebhooksConfig {
+	res := config.WebhooksConfig{
+		Interval:    defaultWebhookInterval,
+		Timeout:     defaultWebhookTimeout,
+		Backoff:     defaultWebhookBackoff,
+		MaxBackoff:  defaultWebhookMaxBackoff,
+		MaxAttempts: defaultWebhookMaxAttempts,
+		Batch:       defaultWebhookBatch,
+	}
+
+	if c.conf == nil || c.conf.Webhooks == nil {
+		return res
+	}
+	conf := c.conf.Webhooks
+	if conf.Interval > 0 {
+		res.Interval = conf.Interval
+	}
+	if conf.Timeout > 0 {
+		res.Timeout = conf.Timeout
+	}
+	if conf.Backoff > 0 {
+		res.Backoff = conf.Backoff
+	}
+	if conf.MaxBackoff > 0 {
+		res.MaxBackoff = conf.MaxBackoff
+	}
+	if conf.MaxAttempts > 0 {
+		res.MaxAttempts = conf.MaxAttempts
+	}
+	if conf.Batch > 0 {
+		res.Batch = conf.Batch
+	}
+	return res
+}
+
+// RunWebhooks drains the delivery queue every interval until ctx is
+// cancelled. A full batch is followed by another one straight away.
+func (c *Controller) RunWebhooks(ctx context.Context) {
+	conf := c.webhookConf()
+	ticker := time.NewTicker(conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				n, err := c.DeliverWebhooks(ctx)
+				if err != nil {
+					zap.L().Warn("failed to deliver webhooks", zap.Error(err))
+				}
+				if err != nil || n < conf.Batch || ctx.Err() != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+// DeliverWebhooks claims one batch of due deliveries, sends them concurrently
+// and records each outcome. It returns the number of deliveries attempted.
+func (c *Controller) DeliverWebhooks(ctx context.Context) (int, error) {
+	const op = "webhook.DeliverWebhooks.ctrl"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	conf := c.webhookConf()
+	// The lease outlives the slowest send, so a claimed delivery is only
+	// picked up again if this worker died before recording the outcome.
+	deliveries, err := c.repo.ClaimWebhookDeliveries(ctx, time.Now(), 2*conf.Timeout, conf.Batch)
+	if err != nil {
+		zap.L().Debug(
+			ErrInternal.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, d := range deliveries {
+		wg.Add(1)
+		go func(d *md.WebhookDelivery) {
+			defer wg.Done()
+			c.deliverWebhook(ctx, d, &conf)
+		}(d)
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// deliverWebhook POSTs the payload signed with the webhook secret. Any 2xx
+// answer marks it delivered; otherwise it is rescheduled with exponential
+// backoff until the attempts run out.
+func (c *Controller) deliverWebhook(ctx context.Context, d *md.WebhookDelivery, conf *config.WebhooksConfig) {
+	const op = "webhook.deliverWebhook.ctrl"
+
+	d.Attempts++
+	code, err := c.sendWebhook(ctx, d, conf.Timeout)
+	d.ResponseCode = code
+
+	now := time.Now()
+	d.NextAttemptAt = now
+	switch {
+	case err == nil:
+		d.Status = md.DeliveryDelivered
+		d.LastError = ""
+		d.DeliveredAt = &now
+	case d.Attempts >= conf.MaxAttempts:
+		d.Status = md.DeliveryFailed
+		d.LastError = truncate(err.Error(), maxWebhookError)
+	default:
+		d.Status = md.DeliveryPending
+		d.LastError = truncate(err.Error(), maxWebhookError)
+		d.NextAttemptAt = now.Add(webhookBackoff(d.Attempts, conf.Backoff, conf.MaxBackoff))
+	}
+
+	if err = c.repo.FinishWebhookDelivery(ctx, d); err != nil {
+		zap.L().Warn(
+			"failed to record webhook delivery",
+			zap.String("op", op),
+			zap.Uint64("id", d.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+func (c *Controller) sendWebhook(ctx context.Context, d *md.WebhookDelivery, timeout time.Duration) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	ts := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Event", d.Event)
+	req.Header.Set("X-Webhook-Delivery", strconv.FormatUint(d.ID, 10))
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(ts, 10))
+	req.Header.Set("X-Webhook-Signature", "sha256="+md.SignWebhook(d.Secret, ts, d.Payload))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxWebhookResponse))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// webhookBackoff is base doubled for every attempt after the first, capped
+// at limit.
+func webhookBackoff(attempt int, base, limit time.Duration) time.Duration {
+	res := base
+	for i := 1; i < attempt && res < limit; i++ {
+		res *= 2
+	}
+	return min(res, limit)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
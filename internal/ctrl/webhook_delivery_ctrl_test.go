@@ -0,0 +1,151 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/config"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestController_DeliverWebhooks(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockRepo := mocks.NewMockAppRepo(ctrlMock)
+	mockCache := mocks.NewMockCacheService(ctrlMock)
+
+	ctx := context.Background()
+	ctrl := New(
+		mockRepo, mockCache, &config.Config{
+			Webhooks: &config.WebhooksConfig{Timeout: time.Second, Backoff: time.Minute, MaxAttempts: 3, Batch: 10},
+		},
+	)
+
+	secret := "0123456789abcdef"
+	payload := []byte(`{"event":"seo.updated","data":{}}`)
+	code := http.StatusNoContent
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				ts, _ := strconv.ParseInt(r.Header.Get("X-Webhook-Timestamp"), 10, 64)
+				assert.Equal(t, payload, body)
+				assert.Equal(t, model.EventSEOUpdated, r.Header.Get("X-Webhook-Event"))
+				assert.Equal(t, "7", r.Header.Get("X-Webhook-Delivery"))
+				assert.Equal(t, "sha256="+model.SignWebhook(secret, ts, body), r.Header.Get("X-Webhook-Signature"))
+				w.WriteHeader(code)
+			},
+		),
+	)
+	defer srv.Close()
+
+	claim := func(attempts int) {
+		mockRepo.EXPECT().
+			ClaimWebhookDeliveries(gomock.Any(), gomock.Any(), 2*time.Second, 10).
+			Return(
+				[]*model.WebhookDelivery{
+					{
+						ID: 7, WebhookID: 1, Event: model.EventSEOUpdated, Payload: payload,
+						Attempts: attempts, URL: srv.URL, Secret: secret,
+					},
+				}, nil,
+			).
+			Times(1)
+	}
+
+	t.Run(
+		"Delivered", func(t *testing.T) {
+			code = http.StatusNoContent
+			claim(0)
+			mockRepo.EXPECT().
+				FinishWebhookDelivery(gomock.Any(), gomock.Any()).
+				DoAndReturn(
+					func(_ context.Context, d *model.WebhookDelivery) error {
+						assert.Equal(t, model.DeliveryDelivered, d.Status)
+						assert.Equal(t, 1, d.Attempts)
+						assert.Equal(t, http.StatusNoContent, d.ResponseCode)
+						assert.NotNil(t, d.DeliveredAt)
+						return nil
+					},
+				).
+				Times(1)
+
+			n, err := ctrl.DeliverWebhooks(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, n)
+		},
+	)
+
+	t.Run(
+		"Rescheduled with backoff", func(t *testing.T) {
+			code = http.StatusServiceUnavailable
+			claim(1)
+			mockRepo.EXPECT().
+				FinishWebhookDelivery(gomock.Any(), gomock.Any()).
+				DoAndReturn(
+					func(_ context.Context, d *model.WebhookDelivery) error {
+						assert.Equal(t, model.DeliveryPending, d.Status)
+						assert.Equal(t, 2, d.Attempts)
+						assert.Equal(t, http.StatusServiceUnavailable, d.ResponseCode)
+						assert.Contains(t, d.LastError, "503")
+						assert.WithinDuration(t, time.Now().Add(2*time.Minute), d.NextAttemptAt, 5*time.Second)
+						return nil
+					},
+				).
+				Times(1)
+
+			_, err := ctrl.DeliverWebhooks(ctx)
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"Failed after last attempt", func(t *testing.T) {
+			code = http.StatusInternalServerError
+			claim(2)
+			mockRepo.EXPECT().
+				FinishWebhookDelivery(gomock.Any(), gomock.Any()).
+				DoAndReturn(
+					func(_ context.Context, d *model.WebhookDelivery) error {
+						assert.Equal(t, model.DeliveryFailed, d.Status)
+						assert.Equal(t, 3, d.Attempts)
+						assert.Nil(t, d.DeliveredAt)
+						return nil
+					},
+				).
+				Times(1)
+
+			_, err := ctrl.DeliverWebhooks(ctx)
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("some error")
+			mockRepo.EXPECT().ClaimWebhookDeliveries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, newErr).Times(1)
+
+			n, err := ctrl.DeliverWebhooks(ctx)
+			assert.Zero(t, n)
+			assert.Equal(t, newErr, err)
+		},
+	)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	base, limit := 30*time.Second, 10*time.Minute
+	assert.Equal(t, 30*time.Second, webhookBackoff(1, base, limit))
+	assert.Equal(t, time.Minute, webhookBackoff(2, base, limit))
+	assert.Equal(t, 8*time.Minute, webhookBackoff(5, base, limit))
+	assert.Equal(t, limit, webhookBackoff(6, base, limit))
+	assert.Equal(t, limit, webhookBackoff(100, base, limit))
+}
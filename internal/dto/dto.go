@@ -1,10 +1,164 @@
 package dto
 
+import md "github.com/JMURv/seo/internal/models"
+
 type CreatePageResponse struct {
 	Slug string `json:"slug"`
 }
 
+// PageNode is a page in the navigation tree with its children ordered by
+// position.
+type PageNode struct {
+	Slug     string      `json:"slug"`
+	Title    string      `json:"title"`
+	Href     string      `json:"href"`
+	Position int         `json:"position"`
+	Status   string      `json:"status"`
+	Children []*PageNode `json:"children"`
+}
+
+type Breadcrumb struct {
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+	Href  string `json:"href"`
+	URL   string `json:"url"`
+}
+
+// Breadcrumbs is the chain from the root page down to the requested one,
+// together with its schema.org BreadcrumbList.
+type Breadcrumbs struct {
+	Items  []*Breadcrumb  `json:"items"`
+	JSONLD map[string]any `json:"json_ld"`
+}
+
+// ResolvePathResponse is the page behind a public path and its SEO record, if
+// one exists.
+type ResolvePathResponse struct {
+	Page *md.Page `json:"page"`
+	SEO  *md.SEO  `json:"seo"`
+}
+
 type CreateSEOResponse struct {
-	Name string `json:"name"`
-	PK   string `json:"pk"`
+	Name   string `json:"name"`
+	PK     string `json:"pk"`
+	Locale string `json:"locale"`
+}
+
+type SEOAlternate struct {
+	Hreflang string `json:"hreflang"`
+	Href     string `json:"href"`
+}
+
+type CreateRobotsGroupResponse struct {
+	ID uint64 `json:"id"`
+}
+
+type CreateRobotsSitemapResponse struct {
+	ID uint64 `json:"id"`
+}
+
+type RobotsTestResponse struct {
+	Allowed   bool           `json:"allowed"`
+	UserAgent string         `json:"user_agent"`
+	Rule      *md.RobotsRule `json:"rule,omitempty"`
+}
+
+type CreateRedirectResponse struct {
+	ID    uint64   `json:"id"`
+	Chain []string `json:"chain,omitempty"`
+}
+
+type CreateWebhookResponse struct {
+	ID uint64 `json:"id"`
+}
+
+type PaginatedWebhookDeliveries struct {
+	Data        []*md.WebhookDelivery `json:"data"`
+	Count       int64                 `json:"count"`
+	TotalPages  int                   `json:"total_pages"`
+	CurrentPage int                   `json:"current_page"`
+	HasNextPage bool                  `json:"has_next_page"`
+}
+
+type ResolveRedirectResponse struct {
+	Source string   `json:"source"`
+	Target string   `json:"target"`
+	Code   int      `json:"code"`
+	Hops   int      `json:"hops"`
+	Chain  []string `json:"chain"`
+}
+
+type FieldDiff struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+type PaginatedPages struct {
+	Data        []*md.Page `json:"data"`
+	Count       int64      `json:"count"`
+	TotalPages  int        `json:"total_pages"`
+	CurrentPage int        `json:"current_page"`
+	HasNextPage bool       `json:"has_next_page"`
+	NextCursor  string     `json:"next_cursor,omitempty"`
+}
+
+type PaginatedSEO struct {
+	Data        []*md.SEO `json:"data"`
+	Count       int64     `json:"count"`
+	TotalPages  int       `json:"total_pages"`
+	CurrentPage int       `json:"current_page"`
+	HasNextPage bool      `json:"has_next_page"`
+	NextCursor  string    `json:"next_cursor,omitempty"`
+}
+
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+type ImportSEOResponse struct {
+	DryRun  bool             `json:"dry_run"`
+	Total   int              `json:"total"`
+	Created int              `json:"created"`
+	Updated int              `json:"updated"`
+	Skipped int              `json:"skipped"`
+	Errors  []ImportRowError `json:"errors,omitempty"`
+}
+
+type AuditViolation struct {
+	Rule    string `json:"rule"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+	Weight  int    `json:"weight"`
+}
+
+// SEOAudit scores one record: 100 minus the weights of violated rules, not
+// below 0.
+type SEOAudit struct {
+	OBJName    string            `json:"obj_name"`
+	OBJPK      string            `json:"obj_pk"`
+	Locale     string            `json:"locale"`
+	Score      int               `json:"score"`
+	Violations []*AuditViolation `json:"violations"`
+}
+
+type AuditObjectReport struct {
+	OBJName    string         `json:"obj_name"`
+	Records    int            `json:"records"`
+	Score      float64        `json:"score"`
+	MinScore   int            `json:"min_score"`
+	Violations map[string]int `json:"violations"`
+}
+
+type AuditReport struct {
+	Records    int                  `json:"records"`
+	Score      float64              `json:"score"`
+	Violations map[string]int       `json:"violations"`
+	Objects    []*AuditObjectReport `json:"objects"`
+}
+
+type SaveSEOTemplateResponse struct {
+	Created bool `json:"created"`
 }
@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	ctrl "github.com/JMURv/seo/internal/ctrl"
+	hdl "github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	utils "github.com/JMURv/seo/internal/models/mapper"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (h *Handler) AuditSEO(ctx context.Context, req *pb.GetSEOReq) (*pb.SEOAuditMsg, error) {
+	const op = "seo.AuditSEO.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Name == "" || req.Pk == "" {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	if err := validation.ValidateLocale(req.Locale); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	res, err := h.ctrl.AuditSEO(ctx, req.Name, req.Pk, req.Locale)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.SEOAuditToProto(res), nil
+}
+
+func (h *Handler) AuditReport(ctx context.Context, req *pb.AuditReportReq) (*pb.AuditReportRes, error) {
+	const op = "seo.AuditReport.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.AuditReport(ctx, req.ObjName)
+	if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.AuditReportToProto(res), nil
+}
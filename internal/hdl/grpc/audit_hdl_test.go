@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_AuditSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				AuditSEO(gomock.Any(), "name", "pk", "").
+				Return(
+					&dto.SEOAudit{
+						Score:      80,
+						Violations: []*dto.AuditViolation{{Rule: model.AuditMissingOGImage, Weight: 20}},
+					}, nil,
+				).
+				Times(1)
+
+			res, err := h.AuditSEO(ctx, &pb.GetSEOReq{Name: "name", Pk: "pk"})
+			assert.Nil(t, err)
+			assert.Equal(t, int32(80), res.Score)
+			assert.Equal(t, model.AuditMissingOGImage, res.Violations[0].Rule)
+		},
+	)
+
+	t.Run(
+		"Invalid request", func(t *testing.T) {
+			_, err := h.AuditSEO(ctx, &pb.GetSEOReq{Name: "name"})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Not found", func(t *testing.T) {
+			mockCtrl.EXPECT().AuditSEO(gomock.Any(), "name", "pk", "").Return(nil, ctrl.ErrNotFound).Times(1)
+
+			_, err := h.AuditSEO(ctx, &pb.GetSEOReq{Name: "name", Pk: "pk"})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().AuditSEO(gomock.Any(), "name", "pk", "").Return(nil, errors.New("err")).Times(1)
+
+			_, err := h.AuditSEO(ctx, &pb.GetSEOReq{Name: "name", Pk: "pk"})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_AuditReport(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				AuditReport(gomock.Any(), "product").
+				Return(
+					&dto.AuditReport{
+						Records:    2,
+						Score:      90,
+						Violations: map[string]int{model.AuditTitleLength: 1},
+						Objects:    []*dto.AuditObjectReport{{OBJName: "product", Records: 2, Score: 90}},
+					}, nil,
+				).
+				Times(1)
+
+			res, err := h.AuditReport(ctx, &pb.AuditReportReq{ObjName: "product"})
+			assert.Nil(t, err)
+			assert.Equal(t, int32(1), res.Violations[model.AuditTitleLength])
+			assert.Equal(t, "product", res.Objects[0].ObjName)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().AuditReport(gomock.Any(), "").Return(nil, errors.New("err")).Times(1)
+
+			_, err := h.AuditReport(ctx, &pb.AuditReportReq{})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
@@ -1 +1,35 @@
 package grpc
+
+import (
+	"errors"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validationError reports err as InvalidArgument, attaching field-level
+// violations as BadRequest details when validation produced them.
+func validationError(err error) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+
+	var fields validation.FieldErrors
+	if !errors.As(err, &fields) {
+		return st.Err()
+	}
+
+	br := &errdetails.BadRequest{}
+	for _, v := range fields {
+		br.FieldViolations = append(
+			br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Message,
+			},
+		)
+	}
+
+	if detailed, err := st.WithDetails(br); err == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
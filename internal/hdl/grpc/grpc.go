@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/JMURv/seo/api/grpc/v1/gen"
@@ -15,13 +16,18 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"net"
+	"net/http"
+	"sync"
 )
 
 type Handler struct {
 	gen.SEOServer
 	gen.PageServer
+	gen.RedirectServer
 	srv  *grpc.Server
 	hsrv *health.Server
+	mu   sync.Mutex
+	lis  net.Listener
 	ctrl ctrl.AppCtrl
 }
 
@@ -34,6 +40,7 @@ func New(name string, ctrl ctrl.AppCtrl, sso sso.SSOSvc) *Handler {
 			),
 		),
 		grpc.ChainStreamInterceptor(
+			interceptors.AuthStreamInterceptor(sso),
 			metrics.SrvMetrics.StreamServerInterceptor(
 				pm.WithExemplarFromContext(metrics.Exemplar),
 			),
@@ -43,22 +50,27 @@ func New(name string, ctrl ctrl.AppCtrl, sso sso.SSOSvc) *Handler {
 	reflection.Register(srv)
 	hsrv := health.NewServer()
 	hsrv.SetServingStatus(name, grpc_health_v1.HealthCheckResponse_SERVING)
-	return &Handler{
+	h := &Handler{
 		ctrl: ctrl,
 		srv:  srv,
 		hsrv: hsrv,
 	}
-}
 
-func (h *Handler) Start(port int) {
 	gen.RegisterSEOServer(h.srv, h)
 	gen.RegisterPageServer(h.srv, h)
+	gen.RegisterRedirectServer(h.srv, h)
 	grpc_health_v1.RegisterHealthServer(h.srv, h.hsrv)
+	return h
+}
 
+func (h *Handler) Start(port int) {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", port))
 	if err != nil {
 		zap.L().Fatal("failed to listen", zap.Error(err))
 	}
+	h.mu.Lock()
+	h.lis = lis
+	h.mu.Unlock()
 
 	zap.L().Info(
 		"Starting GRPC server",
@@ -69,7 +81,35 @@ func (h *Handler) Start(port int) {
 	}
 }
 
-func (h *Handler) Close() error {
-	h.srv.GracefulStop()
+// ServeHTTP serves gRPC calls that arrive through an HTTP/2 server, which is
+// how the HTTP handler shares its port with gRPC.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.srv.ServeHTTP(w, r)
+}
+
+// Close lets in-flight calls finish until ctx is done and then cuts the rest
+// off. Calls served through ServeHTTP cannot be drained by gRPC itself; they
+// are expected to be wound down by the HTTP server first.
+func (h *Handler) Close(ctx context.Context) error {
+	h.hsrv.Shutdown()
+	h.mu.Lock()
+	lis := h.lis
+	h.mu.Unlock()
+	if lis == nil {
+		h.srv.Stop()
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		h.srv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		h.srv.Stop()
+	}
 	return nil
 }
@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	utils "github.com/JMURv/seo/internal/models/mapper"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (h *Handler) GetSEOHead(ctx context.Context, req *pb.GetSEOReq) (*pb.SEOHeadRes, error) {
+	const op = "seo.GetSEOHead.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Name == "" || req.Pk == "" {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	if err := validation.ValidateLocale(req.Locale); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	if err := validation.ValidateTemplateVars(req.Vars); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	var vars map[string]string
+	if req.Generate || len(req.Vars) > 0 {
+		vars = make(map[string]string, len(req.Vars))
+		for k, v := range req.Vars {
+			vars[k] = v
+		}
+	}
+
+	res, err := h.ctrl.GetSEOHead(hdl.Preview(ctx, req.Preview), req.Name, req.Pk, req.Locale, vars)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.HeadToProto(res), nil
+}
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/ctrl"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_GetSEOHead(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	req := &pb.GetSEOReq{Name: "product", Pk: "42"}
+	tags := []model.HeadTag{
+		{Tag: "title", Text: "A & B"},
+		{Tag: "link", Rel: "canonical", Href: "https://example.com/product/42"},
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOHead(gomock.Any(), "product", "42", "", nil).Return(tags, nil).Times(1)
+
+			res, err := h.GetSEOHead(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, "<title>A &amp; B</title>\n<link rel=\"canonical\" href=\"https://example.com/product/42\">\n", res.Html)
+			assert.Len(t, res.Tags, 2)
+			assert.Equal(t, "canonical", res.Tags[1].Rel)
+		},
+	)
+
+	t.Run(
+		"Generate without vars", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetSEOHead(gomock.Any(), "product", "42", "", map[string]string{}).
+				Return(tags, nil).
+				Times(1)
+
+			_, err := h.GetSEOHead(ctx, &pb.GetSEOReq{Name: "product", Pk: "42", Generate: true})
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"Missing pk", func(t *testing.T) {
+			res, err := h.GetSEOHead(ctx, &pb.GetSEOReq{Name: "product"})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOHead(gomock.Any(), "product", "42", "", nil).Return(nil, ctrl.ErrNotFound).Times(1)
+
+			res, err := h.GetSEOHead(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Internal Error", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOHead(gomock.Any(), "product", "42", "", nil).Return(nil, errors.New("err")).Times(1)
+
+			res, err := h.GetSEOHead(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
@@ -10,29 +10,55 @@ import (
 
 func AuthUnaryInterceptor(sso sso.SSOSvc) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			zap.L().Debug("missing metadata")
-			return handler(ctx, req)
+		ctx, err := authenticate(ctx, sso)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		authHeaders := md["authorization"]
-		if len(authHeaders) == 0 {
-			zap.L().Debug("missing authorization token")
-			return handler(ctx, req)
+func AuthStreamInterceptor(sso sso.SSOSvc) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), sso)
+		if err != nil {
+			return err
 		}
+		return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		tokenStr := authHeaders[0]
-		if len(tokenStr) > 7 && tokenStr[:7] == "Bearer " {
-			tokenStr = tokenStr[7:]
-		}
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		uid, err := sso.ParseClaims(ctx, tokenStr)
-		if err != nil {
-			return nil, err
-		}
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
 
-		ctx = context.WithValue(ctx, "uid", uid)
-		return handler(ctx, req)
+func authenticate(ctx context.Context, sso sso.SSOSvc) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		zap.L().Debug("missing metadata")
+		return ctx, nil
+	}
+
+	authHeaders := md["authorization"]
+	if len(authHeaders) == 0 {
+		zap.L().Debug("missing authorization token")
+		return ctx, nil
 	}
+
+	tokenStr := authHeaders[0]
+	if len(tokenStr) > 7 && tokenStr[:7] == "Bearer " {
+		tokenStr = tokenStr[7:]
+	}
+
+	uid, err := sso.ParseClaims(ctx, tokenStr)
+	if err != nil {
+		return ctx, err
+	}
+
+	return context.WithValue(ctx, "uid", uid), nil
 }
@@ -15,7 +15,7 @@ import (
 	"time"
 )
 
-func (h *Handler) ListPages(ctx context.Context, req *pb.EmptySEO) (*pb.ListPageRes, error) {
+func (h *Handler) ListPages(ctx context.Context, req *pb.ListPagesReq) (*pb.ListPageRes, error) {
 	const op = "page.ListPages.hdl"
 	s, c := time.Now(), codes.OK
 	span, ctx := ot.StartSpanFromContext(ctx, op)
@@ -29,15 +29,22 @@ func (h *Handler) ListPages(ctx context.Context, req *pb.EmptySEO) (*pb.ListPage
 		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
 	}
 
-	res, err := h.ctrl.ListPages(ctx)
-	if err != nil {
+	f := utils.ProtoToPageFilter(req)
+	if err := validation.ValidatePageFilter(f); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	res, err := h.ctrl.ListPages(hdl.Preview(ctx, req.Preview), f)
+	if err != nil && errors.Is(err, ctrl.ErrInvalidCursor) {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
 		span.SetTag("error", true)
 		c = codes.Internal
 		return nil, status.Errorf(c, hdl.ErrInternal.Error())
 	}
-	return &pb.ListPageRes{
-		Pages: utils.PagesToProto(res),
-	}, nil
+	return utils.PaginatedPagesToProto(res), nil
 }
 
 func (h *Handler) GetPage(ctx context.Context, req *pb.SlugSEO) (*pb.PageMsg, error) {
@@ -54,7 +61,7 @@ func (h *Handler) GetPage(ctx context.Context, req *pb.SlugSEO) (*pb.PageMsg, er
 		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
 	}
 
-	res, err := h.ctrl.GetPage(ctx, req.Slug)
+	res, err := h.ctrl.GetPage(hdl.Preview(ctx, req.Preview), req.Slug)
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = codes.NotFound
 		return nil, status.Errorf(c, err.Error())
@@ -90,6 +97,12 @@ func (h *Handler) CreatePage(ctx context.Context, req *pb.PageMsg) (*pb.SlugSEO,
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = codes.NotFound
 		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrParentNotFound) {
+		c = codes.FailedPrecondition
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrDuplicateHref) {
+		c = codes.AlreadyExists
+		return nil, status.Errorf(c, err.Error())
 	} else if err != nil {
 		span.SetTag("error", true)
 		c = codes.Internal
@@ -124,6 +137,15 @@ func (h *Handler) UpdatePage(ctx context.Context, req *pb.PageWithSlugMsg) (*pb.
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = codes.NotFound
 		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrPageCycle) {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrParentNotFound) {
+		c = codes.FailedPrecondition
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrDuplicateHref) {
+		c = codes.AlreadyExists
+		return nil, status.Errorf(c, err.Error())
 	} else if err != nil {
 		span.SetTag("error", true)
 		c = codes.Internal
@@ -146,10 +168,18 @@ func (h *Handler) DeletePage(ctx context.Context, req *pb.SlugSEO) (*pb.EmptySEO
 		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
 	}
 
-	err := h.ctrl.DeletePage(ctx, req.Slug)
+	if err := validation.ValidatePageDeleteStrategy(req.Strategy); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	err := h.ctrl.DeletePage(ctx, req.Slug, req.Strategy)
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = codes.NotFound
 		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrHasChildren) {
+		c = codes.FailedPrecondition
+		return nil, status.Errorf(c, err.Error())
 	} else if err != nil {
 		span.SetTag("error", true)
 		c = codes.Internal
@@ -157,3 +187,87 @@ func (h *Handler) DeletePage(ctx context.Context, req *pb.SlugSEO) (*pb.EmptySEO
 	}
 	return &pb.EmptySEO{}, nil
 }
+
+func (h *Handler) GetPageTree(ctx context.Context, req *pb.PageTreeReq) (*pb.PageTreeRes, error) {
+	const op = "page.GetPageTree.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.GetPageTree(hdl.Preview(ctx, req.Preview))
+	if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.PageTreeRes{Nodes: utils.PageTreeToProto(res)}, nil
+}
+
+func (h *Handler) GetBreadcrumbs(ctx context.Context, req *pb.SlugSEO) (*pb.BreadcrumbsRes, error) {
+	const op = "page.GetBreadcrumbs.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.GetBreadcrumbs(hdl.Preview(ctx, req.Preview), req.Slug)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.BreadcrumbsToProto(res), nil
+}
+
+func (h *Handler) ResolvePath(ctx context.Context, req *pb.ResolvePathReq) (*pb.ResolvePathRes, error) {
+	const op = "page.ResolvePath.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	err := validation.ValidateResolvePath(req.Path)
+	if err == nil {
+		err = validation.ValidateLocale(req.Locale)
+	}
+	if err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	res, err := h.ctrl.ResolvePath(hdl.Preview(ctx, req.Preview), req.Path, req.Locale)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.ResolvePathToProto(res), nil
+}
@@ -24,17 +24,38 @@ func TestHandler_ListPages(t *testing.T) {
 	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
 	h := New("", mockCtrl, ssoCtrl)
 
-	var expected []*model.Page
+	expected := &dto.PaginatedPages{Data: []*model.Page{{Slug: "slug"}}, Count: 1, NextCursor: "next"}
 	ctx := context.Background()
-	req := &pb.EmptySEO{}
+	req := &pb.ListPagesReq{}
 
 	t.Run(
 		"Success", func(t *testing.T) {
-			mockCtrl.EXPECT().ListPages(gomock.Any()).Return(expected, nil).Times(1)
+			mockCtrl.EXPECT().ListPages(gomock.Any(), &model.PageFilter{}).Return(expected, nil).Times(1)
 
 			res, err := h.ListPages(ctx, req)
 			assert.Nil(t, err)
-			assert.NotNil(t, res)
+			assert.Len(t, res.Pages, 1)
+			assert.Equal(t, int64(1), res.Count)
+			assert.Equal(t, "next", res.NextCursor)
+		},
+	)
+
+	t.Run(
+		"Invalid filter", func(t *testing.T) {
+			res, err := h.ListPages(ctx, &pb.ListPagesReq{Size: 1000})
+
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrInvalidCursor", func(t *testing.T) {
+			mockCtrl.EXPECT().ListPages(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrInvalidCursor).Times(1)
+
+			res, err := h.ListPages(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
 		},
 	)
 
@@ -50,7 +71,7 @@ func TestHandler_ListPages(t *testing.T) {
 	t.Run(
 		"Internal Error", func(t *testing.T) {
 			newErr := errors.New("new error")
-			mockCtrl.EXPECT().ListPages(gomock.Any()).Return(expected, newErr).Times(1)
+			mockCtrl.EXPECT().ListPages(gomock.Any(), gomock.Any()).Return(nil, newErr).Times(1)
 
 			res, err := h.ListPages(ctx, req)
 			assert.Nil(t, res)
@@ -82,6 +103,21 @@ func TestHandler_GetPage(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"Preview requires auth", func(t *testing.T) {
+			preview := gomock.Cond(func(x any) bool { return ctrl.IsPreview(x.(context.Context)) })
+			mockCtrl.EXPECT().GetPage(gomock.Not(preview), slug).Return(expected, nil).Times(1)
+			mockCtrl.EXPECT().GetPage(preview, slug).Return(expected, nil).Times(1)
+
+			previewReq := &pb.SlugSEO{Slug: slug, Preview: true}
+			_, err := h.GetPage(ctx, previewReq)
+			assert.Nil(t, err)
+
+			_, err = h.GetPage(context.WithValue(ctx, "uid", "uid"), previewReq)
+			assert.Nil(t, err)
+		},
+	)
+
 	t.Run(
 		"InvalidArgument", func(t *testing.T) {
 			req.Slug = ""
@@ -334,7 +370,7 @@ func TestHandler_DeletePage(t *testing.T) {
 	t.Run(
 		"Success", func(t *testing.T) {
 			mockCtrl.EXPECT().
-				DeletePage(gomock.Any(), slug).
+				DeletePage(gomock.Any(), slug, "").
 				Return(nil).
 				Times(1)
 
@@ -356,7 +392,7 @@ func TestHandler_DeletePage(t *testing.T) {
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
 			mockCtrl.EXPECT().
-				DeletePage(gomock.Any(), slug).
+				DeletePage(gomock.Any(), slug, "").
 				Return(ctrl.ErrNotFound).
 				Times(1)
 
@@ -366,11 +402,32 @@ func TestHandler_DeletePage(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"ErrHasChildren", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				DeletePage(gomock.Any(), slug, model.PageDeleteReparent).
+				Return(ctrl.ErrHasChildren).
+				Times(1)
+
+			res, err := h.DeletePage(ctx, &pb.SlugSEO{Slug: slug, Strategy: model.PageDeleteReparent})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Invalid strategy", func(t *testing.T) {
+			res, err := h.DeletePage(ctx, &pb.SlugSEO{Slug: slug, Strategy: "orphan"})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
 	t.Run(
 		"Internal Error", func(t *testing.T) {
 			newErr := errors.New("new error")
 			mockCtrl.EXPECT().
-				DeletePage(gomock.Any(), slug).
+				DeletePage(gomock.Any(), slug, "").
 				Return(newErr).
 				Times(1)
 
@@ -392,3 +449,183 @@ func TestHandler_DeletePage(t *testing.T) {
 	)
 
 }
+
+func TestHandler_GetPageTree(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetPageTree(gomock.Any()).
+				Return(
+					[]*dto.PageNode{
+						{Slug: "home", Children: []*dto.PageNode{{Slug: "about", Position: 1, Children: []*dto.PageNode{}}}},
+					}, nil,
+				).
+				Times(1)
+
+			res, err := h.GetPageTree(ctx, &pb.PageTreeReq{})
+			assert.Nil(t, err)
+			assert.Len(t, res.Nodes, 1)
+			assert.Equal(t, "about", res.Nodes[0].Children[0].Slug)
+			assert.Equal(t, int32(1), res.Nodes[0].Children[0].Position)
+		},
+	)
+
+	t.Run(
+		"Nil req", func(t *testing.T) {
+			res, err := h.GetPageTree(ctx, nil)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Internal Error", func(t *testing.T) {
+			mockCtrl.EXPECT().GetPageTree(gomock.Any()).Return(nil, errors.New("new error")).Times(1)
+
+			res, err := h.GetPageTree(ctx, &pb.PageTreeReq{})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_GetBreadcrumbs(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	req := &pb.SlugSEO{Slug: "chairs"}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetBreadcrumbs(gomock.Any(), "chairs").
+				Return(
+					&dto.Breadcrumbs{
+						Items: []*dto.Breadcrumb{{Slug: "chairs", Title: "Chairs", URL: "https://example.com/chairs"}},
+						JSONLD: map[string]any{
+							"@type": "BreadcrumbList",
+							"itemListElement": []any{
+								map[string]any{"@type": "ListItem", "position": 1, "name": "Chairs"},
+							},
+						},
+					}, nil,
+				).
+				Times(1)
+
+			res, err := h.GetBreadcrumbs(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, "https://example.com/chairs", res.Items[0].Url)
+			assert.Equal(t, "BreadcrumbList", res.JsonLd.AsMap()["@type"])
+		},
+	)
+
+	t.Run(
+		"Missing slug", func(t *testing.T) {
+			res, err := h.GetBreadcrumbs(ctx, &pb.SlugSEO{})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().GetBreadcrumbs(gomock.Any(), "chairs").Return(nil, ctrl.ErrNotFound).Times(1)
+
+			res, err := h.GetBreadcrumbs(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Internal Error", func(t *testing.T) {
+			mockCtrl.EXPECT().GetBreadcrumbs(gomock.Any(), "chairs").Return(nil, errors.New("new error")).Times(1)
+
+			res, err := h.GetBreadcrumbs(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_ResolvePath(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	path := "/catalog/shoes"
+	expected := &dto.ResolvePathResponse{
+		Page: &model.Page{Slug: "shoes", Href: path},
+		SEO:  &model.SEO{Title: "Shoes", OBJName: model.PageOBJName, OBJPK: "shoes"},
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().ResolvePath(gomock.Any(), path, "").Return(expected, nil).Times(1)
+
+			res, err := h.ResolvePath(ctx, &pb.ResolvePathReq{Path: path})
+			assert.Nil(t, err)
+			assert.Equal(t, "shoes", res.Page.Slug)
+			assert.Equal(t, "Shoes", res.Seo.Title)
+		},
+	)
+
+	t.Run(
+		"Without SEO", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				ResolvePath(gomock.Any(), path, "").
+				Return(&dto.ResolvePathResponse{Page: expected.Page}, nil).
+				Times(1)
+
+			res, err := h.ResolvePath(ctx, &pb.ResolvePathReq{Path: path})
+			assert.Nil(t, err)
+			assert.Nil(t, res.Seo)
+		},
+	)
+
+	t.Run(
+		"InvalidArgument", func(t *testing.T) {
+			res, err := h.ResolvePath(ctx, &pb.ResolvePathReq{Path: "catalog"})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().ResolvePath(gomock.Any(), path, "").Return(nil, ctrl.ErrNotFound).Times(1)
+
+			res, err := h.ResolvePath(ctx, &pb.ResolvePathReq{Path: path})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Internal Error", func(t *testing.T) {
+			mockCtrl.EXPECT().ResolvePath(gomock.Any(), path, "").Return(nil, errors.New("new error")).Times(1)
+
+			res, err := h.ResolvePath(ctx, &pb.ResolvePathReq{Path: path})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
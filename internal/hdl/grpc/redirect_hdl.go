@@ -0,0 +1,238 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	ctrl "github.com/JMURv/seo/internal/ctrl"
+	hdl "github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	md "github.com/JMURv/seo/internal/models"
+	utils "github.com/JMURv/seo/internal/models/mapper"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (h *Handler) ListRedirects(ctx context.Context, req *pb.EmptySEO) (*pb.ListRedirectRes, error) {
+	const op = "redirect.ListRedirects.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.ListRedirects(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.ListRedirectRes{
+		Redirects: utils.RedirectsToProto(res),
+	}, nil
+}
+
+func (h *Handler) GetRedirect(ctx context.Context, req *pb.Uuid64SEO) (*pb.RedirectMsg, error) {
+	const op = "redirect.GetRedirect.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Id == 0 {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.GetRedirect(ctx, req.Id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.RedirectToProto(res), nil
+}
+
+func (h *Handler) CreateRedirect(ctx context.Context, req *pb.RedirectMsg) (*pb.CreateRedirectRes, error) {
+	const op = "redirect.CreateRedirect.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	obj := utils.ProtoToRedirect(req)
+	if err := validation.ValidateRedirect(obj); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	res, err := h.ctrl.CreateRedirect(ctx, obj)
+	if err != nil && errors.Is(err, ctrl.ErrRedirectLoop) {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
+		c = codes.AlreadyExists
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.CreateRedirectRes{
+		Id:    res.ID,
+		Chain: res.Chain,
+	}, nil
+}
+
+func (h *Handler) UpdateRedirect(ctx context.Context, req *pb.RedirectMsg) (*pb.EmptySEO, error) {
+	const op = "redirect.UpdateRedirect.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Id == 0 {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	obj := utils.ProtoToRedirect(req)
+	if err := validation.ValidateRedirect(obj); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	err := h.ctrl.UpdateRedirect(ctx, req.Id, obj)
+	if err != nil && errors.Is(err, ctrl.ErrRedirectLoop) {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
+		c = codes.AlreadyExists
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.EmptySEO{}, nil
+}
+
+func (h *Handler) DeleteRedirect(ctx context.Context, req *pb.Uuid64SEO) (*pb.EmptySEO, error) {
+	const op = "redirect.DeleteRedirect.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Id == 0 {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	err := h.ctrl.DeleteRedirect(ctx, req.Id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.EmptySEO{}, nil
+}
+
+func (h *Handler) ResolveRedirect(ctx context.Context, req *pb.ResolveRedirectReq) (*pb.ResolveRedirectRes, error) {
+	const op = "redirect.ResolveRedirect.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Path == "" {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.ResolveRedirect(ctx, req.Path)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrRedirectLoop) {
+		c = codes.FailedPrecondition
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.ResolveRedirectRes{
+		Source: res.Source,
+		Target: res.Target,
+		Code:   int32(res.Code),
+		Hops:   int32(res.Hops),
+		Chain:  res.Chain,
+	}, nil
+}
+
+func (h *Handler) ExportRedirects(ctx context.Context, req *pb.ExportRedirectsReq) (*pb.ExportRedirectsRes, error) {
+	const op = "redirect.ExportRedirects.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	format := req.Format
+	if format == "" {
+		format = md.RedirectFormatNginx
+	}
+
+	res, err := h.ctrl.ExportRedirects(ctx, format)
+	if err != nil && errors.Is(err, ctrl.ErrUnsupportedFormat) {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.ExportRedirectsRes{
+		Content: res,
+	}, nil
+}
@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_CreateRedirect(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	req := &pb.RedirectMsg{Source: "/old", Target: "/new", Code: 301}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				CreateRedirect(gomock.Any(), gomock.Any()).
+				Return(&dto.CreateRedirectResponse{ID: 1, Chain: []string{"/a", "/old", "/new"}}, nil).
+				Times(1)
+
+			res, err := h.CreateRedirect(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, uint64(1), res.Id)
+			assert.Equal(t, []string{"/a", "/old", "/new"}, res.Chain)
+		},
+	)
+
+	t.Run(
+		"Nil req", func(t *testing.T) {
+			res, err := h.CreateRedirect(ctx, nil)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Invalid target", func(t *testing.T) {
+			res, err := h.CreateRedirect(ctx, &pb.RedirectMsg{Source: "/old", Target: "new"})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrRedirectLoop", func(t *testing.T) {
+			mockCtrl.EXPECT().CreateRedirect(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrRedirectLoop).Times(1)
+
+			res, err := h.CreateRedirect(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			mockCtrl.EXPECT().CreateRedirect(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrAlreadyExists).Times(1)
+
+			res, err := h.CreateRedirect(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_GetRedirect(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetRedirect(gomock.Any(), uint64(1)).
+				Return(&model.Redirect{ID: 1, Source: "/old", Target: "/new", Code: 301}, nil).
+				Times(1)
+
+			res, err := h.GetRedirect(ctx, &pb.Uuid64SEO{Id: 1})
+			assert.Nil(t, err)
+			assert.Equal(t, "/new", res.Target)
+		},
+	)
+
+	t.Run(
+		"Missing id", func(t *testing.T) {
+			res, err := h.GetRedirect(ctx, &pb.Uuid64SEO{})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().GetRedirect(gomock.Any(), uint64(1)).Return(nil, ctrl.ErrNotFound).Times(1)
+
+			res, err := h.GetRedirect(ctx, &pb.Uuid64SEO{Id: 1})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_ResolveRedirect(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	req := &pb.ResolveRedirectReq{Path: "/old"}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				ResolveRedirect(gomock.Any(), "/old").
+				Return(&dto.ResolveRedirectResponse{Source: "/old", Target: "/new", Code: 301, Hops: 1, Chain: []string{"/old", "/new"}}, nil).
+				Times(1)
+
+			res, err := h.ResolveRedirect(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, "/new", res.Target)
+			assert.Equal(t, int32(1), res.Hops)
+		},
+	)
+
+	t.Run(
+		"ErrRedirectLoop", func(t *testing.T) {
+			mockCtrl.EXPECT().ResolveRedirect(gomock.Any(), "/old").Return(nil, ctrl.ErrRedirectLoop).Times(1)
+
+			res, err := h.ResolveRedirect(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_ExportRedirects(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Default format", func(t *testing.T) {
+			mockCtrl.EXPECT().ExportRedirects(gomock.Any(), model.RedirectFormatNginx).Return([]byte("map"), nil).Times(1)
+
+			res, err := h.ExportRedirects(ctx, &pb.ExportRedirectsReq{})
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("map"), res.Content)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().ExportRedirects(gomock.Any(), model.RedirectFormatApache).Return(nil, errors.New("err")).Times(1)
+
+			res, err := h.ExportRedirects(ctx, &pb.ExportRedirectsReq{Format: model.RedirectFormatApache})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	ctrl "github.com/JMURv/seo/internal/ctrl"
+	hdl "github.com/JMURv/seo/internal/hdl"
+	utils "github.com/JMURv/seo/internal/models/mapper"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (h *Handler) ListSEORevisions(ctx context.Context, req *pb.GetSEOReq) (*pb.ListSEORevisionsRes, error) {
+	const op = "revision.ListSEORevisions.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Name == "" || req.Pk == "" {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.ListSEORevisions(ctx, req.Name, req.Pk)
+	if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.ListSEORevisionsRes{Revisions: utils.RevisionsToProto(res)}, nil
+}
+
+func (h *Handler) GetSEORevision(ctx context.Context, req *pb.SEORevisionReq) (*pb.SEORevisionMsg, error) {
+	const op = "revision.GetSEORevision.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Name == "" || req.Pk == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.GetSEORevision(ctx, req.Name, req.Pk, req.Id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.RevisionToProto(res), nil
+}
+
+func (h *Handler) DiffSEORevisions(ctx context.Context, req *pb.DiffSEORevisionsReq) (*pb.DiffSEORevisionsRes, error) {
+	const op = "revision.DiffSEORevisions.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Name == "" || req.Pk == "" || req.From == 0 || req.To == 0 {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.DiffSEORevisions(ctx, req.Name, req.Pk, req.From, req.To)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.DiffSEORevisionsRes{Changes: utils.FieldDiffsToProto(res)}, nil
+}
+
+func (h *Handler) RollbackSEO(ctx context.Context, req *pb.SEORevisionReq) (*pb.SEOMsg, error) {
+	const op = "revision.RollbackSEO.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Name == "" || req.Pk == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.RollbackSEO(ctx, req.Name, req.Pk, req.Id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.ModelToProto(res), nil
+}
@@ -0,0 +1,200 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_ListSEORevisions(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				ListSEORevisions(gomock.Any(), "name", "pk").
+				Return([]*model.SEORevision{{ID: 2, Data: &model.SEO{Title: "t"}}, {ID: 1}}, nil).
+				Times(1)
+
+			res, err := h.ListSEORevisions(ctx, &pb.GetSEOReq{Name: "name", Pk: "pk"})
+			assert.Nil(t, err)
+			assert.Len(t, res.Revisions, 2)
+			assert.Equal(t, "t", res.Revisions[0].Data.Title)
+			assert.Nil(t, res.Revisions[1].Data)
+		},
+	)
+
+	t.Run(
+		"InvalidArgument", func(t *testing.T) {
+			res, err := h.ListSEORevisions(ctx, &pb.GetSEOReq{Name: "name"})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Internal", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				ListSEORevisions(gomock.Any(), "name", "pk").
+				Return(nil, errors.New("err")).
+				Times(1)
+
+			res, err := h.ListSEORevisions(ctx, &pb.GetSEOReq{Name: "name", Pk: "pk"})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_GetSEORevision(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	req := &pb.SEORevisionReq{Name: "name", Pk: "pk", Id: 1}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetSEORevision(gomock.Any(), "name", "pk", uint64(1)).
+				Return(&model.SEORevision{ID: 1, Action: model.RevisionCreate}, nil).
+				Times(1)
+
+			res, err := h.GetSEORevision(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, model.RevisionCreate, res.Action)
+		},
+	)
+
+	t.Run(
+		"NotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetSEORevision(gomock.Any(), "name", "pk", uint64(1)).
+				Return(nil, ctrl.ErrNotFound).
+				Times(1)
+
+			res, err := h.GetSEORevision(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"InvalidArgument", func(t *testing.T) {
+			res, err := h.GetSEORevision(ctx, &pb.SEORevisionReq{Name: "name", Pk: "pk"})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_DiffSEORevisions(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	req := &pb.DiffSEORevisionsReq{Name: "name", Pk: "pk", From: 1, To: 2}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				DiffSEORevisions(gomock.Any(), "name", "pk", uint64(1), uint64(2)).
+				Return([]*dto.FieldDiff{{Field: "title", From: "a", To: "b"}, {Field: "OGImageWidth", From: nil, To: 1200.0}}, nil).
+				Times(1)
+
+			res, err := h.DiffSEORevisions(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, res.Changes, 2)
+			assert.Equal(t, "b", res.Changes[0].To.GetStringValue())
+			assert.Equal(t, 1200.0, res.Changes[1].To.GetNumberValue())
+		},
+	)
+
+	t.Run(
+		"NotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				DiffSEORevisions(gomock.Any(), "name", "pk", uint64(1), uint64(2)).
+				Return(nil, ctrl.ErrNotFound).
+				Times(1)
+
+			res, err := h.DiffSEORevisions(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_RollbackSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	req := &pb.SEORevisionReq{Name: "name", Pk: "pk", Id: 3}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				RollbackSEO(gomock.Any(), "name", "pk", uint64(3)).
+				Return(&model.SEO{Title: "old"}, nil).
+				Times(1)
+
+			res, err := h.RollbackSEO(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, "old", res.Title)
+		},
+	)
+
+	t.Run(
+		"NotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				RollbackSEO(gomock.Any(), "name", "pk", uint64(3)).
+				Return(nil, ctrl.ErrNotFound).
+				Times(1)
+
+			res, err := h.RollbackSEO(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Internal", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				RollbackSEO(gomock.Any(), "name", "pk", uint64(3)).
+				Return(nil, errors.New("err")).
+				Times(1)
+
+			res, err := h.RollbackSEO(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
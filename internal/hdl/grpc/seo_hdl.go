@@ -7,6 +7,7 @@ import (
 	ctrl "github.com/JMURv/seo/internal/ctrl"
 	hdl "github.com/JMURv/seo/internal/hdl"
 	"github.com/JMURv/seo/internal/hdl/validation"
+	model "github.com/JMURv/seo/internal/models"
 	utils "github.com/JMURv/seo/internal/models/mapper"
 	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
 	ot "github.com/opentracing/opentracing-go"
@@ -15,6 +16,38 @@ import (
 	"time"
 )
 
+func (h *Handler) ListSEO(ctx context.Context, req *pb.ListSEOReq) (*pb.ListSEORes, error) {
+	const op = "seo.ListSEO.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	f := utils.ProtoToSEOFilter(req)
+	if err := validation.ValidateSEOFilter(f); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	res, err := h.ctrl.ListSEO(hdl.Preview(ctx, req.Preview), f)
+	if err != nil && errors.Is(err, ctrl.ErrInvalidCursor) {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.PaginatedSEOToProto(res), nil
+}
+
 func (h *Handler) GetSEO(ctx context.Context, req *pb.GetSEOReq) (*pb.SEOMsg, error) {
 	const op = "seo.GetSEO.hdl"
 	s, c := time.Now(), codes.OK
@@ -29,7 +62,24 @@ func (h *Handler) GetSEO(ctx context.Context, req *pb.GetSEOReq) (*pb.SEOMsg, er
 		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
 	}
 
-	res, err := h.ctrl.GetSEO(ctx, req.Name, req.Pk)
+	if err := validation.ValidateLocale(req.Locale); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	if err := validation.ValidateTemplateVars(req.Vars); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	var res *model.SEO
+	var err error
+	ctx = hdl.Preview(ctx, req.Preview)
+	if req.Generate || len(req.Vars) > 0 {
+		res, err = h.ctrl.GetSEOWithVars(ctx, req.Name, req.Pk, req.Locale, req.Vars)
+	} else {
+		res, err = h.ctrl.GetSEO(ctx, req.Name, req.Pk, req.Locale)
+	}
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = codes.NotFound
 		return nil, status.Errorf(c, err.Error())
@@ -53,7 +103,7 @@ func (h *Handler) CreateSEO(ctx context.Context, req *pb.SEOMsg) (*pb.CreateSEOR
 	obj := utils.ProtoToModel(req)
 	if err := validation.ValidateSEO(obj); err != nil {
 		c = codes.InvalidArgument
-		return nil, status.Errorf(c, err.Error())
+		return nil, validationError(err)
 	}
 
 	res, err := h.ctrl.CreateSEO(ctx, obj)
@@ -66,8 +116,9 @@ func (h *Handler) CreateSEO(ctx context.Context, req *pb.SEOMsg) (*pb.CreateSEOR
 		return nil, status.Errorf(c, hdl.ErrInternal.Error())
 	}
 	return &pb.CreateSEOResponse{
-		Name: res.Name,
-		Pk:   res.PK,
+		Name:   res.Name,
+		Pk:     res.PK,
+		Locale: res.Locale,
 	}, nil
 }
 
@@ -83,7 +134,7 @@ func (h *Handler) UpdateSEO(ctx context.Context, req *pb.SEOMsg) (*pb.EmptySEO,
 	obj := utils.ProtoToModel(req)
 	if err := validation.ValidateSEO(obj); err != nil {
 		c = codes.InvalidArgument
-		return nil, status.Errorf(c, err.Error())
+		return nil, validationError(err)
 	}
 
 	err := h.ctrl.UpdateSEO(ctx, obj)
@@ -112,7 +163,12 @@ func (h *Handler) DeleteSEO(ctx context.Context, req *pb.GetSEOReq) (*pb.EmptySE
 		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
 	}
 
-	err := h.ctrl.DeleteSEO(ctx, req.Name, req.Pk)
+	if err := validation.ValidateLocale(req.Locale); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	err := h.ctrl.DeleteSEO(ctx, req.Name, req.Pk, req.Locale)
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = codes.NotFound
 		return nil, status.Errorf(c, err.Error())
@@ -123,3 +179,34 @@ func (h *Handler) DeleteSEO(ctx context.Context, req *pb.GetSEOReq) (*pb.EmptySE
 	}
 	return &pb.EmptySEO{}, nil
 }
+
+func (h *Handler) GetSEOAlternates(ctx context.Context, req *pb.GetSEOReq) (*pb.ListAlternatesRes, error) {
+	const op = "seo.GetSEOAlternates.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Name == "" || req.Pk == "" {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.GetSEOAlternates(ctx, req.Name, req.Pk)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil && errors.Is(err, ctrl.ErrUnknownObject) {
+		c = codes.FailedPrecondition
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.ListAlternatesRes{
+		Alternates: utils.AlternatesToProto(res),
+	}, nil
+}
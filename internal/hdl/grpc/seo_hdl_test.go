@@ -11,11 +11,66 @@ import (
 	"github.com/JMURv/seo/tests/mocks"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	"testing"
 )
 
+func TestHandler_ListSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			req := &pb.ListSEOReq{ObjName: "product", PkPrefix: "1", Missing: []string{"og_image"}}
+			mockCtrl.EXPECT().
+				ListSEO(gomock.Any(), &model.SEOFilter{OBJName: "product", PKPrefix: "1", Missing: []string{"og_image"}}).
+				Return(&dto.PaginatedSEO{Data: []*model.SEO{{OBJName: "product", OBJPK: "1"}}, Count: 1}, nil).
+				Times(1)
+
+			res, err := h.ListSEO(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, res.Seo, 1)
+			assert.Equal(t, int64(1), res.Count)
+		},
+	)
+
+	t.Run(
+		"Invalid missing field", func(t *testing.T) {
+			res, err := h.ListSEO(ctx, &pb.ListSEOReq{Missing: []string{"slug"}})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Nil req", func(t *testing.T) {
+			res, err := h.ListSEO(ctx, nil)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Internal Error", func(t *testing.T) {
+			mockCtrl.EXPECT().ListSEO(gomock.Any(), gomock.Any()).Return(nil, errors.New("err")).Times(1)
+
+			res, err := h.ListSEO(ctx, &pb.ListSEOReq{})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
+
 func TestHandler_GetSEO(t *testing.T) {
 	ctrlMock := gomock.NewController(t)
 	defer ctrlMock.Finish()
@@ -31,7 +86,7 @@ func TestHandler_GetSEO(t *testing.T) {
 	t.Run(
 		"Success", func(t *testing.T) {
 			req := &pb.GetSEOReq{Name: "name", Pk: "pk"}
-			mockCtrl.EXPECT().GetSEO(gomock.Any(), name, pk).Return(expectedSEO, nil).Times(1)
+			mockCtrl.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(expectedSEO, nil).Times(1)
 
 			res, err := h.GetSEO(ctx, req)
 			assert.Nil(t, err)
@@ -51,7 +106,7 @@ func TestHandler_GetSEO(t *testing.T) {
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
 			req := &pb.GetSEOReq{Name: "name", Pk: "pk"}
-			mockCtrl.EXPECT().GetSEO(gomock.Any(), name, pk).Return(expectedSEO, ctrl.ErrNotFound).Times(1)
+			mockCtrl.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(expectedSEO, ctrl.ErrNotFound).Times(1)
 
 			res, err := h.GetSEO(ctx, req)
 			assert.Nil(t, res)
@@ -63,7 +118,7 @@ func TestHandler_GetSEO(t *testing.T) {
 		"Internal Error", func(t *testing.T) {
 			req := &pb.GetSEOReq{Name: "name", Pk: "pk"}
 			newErr := errors.New("new error")
-			mockCtrl.EXPECT().GetSEO(gomock.Any(), name, pk).Return(expectedSEO, newErr).Times(1)
+			mockCtrl.EXPECT().GetSEO(gomock.Any(), name, pk, "").Return(expectedSEO, newErr).Times(1)
 
 			res, err := h.GetSEO(ctx, req)
 			assert.Nil(t, res)
@@ -278,6 +333,44 @@ func TestHandler_CreateSEO(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"InvalidArgument - summary_large_image without image size", func(t *testing.T) {
+			invalid := proto.Clone(req).(*pb.SEOMsg)
+			invalid.TwitterCard = "summary_large_image"
+
+			res, err := h.CreateSEO(ctx, invalid)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"InvalidArgument - Invalid JSON-LD", func(t *testing.T) {
+			block, err := structpb.NewStruct(
+				map[string]any{
+					"@context": "https://schema.org",
+					"@type":    "Article",
+					"author":   map[string]any{"@type": "Person"},
+				},
+			)
+			assert.Nil(t, err)
+
+			invalid := proto.Clone(req).(*pb.SEOMsg)
+			invalid.JsonLd = []*structpb.Struct{block}
+
+			res, err := h.CreateSEO(ctx, invalid)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+			details := status.Convert(err).Details()
+			assert.Len(t, details, 1)
+			br, ok := details[0].(*errdetails.BadRequest)
+			assert.True(t, ok)
+			assert.Equal(t, "json_ld[0].headline", br.FieldViolations[0].Field)
+			assert.Equal(t, "json_ld[0].author.name", br.FieldViolations[1].Field)
+		},
+	)
+
 	t.Run(
 		"InvalidArgument - Missing OBJPK", func(t *testing.T) {
 			res, err := h.CreateSEO(
@@ -536,7 +629,7 @@ func TestHandler_DeleteSEO(t *testing.T) {
 		"Success", func(t *testing.T) {
 			req := &pb.GetSEOReq{Name: "name", Pk: "pk"}
 			mockCtrl.EXPECT().
-				DeleteSEO(gomock.Any(), name, pk).
+				DeleteSEO(gomock.Any(), name, pk, "").
 				Return(nil).
 				Times(1)
 
@@ -550,7 +643,7 @@ func TestHandler_DeleteSEO(t *testing.T) {
 		"ErrNotFound", func(t *testing.T) {
 			req := &pb.GetSEOReq{Name: "name", Pk: "pk"}
 			mockCtrl.EXPECT().
-				DeleteSEO(gomock.Any(), name, pk).
+				DeleteSEO(gomock.Any(), name, pk, "").
 				Return(ctrl.ErrNotFound).
 				Times(1)
 
@@ -565,7 +658,7 @@ func TestHandler_DeleteSEO(t *testing.T) {
 			req := &pb.GetSEOReq{Name: "name", Pk: "pk"}
 			newErr := errors.New("new error")
 			mockCtrl.EXPECT().
-				DeleteSEO(gomock.Any(), name, pk).
+				DeleteSEO(gomock.Any(), name, pk, "").
 				Return(newErr).
 				Times(1)
 
@@ -602,3 +695,47 @@ func TestHandler_DeleteSEO(t *testing.T) {
 		},
 	)
 }
+
+func TestHandler_GetSEOAlternates(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	req := &pb.GetSEOReq{Name: "name", Pk: "pk"}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetSEOAlternates(gomock.Any(), "name", "pk").
+				Return([]*dto.SEOAlternate{{Hreflang: "ru", Href: "/ru/p"}, {Hreflang: "x-default", Href: "/p"}}, nil).
+				Times(1)
+
+			res, err := h.GetSEOAlternates(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, res.Alternates, 2)
+			assert.Equal(t, "x-default", res.Alternates[1].Hreflang)
+		},
+	)
+
+	t.Run(
+		"Missing pk", func(t *testing.T) {
+			res, err := h.GetSEOAlternates(ctx, &pb.GetSEOReq{Name: "name"})
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrUnknownObject", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOAlternates(gomock.Any(), "name", "pk").Return(nil, ctrl.ErrUnknownObject).Times(1)
+
+			res, err := h.GetSEOAlternates(ctx, req)
+			assert.Nil(t, res)
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		},
+	)
+}
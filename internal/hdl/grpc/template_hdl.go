@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	ctrl "github.com/JMURv/seo/internal/ctrl"
+	hdl "github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	utils "github.com/JMURv/seo/internal/models/mapper"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (h *Handler) ListSEOTemplates(ctx context.Context, req *pb.EmptySEO) (*pb.ListSEOTemplatesRes, error) {
+	const op = "template.ListSEOTemplates.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	res, err := h.ctrl.ListSEOTemplates(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.ListSEOTemplatesRes{Templates: utils.TemplatesToProto(res)}, nil
+}
+
+func (h *Handler) GetSEOTemplate(ctx context.Context, req *pb.SEOTemplateReq) (*pb.SEOTemplateMsg, error) {
+	const op = "template.GetSEOTemplate.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.ObjName == "" {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	if err := validation.ValidateLocale(req.Locale); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	res, err := h.ctrl.GetSEOTemplate(ctx, req.ObjName, req.Locale)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return utils.TemplateToProto(res), nil
+}
+
+func (h *Handler) SaveSEOTemplate(ctx context.Context, req *pb.SEOTemplateMsg) (*pb.SaveSEOTemplateRes, error) {
+	const op = "template.SaveSEOTemplate.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	tpl := utils.ProtoToTemplate(req)
+	if err := validation.ValidateSEOTemplate(tpl); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	res, err := h.ctrl.SaveSEOTemplate(ctx, tpl)
+	if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.SaveSEOTemplateRes{Created: res.Created}, nil
+}
+
+func (h *Handler) DeleteSEOTemplate(ctx context.Context, req *pb.SEOTemplateReq) (*pb.EmptySEO, error) {
+	const op = "template.DeleteSEOTemplate.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.ObjName == "" {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	if err := validation.ValidateLocale(req.Locale); err != nil {
+		c = codes.InvalidArgument
+		return nil, status.Errorf(c, err.Error())
+	}
+
+	err := h.ctrl.DeleteSEOTemplate(ctx, req.ObjName, req.Locale)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = codes.NotFound
+		return nil, status.Errorf(c, err.Error())
+	} else if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return nil, status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return &pb.EmptySEO{}, nil
+}
@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_GetSEO_Generated(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	vars := map[string]string{"name": "Oak"}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetSEOWithVars(gomock.Any(), "product", "1", "", vars).
+				Return(&model.SEO{Title: "Oak", Generated: true}, nil).
+				Times(1)
+
+			res, err := h.GetSEO(ctx, &pb.GetSEOReq{Name: "product", Pk: "1", Vars: vars})
+			assert.Nil(t, err)
+			assert.True(t, res.Generated)
+		},
+	)
+
+	t.Run(
+		"Invalid vars", func(t *testing.T) {
+			_, err := h.GetSEO(ctx, &pb.GetSEOReq{Name: "product", Pk: "1", Vars: map[string]string{"a-b": "x"}})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"Not found", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOWithVars(gomock.Any(), "product", "1", "", nil).Return(nil, ctrl.ErrNotFound).Times(1)
+
+			_, err := h.GetSEO(ctx, &pb.GetSEOReq{Name: "product", Pk: "1", Generate: true})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_SaveSEOTemplate(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				SaveSEOTemplate(gomock.Any(), gomock.Any()).
+				Return(&dto.SaveSEOTemplateResponse{Created: true}, nil).
+				Times(1)
+
+			res, err := h.SaveSEOTemplate(ctx, &pb.SEOTemplateMsg{ObjName: "product", Title: "{{.name}}"})
+			assert.Nil(t, err)
+			assert.True(t, res.Created)
+		},
+	)
+
+	t.Run(
+		"Invalid template", func(t *testing.T) {
+			_, err := h.SaveSEOTemplate(ctx, &pb.SEOTemplateMsg{ObjName: "product", Title: "{{end}}"})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().SaveSEOTemplate(gomock.Any(), gomock.Any()).Return(nil, errors.New("err")).Times(1)
+
+			_, err := h.SaveSEOTemplate(ctx, &pb.SEOTemplateMsg{ObjName: "product", Title: "{{.name}}"})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_GetSEOTemplate(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetSEOTemplate(gomock.Any(), "product", "").
+				Return(&model.SEOTemplate{OBJName: "product", Title: "{{.name}}"}, nil).
+				Times(1)
+
+			res, err := h.GetSEOTemplate(ctx, &pb.SEOTemplateReq{ObjName: "product"})
+			assert.Nil(t, err)
+			assert.Equal(t, "{{.name}}", res.Title)
+		},
+	)
+
+	t.Run(
+		"Not found", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOTemplate(gomock.Any(), "product", "").Return(nil, ctrl.ErrNotFound).Times(1)
+
+			_, err := h.GetSEOTemplate(ctx, &pb.SEOTemplateReq{ObjName: "product"})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_ListAndDeleteSEOTemplates(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"List", func(t *testing.T) {
+			mockCtrl.EXPECT().ListSEOTemplates(gomock.Any()).Return([]*model.SEOTemplate{{OBJName: "product"}}, nil).Times(1)
+
+			res, err := h.ListSEOTemplates(ctx, &pb.EmptySEO{})
+			assert.Nil(t, err)
+			assert.Len(t, res.Templates, 1)
+		},
+	)
+
+	t.Run(
+		"Delete", func(t *testing.T) {
+			mockCtrl.EXPECT().DeleteSEOTemplate(gomock.Any(), "product", "ru").Return(nil).Times(1)
+
+			_, err := h.DeleteSEOTemplate(ctx, &pb.SEOTemplateReq{ObjName: "product", Locale: "ru"})
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"Delete not found", func(t *testing.T) {
+			mockCtrl.EXPECT().DeleteSEOTemplate(gomock.Any(), "product", "").Return(ctrl.ErrNotFound).Times(1)
+
+			_, err := h.DeleteSEOTemplate(ctx, &pb.SEOTemplateReq{ObjName: "product"})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/dto"
+	hdl "github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	md "github.com/JMURv/seo/internal/models"
+	utils "github.com/JMURv/seo/internal/models/mapper"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"time"
+)
+
+// ImportSEO reads one record per message; mode and dry_run are taken from the
+// first message. Invalid rows are reported in the response and nothing is
+// written.
+func (h *Handler) ImportSEO(stream pb.SEO_ImportSEOServer) error {
+	const op = "seo.ImportSEO.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(stream.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	var mode string
+	var dryRun bool
+	var rows []*md.SEO
+	var errs []dto.ImportRowError
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			c = status.Code(err)
+			return err
+		}
+
+		if len(rows) == 0 {
+			mode, dryRun = req.Mode, req.DryRun
+		}
+
+		if req.Seo == nil {
+			errs = append(errs, dto.ImportRowError{Row: len(rows) + 1, Message: hdl.ErrDecodeRequest.Error()})
+			rows = append(rows, nil)
+			continue
+		}
+		rows = append(rows, utils.ProtoToModel(req.Seo))
+	}
+
+	if err := validation.ValidateImportMode(mode); err != nil {
+		c = codes.InvalidArgument
+		return status.Errorf(c, err.Error())
+	}
+
+	errs = validation.ValidateSEORows(rows, errs)
+	if len(errs) > 0 {
+		c = codes.InvalidArgument
+		return stream.SendAndClose(
+			utils.ImportSEOResToProto(&dto.ImportSEOResponse{DryRun: dryRun, Total: len(rows), Errors: errs}),
+		)
+	}
+
+	if dryRun {
+		return stream.SendAndClose(utils.ImportSEOResToProto(&dto.ImportSEOResponse{DryRun: true, Total: len(rows)}))
+	}
+
+	res, err := h.ctrl.ImportSEO(ctx, rows, mode)
+	if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return stream.SendAndClose(utils.ImportSEOResToProto(res))
+}
+
+// ExportSEO streams every record matching the ListSEO filters. Pagination
+// fields of the request are ignored.
+func (h *Handler) ExportSEO(req *pb.ListSEOReq, stream pb.SEO_ExportSEOServer) error {
+	const op = "seo.ExportSEO.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(stream.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	f := utils.ProtoToSEOFilter(req)
+	f.Page, f.Size, f.Cursor = 0, 0, ""
+	if err := validation.ValidateSEOFilter(f); err != nil {
+		c = codes.InvalidArgument
+		return status.Errorf(c, err.Error())
+	}
+
+	err := h.ctrl.ExportSEO(
+		hdl.Preview(ctx, req.Preview), f, func(seo *md.SEO) error {
+			return stream.Send(utils.ModelToProto(seo))
+		},
+	)
+	if err != nil {
+		span.SetTag("error", true)
+		c = codes.Internal
+		return status.Errorf(c, hdl.ErrInternal.Error())
+	}
+	return nil
+}
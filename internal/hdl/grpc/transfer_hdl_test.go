@@ -0,0 +1,184 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/dto"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"testing"
+)
+
+type importStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*pb.ImportSEOReq
+	res  *pb.ImportSEORes
+}
+
+func (s *importStream) Context() context.Context { return s.ctx }
+
+func (s *importStream) Recv() (*pb.ImportSEOReq, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *importStream) SendAndClose(res *pb.ImportSEORes) error {
+	s.res = res
+	return nil
+}
+
+type exportStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.SEOMsg
+}
+
+func (s *exportStream) Context() context.Context { return s.ctx }
+
+func (s *exportStream) Send(msg *pb.SEOMsg) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func TestHandler_ImportSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	valid := func(pk string) *pb.SEOMsg {
+		return &pb.SEOMsg{
+			Title:         "title",
+			Description:   "description",
+			Keywords:      "keywords",
+			OGTitle:       "ogtitle",
+			OGDescription: "ogdescription",
+			OGImage:       "ogimage",
+			ObjName:       "product",
+			ObjPk:         pk,
+		}
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				ImportSEO(gomock.Any(), gomock.Len(2), model.ImportModeInsert).
+				Return(&dto.ImportSEOResponse{Total: 2, Created: 2}, nil).
+				Times(1)
+
+			stream := &importStream{
+				ctx: ctx,
+				reqs: []*pb.ImportSEOReq{
+					{Seo: valid("1"), Mode: model.ImportModeInsert},
+					{Seo: valid("2")},
+				},
+			}
+			assert.Nil(t, h.ImportSEO(stream))
+			assert.Equal(t, int32(2), stream.res.Created)
+		},
+	)
+
+	t.Run(
+		"Dry run", func(t *testing.T) {
+			stream := &importStream{ctx: ctx, reqs: []*pb.ImportSEOReq{{Seo: valid("1"), DryRun: true}}}
+			assert.Nil(t, h.ImportSEO(stream))
+			assert.True(t, stream.res.DryRun)
+			assert.Equal(t, int32(1), stream.res.Total)
+		},
+	)
+
+	t.Run(
+		"Row errors", func(t *testing.T) {
+			stream := &importStream{
+				ctx:  ctx,
+				reqs: []*pb.ImportSEOReq{{Seo: valid("1")}, {}, {Seo: valid("1")}},
+			}
+			assert.Nil(t, h.ImportSEO(stream))
+			assert.Len(t, stream.res.Errors, 2)
+			assert.Equal(t, int32(2), stream.res.Errors[0].Row)
+			assert.Equal(t, int32(3), stream.res.Errors[1].Row)
+		},
+	)
+
+	t.Run(
+		"Invalid mode", func(t *testing.T) {
+			stream := &importStream{ctx: ctx, reqs: []*pb.ImportSEOReq{{Seo: valid("1"), Mode: "merge"}}}
+			err := h.ImportSEO(stream)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().ImportSEO(gomock.Any(), gomock.Any(), "").Return(nil, errors.New("err")).Times(1)
+
+			stream := &importStream{ctx: ctx, reqs: []*pb.ImportSEOReq{{Seo: valid("1")}}}
+			err := h.ImportSEO(stream)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_ExportSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				ExportSEO(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(
+					func(_ context.Context, _ *model.SEOFilter, fn func(*model.SEO) error) error {
+						for _, pk := range []string{"1", "2"} {
+							if err := fn(&model.SEO{OBJPK: pk}); err != nil {
+								return err
+							}
+						}
+						return nil
+					},
+				).
+				Times(1)
+
+			stream := &exportStream{ctx: ctx}
+			assert.Nil(t, h.ExportSEO(&pb.ListSEOReq{Size: 10}, stream))
+			assert.Len(t, stream.sent, 2)
+		},
+	)
+
+	t.Run(
+		"Invalid filter", func(t *testing.T) {
+			err := h.ExportSEO(&pb.ListSEOReq{Missing: []string{"unknown"}}, &exportStream{ctx: ctx})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().ExportSEO(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("err")).Times(1)
+
+			err := h.ExportSEO(&pb.ListSEOReq{}, &exportStream{ctx: ctx})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
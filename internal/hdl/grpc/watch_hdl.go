@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/ctrl"
+	hdl "github.com/JMURv/seo/internal/hdl"
+	md "github.com/JMURv/seo/internal/models"
+	utils "github.com/JMURv/seo/internal/models/mapper"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// WatchSEO streams SEO changes, optionally of one obj_name, as they commit.
+// A non-zero last_event_id replays the changes made after it first.
+func (h *Handler) WatchSEO(req *pb.WatchSEOReq, stream pb.SEO_WatchSEOServer) error {
+	const op = "seo.WatchSEO.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(stream.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	err := h.ctrl.Watch(
+		ctx, &md.ChangeFilter{SEO: true, OBJName: req.ObjName}, req.LastEventId, func(e *md.OutboxEvent) error {
+			msg, err := utils.SEOChangeToProto(e)
+			if err != nil {
+				zap.L().Warn("failed to decode change event", zap.String("op", op), zap.Uint64("id", e.ID), zap.Error(err))
+				return nil
+			}
+			return stream.Send(msg)
+		},
+	)
+	return watchError(span, &c, err)
+}
+
+// WatchPages streams page changes, optionally under a slug prefix, as they
+// commit. A non-zero last_event_id replays the changes made after it first.
+func (h *Handler) WatchPages(req *pb.WatchPagesReq, stream pb.Page_WatchPagesServer) error {
+	const op = "page.WatchPages.hdl"
+	s, c := time.Now(), codes.OK
+	span, ctx := ot.StartSpanFromContext(stream.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		return status.Errorf(c, hdl.ErrDecodeRequest.Error())
+	}
+
+	err := h.ctrl.Watch(
+		ctx, &md.ChangeFilter{Pages: true, SlugPrefix: req.SlugPrefix}, req.LastEventId, func(e *md.OutboxEvent) error {
+			msg, err := utils.PageChangeToProto(e)
+			if err != nil {
+				zap.L().Warn("failed to decode change event", zap.String("op", op), zap.Uint64("id", e.ID), zap.Error(err))
+				return nil
+			}
+			return stream.Send(msg)
+		},
+	)
+	return watchError(span, &c, err)
+}
+
+// watchError maps the end of a watch to a status. A lagging watcher gets
+// Aborted so that it reconnects with the last id it received.
+func watchError(span ot.Span, c *codes.Code, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ctrl.ErrWatcherLagging):
+		*c = codes.Aborted
+		return status.Errorf(*c, err.Error())
+	case status.Code(err) != codes.Unknown:
+		// The stream itself failed, most likely because the client left.
+		*c = status.Code(err)
+		return err
+	default:
+		span.SetTag("error", true)
+		*c = codes.Internal
+		return status.Errorf(*c, hdl.ErrInternal.Error())
+	}
+}
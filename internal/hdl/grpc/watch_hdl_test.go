@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/ctrl"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+type watchSEOStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.SEOChangeMsg
+}
+
+func (s *watchSEOStream) Context() context.Context { return s.ctx }
+
+func (s *watchSEOStream) Send(msg *pb.SEOChangeMsg) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+type watchPagesStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.PageChangeMsg
+}
+
+func (s *watchPagesStream) Context() context.Context { return s.ctx }
+
+func (s *watchPagesStream) Send(msg *pb.PageChangeMsg) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+// replay returns a Watch implementation that hands events to fn and then ends with err.
+func replay(err error, events ...*model.OutboxEvent) func(context.Context, *model.ChangeFilter, uint64, func(*model.OutboxEvent) error) error {
+	return func(_ context.Context, _ *model.ChangeFilter, _ uint64, fn func(*model.OutboxEvent) error) error {
+		for _, e := range events {
+			if fnErr := fn(e); fnErr != nil {
+				return fnErr
+			}
+		}
+		return err
+	}
+}
+
+func TestHandler_WatchSEO(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	filter := &model.ChangeFilter{SEO: true, OBJName: "product"}
+	events := []*model.OutboxEvent{
+		{
+			ID: 7, Event: model.EventSEOUpdated, Key: "seo:product:1:en",
+			Payload: []byte(`{"event":"seo.updated","uid":"editor","before":{"title":"old"},"after":{"title":"new"}}`),
+		},
+		{ID: 8, Event: model.EventSEODeleted, Key: "seo:product:2:en", Payload: []byte(`not json`)},
+		{
+			ID: 9, Event: model.EventSEODeleted, Key: "seo:product:3:en",
+			Payload: []byte(`{"event":"seo.deleted","before":{"title":"gone"},"after":null}`),
+		},
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().Watch(gomock.Any(), filter, uint64(5), gomock.Any()).DoAndReturn(replay(nil, events...)).Times(1)
+
+			stream := &watchSEOStream{ctx: ctx}
+			assert.Nil(t, h.WatchSEO(&pb.WatchSEOReq{ObjName: "product", LastEventId: 5}, stream))
+			require.Len(t, stream.sent, 2)
+			assert.Equal(t, uint64(7), stream.sent[0].Id)
+			assert.Equal(t, "editor", stream.sent[0].Uid)
+			assert.Equal(t, "old", stream.sent[0].Before.Title)
+			assert.Equal(t, "new", stream.sent[0].After.Title)
+			assert.Nil(t, stream.sent[1].After)
+		},
+	)
+
+	t.Run(
+		"Lagging", func(t *testing.T) {
+			mockCtrl.EXPECT().Watch(gomock.Any(), filter, uint64(0), gomock.Any()).Return(ctrl.ErrWatcherLagging).Times(1)
+
+			err := h.WatchSEO(&pb.WatchSEOReq{ObjName: "product"}, &watchSEOStream{ctx: ctx})
+			assert.Equal(t, codes.Aborted, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().Watch(gomock.Any(), filter, uint64(0), gomock.Any()).Return(errors.New("err")).Times(1)
+
+			err := h.WatchSEO(&pb.WatchSEOReq{ObjName: "product"}, &watchSEOStream{ctx: ctx})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
+
+func TestHandler_WatchPages(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New("", mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+	filter := &model.ChangeFilter{Pages: true, SlugPrefix: "blog/"}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			event := &model.OutboxEvent{
+				ID: 3, Event: model.EventPageCreated, Key: "page:blog/post",
+				Payload: []byte(`{"event":"page.created","before":null,"after":{"slug":"blog/post"}}`),
+			}
+			mockCtrl.EXPECT().Watch(gomock.Any(), filter, uint64(0), gomock.Any()).DoAndReturn(replay(nil, event)).Times(1)
+
+			stream := &watchPagesStream{ctx: ctx}
+			assert.Nil(t, h.WatchPages(&pb.WatchPagesReq{SlugPrefix: "blog/"}, stream))
+			require.Len(t, stream.sent, 1)
+			assert.Nil(t, stream.sent[0].Before)
+			assert.Equal(t, "blog/post", stream.sent[0].After.Slug)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().Watch(gomock.Any(), filter, uint64(0), gomock.Any()).Return(errors.New("err")).Times(1)
+
+			err := h.WatchPages(&pb.WatchPagesReq{SlugPrefix: "blog/"}, &watchPagesStream{ctx: ctx})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		},
+	)
+}
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+func (h *Handler) AuditSEO(w http.ResponseWriter, r *http.Request) {
+	const op = "seo.AuditSEO.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name, pk, _ := utils.ParseSEOAction(r.URL.Path)
+	if name == "" || pk == "" {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+			zap.String("name", name), zap.String("pk", pk),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	locale := r.URL.Query().Get("locale")
+	if err := validation.ValidateLocale(locale); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.AuditSEO(ctx, name, pk, locale)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) AuditReport(w http.ResponseWriter, r *http.Request) {
+	const op = "seo.AuditReport.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.AuditReport(ctx, r.URL.Query().Get("obj_name"))
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
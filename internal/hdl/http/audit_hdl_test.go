@@ -0,0 +1,133 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_AuditSEO(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Missing pk",
+			url:    "/api/seo/name//audit",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Invalid locale",
+			url:    "/api/seo/name/pk/audit?locale=!!",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Not found",
+			url:    "/api/seo/name/pk/audit",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().AuditSEO(gomock.Any(), "name", "pk", "").Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "ErrInternal",
+			url:    "/api/seo/name/pk/audit",
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().AuditSEO(gomock.Any(), "name", "pk", "").Return(nil, errors.New("err")).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/seo/name/pk/audit?locale=en",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					AuditSEO(gomock.Any(), "name", "pk", "en").
+					Return(&dto.SEOAudit{OBJName: "name", OBJPK: "pk", Score: 100}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+				w := httptest.NewRecorder()
+				h.AuditSEO(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_AuditReport(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "ErrInternal",
+			url:    "/api/seo/audit",
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().AuditReport(gomock.Any(), "").Return(nil, errors.New("err")).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/seo/audit?obj_name=product",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().AuditReport(gomock.Any(), "product").Return(&dto.AuditReport{Records: 1}, nil).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+				w := httptest.NewRecorder()
+				h.AuditReport(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl/http/middleware"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	md "github.com/JMURv/seo/internal/models"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// heartbeatInterval keeps idle event streams from being cut by proxies.
+const heartbeatInterval = 15 * time.Second
+
+func RegisterEventRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/events", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				middleware.Apply(h.WatchEvents, middleware.Auth(h.sso))(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+// WatchEvents streams SEO and page changes as Server-Sent Events until the
+// client leaves. Every event carries the outbox id, so a reconnecting
+// EventSource resumes where it stopped through Last-Event-ID.
+func (h *Handler) WatchEvents(w http.ResponseWriter, r *http.Request) {
+	const op = "events.WatchEvents.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	f, after, err := utils.ParseChangeFilter(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	// The stream outlives the server's write timeout.
+	rc := http.NewResponseController(w)
+	if err = rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		zap.L().Debug("failed to lift write deadline", zap.String("op", op), zap.Error(err))
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(c)
+
+	var mu sync.Mutex
+	write := func(format string, args ...any) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if _, err := fmt.Fprintf(w, format, args...); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	if err = write(": connected\n\n"); err != nil {
+		return
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := write(": ping\n\n"); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	err = h.ctrl.Watch(
+		ctx, f, after, func(e *md.OutboxEvent) error {
+			// A data line must not contain a newline.
+			data := &bytes.Buffer{}
+			if err := json.Compact(data, e.Payload); err != nil {
+				zap.L().Warn("failed to encode change event", zap.String("op", op), zap.Uint64("id", e.ID), zap.Error(err))
+				return nil
+			}
+			return write("id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Event, data)
+		},
+	)
+	if err != nil && !errors.Is(err, ctrl.ErrWatcherLagging) {
+		span.SetTag("error", true)
+		zap.L().Debug("failed to watch events", zap.String("op", op), zap.Error(err))
+	}
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_WatchEvents(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	event := &md.OutboxEvent{
+		ID:      42,
+		Event:   md.EventSEOUpdated,
+		Key:     "seo:product:1:en",
+		Payload: []byte("{\n  \"event\": \"seo.updated\"\n}"),
+	}
+
+	tests := []struct {
+		name   string
+		url    string
+		header string
+		status int
+		body   string
+		expect func()
+	}{
+		{
+			name:   "InvalidLastEventID",
+			url:    "/api/events?last_event_id=abc",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Success",
+			url:    "/api/events?obj_name=product",
+			status: http.StatusOK,
+			body:   ": connected\n\nid: 42\nevent: seo.updated\ndata: {\"event\":\"seo.updated\"}\n\n",
+			expect: func() {
+				mctrl.EXPECT().
+					Watch(gomock.Any(), &md.ChangeFilter{SEO: true, OBJName: "product"}, uint64(0), gomock.Any()).
+					DoAndReturn(
+						func(_ context.Context, _ *md.ChangeFilter, _ uint64, fn func(*md.OutboxEvent) error) error {
+							return fn(event)
+						},
+					).
+					Times(1)
+			},
+		},
+		{
+			name:   "Resume from header",
+			url:    "/api/events?slug_prefix=blog/&last_event_id=1",
+			header: "41",
+			status: http.StatusOK,
+			body:   ": connected\n\n",
+			expect: func() {
+				mctrl.EXPECT().
+					Watch(gomock.Any(), &md.ChangeFilter{Pages: true, SlugPrefix: "blog/"}, uint64(41), gomock.Any()).
+					Return(ctrl.ErrWatcherLagging).
+					Times(1)
+			},
+		},
+		{
+			name:   "Both kinds",
+			url:    "/api/events",
+			status: http.StatusOK,
+			body:   ": connected\n\n",
+			expect: func() {
+				mctrl.EXPECT().
+					Watch(gomock.Any(), &md.ChangeFilter{SEO: true, Pages: true}, uint64(0), gomock.Any()).
+					Return(errors.New("other error")).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+				if tt.header != "" {
+					req.Header.Set("Last-Event-ID", tt.header)
+				}
+
+				w := httptest.NewRecorder()
+				h.WatchEvents(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				if tt.body != "" {
+					assert.Equal(t, "text/event-stream", w.Result().Header.Get("Content-Type"))
+					assert.Equal(t, tt.body, w.Body.String())
+				}
+			},
+		)
+	}
+}
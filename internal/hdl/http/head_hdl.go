@@ -0,0 +1,84 @@
+package http
+
+import (
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	md "github.com/JMURv/seo/internal/models"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// GetSEOHead serves the record's head tags as an escaped HTML fragment, or as
+// a JSON list of tag objects when the client accepts application/json. Query
+// parameters are the same as for GetSEO.
+func (h *Handler) GetSEOHead(w http.ResponseWriter, r *http.Request) {
+	const op = "seo.GetSEOHead.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name, pk, _ := utils.ParseSEOAction(r.URL.Path)
+	if name == "" || pk == "" {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+			zap.String("name", name), zap.String("pk", pk),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	locale := r.URL.Query().Get("locale")
+	if err := validation.ValidateLocale(locale); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	vars, generate := utils.ParseTemplateVars(r)
+	if err := validation.ValidateTemplateVars(vars); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	if !generate {
+		vars = nil
+	}
+
+	res, err := h.ctrl.GetSEOHead(hdl.Preview(ctx, utils.ParsePreview(r)), name, pk, locale, vars)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept")
+	if strings.Contains(r.Header.Get("Accept"), "application/json") {
+		utils.SuccessResponse(w, c, res)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(c)
+	if _, err = io.WriteString(w, md.RenderHead(res)); err != nil {
+		zap.L().Debug("failed to write response", zap.String("op", op), zap.Error(err))
+	}
+}
@@ -0,0 +1,118 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	ctrl "github.com/JMURv/seo/internal/ctrl"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_GetSEOHead(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	const url = "/api/seo/product/42/head"
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New(mockCtrl, ssoCtrl)
+
+	seo := &model.SEO{
+		Title:       `Oak "chair" <script>alert(1)</script>`,
+		Description: "Solid & sturdy",
+		JSONLD:      []map[string]any{{"@type": "Product", "name": "</script><b>"}},
+	}
+	tags := seo.HeadTags("https://example.com/product/42?a=1&b=2")
+
+	t.Run(
+		"HTML", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOHead(gomock.Any(), "product", "42", "", nil).Return(tags, nil).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			w := httptest.NewRecorder()
+			h.GetSEOHead(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+			assert.Equal(t, "text/html; charset=utf-8", w.Result().Header.Get("Content-Type"))
+
+			body, _ := io.ReadAll(w.Result().Body)
+			assert.Equal(
+				t,
+				"<title>Oak &#34;chair&#34; &lt;script&gt;alert(1)&lt;/script&gt;</title>\n"+
+					`<meta name="description" content="Solid &amp; sturdy">`+"\n"+
+					`<link rel="canonical" href="https://example.com/product/42?a=1&amp;b=2">`+"\n"+
+					`<meta property="og:title" content="Oak &#34;chair&#34; &lt;script&gt;alert(1)&lt;/script&gt;">`+"\n"+
+					`<meta property="og:description" content="Solid &amp; sturdy">`+"\n"+
+					`<meta property="og:url" content="https://example.com/product/42?a=1&amp;b=2">`+"\n"+
+					`<script type="application/ld+json">{"@type":"Product","name":"\u003c/script\u003e\u003cb\u003e"}</script>`+"\n",
+				string(body),
+			)
+		},
+	)
+
+	t.Run(
+		"JSON", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOHead(gomock.Any(), "product", "42", "en", nil).Return(tags, nil).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url+"?locale=en", nil)
+			req.Header.Set("Accept", "application/json")
+			w := httptest.NewRecorder()
+			h.GetSEOHead(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			var res []model.HeadTag
+			assert.Nil(t, json.NewDecoder(w.Result().Body).Decode(&res))
+			assert.Equal(t, tags, res)
+		},
+	)
+
+	t.Run(
+		"Template vars", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetSEOHead(gomock.Any(), "product", "42", "", map[string]string{"name": "Oak"}).
+				Return(tags, nil).
+				Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url+"?var.name=Oak", nil)
+			w := httptest.NewRecorder()
+			h.GetSEOHead(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"Invalid locale", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, url+"?locale=not+a+locale", nil)
+			w := httptest.NewRecorder()
+			h.GetSEOHead(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOHead(gomock.Any(), "product", "42", "", nil).Return(nil, ctrl.ErrNotFound).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			w := httptest.NewRecorder()
+			h.GetSEOHead(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().GetSEOHead(gomock.Any(), "product", "42", "", nil).Return(nil, errors.New("other")).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			w := httptest.NewRecorder()
+			h.GetSEOHead(w, req)
+			assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+		},
+	)
+}
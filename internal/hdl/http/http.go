@@ -8,12 +8,16 @@ import (
 	mid "github.com/JMURv/seo/internal/hdl/http/middleware"
 	"github.com/JMURv/seo/internal/hdl/http/utils"
 	"go.uber.org/zap"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"net/http"
+	"strings"
 	"time"
 )
 
 type Handler struct {
 	srv  *http.Server
+	grpc http.Handler
 	ctrl ctrl.AppCtrl
 	sso  sso.SSOSvc
 }
@@ -25,11 +29,24 @@ func New(ctrl ctrl.AppCtrl, sso sso.SSOSvc) *Handler {
 	}
 }
 
+// ServeGRPC makes Start accept gRPC calls on the same port: requests arriving
+// over cleartext HTTP/2 (h2c) with an application/grpc content type go to g,
+// everything else to the REST routes. Call it before Start.
+func (h *Handler) ServeGRPC(g http.Handler) {
+	h.grpc = g
+}
+
 func (h *Handler) Start(port int) {
 	mux := http.NewServeMux()
 
 	RegisterSEORoutes(mux, h)
+	RegisterTemplateRoutes(mux, h)
 	RegisterPageRoutes(mux, h)
+	RegisterSitemapRoutes(mux, h)
+	RegisterRobotsRoutes(mux, h)
+	RegisterRedirectRoutes(mux, h)
+	RegisterWebhookRoutes(mux, h)
+	RegisterEventRoutes(mux, h)
 	mux.HandleFunc(
 		"/health", func(w http.ResponseWriter, r *http.Request) {
 			utils.SuccessResponse(w, http.StatusOK, "OK")
@@ -46,6 +63,16 @@ func (h *Handler) Start(port int) {
 		IdleTimeout:  60 * time.Second,
 	}
 
+	if h.grpc != nil {
+		h2s := &http2.Server{}
+		// Lets Shutdown send GOAWAY to h2c connections, which the HTTP/1
+		// server no longer tracks once they are upgraded.
+		if err := http2.ConfigureServer(h.srv, h2s); err != nil {
+			zap.L().Fatal("failed to configure HTTP/2", zap.Error(err))
+		}
+		h.srv.Handler = h2c.NewHandler(multiplex(h.grpc, handler), h2s)
+	}
+
 	zap.L().Info(
 		fmt.Sprintf(
 			"Starting HTTP server on %v",
@@ -61,3 +88,20 @@ func (h *Handler) Start(port int) {
 func (h *Handler) Close(ctx context.Context) error {
 	return h.srv.Shutdown(ctx)
 }
+
+// multiplex routes gRPC calls to g and everything else to rest. gRPC streams
+// may outlive the server's timeouts, so they are lifted for them.
+func multiplex(g, rest http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+				rc := http.NewResponseController(w)
+				_ = rc.SetReadDeadline(time.Time{})
+				_ = rc.SetWriteDeadline(time.Time{})
+				g.ServeHTTP(w, r)
+				return
+			}
+			rest.ServeHTTP(w, r)
+		},
+	)
+}
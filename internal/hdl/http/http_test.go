@@ -0,0 +1,69 @@
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMultiplex(t *testing.T) {
+	named := func(name string) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, name)
+			},
+		)
+	}
+
+	srv := httptest.NewServer(h2c.NewHandler(multiplex(named("grpc"), named("rest")), &http2.Server{}))
+	defer srv.Close()
+
+	h2 := &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, addr)
+			},
+		},
+	}
+
+	call := func(t *testing.T, cli *http.Client, contentType string) string {
+		req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+
+		resp, err := cli.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	t.Run(
+		"gRPC over h2c", func(t *testing.T) {
+			assert.Equal(t, "grpc", call(t, h2, "application/grpc+proto"))
+		},
+	)
+
+	t.Run(
+		"REST over h2c", func(t *testing.T) {
+			assert.Equal(t, "rest", call(t, h2, "application/json"))
+		},
+	)
+
+	t.Run(
+		"REST over HTTP/1.1", func(t *testing.T) {
+			assert.Equal(t, "rest", call(t, srv.Client(), "application/grpc"))
+		},
+	)
+}
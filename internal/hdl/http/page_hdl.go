@@ -21,6 +21,10 @@ func RegisterPageRoutes(mux *http.ServeMux, h *Handler) {
 		"/api/page", func(w http.ResponseWriter, r *http.Request) {
 			switch r.Method {
 			case http.MethodGet:
+				if utils.ParsePreview(r) {
+					middleware.Apply(h.ListPages, middleware.Auth(h.sso))(w, r)
+					return
+				}
 				h.ListPages(w, r)
 			case http.MethodPost:
 				middleware.Apply(h.CreatePage, middleware.Auth(h.sso))(w, r)
@@ -30,11 +34,36 @@ func RegisterPageRoutes(mux *http.ServeMux, h *Handler) {
 		},
 	)
 
+	mux.HandleFunc(
+		"/api/resolve", func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method != http.MethodGet:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			case utils.ParsePreview(r):
+				middleware.Apply(h.ResolvePath, middleware.Auth(h.sso))(w, r)
+			default:
+				h.ResolvePath(w, r)
+			}
+		},
+	)
+
 	mux.HandleFunc(
 		"/api/page/", func(w http.ResponseWriter, r *http.Request) {
 			switch r.Method {
 			case http.MethodGet:
-				h.GetPage(w, r)
+				fn := h.GetPage
+				switch _, action := utils.ParsePageAction(r.URL.Path); {
+				case r.URL.Path == "/api/page/tree":
+					fn = h.GetPageTree
+				case action == "breadcrumbs":
+					fn = h.GetBreadcrumbs
+				}
+
+				if utils.ParsePreview(r) {
+					middleware.Apply(fn, middleware.Auth(h.sso))(w, r)
+					return
+				}
+				fn(w, r)
 			case http.MethodPut:
 				middleware.Apply(h.UpdatePage, middleware.Auth(h.sso))(w, r)
 			case http.MethodDelete:
@@ -55,8 +84,29 @@ func (h *Handler) ListPages(w http.ResponseWriter, r *http.Request) {
 		metrics.ObserveRequest(time.Since(s), c, op)
 	}()
 
-	res, err := h.ctrl.ListPages(ctx)
+	f, err := utils.ParsePageFilter(r)
+	if err == nil {
+		err = validation.ValidatePageFilter(f)
+	}
 	if err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.String("query", r.URL.RawQuery),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.ListPages(hdl.Preview(ctx, utils.ParsePreview(r)), f)
+	if err != nil && errors.Is(err, ctrl.ErrInvalidCursor) {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
 		c = http.StatusInternalServerError
 		utils.ErrResponse(w, c, hdl.ErrInternal)
 		return
@@ -87,7 +137,7 @@ func (h *Handler) GetPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.ctrl.GetPage(ctx, slug)
+	res, err := h.ctrl.GetPage(hdl.Preview(ctx, utils.ParsePreview(r)), slug)
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = http.StatusNotFound
 		utils.ErrResponse(w, c, err)
@@ -142,6 +192,14 @@ func (h *Handler) CreatePage(w http.ResponseWriter, r *http.Request) {
 		c = http.StatusConflict
 		utils.ErrResponse(w, c, err)
 		return
+	} else if err != nil && errors.Is(err, ctrl.ErrParentNotFound) {
+		c = http.StatusUnprocessableEntity
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrDuplicateHref) {
+		c = http.StatusConflict
+		utils.ErrResponse(w, c, err)
+		return
 	} else if err != nil {
 		c = http.StatusInternalServerError
 		utils.ErrResponse(w, c, hdl.ErrInternal)
@@ -206,6 +264,18 @@ func (h *Handler) UpdatePage(w http.ResponseWriter, r *http.Request) {
 		c = http.StatusNotFound
 		utils.ErrResponse(w, c, err)
 		return
+	} else if err != nil && errors.Is(err, ctrl.ErrPageCycle) {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrParentNotFound) {
+		c = http.StatusUnprocessableEntity
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrDuplicateHref) {
+		c = http.StatusConflict
+		utils.ErrResponse(w, c, err)
+		return
 	} else if err != nil {
 		c = http.StatusInternalServerError
 		utils.ErrResponse(w, c, hdl.ErrInternal)
@@ -238,11 +308,29 @@ func (h *Handler) DeletePage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.ctrl.DeletePage(ctx, slug)
+	strategy := r.URL.Query().Get("strategy")
+	if err := validation.ValidatePageDeleteStrategy(strategy); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.String("strategy", strategy),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.DeletePage(ctx, slug, strategy)
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = http.StatusNotFound
 		utils.ErrResponse(w, c, err)
 		return
+	} else if err != nil && errors.Is(err, ctrl.ErrHasChildren) {
+		c = http.StatusConflict
+		utils.ErrResponse(w, c, err)
+		return
 	} else if err != nil {
 		c = http.StatusInternalServerError
 		utils.ErrResponse(w, c, hdl.ErrInternal)
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestHandler_ListPages(t *testing.T) {
@@ -30,8 +31,8 @@ func TestHandler_ListPages(t *testing.T) {
 	t.Run(
 		"Success", func(t *testing.T) {
 			mockCtrl.EXPECT().
-				ListPages(gomock.Any()).
-				Return([]*model.Page{}, nil).
+				ListPages(gomock.Any(), &model.PageFilter{}).
+				Return(&dto.PaginatedPages{Data: []*model.Page{}}, nil).
 				Times(1)
 
 			req := httptest.NewRequest(http.MethodGet, url, nil)
@@ -44,11 +45,59 @@ func TestHandler_ListPages(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"Query params", func(t *testing.T) {
+			from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			mockCtrl.EXPECT().
+				ListPages(
+					gomock.Any(), &model.PageFilter{
+						Page: 2, Size: 10, Sort: model.SortUpdatedAt, Order: model.OrderDesc,
+						Title: "news", Href: "/blog", CreatedFrom: &from,
+					},
+				).
+				Return(&dto.PaginatedPages{Data: []*model.Page{}}, nil).
+				Times(1)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				url+"?page=2&size=10&sort=updated_at&order=desc&title=news&href=/blog&created_from=2024-01-01T00:00:00Z",
+				nil,
+			)
+			req = req.WithContext(ctx)
+
+			w := httptest.NewRecorder()
+			h.ListPages(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+		},
+	)
+
+	for _, query := range []string{
+		"?size=1000",
+		"?page=x",
+		"?sort=href",
+		"?order=up",
+		"?sort=title&cursor=" + model.Cursor{Sort: "title", Key: []string{"a"}}.Encode(),
+		"?cursor=garbage",
+		"?updated_from=yesterday",
+		"?created_from=2024-02-01T00:00:00Z&created_to=2024-01-01T00:00:00Z",
+	} {
+		t.Run(
+			"Bad request "+query, func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodGet, url+query, nil)
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.ListPages(w, req)
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		)
+	}
+
 	t.Run(
 		"ErrInternalError", func(t *testing.T) {
 			var ErrOther = errors.New("other error")
 			mockCtrl.EXPECT().
-				ListPages(gomock.Any()).
+				ListPages(gomock.Any(), gomock.Any()).
 				Return(nil, ErrOther).
 				Times(1)
 
@@ -307,6 +356,24 @@ func TestHandler_UpdatePage(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"ErrPageCycle", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				UpdatePage(gomock.Any(), slug, reqData).
+				Return(ctrl.ErrPageCycle).
+				Times(1)
+
+			payload, _ := json.Marshal(reqData)
+			req := httptest.NewRequest(http.MethodPut, url, bytes.NewBuffer(payload))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(ctx)
+
+			w := httptest.NewRecorder()
+			h.UpdatePage(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		},
+	)
+
 	t.Run(
 		"ErrInternalError", func(t *testing.T) {
 			var ErrOther = errors.New("other error")
@@ -369,7 +436,7 @@ func TestHandler_DeletePage(t *testing.T) {
 	t.Run(
 		"Success", func(t *testing.T) {
 			mockCtrl.EXPECT().
-				DeletePage(gomock.Any(), slug).
+				DeletePage(gomock.Any(), slug, "").
 				Return(nil).
 				Times(1)
 
@@ -398,7 +465,7 @@ func TestHandler_DeletePage(t *testing.T) {
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
 			mockCtrl.EXPECT().
-				DeletePage(gomock.Any(), slug).
+				DeletePage(gomock.Any(), slug, "").
 				Return(ctrl.ErrNotFound).
 				Times(1)
 
@@ -412,11 +479,54 @@ func TestHandler_DeletePage(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"Cascade", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				DeletePage(gomock.Any(), slug, model.PageDeleteCascade).
+				Return(nil).
+				Times(1)
+
+			req := httptest.NewRequest(http.MethodDelete, url+"?strategy=cascade", nil)
+			req = req.WithContext(ctx)
+
+			w := httptest.NewRecorder()
+			h.DeletePage(w, req)
+			assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"Invalid strategy", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, url+"?strategy=orphan", nil)
+			req = req.WithContext(ctx)
+
+			w := httptest.NewRecorder()
+			h.DeletePage(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrHasChildren", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				DeletePage(gomock.Any(), slug, "").
+				Return(ctrl.ErrHasChildren).
+				Times(1)
+
+			req := httptest.NewRequest(http.MethodDelete, url, nil)
+			req = req.WithContext(ctx)
+
+			w := httptest.NewRecorder()
+			h.DeletePage(w, req)
+			assert.Equal(t, http.StatusConflict, w.Result().StatusCode)
+		},
+	)
+
 	t.Run(
 		"ErrInternalError", func(t *testing.T) {
 			var ErrOther = errors.New("other error")
 			mockCtrl.EXPECT().
-				DeletePage(gomock.Any(), slug).
+				DeletePage(gomock.Any(), slug, "").
 				Return(ErrOther).
 				Times(1)
 
@@ -0,0 +1,68 @@
+package http
+
+import (
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+func (h *Handler) GetPageTree(w http.ResponseWriter, r *http.Request) {
+	const op = "pages.GetPageTree.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.GetPageTree(hdl.Preview(ctx, utils.ParsePreview(r)))
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) GetBreadcrumbs(w http.ResponseWriter, r *http.Request) {
+	const op = "pages.GetBreadcrumbs.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	slug, _ := utils.ParsePageAction(r.URL.Path)
+	if slug == "" {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.GetBreadcrumbs(hdl.Preview(ctx, utils.ParsePreview(r)), slug)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
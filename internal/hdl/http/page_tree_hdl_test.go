@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	ctrl "github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_GetPageTree(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	const url = "/api/page/tree"
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New(mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			tree := []*dto.PageNode{
+				{Slug: "home", Title: "Home", Href: "/", Children: []*dto.PageNode{{Slug: "about", Children: []*dto.PageNode{}}}},
+			}
+			mockCtrl.EXPECT().GetPageTree(gomock.Any()).Return(tree, nil).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.GetPageTree(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			var res []*dto.PageNode
+			assert.Nil(t, json.NewDecoder(w.Result().Body).Decode(&res))
+			assert.Equal(t, tree, res)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().GetPageTree(gomock.Any()).Return(nil, errors.New("other error")).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.GetPageTree(w, req)
+			assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+		},
+	)
+}
+
+func TestHandler_GetBreadcrumbs(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	const url = "/api/page/chairs/breadcrumbs"
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New(mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mockCtrl.EXPECT().
+				GetBreadcrumbs(gomock.Any(), "chairs").
+				Return(&dto.Breadcrumbs{Items: []*dto.Breadcrumb{{Slug: "chairs"}}}, nil).
+				Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.GetBreadcrumbs(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"Missing slug", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/page//breadcrumbs", nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.GetBreadcrumbs(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().GetBreadcrumbs(gomock.Any(), "chairs").Return(nil, ctrl.ErrNotFound).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.GetBreadcrumbs(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().GetBreadcrumbs(gomock.Any(), "chairs").Return(nil, errors.New("other error")).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.GetBreadcrumbs(w, req)
+			assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+		},
+	)
+}
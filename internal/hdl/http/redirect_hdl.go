@@ -0,0 +1,357 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/middleware"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	md "github.com/JMURv/seo/internal/models"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+func RegisterRedirectRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/redirects", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				middleware.Apply(h.ListRedirects, middleware.Auth(h.sso))(w, r)
+			case http.MethodPost:
+				middleware.Apply(h.CreateRedirect, middleware.Auth(h.sso))(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/redirects/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				middleware.Apply(h.GetRedirect, middleware.Auth(h.sso))(w, r)
+			case http.MethodPut:
+				middleware.Apply(h.UpdateRedirect, middleware.Auth(h.sso))(w, r)
+			case http.MethodDelete:
+				middleware.Apply(h.DeleteRedirect, middleware.Auth(h.sso))(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/redirects/resolve", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.ResolveRedirect(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/redirects/export", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.ExportRedirects(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) ListRedirects(w http.ResponseWriter, r *http.Request) {
+	const op = "redirect.ListRedirects.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.ListRedirects(ctx)
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) GetRedirect(w http.ResponseWriter, r *http.Request) {
+	const op = "redirect.GetRedirect.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id := utils.ParseIDParam(r.URL.Path, "/api/redirects/")
+	if id == 0 {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.GetRedirect(ctx, id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) CreateRedirect(w http.ResponseWriter, r *http.Request) {
+	const op = "redirect.CreateRedirect.hdl"
+	s, c := time.Now(), http.StatusCreated
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &md.Redirect{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	if err := validation.ValidateRedirect(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateRedirect(ctx, req)
+	if err != nil && errors.Is(err, ctrl.ErrRedirectLoop) {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
+		c = http.StatusConflict
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) UpdateRedirect(w http.ResponseWriter, r *http.Request) {
+	const op = "redirect.UpdateRedirect.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id := utils.ParseIDParam(r.URL.Path, "/api/redirects/")
+	if id == 0 {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	req := &md.Redirect{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	if err := validation.ValidateRedirect(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.UpdateRedirect(ctx, id, req)
+	if err != nil && errors.Is(err, ctrl.ErrRedirectLoop) {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
+		c = http.StatusConflict
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, c)
+}
+
+func (h *Handler) DeleteRedirect(w http.ResponseWriter, r *http.Request) {
+	const op = "redirect.DeleteRedirect.hdl"
+	s, c := time.Now(), http.StatusNoContent
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id := utils.ParseIDParam(r.URL.Path, "/api/redirects/")
+	if id == 0 {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	err := h.ctrl.DeleteRedirect(ctx, id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, c)
+}
+
+func (h *Handler) ResolveRedirect(w http.ResponseWriter, r *http.Request) {
+	const op = "redirect.ResolveRedirect.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	path := r.URL.Query().Get("path")
+	if path == "" {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("query", r.URL.RawQuery),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.ResolveRedirect(ctx, path)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrRedirectLoop) {
+		c = http.StatusConflict
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) ExportRedirects(w http.ResponseWriter, r *http.Request) {
+	const op = "redirect.ExportRedirects.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = md.RedirectFormatNginx
+	}
+
+	res, err := h.ctrl.ExportRedirects(ctx, format)
+	if err != nil && errors.Is(err, ctrl.ErrUnsupportedFormat) {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(c)
+	if _, err = w.Write(res); err != nil {
+		zap.L().Debug("failed to write response", zap.String("op", op), zap.Error(err))
+	}
+}
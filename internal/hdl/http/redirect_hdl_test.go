@@ -0,0 +1,299 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_CreateRedirect(t *testing.T) {
+	const url = "/api/redirects"
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	reqData := &md.Redirect{Source: "/old", Target: "/new", Code: http.StatusMovedPermanently}
+
+	tests := []struct {
+		name    string
+		status  int
+		payload map[string]any
+		expect  func()
+	}{
+		{
+			name:    "ErrDecodeRequest",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"source": 0},
+			expect:  func() {},
+		},
+		{
+			name:    "InvalidSource",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"source": "old", "target": "/new"},
+			expect:  func() {},
+		},
+		{
+			name:    "InvalidRegex",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"source": "^/(", "target": "/new", "regex": true},
+			expect:  func() {},
+		},
+		{
+			name:    "InvalidCode",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"source": "/old", "target": "/new", "code": 303},
+			expect:  func() {},
+		},
+		{
+			name:    "ErrRedirectLoop",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"source": "/old", "target": "/new", "code": 301},
+			expect: func() {
+				mctrl.EXPECT().CreateRedirect(gomock.Any(), reqData).Return(nil, ctrl.ErrRedirectLoop).Times(1)
+			},
+		},
+		{
+			name:    "ErrAlreadyExists",
+			status:  http.StatusConflict,
+			payload: map[string]any{"source": "/old", "target": "/new", "code": 301},
+			expect: func() {
+				mctrl.EXPECT().CreateRedirect(gomock.Any(), reqData).Return(nil, ctrl.ErrAlreadyExists).Times(1)
+			},
+		},
+		{
+			name:    "Success",
+			status:  http.StatusCreated,
+			payload: map[string]any{"source": "/old", "target": "/new", "code": 301},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateRedirect(gomock.Any(), reqData).
+					Return(&dto.CreateRedirectResponse{ID: 1}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				payload, err := json.Marshal(tt.payload)
+				assert.Nil(t, err)
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payload))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.CreateRedirect(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_UpdateRedirect(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	reqData := &md.Redirect{Source: "/old", Target: "/new", Code: http.StatusFound}
+	payload := map[string]any{"source": "/old", "target": "/new", "code": 302}
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Invalid id",
+			url:    "/api/redirects/abc",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrNotFound",
+			url:    "/api/redirects/1",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().UpdateRedirect(gomock.Any(), uint64(1), reqData).Return(ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/redirects/1",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().UpdateRedirect(gomock.Any(), uint64(1), reqData).Return(nil).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				body, err := json.Marshal(payload)
+				assert.Nil(t, err)
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodPut, tt.url, bytes.NewBuffer(body))
+				w := httptest.NewRecorder()
+				h.UpdateRedirect(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_ResolveRedirect(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Missing path",
+			url:    "/api/redirects/resolve",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrNotFound",
+			url:    "/api/redirects/resolve?path=/old",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().ResolveRedirect(gomock.Any(), "/old").Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "ErrRedirectLoop",
+			url:    "/api/redirects/resolve?path=/old",
+			status: http.StatusConflict,
+			expect: func() {
+				mctrl.EXPECT().ResolveRedirect(gomock.Any(), "/old").Return(nil, ctrl.ErrRedirectLoop).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/redirects/resolve?path=/old",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					ResolveRedirect(gomock.Any(), "/old").
+					Return(&dto.ResolveRedirectResponse{Source: "/old", Target: "/new", Code: 301, Hops: 1}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+
+				w := httptest.NewRecorder()
+				h.ResolveRedirect(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_ExportRedirects(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	body := []byte("RewriteEngine On\n")
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Default format",
+			url:    "/api/redirects/export",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().ExportRedirects(gomock.Any(), md.RedirectFormatNginx).Return(body, nil).Times(1)
+			},
+		},
+		{
+			name:   "Apache",
+			url:    "/api/redirects/export?format=apache",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().ExportRedirects(gomock.Any(), md.RedirectFormatApache).Return(body, nil).Times(1)
+			},
+		},
+		{
+			name:   "ErrUnsupportedFormat",
+			url:    "/api/redirects/export?format=caddy",
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().ExportRedirects(gomock.Any(), "caddy").Return(nil, ctrl.ErrUnsupportedFormat).Times(1)
+			},
+		},
+		{
+			name:   "ErrInternal",
+			url:    "/api/redirects/export",
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().ExportRedirects(gomock.Any(), md.RedirectFormatNginx).Return(nil, errors.New("test error")).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+
+				w := httptest.NewRecorder()
+				h.ExportRedirects(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				if tt.status == http.StatusOK {
+					assert.Equal(t, body, w.Body.Bytes())
+				}
+			},
+		)
+	}
+}
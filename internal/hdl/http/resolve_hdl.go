@@ -0,0 +1,55 @@
+package http
+
+import (
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+func (h *Handler) ResolvePath(w http.ResponseWriter, r *http.Request) {
+	const op = "pages.ResolvePath.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	path, locale := r.URL.Query().Get("path"), r.URL.Query().Get("locale")
+	err := validation.ValidateResolvePath(path)
+	if err == nil {
+		err = validation.ValidateLocale(locale)
+	}
+	if err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.String("path", path), zap.String("locale", locale),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.ResolvePath(hdl.Preview(ctx, utils.ParsePreview(r)), path, locale)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	ctrl "github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_ResolvePath(t *testing.T) {
+	ctrlMock := gomock.NewController(t)
+	defer ctrlMock.Finish()
+
+	const url = "/api/resolve?path=/catalog/shoes"
+	mockCtrl := mocks.NewMockAppCtrl(ctrlMock)
+	ssoCtrl := mocks.NewMockSSOSvc(ctrlMock)
+	h := New(mockCtrl, ssoCtrl)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			resolved := &dto.ResolvePathResponse{
+				Page: &md.Page{Slug: "shoes", Href: "/catalog/shoes"},
+				SEO:  &md.SEO{Title: "Shoes", OBJName: md.PageOBJName, OBJPK: "shoes"},
+			}
+			mockCtrl.EXPECT().ResolvePath(gomock.Any(), "/catalog/shoes", "").Return(resolved, nil).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.ResolvePath(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			res := &dto.ResolvePathResponse{}
+			assert.Nil(t, json.NewDecoder(w.Result().Body).Decode(res))
+			assert.Equal(t, "shoes", res.Page.Slug)
+			assert.Equal(t, "Shoes", res.SEO.Title)
+		},
+	)
+
+	t.Run(
+		"Missing path", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/resolve", nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.ResolvePath(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"Relative path", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/resolve?path=catalog", nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.ResolvePath(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mockCtrl.EXPECT().ResolvePath(gomock.Any(), "/catalog/shoes", "").Return(nil, ctrl.ErrNotFound).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.ResolvePath(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mockCtrl.EXPECT().ResolvePath(gomock.Any(), "/catalog/shoes", "").Return(nil, errors.New("other error")).Times(1)
+
+			req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			h.ResolvePath(w, req)
+			assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+		},
+	)
+}
@@ -0,0 +1,159 @@
+package http
+
+import (
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (h *Handler) ListSEORevisions(w http.ResponseWriter, r *http.Request) {
+	const op = "revision.ListSEORevisions.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name, pk, _ := utils.ParseSEOAction(r.URL.Path)
+	if name == "" || pk == "" {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.ListSEORevisions(ctx, name, pk)
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) GetSEORevision(w http.ResponseWriter, r *http.Request) {
+	const op = "revision.GetSEORevision.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name, pk, action := utils.ParseSEOAction(r.URL.Path)
+	id := utils.ParseRevisionID(action)
+	if name == "" || pk == "" || id == 0 {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.GetSEORevision(ctx, name, pk, id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) DiffSEORevisions(w http.ResponseWriter, r *http.Request) {
+	const op = "revision.DiffSEORevisions.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name, pk, _ := utils.ParseSEOAction(r.URL.Path)
+	from, fromErr := strconv.ParseUint(r.URL.Query().Get("from"), 10, 64)
+	to, toErr := strconv.ParseUint(r.URL.Query().Get("to"), 10, 64)
+	if name == "" || pk == "" || fromErr != nil || toErr != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+			zap.String("query", r.URL.RawQuery),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.DiffSEORevisions(ctx, name, pk, from, to)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) RollbackSEO(w http.ResponseWriter, r *http.Request) {
+	const op = "revision.RollbackSEO.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name, pk, action := utils.ParseSEOAction(r.URL.Path)
+	id := utils.ParseRevisionID(action)
+	if name == "" || pk == "" || id == 0 {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.RollbackSEO(ctx, name, pk, id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
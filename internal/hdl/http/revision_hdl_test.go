@@ -0,0 +1,243 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_ListSEORevisions(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Missing pk",
+			url:    "/api/seo/name//revisions",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrInternal",
+			url:    "/api/seo/name/pk/revisions",
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().ListSEORevisions(gomock.Any(), "name", "pk").Return(nil, errors.New("err")).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/seo/name/pk/revisions",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					ListSEORevisions(gomock.Any(), "name", "pk").
+					Return([]*md.SEORevision{{ID: 1}}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+				w := httptest.NewRecorder()
+				h.ListSEORevisions(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_GetSEORevision(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Invalid id",
+			url:    "/api/seo/name/pk/revisions/abc",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrNotFound",
+			url:    "/api/seo/name/pk/revisions/1",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().GetSEORevision(gomock.Any(), "name", "pk", uint64(1)).Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/seo/name/pk/revisions/1",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					GetSEORevision(gomock.Any(), "name", "pk", uint64(1)).
+					Return(&md.SEORevision{ID: 1}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+				w := httptest.NewRecorder()
+				h.GetSEORevision(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_DiffSEORevisions(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Missing to",
+			url:    "/api/seo/name/pk/revisions/diff?from=1",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrNotFound",
+			url:    "/api/seo/name/pk/revisions/diff?from=1&to=2",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().
+					DiffSEORevisions(gomock.Any(), "name", "pk", uint64(1), uint64(2)).
+					Return(nil, ctrl.ErrNotFound).
+					Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/seo/name/pk/revisions/diff?from=1&to=2",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					DiffSEORevisions(gomock.Any(), "name", "pk", uint64(1), uint64(2)).
+					Return([]*dto.FieldDiff{{Field: "title", From: "a", To: "b"}}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+				w := httptest.NewRecorder()
+				h.DiffSEORevisions(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_RollbackSEO(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	const url = "/api/seo/name/pk/revisions/3/rollback"
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mctrl.EXPECT().
+				RollbackSEO(gomock.Any(), "name", "pk", uint64(3)).
+				Return(&md.SEO{Title: "old"}, nil).
+				Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+			w := httptest.NewRecorder()
+			h.RollbackSEO(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			res := &md.SEO{}
+			assert.Nil(t, json.NewDecoder(w.Result().Body).Decode(res))
+			assert.Equal(t, "old", res.Title)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mctrl.EXPECT().
+				RollbackSEO(gomock.Any(), "name", "pk", uint64(3)).
+				Return(nil, ctrl.ErrNotFound).
+				Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+			w := httptest.NewRecorder()
+			h.RollbackSEO(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"Invalid id", func(t *testing.T) {
+			req := httptest.NewRequestWithContext(ctx, http.MethodPost, "/api/seo/name/pk/revisions/0/rollback", nil)
+			w := httptest.NewRecorder()
+			h.RollbackSEO(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		},
+	)
+}
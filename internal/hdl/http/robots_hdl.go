@@ -0,0 +1,462 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/middleware"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	md "github.com/JMURv/seo/internal/models"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"time"
+)
+
+func RegisterRobotsRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/robots.txt", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.GetRobotsTxt(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/robots", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				middleware.Apply(h.ListRobotsGroups, middleware.Auth(h.sso))(w, r)
+			case http.MethodPost:
+				middleware.Apply(h.CreateRobotsGroup, middleware.Auth(h.sso))(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/robots/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				middleware.Apply(h.GetRobotsGroup, middleware.Auth(h.sso))(w, r)
+			case http.MethodPut:
+				middleware.Apply(h.UpdateRobotsGroup, middleware.Auth(h.sso))(w, r)
+			case http.MethodDelete:
+				middleware.Apply(h.DeleteRobotsGroup, middleware.Auth(h.sso))(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/robots/test", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.TestRobots(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/robots/sitemaps", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				middleware.Apply(h.ListRobotsSitemaps, middleware.Auth(h.sso))(w, r)
+			case http.MethodPost:
+				middleware.Apply(h.CreateRobotsSitemap, middleware.Auth(h.sso))(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/robots/sitemaps/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodDelete:
+				middleware.Apply(h.DeleteRobotsSitemap, middleware.Auth(h.sso))(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) GetRobotsTxt(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.GetRobotsTxt.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.GetRobotsTxt(ctx)
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(c)
+	if _, err = io.WriteString(w, res); err != nil {
+		zap.L().Debug("failed to write response", zap.String("op", op), zap.Error(err))
+	}
+}
+
+func (h *Handler) TestRobots(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.TestRobots.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	agent, path := r.URL.Query().Get("agent"), r.URL.Query().Get("path")
+	if agent == "" || path == "" {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("agent", agent), zap.String("path", path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.TestRobots(ctx, agent, path)
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) ListRobotsGroups(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.ListRobotsGroups.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.ListRobotsGroups(ctx)
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) GetRobotsGroup(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.GetRobotsGroup.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id := utils.ParseIDParam(r.URL.Path, "/api/robots/")
+	if id == 0 {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.GetRobotsGroup(ctx, id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) CreateRobotsGroup(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.CreateRobotsGroup.hdl"
+	s, c := time.Now(), http.StatusCreated
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &md.RobotsGroup{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	if err := validation.ValidateRobotsGroup(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateRobotsGroup(ctx, req)
+	if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
+		c = http.StatusConflict
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) UpdateRobotsGroup(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.UpdateRobotsGroup.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id := utils.ParseIDParam(r.URL.Path, "/api/robots/")
+	if id == 0 {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	req := &md.RobotsGroup{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	if err := validation.ValidateRobotsGroup(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.UpdateRobotsGroup(ctx, id, req)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
+		c = http.StatusConflict
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, c)
+}
+
+func (h *Handler) DeleteRobotsGroup(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.DeleteRobotsGroup.hdl"
+	s, c := time.Now(), http.StatusNoContent
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id := utils.ParseIDParam(r.URL.Path, "/api/robots/")
+	if id == 0 {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	err := h.ctrl.DeleteRobotsGroup(ctx, id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, c)
+}
+
+func (h *Handler) ListRobotsSitemaps(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.ListRobotsSitemaps.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.ListRobotsSitemaps(ctx)
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) CreateRobotsSitemap(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.CreateRobotsSitemap.hdl"
+	s, c := time.Now(), http.StatusCreated
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &md.RobotsSitemap{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	if err := validation.ValidateRobotsSitemap(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateRobotsSitemap(ctx, req.URL)
+	if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
+		c = http.StatusConflict
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) DeleteRobotsSitemap(w http.ResponseWriter, r *http.Request) {
+	const op = "robots.DeleteRobotsSitemap.hdl"
+	s, c := time.Now(), http.StatusNoContent
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id := utils.ParseIDParam(r.URL.Path, "/api/robots/sitemaps/")
+	if id == 0 {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	err := h.ctrl.DeleteRobotsSitemap(ctx, id)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, c)
+}
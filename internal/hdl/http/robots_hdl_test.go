@@ -0,0 +1,305 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_GetRobotsTxt(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	body := "User-agent: *\nDisallow: /admin\n"
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mctrl.EXPECT().GetRobotsTxt(gomock.Any()).Return(body, nil).Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/robots.txt", nil)
+			w := httptest.NewRecorder()
+			h.GetRobotsTxt(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+			assert.Equal(t, "text/plain; charset=utf-8", w.Result().Header.Get("Content-Type"))
+			assert.Equal(t, body, w.Body.String())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mctrl.EXPECT().GetRobotsTxt(gomock.Any()).Return("", errors.New("test error")).Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/robots.txt", nil)
+			w := httptest.NewRecorder()
+			h.GetRobotsTxt(w, req)
+			assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+		},
+	)
+}
+
+func TestHandler_TestRobots(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Missing path",
+			url:    "/api/robots/test?agent=Googlebot",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrInternal",
+			url:    "/api/robots/test?agent=Googlebot&path=/admin",
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().TestRobots(gomock.Any(), "Googlebot", "/admin").Return(nil, errors.New("test error")).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/robots/test?agent=Googlebot&path=/admin",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					TestRobots(gomock.Any(), "Googlebot", "/admin").
+					Return(&dto.RobotsTestResponse{Allowed: false, UserAgent: "*"}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+
+				w := httptest.NewRecorder()
+				h.TestRobots(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_CreateRobotsGroup(t *testing.T) {
+	const url = "/api/robots"
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	reqData := &md.RobotsGroup{
+		UserAgent: "*",
+		Rules:     []md.RobotsRule{{Type: md.RobotsDisallow, Path: "/admin"}},
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		payload map[string]any
+		expect  func()
+	}{
+		{
+			name:    "ErrDecodeRequest",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"user_agent": 0},
+			expect:  func() {},
+		},
+		{
+			name:    "MissingUserAgent",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"user_agent": ""},
+			expect:  func() {},
+		},
+		{
+			name:   "InvalidRuleType",
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"user_agent": "*",
+				"rules":      []map[string]any{{"type": "block", "path": "/"}},
+			},
+			expect: func() {},
+		},
+		{
+			name:   "ErrAlreadyExists",
+			status: http.StatusConflict,
+			payload: map[string]any{
+				"user_agent": reqData.UserAgent,
+				"rules":      reqData.Rules,
+			},
+			expect: func() {
+				mctrl.EXPECT().CreateRobotsGroup(gomock.Any(), reqData).Return(nil, ctrl.ErrAlreadyExists).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			status: http.StatusCreated,
+			payload: map[string]any{
+				"user_agent": reqData.UserAgent,
+				"rules":      reqData.Rules,
+			},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateRobotsGroup(gomock.Any(), reqData).
+					Return(&dto.CreateRobotsGroupResponse{ID: 1}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				payload, err := json.Marshal(tt.payload)
+				assert.Nil(t, err)
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payload))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.CreateRobotsGroup(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_DeleteRobotsGroup(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Invalid id",
+			url:    "/api/robots/abc",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrNotFound",
+			url:    "/api/robots/1",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().DeleteRobotsGroup(gomock.Any(), uint64(1)).Return(ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/robots/1",
+			status: http.StatusNoContent,
+			expect: func() {
+				mctrl.EXPECT().DeleteRobotsGroup(gomock.Any(), uint64(1)).Return(nil).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequestWithContext(ctx, http.MethodDelete, tt.url, nil)
+
+				w := httptest.NewRecorder()
+				h.DeleteRobotsGroup(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_CreateRobotsSitemap(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	url := "https://example.com/sitemap.xml"
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Relative url",
+			url:    "/sitemap.xml",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Success",
+			url:    url,
+			status: http.StatusCreated,
+			expect: func() {
+				mctrl.EXPECT().
+					CreateRobotsSitemap(gomock.Any(), url).
+					Return(&dto.CreateRobotsSitemapResponse{ID: 1}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				payload, err := json.Marshal(map[string]any{"url": tt.url})
+				assert.Nil(t, err)
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodPost, "/api/robots/sitemaps", bytes.NewBuffer(payload))
+				w := httptest.NewRecorder()
+				h.CreateRobotsSitemap(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
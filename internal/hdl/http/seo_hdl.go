@@ -13,6 +13,7 @@ import (
 	ot "github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -20,6 +21,12 @@ func RegisterSEORoutes(mux *http.ServeMux, h *Handler) {
 	mux.HandleFunc(
 		"/api/seo", func(w http.ResponseWriter, r *http.Request) {
 			switch r.Method {
+			case http.MethodGet:
+				if utils.ParsePreview(r) {
+					middleware.Apply(h.ListSEO, middleware.Auth(h.sso))(w, r)
+					return
+				}
+				h.ListSEO(w, r)
 			case http.MethodPost:
 				middleware.Apply(h.CreateSEO, middleware.Auth(h.sso))(w, r)
 			default:
@@ -30,9 +37,46 @@ func RegisterSEORoutes(mux *http.ServeMux, h *Handler) {
 
 	mux.HandleFunc(
 		"/api/seo/", func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/api/seo/import" && r.Method == http.MethodPost:
+				middleware.Apply(h.ImportSEO, middleware.Auth(h.sso))(w, r)
+				return
+			case r.URL.Path == "/api/seo/export" && r.Method == http.MethodGet:
+				middleware.Apply(h.ExportSEO, middleware.Auth(h.sso))(w, r)
+				return
+			case r.URL.Path == "/api/seo/audit" && r.Method == http.MethodGet:
+				middleware.Apply(h.AuditReport, middleware.Auth(h.sso))(w, r)
+				return
+			}
+
 			switch r.Method {
 			case http.MethodGet:
-				h.GetSEO(w, r)
+				switch _, _, action := utils.ParseSEOAction(r.URL.Path); {
+				case action == "alternates":
+					h.GetSEOAlternates(w, r)
+				case action == "head" && utils.ParsePreview(r):
+					middleware.Apply(h.GetSEOHead, middleware.Auth(h.sso))(w, r)
+				case action == "head":
+					h.GetSEOHead(w, r)
+				case action == "audit":
+					middleware.Apply(h.AuditSEO, middleware.Auth(h.sso))(w, r)
+				case action == "revisions":
+					middleware.Apply(h.ListSEORevisions, middleware.Auth(h.sso))(w, r)
+				case action == "revisions/diff":
+					middleware.Apply(h.DiffSEORevisions, middleware.Auth(h.sso))(w, r)
+				case strings.HasPrefix(action, "revisions/"):
+					middleware.Apply(h.GetSEORevision, middleware.Auth(h.sso))(w, r)
+				case utils.ParsePreview(r):
+					middleware.Apply(h.GetSEO, middleware.Auth(h.sso))(w, r)
+				default:
+					h.GetSEO(w, r)
+				}
+			case http.MethodPost:
+				if _, _, action := utils.ParseSEOAction(r.URL.Path); strings.HasSuffix(action, "/rollback") {
+					middleware.Apply(h.RollbackSEO, middleware.Auth(h.sso))(w, r)
+					return
+				}
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
 			case http.MethodPut:
 				middleware.Apply(h.UpdateSEO, middleware.Auth(h.sso))(w, r)
 			case http.MethodDelete:
@@ -44,6 +88,46 @@ func RegisterSEORoutes(mux *http.ServeMux, h *Handler) {
 	)
 }
 
+func (h *Handler) ListSEO(w http.ResponseWriter, r *http.Request) {
+	const op = "seo.ListSEO.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	f, err := utils.ParseSEOFilter(r)
+	if err == nil {
+		err = validation.ValidateSEOFilter(f)
+	}
+	if err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.String("query", r.URL.RawQuery),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.ListSEO(hdl.Preview(ctx, utils.ParsePreview(r)), f)
+	if err != nil && errors.Is(err, ctrl.ErrInvalidCursor) {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
 func (h *Handler) GetSEO(w http.ResponseWriter, r *http.Request) {
 	const op = "seo.GetItemSEO.hdl"
 	s, c := time.Now(), http.StatusOK
@@ -67,7 +151,35 @@ func (h *Handler) GetSEO(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.ctrl.GetSEO(ctx, name, pk)
+	locale := r.URL.Query().Get("locale")
+	if err := validation.ValidateLocale(locale); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.String("locale", locale),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	vars, generate := utils.ParseTemplateVars(r)
+	if err := validation.ValidateTemplateVars(vars); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	var res *md.SEO
+	var err error
+	ctx = hdl.Preview(ctx, utils.ParsePreview(r))
+	if generate {
+		res, err = h.ctrl.GetSEOWithVars(ctx, name, pk, locale, vars)
+	} else {
+		res, err = h.ctrl.GetSEO(ctx, name, pk, locale)
+	}
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = http.StatusNotFound
 		utils.ErrResponse(w, c, err)
@@ -218,7 +330,21 @@ func (h *Handler) DeleteSEO(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.ctrl.DeleteSEO(ctx, name, pk)
+	locale := r.URL.Query().Get("locale")
+	if err := validation.ValidateLocale(locale); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.String("locale", locale),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.DeleteSEO(ctx, name, pk, locale)
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		c = http.StatusNotFound
 		utils.ErrResponse(w, c, err)
@@ -231,3 +357,44 @@ func (h *Handler) DeleteSEO(w http.ResponseWriter, r *http.Request) {
 
 	utils.StatusResponse(w, c)
 }
+
+func (h *Handler) GetSEOAlternates(w http.ResponseWriter, r *http.Request) {
+	const op = "seo.GetSEOAlternates.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name, pk, _ := utils.ParseSEOAction(r.URL.Path)
+	if name == "" || pk == "" {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+			zap.String("name", name), zap.String("pk", pk),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.GetSEOAlternates(ctx, name, pk)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrUnknownObject) {
+		c = http.StatusUnprocessableEntity
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
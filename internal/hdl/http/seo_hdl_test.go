@@ -7,6 +7,8 @@ import (
 	"errors"
 	"github.com/JMURv/seo/internal/ctrl"
 	"github.com/JMURv/seo/internal/dto"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	"github.com/JMURv/seo/internal/hdl/validation"
 	md "github.com/JMURv/seo/internal/models"
 	"github.com/JMURv/seo/tests/mocks"
 	"github.com/stretchr/testify/assert"
@@ -14,8 +16,93 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
+func TestHandler_ListSEO(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	const url = "/api/seo"
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Success",
+			url:    url + "?obj_name=product&pk_prefix=12&missing=og_image,description&missing=json_ld&updated_from=2024-01-01T00:00:00Z&page=2",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					ListSEO(
+						gomock.Any(), &md.SEOFilter{
+							Page: 2, OBJName: "product", PKPrefix: "12", UpdatedFrom: &from,
+							Missing: []string{"og_image", "description", "json_ld"},
+						},
+					).
+					Return(&dto.PaginatedSEO{Data: []*md.SEO{}}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "Unknown missing field",
+			url:    url + "?missing=slug",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Cursor for unsupported sort",
+			url:    url + "?sort=title&cursor=" + md.Cursor{Sort: md.SortTitle, Key: []string{"a"}}.Encode(),
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Invalid locale",
+			url:    url + "?locale=1",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrInvalidCursor",
+			url:    url,
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().ListSEO(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrInvalidCursor).Times(1)
+			},
+		},
+		{
+			name:   "ErrInternal",
+			url:    url,
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().ListSEO(gomock.Any(), gomock.Any()).Return(nil, errors.New("test error")).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+
+				w := httptest.NewRecorder()
+				h.ListSEO(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
 func TestHandler_GetSEO(t *testing.T) {
 	cmock := gomock.NewController(t)
 	defer cmock.Finish()
@@ -28,6 +115,7 @@ func TestHandler_GetSEO(t *testing.T) {
 	ctx := context.Background()
 	name, pk := "name", "pk"
 	testErr := errors.New("test error")
+	preview := gomock.Cond(func(x any) bool { return ctrl.IsPreview(x.(context.Context)) })
 
 	tests := []struct {
 		name   string
@@ -43,11 +131,42 @@ func TestHandler_GetSEO(t *testing.T) {
 			status: http.StatusOK,
 			expect: func() {
 				mctrl.EXPECT().
-					GetSEO(gomock.Any(), name, pk).
+					GetSEO(gomock.Any(), name, pk, "").
 					Return(&md.SEO{}, nil).
 					Times(1)
 			},
 		},
+		{
+			name:   "Success with locale",
+			url:    url + "?locale=ru-RU",
+			method: http.MethodGet,
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					GetSEO(gomock.Any(), name, pk, "ru-RU").
+					Return(&md.SEO{Locale: "ru"}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "Preview ignored without uid",
+			url:    url + "?preview=true",
+			method: http.MethodGet,
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().
+					GetSEO(gomock.Not(preview), name, pk, "").
+					Return(nil, ctrl.ErrNotFound).
+					Times(1)
+			},
+		},
+		{
+			name:   "Invalid locale",
+			url:    url + "?locale=1",
+			method: http.MethodGet,
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
 		{
 			name:   "Missing name or pk",
 			url:    "/api/seo/test-name/",
@@ -62,7 +181,7 @@ func TestHandler_GetSEO(t *testing.T) {
 			status: http.StatusNotFound,
 			expect: func() {
 				mctrl.EXPECT().
-					GetSEO(gomock.Any(), name, pk).
+					GetSEO(gomock.Any(), name, pk, "").
 					Return(nil, ctrl.ErrNotFound).
 					Times(1)
 			},
@@ -74,7 +193,7 @@ func TestHandler_GetSEO(t *testing.T) {
 			status: http.StatusInternalServerError,
 			expect: func() {
 				mctrl.EXPECT().
-					GetSEO(gomock.Any(), name, pk).
+					GetSEO(gomock.Any(), name, pk, "").
 					Return(nil, testErr).
 					Times(1)
 			},
@@ -94,6 +213,18 @@ func TestHandler_GetSEO(t *testing.T) {
 			},
 		)
 	}
+
+	t.Run(
+		"Preview with uid", func(t *testing.T) {
+			draft := &md.SEO{OBJName: name, OBJPK: pk, Status: md.StatusDraft}
+			mctrl.EXPECT().GetSEO(preview, name, pk, "").Return(draft, nil).Times(1)
+
+			req := httptest.NewRequestWithContext(context.WithValue(ctx, "uid", "uid"), http.MethodGet, url+"?preview=true", nil)
+			w := httptest.NewRecorder()
+			h.GetSEO(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+		},
+	)
 }
 
 func TestHandler_CreateSEO(t *testing.T) {
@@ -167,6 +298,100 @@ func TestHandler_CreateSEO(t *testing.T) {
 			},
 			expect: func() {},
 		},
+		{
+			name:   "InvalidTwitterCard",
+			url:    url,
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"title":         reqData.Title,
+				"description":   reqData.Description,
+				"keywords":      reqData.Keywords,
+				"OGTitle":       reqData.OGTitle,
+				"OGDescription": reqData.OGDescription,
+				"OGImage":       reqData.OGImage,
+				"obj_name":      reqData.OBJName,
+				"obj_pk":        reqData.OBJPK,
+				"TwitterCard":   "gallery",
+			},
+			expect: func() {},
+		},
+		{
+			name:   "SummaryLargeImageWithoutSize",
+			url:    url,
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"title":         reqData.Title,
+				"description":   reqData.Description,
+				"keywords":      reqData.Keywords,
+				"OGTitle":       reqData.OGTitle,
+				"OGDescription": reqData.OGDescription,
+				"OGImage":       reqData.OGImage,
+				"obj_name":      reqData.OBJName,
+				"obj_pk":        reqData.OBJPK,
+				"TwitterCard":   "summary_large_image",
+				"OGImageWidth":  1200,
+			},
+			expect: func() {},
+		},
+		{
+			name:   "ArticleFieldsWithoutArticleType",
+			url:    url,
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"title":         reqData.Title,
+				"description":   reqData.Description,
+				"keywords":      reqData.Keywords,
+				"OGTitle":       reqData.OGTitle,
+				"OGDescription": reqData.OGDescription,
+				"OGImage":       reqData.OGImage,
+				"obj_name":      reqData.OBJName,
+				"obj_pk":        reqData.OBJPK,
+				"OGType":        "website",
+				"ArticleTag":    []string{"go"},
+			},
+			expect: func() {},
+		},
+		{
+			name:   "ScheduledWithoutPublishAt",
+			url:    url,
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"title":         reqData.Title,
+				"description":   reqData.Description,
+				"keywords":      reqData.Keywords,
+				"OGTitle":       reqData.OGTitle,
+				"OGDescription": reqData.OGDescription,
+				"OGImage":       reqData.OGImage,
+				"obj_name":      reqData.OBJName,
+				"obj_pk":        reqData.OBJPK,
+				"status":        "scheduled",
+			},
+			expect: func() {},
+		},
+		{
+			name:   "InvalidJSONLD",
+			url:    url,
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"title":         reqData.Title,
+				"description":   reqData.Description,
+				"keywords":      reqData.Keywords,
+				"OGTitle":       reqData.OGTitle,
+				"OGDescription": reqData.OGDescription,
+				"OGImage":       reqData.OGImage,
+				"obj_name":      reqData.OBJName,
+				"obj_pk":        reqData.OBJPK,
+				"json_ld": []map[string]any{
+					{"@context": "https://schema.org", "@type": "Product"},
+				},
+			},
+			expect: func() {},
+		},
 		{
 			name:   "ErrDecodeRequest",
 			url:    url,
@@ -252,6 +477,45 @@ func TestHandler_CreateSEO(t *testing.T) {
 			},
 		)
 	}
+
+	t.Run(
+		"InvalidJSONLD field errors", func(t *testing.T) {
+			payload, err := json.Marshal(
+				map[string]any{
+					"title":         reqData.Title,
+					"description":   reqData.Description,
+					"keywords":      reqData.Keywords,
+					"OGTitle":       reqData.OGTitle,
+					"OGDescription": reqData.OGDescription,
+					"OGImage":       reqData.OGImage,
+					"obj_name":      reqData.OBJName,
+					"obj_pk":        reqData.OBJPK,
+					"json_ld": []map[string]any{
+						{
+							"@context": "https://schema.org",
+							"@type":    "Product",
+							"name":     "Phone",
+							"offers":   map[string]any{"price": "free", "priceCurrency": "USD"},
+						},
+					},
+				},
+			)
+			assert.Nil(t, err)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			h.CreateSEO(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+			res := &utils.ErrorResponse{}
+			assert.Nil(t, json.NewDecoder(w.Result().Body).Decode(res))
+			assert.Equal(
+				t, []validation.FieldError{{Field: "json_ld[0].offers.price", Message: "must be Number"}}, res.Fields,
+			)
+		},
+	)
 }
 
 func TestHandler_UpdateSEO(t *testing.T) {
@@ -431,7 +695,7 @@ func TestHandler_DeleteSEO(t *testing.T) {
 			status: http.StatusNoContent,
 			expect: func() {
 				mockCtrl.EXPECT().
-					DeleteSEO(gomock.Any(), name, pk).
+					DeleteSEO(gomock.Any(), name, pk, "").
 					Return(nil).
 					Times(1)
 			},
@@ -450,7 +714,7 @@ func TestHandler_DeleteSEO(t *testing.T) {
 			status: http.StatusNotFound,
 			expect: func() {
 				mockCtrl.EXPECT().
-					DeleteSEO(gomock.Any(), name, pk).
+					DeleteSEO(gomock.Any(), name, pk, "").
 					Return(ctrl.ErrNotFound).
 					Times(1)
 			},
@@ -462,7 +726,7 @@ func TestHandler_DeleteSEO(t *testing.T) {
 			status: http.StatusInternalServerError,
 			expect: func() {
 				mockCtrl.EXPECT().
-					DeleteSEO(gomock.Any(), name, pk).
+					DeleteSEO(gomock.Any(), name, pk, "").
 					Return(ErrOther).
 					Times(1)
 			},
@@ -484,3 +748,70 @@ func TestHandler_DeleteSEO(t *testing.T) {
 		)
 	}
 }
+
+func TestHandler_GetSEOAlternates(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	const url = "/api/seo/name/pk/alternates"
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	name, pk := "name", "pk"
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Success",
+			url:    url,
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					GetSEOAlternates(gomock.Any(), name, pk).
+					Return([]*dto.SEOAlternate{{Hreflang: "x-default", Href: "/"}}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "Missing pk",
+			url:    "/api/seo/name//alternates",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrNotFound",
+			url:    url,
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().GetSEOAlternates(gomock.Any(), name, pk).Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "ErrUnknownObject",
+			url:    url,
+			status: http.StatusUnprocessableEntity,
+			expect: func() {
+				mctrl.EXPECT().GetSEOAlternates(gomock.Any(), name, pk).Return(nil, ctrl.ErrUnknownObject).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+
+				w := httptest.NewRecorder()
+				h.GetSEOAlternates(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
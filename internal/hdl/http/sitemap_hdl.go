@@ -0,0 +1,115 @@
+package http
+
+import (
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func RegisterSitemapRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/sitemap.xml", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.GetSitemap(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/sitemap.xml.gz", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.GetSitemap(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/sitemaps/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.GetSitemap(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) GetSitemap(w http.ResponseWriter, r *http.Request) {
+	const op = "sitemap.GetSitemap.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	idx, gz, ok := parseSitemapPath(r.URL.Path)
+	if !ok {
+		c = http.StatusNotFound
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, c, ctrl.ErrNotFound)
+		return
+	}
+
+	res, err := h.ctrl.GetSitemap(ctx, idx, gz)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	if gz {
+		w.Header().Set("Content-Type", "application/gzip")
+	} else {
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	}
+	w.WriteHeader(c)
+	if _, err = w.Write(res); err != nil {
+		zap.L().Debug("failed to write response", zap.String("op", op), zap.Error(err))
+	}
+}
+
+// parseSitemapPath maps /sitemap.xml[.gz] to the root document and
+// /sitemaps/{n}.xml[.gz] to the n-th child document.
+func parseSitemapPath(path string) (int, bool, bool) {
+	gz := strings.HasSuffix(path, ".gz")
+	path = strings.TrimSuffix(path, ".gz")
+	if path == "/sitemap.xml" {
+		return 0, gz, true
+	}
+
+	name := strings.TrimPrefix(path, "/sitemaps/")
+	if name == path || !strings.HasSuffix(name, ".xml") {
+		return 0, false, false
+	}
+
+	idx, err := strconv.Atoi(strings.TrimSuffix(name, ".xml"))
+	if err != nil || idx < 1 {
+		return 0, false, false
+	}
+	return idx, gz, true
+}
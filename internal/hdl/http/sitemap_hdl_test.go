@@ -0,0 +1,104 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_GetSitemap(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	doc := []byte("<urlset/>")
+	testErr := errors.New("test error")
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		ctype  string
+		expect func()
+	}{
+		{
+			name:   "Root",
+			url:    "/sitemap.xml",
+			status: http.StatusOK,
+			ctype:  "application/xml; charset=utf-8",
+			expect: func() {
+				mctrl.EXPECT().GetSitemap(gomock.Any(), 0, false).Return(doc, nil).Times(1)
+			},
+		},
+		{
+			name:   "Root gzip",
+			url:    "/sitemap.xml.gz",
+			status: http.StatusOK,
+			ctype:  "application/gzip",
+			expect: func() {
+				mctrl.EXPECT().GetSitemap(gomock.Any(), 0, true).Return(doc, nil).Times(1)
+			},
+		},
+		{
+			name:   "Child",
+			url:    "/sitemaps/2.xml",
+			status: http.StatusOK,
+			ctype:  "application/xml; charset=utf-8",
+			expect: func() {
+				mctrl.EXPECT().GetSitemap(gomock.Any(), 2, false).Return(doc, nil).Times(1)
+			},
+		},
+		{
+			name:   "Invalid child",
+			url:    "/sitemaps/0.xml",
+			status: http.StatusNotFound,
+			ctype:  "application/json",
+			expect: func() {},
+		},
+		{
+			name:   "ErrNotFound",
+			url:    "/sitemaps/5.xml.gz",
+			status: http.StatusNotFound,
+			ctype:  "application/json",
+			expect: func() {
+				mctrl.EXPECT().GetSitemap(gomock.Any(), 5, true).Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "ErrInternal",
+			url:    "/sitemap.xml",
+			status: http.StatusInternalServerError,
+			ctype:  "application/json",
+			expect: func() {
+				mctrl.EXPECT().GetSitemap(gomock.Any(), 0, false).Return(nil, testErr).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+
+				w := httptest.NewRecorder()
+				h.GetSitemap(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				assert.Equal(t, tt.ctype, w.Result().Header.Get("Content-Type"))
+				if tt.status == http.StatusOK {
+					assert.Equal(t, doc, w.Body.Bytes())
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,208 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/middleware"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	md "github.com/JMURv/seo/internal/models"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const templatePrefix = "/api/seo-templates/"
+
+func RegisterTemplateRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/seo-templates", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				middleware.Apply(h.ListSEOTemplates, middleware.Auth(h.sso))(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		templatePrefix, func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				middleware.Apply(h.GetSEOTemplate, middleware.Auth(h.sso))(w, r)
+			case http.MethodPut:
+				middleware.Apply(h.SaveSEOTemplate, middleware.Auth(h.sso))(w, r)
+			case http.MethodDelete:
+				middleware.Apply(h.DeleteSEOTemplate, middleware.Auth(h.sso))(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) ListSEOTemplates(w http.ResponseWriter, r *http.Request) {
+	const op = "template.ListSEOTemplates.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.ListSEOTemplates(ctx)
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) GetSEOTemplate(w http.ResponseWriter, r *http.Request) {
+	const op = "template.GetSEOTemplate.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name, locale, err := parseTemplateKey(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.GetSEOTemplate(ctx, name, locale)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+// SaveSEOTemplate creates or replaces the template of the obj_name in the path
+// for the locale in the body.
+func (h *Handler) SaveSEOTemplate(w http.ResponseWriter, r *http.Request) {
+	const op = "template.SaveSEOTemplate.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name := strings.TrimPrefix(r.URL.Path, templatePrefix)
+	req := &md.SEOTemplate{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil || name == "" || strings.Contains(name, "/") {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, hdl.ErrDecodeRequest)
+		return
+	}
+
+	req.OBJName = name
+	if err := validation.ValidateSEOTemplate(req); err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			"failed to validate",
+			zap.String("op", op),
+			zap.Any("req", req),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.SaveSEOTemplate(ctx, req)
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	if res.Created {
+		c = http.StatusCreated
+	}
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) DeleteSEOTemplate(w http.ResponseWriter, r *http.Request) {
+	const op = "template.DeleteSEOTemplate.hdl"
+	s, c := time.Now(), http.StatusNoContent
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	name, locale, err := parseTemplateKey(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.DeleteSEOTemplate(ctx, name, locale)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, err)
+		return
+	} else if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, c)
+}
+
+// parseTemplateKey reads /api/seo-templates/{name}?locale=.
+func parseTemplateKey(r *http.Request) (string, string, error) {
+	name := strings.TrimPrefix(r.URL.Path, templatePrefix)
+	if name == "" || strings.Contains(name, "/") {
+		return "", "", hdl.ErrDecodeRequest
+	}
+
+	locale := r.URL.Query().Get("locale")
+	if err := validation.ValidateLocale(locale); err != nil {
+		return "", "", err
+	}
+	return name, locale, nil
+}
@@ -0,0 +1,299 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_GetSEO_Generated(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Invalid var name",
+			url:    "/api/seo/product/1?var.1x=a",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Vars select template variant",
+			url:    "/api/seo/product/1?var.name=Oak&var.city=Berlin",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					GetSEOWithVars(gomock.Any(), "product", "1", "", map[string]string{"name": "Oak", "city": "Berlin"}).
+					Return(&md.SEO{Title: "Oak", Generated: true}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "Generate without vars",
+			url:    "/api/seo/product/1?generate=true",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().
+					GetSEOWithVars(gomock.Any(), "product", "1", "", map[string]string{}).
+					Return(nil, ctrl.ErrNotFound).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+				w := httptest.NewRecorder()
+				h.GetSEO(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_ListSEOTemplates(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mctrl.EXPECT().ListSEOTemplates(gomock.Any()).Return([]*md.SEOTemplate{{OBJName: "product"}}, nil).Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/seo-templates", nil)
+			w := httptest.NewRecorder()
+			h.ListSEOTemplates(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mctrl.EXPECT().ListSEOTemplates(gomock.Any()).Return(nil, errors.New("err")).Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/seo-templates", nil)
+			w := httptest.NewRecorder()
+			h.ListSEOTemplates(w, req)
+			assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+		},
+	)
+}
+
+func TestHandler_GetSEOTemplate(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Missing name",
+			url:    "/api/seo-templates/",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Not found",
+			url:    "/api/seo-templates/product?locale=en",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().GetSEOTemplate(gomock.Any(), "product", "en").Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/seo-templates/product",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().GetSEOTemplate(gomock.Any(), "product", "").Return(&md.SEOTemplate{}, nil).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+				w := httptest.NewRecorder()
+				h.GetSEOTemplate(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_SaveSEOTemplate(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		body   any
+		status int
+		expect func()
+	}{
+		{
+			name:   "Invalid body",
+			url:    "/api/seo-templates/product",
+			body:   "not an object",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Broken template",
+			url:    "/api/seo-templates/product",
+			body:   &md.SEOTemplate{Title: "{{.name", Description: "<b>{{.name}}</b>"},
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Created",
+			url:    "/api/seo-templates/product",
+			body:   &md.SEOTemplate{OBJName: "ignored", Title: "{{.name}} | Shop"},
+			status: http.StatusCreated,
+			expect: func() {
+				mctrl.EXPECT().
+					SaveSEOTemplate(gomock.Any(), gomock.Cond(func(x any) bool { return x.(*md.SEOTemplate).OBJName == "product" })).
+					Return(&dto.SaveSEOTemplateResponse{Created: true}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "Updated",
+			url:    "/api/seo-templates/product",
+			body:   &md.SEOTemplate{Title: "{{.name}}"},
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().SaveSEOTemplate(gomock.Any(), gomock.Any()).Return(&dto.SaveSEOTemplateResponse{}, nil).Times(1)
+			},
+		},
+		{
+			name:   "ErrInternal",
+			url:    "/api/seo-templates/product",
+			body:   &md.SEOTemplate{Title: "{{.name}}"},
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().SaveSEOTemplate(gomock.Any(), gomock.Any()).Return(nil, errors.New("err")).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				body, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodPut, tt.url, bytes.NewBuffer(body))
+				w := httptest.NewRecorder()
+				h.SaveSEOTemplate(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_DeleteSEOTemplate(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "Invalid locale",
+			url:    "/api/seo-templates/product?locale=!!",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Not found",
+			url:    "/api/seo-templates/product",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().DeleteSEOTemplate(gomock.Any(), "product", "").Return(ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    "/api/seo-templates/product?locale=ru",
+			status: http.StatusNoContent,
+			expect: func() {
+				mctrl.EXPECT().DeleteSEOTemplate(gomock.Any(), "product", "ru").Return(nil).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodDelete, tt.url, nil)
+				w := httptest.NewRecorder()
+				h.DeleteSEOTemplate(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
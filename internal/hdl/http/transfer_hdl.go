@@ -0,0 +1,249 @@
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/dto"
+	"github.com/JMURv/seo/internal/hdl"
+	"github.com/JMURv/seo/internal/hdl/http/utils"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/models/mapper"
+	metrics "github.com/JMURv/seo/internal/observability/metrics/prometheus"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const maxImportBytes = 64 << 20
+const maxJSONLLine = 1 << 20
+
+// ImportSEO accepts CSV or JSONL (?format=, else Content-Type), validates every
+// row and writes them in one transaction unless ?dry_run=true. Any invalid row
+// rejects the whole import with a per-row report.
+func (h *Handler) ImportSEO(w http.ResponseWriter, r *http.Request) {
+	const op = "seo.ImportSEO.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	q := r.URL.Query()
+	mode := q.Get("mode")
+	dryRun, _ := strconv.ParseBool(q.Get("dry_run"))
+	if err := validation.ValidateImportMode(mode); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	var rows []*md.SEO
+	var errs []dto.ImportRowError
+	var err error
+
+	body := http.MaxBytesReader(w, r.Body, maxImportBytes)
+	switch importFormat(r) {
+	case md.FormatCSV:
+		rows, errs, err = decodeSEOCSV(body)
+	case md.FormatJSONL:
+		rows, errs, err = decodeSEOJSONL(body)
+	default:
+		err = validation.ErrInvalidFormat
+	}
+	if err != nil {
+		c = http.StatusBadRequest
+		span.SetTag("error", true)
+		zap.L().Debug(
+			hdl.ErrDecodeRequest.Error(),
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	errs = validation.ValidateSEORows(rows, errs)
+	if len(errs) > 0 {
+		c = http.StatusBadRequest
+		utils.SuccessResponse(w, c, &dto.ImportSEOResponse{DryRun: dryRun, Total: len(rows), Errors: errs})
+		return
+	}
+
+	if dryRun {
+		utils.SuccessResponse(w, c, &dto.ImportSEOResponse{DryRun: true, Total: len(rows)})
+		return
+	}
+
+	res, err := h.ctrl.ImportSEO(ctx, rows, mode)
+	if err != nil {
+		c = http.StatusInternalServerError
+		utils.ErrResponse(w, c, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+// ExportSEO streams records matching the ListSEO filters as CSV (default) or
+// JSONL. Drafts are included.
+func (h *Handler) ExportSEO(w http.ResponseWriter, r *http.Request) {
+	const op = "seo.ExportSEO.hdl"
+	s, c := time.Now(), http.StatusOK
+	span, ctx := ot.StartSpanFromContext(r.Context(), op)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	f, err := utils.ParseSEOFilter(r)
+	if err == nil {
+		f.Page, f.Size, f.Cursor = 0, 0, ""
+		err = validation.ValidateSEOFilter(f)
+	}
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = md.FormatCSV
+	}
+
+	var write func(*md.SEO) error
+	var flush func() error
+	switch format {
+	case md.FormatCSV:
+		cw := csv.NewWriter(w)
+		write = func(seo *md.SEO) error {
+			record, err := mapper.SEOToCSV(seo)
+			if err != nil {
+				return err
+			}
+			return cw.Write(record)
+		}
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="seo.csv"`)
+		w.WriteHeader(c)
+		if err = cw.Write(mapper.SEOCSVHeader); err != nil {
+			zap.L().Debug("failed to write response", zap.String("op", op), zap.Error(err))
+			return
+		}
+	case md.FormatJSONL:
+		enc := json.NewEncoder(w)
+		write = func(seo *md.SEO) error {
+			return enc.Encode(seo)
+		}
+		flush = func() error { return nil }
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", `attachment; filename="seo.jsonl"`)
+		w.WriteHeader(c)
+	default:
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, validation.ErrInvalidFormat)
+		return
+	}
+
+	if err = h.ctrl.ExportSEO(hdl.Preview(ctx, true), f, write); err == nil {
+		err = flush()
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		zap.L().Debug("failed to export", zap.String("op", op), zap.Error(err))
+	}
+}
+
+func importFormat(r *http.Request) string {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return format
+	}
+
+	mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mt {
+	case "text/csv":
+		return md.FormatCSV
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return md.FormatJSONL
+	}
+	return ""
+}
+
+// decodeSEOCSV reads the header and every record; records that fail to
+// decode are kept as nil rows and reported in the returned row errors.
+func decodeSEOCSV(body io.Reader) ([]*md.SEO, []dto.ImportRowError, error) {
+	cr := csv.NewReader(body)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = mapper.CheckSEOCSVHeader(header); err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*md.SEO
+	var errs []dto.ImportRowError
+	for n := 1; ; n++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var perr *csv.ParseError
+		if err != nil && !errors.As(err, &perr) {
+			return nil, nil, err
+		}
+
+		var row *md.SEO
+		if err == nil {
+			row, err = mapper.CSVToSEO(header, record)
+		}
+		if err != nil {
+			errs = append(errs, dto.ImportRowError{Row: n, Message: err.Error()})
+		}
+		rows = append(rows, row)
+	}
+	return rows, errs, nil
+}
+
+// decodeSEOJSONL reads one SEO object per non-blank line.
+func decodeSEOJSONL(body io.Reader) ([]*md.SEO, []dto.ImportRowError, error) {
+	sc := bufio.NewScanner(body)
+	sc.Buffer(make([]byte, 0, 64*1024), maxJSONLLine)
+
+	var rows []*md.SEO
+	var errs []dto.ImportRowError
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		row := &md.SEO{}
+		if err := json.Unmarshal(line, row); err != nil {
+			errs = append(errs, dto.ImportRowError{Row: len(rows) + 1, Message: err.Error()})
+			row = nil
+		}
+		rows = append(rows, row)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, nil, err
+	}
+	return rows, errs, nil
+}
@@ -0,0 +1,240 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_ImportSEO(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	const header = "obj_name,obj_pk,locale,title,description,keywords,OGTitle,OGDescription,OGImage\n"
+	const row = "product,1,en,title,description,keywords,ogtitle,ogdescription,ogimage\n"
+	const line = `{"obj_name":"product","obj_pk":"1","title":"title","description":"description","keywords":"keywords","OGTitle":"ogtitle","OGDescription":"ogdescription","OGImage":"ogimage"}`
+
+	tests := []struct {
+		name        string
+		url         string
+		contentType string
+		body        string
+		status      int
+		expect      func()
+		check       func(t *testing.T, res *dto.ImportSEOResponse)
+	}{
+		{
+			name:   "Unknown format",
+			url:    "/api/seo/import",
+			body:   row,
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Invalid mode",
+			url:    "/api/seo/import?format=csv&mode=merge",
+			body:   header + row,
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Unknown column",
+			url:    "/api/seo/import?format=csv",
+			body:   "obj_name,unknown\n",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Row errors",
+			url:    "/api/seo/import?format=csv",
+			body:   header + row + row + "product,2,en,,description,keywords,ogtitle,ogdescription,ogimage\n" + "product,3\n",
+			status: http.StatusBadRequest,
+			expect: func() {},
+			check: func(t *testing.T, res *dto.ImportSEOResponse) {
+				assert.Equal(t, 4, res.Total)
+				require.Len(t, res.Errors, 3)
+				assert.Equal(t, 4, res.Errors[0].Row)
+				assert.Equal(t, 2, res.Errors[1].Row)
+				assert.Equal(t, "duplicates row 1", res.Errors[1].Message)
+				assert.Equal(t, 3, res.Errors[2].Row)
+			},
+		},
+		{
+			name:   "Dry run",
+			url:    "/api/seo/import?format=csv&dry_run=true",
+			body:   header + row,
+			status: http.StatusOK,
+			expect: func() {},
+			check: func(t *testing.T, res *dto.ImportSEOResponse) {
+				assert.True(t, res.DryRun)
+				assert.Equal(t, 1, res.Total)
+			},
+		},
+		{
+			name:        "ErrInternal",
+			url:         "/api/seo/import",
+			contentType: "application/x-ndjson",
+			body:        line + "\n",
+			status:      http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().ImportSEO(gomock.Any(), gomock.Any(), "").Return(nil, errors.New("err")).Times(1)
+			},
+		},
+		{
+			name:        "Success CSV",
+			url:         "/api/seo/import?mode=insert",
+			contentType: "text/csv; charset=utf-8",
+			body:        header + row,
+			status:      http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					ImportSEO(
+						gomock.Any(), gomock.Cond(
+							func(x any) bool {
+								rows := x.([]*md.SEO)
+								return len(rows) == 1 && rows[0].OBJPK == "1" && rows[0].Locale == "en"
+							},
+						), md.ImportModeInsert,
+					).
+					Return(&dto.ImportSEOResponse{Total: 1, Created: 1}, nil).
+					Times(1)
+			},
+			check: func(t *testing.T, res *dto.ImportSEOResponse) {
+				assert.Equal(t, 1, res.Created)
+			},
+		},
+		{
+			name:   "Success JSONL",
+			url:    "/api/seo/import?format=jsonl",
+			body:   line + "\n\n" + strings.Replace(line, `"obj_pk":"1"`, `"obj_pk":"2"`, 1) + "\n",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					ImportSEO(gomock.Any(), gomock.Len(2), "").
+					Return(&dto.ImportSEOResponse{Total: 2, Updated: 2}, nil).
+					Times(1)
+			},
+			check: func(t *testing.T, res *dto.ImportSEOResponse) {
+				assert.Equal(t, 2, res.Updated)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodPost, tt.url, strings.NewReader(tt.body))
+				if tt.contentType != "" {
+					req.Header.Set("Content-Type", tt.contentType)
+				}
+				w := httptest.NewRecorder()
+				h.ImportSEO(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+
+				if tt.check != nil {
+					res := &dto.ImportSEOResponse{}
+					require.NoError(t, json.NewDecoder(w.Body).Decode(res))
+					tt.check(t, res)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_ExportSEO(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	export := func(_ context.Context, _ *md.SEOFilter, fn func(*md.SEO) error) error {
+		return fn(&md.SEO{OBJName: "product", OBJPK: "1", Title: "title", ArticleTag: []string{"a", "b"}})
+	}
+
+	tests := []struct {
+		name        string
+		url         string
+		status      int
+		contentType string
+		body        string
+		expect      func()
+	}{
+		{
+			name:   "Unknown format",
+			url:    "/api/seo/export?format=xml",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Invalid filter",
+			url:    "/api/seo/export?missing=unknown",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:        "CSV",
+			url:         "/api/seo/export",
+			status:      http.StatusOK,
+			contentType: "text/csv; charset=utf-8",
+			body:        "product,1,,,,title,",
+			expect: func() {
+				mctrl.EXPECT().ExportSEO(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(export).Times(1)
+			},
+		},
+		{
+			name:        "JSONL",
+			url:         "/api/seo/export?format=jsonl&obj_name=product",
+			status:      http.StatusOK,
+			contentType: "application/x-ndjson",
+			body:        `"obj_pk":"1"`,
+			expect: func() {
+				mctrl.EXPECT().
+					ExportSEO(
+						gomock.Any(),
+						gomock.Cond(func(x any) bool { return x.(*md.SEOFilter).OBJName == "product" }),
+						gomock.Any(),
+					).
+					DoAndReturn(export).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+				w := httptest.NewRecorder()
+				h.ExportSEO(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				if tt.contentType != "" {
+					assert.Equal(t, tt.contentType, w.Result().Header.Get("Content-Type"))
+				}
+				assert.Contains(t, w.Body.String(), tt.body)
+			},
+		)
+	}
+}
@@ -2,13 +2,20 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/hdl/validation"
+	md "github.com/JMURv/seo/internal/models"
 	"go.uber.org/zap"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Error  string                  `json:"error"`
+	Fields []validation.FieldError `json:"fields,omitempty"`
 }
 
 func StatusResponse(w http.ResponseWriter, statusCode int) {
@@ -25,11 +32,13 @@ func SuccessResponse(w http.ResponseWriter, statusCode int, data any) {
 func ErrResponse(w http.ResponseWriter, statusCode int, err error) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(
-		&ErrorResponse{
-			Error: err.Error(),
-		},
-	)
+
+	res := &ErrorResponse{Error: err.Error()}
+	var fields validation.FieldErrors
+	if errors.As(err, &fields) {
+		res.Fields = fields
+	}
+	json.NewEncoder(w).Encode(res)
 }
 
 func ParseURLParams(path string) (string, string) {
@@ -48,6 +57,195 @@ func ParseURLParams(path string) (string, string) {
 	return parts[0], parts[1]
 }
 
+// ParseSEOAction splits /api/seo/{name}/{pk}/{action} paths; action keeps any
+// remaining segments, e.g. "revisions/5/rollback".
+func ParseSEOAction(path string) (string, string, string) {
+	parts := strings.SplitN(
+		strings.TrimPrefix(path, "/api/seo/"), "/", 3,
+	)
+
+	if len(parts) != 3 {
+		return "", "", ""
+	}
+
+	return parts[0], parts[1], parts[2]
+}
+
+// ParseRevisionID extracts {id} from a "revisions/{id}[/...]" action.
+func ParseRevisionID(action string) uint64 {
+	parts := strings.Split(action, "/")
+	if len(parts) < 2 || parts[0] != "revisions" {
+		return 0
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// ParsePreview reports whether the request asks for unpublished content.
+func ParsePreview(r *http.Request) bool {
+	preview, _ := strconv.ParseBool(r.URL.Query().Get("preview"))
+	return preview
+}
+
+// ParseTemplateVars collects var.{name} query parameters for template
+// rendering. It reports false unless at least one variable is given or
+// generate=true is set.
+func ParseTemplateVars(r *http.Request) (map[string]string, bool) {
+	q := r.URL.Query()
+	vars := make(map[string]string)
+	for k, v := range q {
+		if name, ok := strings.CutPrefix(k, "var."); ok && len(v) > 0 {
+			vars[name] = v[0]
+		}
+	}
+
+	generate, _ := strconv.ParseBool(q.Get("generate"))
+	return vars, generate || len(vars) > 0
+}
+
+// ParsePageFilter reads pagination, sorting and filters of GET /api/page.
+// Timestamps are RFC 3339.
+func ParsePageFilter(r *http.Request) (*md.PageFilter, error) {
+	q := r.URL.Query()
+	f := &md.PageFilter{
+		Cursor: q.Get("cursor"),
+		Sort:   q.Get("sort"),
+		Order:  q.Get("order"),
+		Title:  q.Get("title"),
+		Href:   q.Get("href"),
+		Status: q.Get("status"),
+	}
+
+	var err error
+	if f.Page, err = parseInt(q, "page"); err != nil {
+		return nil, validation.ErrInvalidPagination
+	}
+	if f.Size, err = parseInt(q, "size"); err != nil {
+		return nil, validation.ErrInvalidPagination
+	}
+
+	for key, dst := range map[string]**time.Time{
+		"created_from": &f.CreatedFrom,
+		"created_to":   &f.CreatedTo,
+		"updated_from": &f.UpdatedFrom,
+		"updated_to":   &f.UpdatedTo,
+	} {
+		if *dst, err = parseTime(q, key); err != nil {
+			return nil, validation.ErrInvalidTimeFilter
+		}
+	}
+	return f, nil
+}
+
+// ParseSEOFilter reads pagination, sorting and filters of GET /api/seo.
+// missing accepts a comma separated list and may be repeated.
+func ParseSEOFilter(r *http.Request) (*md.SEOFilter, error) {
+	q := r.URL.Query()
+	f := &md.SEOFilter{
+		Cursor:   q.Get("cursor"),
+		Sort:     q.Get("sort"),
+		Order:    q.Get("order"),
+		OBJName:  q.Get("obj_name"),
+		PKPrefix: q.Get("pk_prefix"),
+		Locale:   q.Get("locale"),
+		Status:   q.Get("status"),
+	}
+
+	for _, v := range q["missing"] {
+		for _, field := range strings.Split(v, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				f.Missing = append(f.Missing, field)
+			}
+		}
+	}
+
+	var err error
+	if f.Page, err = parseInt(q, "page"); err != nil {
+		return nil, validation.ErrInvalidPagination
+	}
+	if f.Size, err = parseInt(q, "size"); err != nil {
+		return nil, validation.ErrInvalidPagination
+	}
+	if f.UpdatedFrom, err = parseTime(q, "updated_from"); err != nil {
+		return nil, validation.ErrInvalidTimeFilter
+	}
+	if f.UpdatedTo, err = parseTime(q, "updated_to"); err != nil {
+		return nil, validation.ErrInvalidTimeFilter
+	}
+	return f, nil
+}
+
+// ParseWebhookDeliveryFilter reads the delivery log query of the webhook id.
+func ParseWebhookDeliveryFilter(r *http.Request, id uint64) (*md.WebhookDeliveryFilter, error) {
+	q := r.URL.Query()
+	f := &md.WebhookDeliveryFilter{
+		WebhookID: id,
+		Status:    q.Get("status"),
+	}
+
+	var err error
+	if f.Page, err = parseInt(q, "page"); err != nil {
+		return nil, validation.ErrInvalidPagination
+	}
+	if f.Size, err = parseInt(q, "size"); err != nil {
+		return nil, validation.ErrInvalidPagination
+	}
+	return f, nil
+}
+
+// ParseChangeFilter reads the change feed query. Giving only obj_name or only
+// slug_prefix limits the feed to SEO or page changes respectively. The resume
+// point comes from the Last-Event-ID header that EventSource sends on
+// reconnect, else from ?last_event_id.
+func ParseChangeFilter(r *http.Request) (*md.ChangeFilter, uint64, error) {
+	q := r.URL.Query()
+	f := &md.ChangeFilter{
+		OBJName:    q.Get("obj_name"),
+		SlugPrefix: q.Get("slug_prefix"),
+	}
+	f.SEO = f.OBJName != "" || f.SlugPrefix == ""
+	f.Pages = f.SlugPrefix != "" || f.OBJName == ""
+
+	v := r.Header.Get("Last-Event-ID")
+	if v == "" {
+		v = q.Get("last_event_id")
+	}
+	if v == "" {
+		return f, 0, nil
+	}
+
+	after, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return nil, 0, validation.ErrInvalidEventID
+	}
+	return f, after, nil
+}
+
+func parseInt(q url.Values, key string) (int, error) {
+	v := q.Get(key)
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(v)
+}
+
+func parseTime(q url.Values, key string) (*time.Time, error) {
+	v := q.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
 func ParsePageParams(path string) string {
 	parts := strings.Split(
 		strings.TrimPrefix(path, "/api/page/"), "/",
@@ -55,3 +253,27 @@ func ParsePageParams(path string) string {
 
 	return parts[0]
 }
+
+// ParsePageAction splits "/api/page/{slug}/{action}" into slug and action.
+func ParsePageAction(path string) (string, string) {
+	slug, action, _ := strings.Cut(strings.TrimPrefix(path, "/api/page/"), "/")
+	return slug, action
+}
+
+func ParseIDParam(path, prefix string) uint64 {
+	parts := strings.Split(
+		strings.TrimPrefix(path, prefix), "/",
+	)
+
+	id, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		zap.L().Debug(
+			"failed to decode request, incorrect id",
+			zap.String("path", path),
+			zap.Error(err),
+		)
+		return 0
+	}
+
+	return id
+}
@@ -0,0 +1,277 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_CreateWebhook(t *testing.T) {
+	const url = "/api/webhooks"
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	secret := "0123456789abcdef"
+	reqData := &md.Webhook{URL: "https://cdn.example.com/purge", Secret: secret, Events: []string{md.EventSEOUpdated}}
+
+	tests := []struct {
+		name    string
+		status  int
+		payload map[string]any
+		expect  func()
+	}{
+		{
+			name:    "ErrDecodeRequest",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"url": 0},
+			expect:  func() {},
+		},
+		{
+			name:    "InvalidURL",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"url": "/purge", "secret": secret, "events": []string{md.EventSEOUpdated}},
+			expect:  func() {},
+		},
+		{
+			name:    "ShortSecret",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"url": reqData.URL, "secret": "short", "events": []string{md.EventSEOUpdated}},
+			expect:  func() {},
+		},
+		{
+			name:    "UnknownEvent",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"url": reqData.URL, "secret": secret, "events": []string{"seo.renamed"}},
+			expect:  func() {},
+		},
+		{
+			name:    "ErrInternal",
+			status:  http.StatusInternalServerError,
+			payload: map[string]any{"url": reqData.URL, "secret": secret, "events": []string{md.EventSEOUpdated}},
+			expect: func() {
+				mctrl.EXPECT().CreateWebhook(gomock.Any(), reqData).Return(nil, errors.New("other error")).Times(1)
+			},
+		},
+		{
+			name:    "Success",
+			status:  http.StatusCreated,
+			payload: map[string]any{"url": reqData.URL, "secret": secret, "events": []string{md.EventSEOUpdated}},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateWebhook(gomock.Any(), reqData).
+					Return(&dto.CreateWebhookResponse{ID: 1}, nil).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				payload, err := json.Marshal(tt.payload)
+				assert.Nil(t, err)
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payload))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.CreateWebhook(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_UpdateWebhook(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	reqData := &md.Webhook{URL: "https://example.com", Events: []string{md.EventAll}, Disabled: true}
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "InvalidID",
+			url:    "/api/webhooks/abc",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrNotFound",
+			url:    "/api/webhooks/1",
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().UpdateWebhook(gomock.Any(), uint64(1), reqData).Return(ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "Keeps secret",
+			url:    "/api/webhooks/1",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().UpdateWebhook(gomock.Any(), uint64(1), reqData).Return(nil).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				payload, err := json.Marshal(reqData)
+				assert.Nil(t, err)
+
+				req := httptest.NewRequestWithContext(ctx, http.MethodPut, tt.url, bytes.NewBuffer(payload))
+				w := httptest.NewRecorder()
+				h.UpdateWebhook(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_DeleteWebhook(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mctrl.EXPECT().DeleteWebhook(gomock.Any(), uint64(1)).Return(nil).Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodDelete, "/api/webhooks/1", nil)
+			w := httptest.NewRecorder()
+			h.DeleteWebhook(w, req)
+			assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mctrl.EXPECT().DeleteWebhook(gomock.Any(), uint64(1)).Return(ctrl.ErrNotFound).Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodDelete, "/api/webhooks/1", nil)
+			w := httptest.NewRecorder()
+			h.DeleteWebhook(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+		},
+	)
+}
+
+func TestHandler_ListWebhookDeliveries(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			f := &md.WebhookDeliveryFilter{WebhookID: 1, Status: md.DeliveryFailed, Page: 2, Size: 10}
+			mctrl.EXPECT().
+				ListWebhookDeliveries(gomock.Any(), f).
+				Return(&dto.PaginatedWebhookDeliveries{Data: []*md.WebhookDelivery{{ID: 5}}, Count: 11}, nil).
+				Times(1)
+
+			req := httptest.NewRequestWithContext(
+				ctx, http.MethodGet, "/api/webhooks/1/deliveries?status=failed&page=2&size=10", nil,
+			)
+			w := httptest.NewRecorder()
+			h.ListWebhookDeliveries(w, req)
+			assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			res := &dto.PaginatedWebhookDeliveries{}
+			assert.Nil(t, json.NewDecoder(w.Result().Body).Decode(res))
+			assert.Equal(t, uint64(5), res.Data[0].ID)
+		},
+	)
+
+	t.Run(
+		"InvalidStatus", func(t *testing.T) {
+			req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/webhooks/1/deliveries?status=lost", nil)
+			w := httptest.NewRecorder()
+			h.ListWebhookDeliveries(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mctrl.EXPECT().ListWebhookDeliveries(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrNotFound).Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/webhooks/9/deliveries", nil)
+			w := httptest.NewRecorder()
+			h.ListWebhookDeliveries(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+		},
+	)
+}
+
+func TestHandler_RetryWebhookDelivery(t *testing.T) {
+	cmock := gomock.NewController(t)
+	defer cmock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(cmock)
+	sso := mocks.NewMockSSOSvc(cmock)
+	h := New(mctrl, sso)
+
+	ctx := context.Background()
+	const url = "/api/webhook-deliveries/5/retry"
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mctrl.EXPECT().RetryWebhookDelivery(gomock.Any(), uint64(5)).Return(nil).Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+			w := httptest.NewRecorder()
+			h.RetryWebhookDelivery(w, req)
+			assert.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mctrl.EXPECT().RetryWebhookDelivery(gomock.Any(), uint64(5)).Return(ctrl.ErrNotFound).Times(1)
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+			w := httptest.NewRecorder()
+			h.RetryWebhookDelivery(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+		},
+	)
+}
@@ -0,0 +1,15 @@
+package hdl
+
+import (
+	"context"
+	"github.com/JMURv/seo/internal/ctrl"
+)
+
+// Preview switches ctx into preview mode when it was requested by an
+// authenticated caller; anonymous preview requests are served published content.
+func Preview(ctx context.Context, requested bool) context.Context {
+	if uid, _ := ctx.Value("uid").(string); requested && uid != "" {
+		return ctrl.WithPreview(ctx)
+	}
+	return ctx
+}
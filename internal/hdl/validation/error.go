@@ -1,6 +1,26 @@
 package validation
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
+
+// FieldError describes a single invalid value by its path in the request body.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// FieldErrors collects every FieldError found while validating one request.
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, v := range e {
+		msgs = append(msgs, v.Field+" "+v.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
 
 var ErrMissingSlug = errors.New("missing slug")
 var ErrMissingTitle = errors.New("missing title")
@@ -11,5 +31,64 @@ var ErrMissingOGDescription = errors.New("missing og description")
 var ErrMissingOGImage = errors.New("missing og image")
 var ErrMissingOBJName = errors.New("missing related obj name")
 var ErrMissingOBJPK = errors.New("missing related obj pk")
+var ErrInvalidLocale = errors.New("invalid locale")
+var ErrInvalidOGType = errors.New("invalid og type")
+var ErrInvalidOGURL = errors.New("og url must be an absolute url")
+var ErrInvalidOGLocale = errors.New("og locale must look like en_US")
+var ErrInvalidOGImageSize = errors.New("og image width and height must not be negative")
+var ErrInvalidOGImageType = errors.New("og image type must be an image mime type")
+var ErrArticleFieldsNotArticle = errors.New("article fields require og type article")
+var ErrInvalidArticleTime = errors.New("article modified time must not be before published time")
+var ErrInvalidTwitterCard = errors.New("twitter card must be one of summary, summary_large_image, app, player")
+var ErrInvalidTwitterHandle = errors.New("twitter site and creator must be @handles")
+var ErrMissingOGImageSize = errors.New("og image width and height are required for summary_large_image")
+var ErrImageAltTooLong = errors.New("image alt must not exceed 420 characters")
+
+var ErrInvalidStatus = errors.New("status must be one of draft, scheduled, published, archived")
+var ErrMissingPublishAt = errors.New("publish_at is required for scheduled status")
+
+var ErrInvalidPagination = errors.New("page must be positive and size between 1 and 100")
+var ErrInvalidSort = errors.New("invalid sort field")
+var ErrInvalidOrder = errors.New("order must be asc or desc")
+var ErrInvalidCursor = errors.New("cursor is malformed or does not match sort, which must be slug or updated_at")
+var ErrInvalidSEOCursor = errors.New("cursor is malformed or does not match sort, which must be obj_pk or updated_at")
+var ErrInvalidMissingField = errors.New("missing must list description, keywords, og_title, og_description, og_image, og_type, og_url, og_image_alt, twitter_card or json_ld")
+var ErrInvalidTimeFilter = errors.New("time filters must be RFC 3339 timestamps")
+var ErrInvalidTimeRange = errors.New("time range start must not be after its end")
+
+var ErrInvalidImportMode = errors.New("mode must be upsert or insert")
+var ErrInvalidFormat = errors.New("format must be csv or jsonl")
+var ErrTooManyRows = errors.New("import must not exceed 100000 rows")
+
+var ErrInvalidTemplateVars = errors.New("at most 50 template variables with identifier names and values up to 1024 characters")
 
 var ErrMissingHref = errors.New("missing href")
+var ErrInvalidChangeFreq = errors.New("invalid changefreq")
+var ErrInvalidPriority = errors.New("priority must be between 0.0 and 1.0")
+var ErrSelfParent = errors.New("parent slug must differ from slug")
+var ErrInvalidPosition = errors.New("position must not be negative")
+var ErrInvalidDeleteStrategy = errors.New("strategy must be reject, cascade or reparent")
+var ErrInvalidResolvePath = errors.New("path must start with / and not exceed 2048 characters")
+
+var ErrMissingUserAgent = errors.New("missing user agent")
+var ErrInvalidRuleType = errors.New("rule type must be allow or disallow")
+var ErrInvalidRulePath = errors.New("rule path must start with / or *")
+var ErrInvalidCrawlDelay = errors.New("crawl delay must not be negative")
+var ErrInvalidRobotsValue = errors.New("robots values must not contain line breaks")
+var ErrInvalidURL = errors.New("invalid absolute url")
+
+var ErrMissingSource = errors.New("missing source")
+var ErrInvalidSource = errors.New("source must be a path starting with /")
+var ErrInvalidSourceRegex = errors.New("source is not a valid regular expression")
+var ErrMissingTarget = errors.New("missing target")
+var ErrInvalidTarget = errors.New("target must be a path starting with / or an absolute url")
+var ErrInvalidRedirectCode = errors.New("code must be one of 301, 302, 307, 308")
+var ErrSelfRedirect = errors.New("source and target must differ")
+var ErrInvalidRedirectValue = errors.New("redirect values must not contain line breaks")
+
+var ErrMissingWebhookURL = errors.New("missing url")
+var ErrInvalidWebhookSecret = errors.New("secret must be at least 16 characters")
+var ErrMissingWebhookEvents = errors.New("missing events")
+var ErrInvalidWebhookEvent = errors.New("events must be *, seo.created, seo.updated, seo.deleted, page.created, page.updated or page.deleted")
+var ErrInvalidDeliveryStatus = errors.New("status must be pending, delivered or failed")
+var ErrInvalidEventID = errors.New("last event id must be an unsigned integer")
@@ -0,0 +1,221 @@
+package validation
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// schemaOrgJSON is the bundled subset of the schema.org vocabulary. Every type
+// lists its required properties and the value types accepted by each property;
+// properties are inherited through "extends".
+//
+//go:embed schemaorg.json
+var schemaOrgJSON []byte
+
+type schemaType struct {
+	Extends    string              `json:"extends"`
+	Required   []string            `json:"required"`
+	Properties map[string][]string `json:"properties"`
+}
+
+var schemaOrg = mustLoadSchema(schemaOrgJSON)
+
+func mustLoadSchema(data []byte) map[string]*schemaType {
+	res := make(map[string]*schemaType)
+	if err := json.Unmarshal(data, &res); err != nil {
+		panic(fmt.Sprintf("invalid bundled schema.org vocabulary: %v", err))
+	}
+	return res
+}
+
+// ValidateJSONLD checks every block against the bundled vocabulary and reports
+// all problems at once as FieldErrors keyed by the path inside json_ld.
+func ValidateJSONLD(blocks []map[string]any) error {
+	var errs FieldErrors
+	for i, block := range blocks {
+		path := fmt.Sprintf("json_ld[%d]", i)
+
+		switch block["@context"] {
+		case "https://schema.org", "https://schema.org/", "http://schema.org", "http://schema.org/":
+		default:
+			errs = append(errs, FieldError{Field: path + ".@context", Message: "must be https://schema.org"})
+		}
+
+		name, ok := block["@type"].(string)
+		if !ok || name == "" {
+			errs = append(errs, FieldError{Field: path + ".@type", Message: "is required"})
+			continue
+		}
+
+		errs = validateEntity(errs, path, name, block)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateEntity(errs FieldErrors, path, name string, obj map[string]any) FieldErrors {
+	if _, ok := schemaOrg[name]; !ok {
+		return append(errs, FieldError{Field: path + ".@type", Message: "unsupported type " + name})
+	}
+
+	for _, prop := range requiredProperties(name) {
+		if isEmptyValue(obj[prop]) {
+			errs = append(errs, FieldError{Field: path + "." + prop, Message: "is required for " + name})
+		}
+	}
+
+	props := make([]string, 0, len(obj))
+	for prop := range obj {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+
+	for _, prop := range props {
+		if prop == "@context" || prop == "@type" {
+			continue
+		}
+
+		field := path + "." + prop
+		expected, ok := propertyTypes(name, prop)
+		if !ok {
+			errs = append(errs, FieldError{Field: field, Message: "is not a property of " + name})
+			continue
+		}
+
+		if values, ok := obj[prop].([]any); ok {
+			for i, v := range values {
+				errs = validateValue(errs, fmt.Sprintf("%s[%d]", field, i), expected, v)
+			}
+			continue
+		}
+		errs = validateValue(errs, field, expected, obj[prop])
+	}
+
+	return errs
+}
+
+// validateValue accepts v if it matches any of the expected types. Nested
+// objects without an explicit @type are validated as the first entity type.
+func validateValue(errs FieldErrors, field string, expected []string, v any) FieldErrors {
+	if obj, ok := v.(map[string]any); ok {
+		name, _ := obj["@type"].(string)
+		for _, t := range expected {
+			if _, isEntity := schemaOrg[t]; !isEntity {
+				continue
+			}
+			if name == "" {
+				return validateEntity(errs, field, t, obj)
+			}
+			if isSubtype(name, t) {
+				return validateEntity(errs, field, name, obj)
+			}
+		}
+	} else {
+		for _, t := range expected {
+			if matchesDataType(t, v) {
+				return errs
+			}
+		}
+	}
+
+	return append(errs, FieldError{Field: field, Message: "must be " + strings.Join(expected, " or ")})
+}
+
+func matchesDataType(t string, v any) bool {
+	switch t {
+	case "Text":
+		_, ok := v.(string)
+		return ok
+	case "URL":
+		s, ok := v.(string)
+		return ok && isAbsURL(s)
+	case "Number":
+		_, ok := toNumber(v)
+		return ok
+	case "Integer":
+		n, ok := toNumber(v)
+		return ok && n == math.Trunc(n)
+	case "Boolean":
+		_, ok := v.(bool)
+		return ok
+	case "Date":
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		_, err := time.Parse(time.DateOnly, s)
+		return err == nil
+	case "DateTime":
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	}
+	return false
+}
+
+func toNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func isEmptyValue(v any) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(val) == ""
+	case []any:
+		return len(val) == 0
+	}
+	return false
+}
+
+func isSubtype(name, parent string) bool {
+	for t, ok := schemaOrg[name]; ok; t, ok = schemaOrg[t.Extends] {
+		if name == parent {
+			return true
+		}
+		name = t.Extends
+	}
+	return false
+}
+
+func requiredProperties(name string) []string {
+	var res []string
+	for t, ok := schemaOrg[name]; ok; t, ok = schemaOrg[t.Extends] {
+		res = append(res, t.Required...)
+	}
+	return res
+}
+
+func propertyTypes(name, prop string) ([]string, bool) {
+	for t, ok := schemaOrg[name]; ok; t, ok = schemaOrg[t.Extends] {
+		if types, ok := t.Properties[prop]; ok {
+			return types, true
+		}
+	}
+	return nil, false
+}
@@ -1,6 +1,92 @@
 package validation
 
-import md "github.com/JMURv/seo/internal/models"
+import (
+	md "github.com/JMURv/seo/internal/models"
+	"strings"
+	"time"
+)
+
+const maxPageSize = 100
+const maxResolvePath = 2048
+
+var changeFreqs = map[string]struct{}{
+	"":        {},
+	"always":  {},
+	"hourly":  {},
+	"daily":   {},
+	"weekly":  {},
+	"monthly": {},
+	"yearly":  {},
+	"never":   {},
+}
+
+var pageSorts = map[string]struct{}{
+	"":               {},
+	md.SortSlug:      {},
+	md.SortTitle:     {},
+	md.SortPriority:  {},
+	md.SortCreatedAt: {},
+	md.SortUpdatedAt: {},
+}
+
+var pageDeleteStrategies = map[string]struct{}{
+	"":                    {},
+	md.PageDeleteReject:   {},
+	md.PageDeleteCascade:  {},
+	md.PageDeleteReparent: {},
+}
+
+// keysetSorts are the sorts a cursor can continue.
+var keysetSorts = map[string]struct{}{
+	md.SortSlug:      {},
+	md.SortUpdatedAt: {},
+}
+
+func ValidatePageFilter(f *md.PageFilter) error {
+	if f.Page < 0 || f.Size < 0 || f.Size > maxPageSize {
+		return ErrInvalidPagination
+	}
+
+	if _, ok := pageSorts[f.Sort]; !ok {
+		return ErrInvalidSort
+	}
+
+	if err := validateListParams(f.Order, f.Status); err != nil {
+		return err
+	}
+
+	if f.Cursor != "" {
+		sort := f.Sort
+		if sort == "" {
+			sort = md.SortSlug
+		}
+
+		cur, err := md.DecodeCursor(f.Cursor)
+		if _, ok := keysetSorts[sort]; !ok || err != nil || cur.Sort != sort {
+			return ErrInvalidCursor
+		}
+	}
+
+	if !validRange(f.CreatedFrom, f.CreatedTo) || !validRange(f.UpdatedFrom, f.UpdatedTo) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
+func validateListParams(order, status string) error {
+	if order != "" && order != md.OrderAsc && order != md.OrderDesc {
+		return ErrInvalidOrder
+	}
+
+	if _, ok := statuses[status]; !ok {
+		return ErrInvalidStatus
+	}
+	return nil
+}
+
+func validRange(from, to *time.Time) bool {
+	return from == nil || to == nil || !from.After(*to)
+}
 
 func ValidatePage(req *md.Page) error {
 	if req.Slug == "" {
@@ -14,5 +100,39 @@ func ValidatePage(req *md.Page) error {
 	if req.Href == "" {
 		return ErrMissingHref
 	}
+
+	if _, ok := changeFreqs[req.ChangeFreq]; !ok {
+		return ErrInvalidChangeFreq
+	}
+
+	if req.Priority < 0 || req.Priority > 1 {
+		return ErrInvalidPriority
+	}
+
+	if req.ParentSlug == req.Slug {
+		return ErrSelfParent
+	}
+
+	if req.Position < 0 {
+		return ErrInvalidPosition
+	}
+
+	if err := validatePublishing(req.Status, req.PublishAt); err != nil {
+		return err
+	}
+	return nil
+}
+
+func ValidatePageDeleteStrategy(strategy string) error {
+	if _, ok := pageDeleteStrategies[strategy]; !ok {
+		return ErrInvalidDeleteStrategy
+	}
+	return nil
+}
+
+func ValidateResolvePath(path string) error {
+	if !strings.HasPrefix(path, "/") || len(path) > maxResolvePath {
+		return ErrInvalidResolvePath
+	}
 	return nil
 }
@@ -0,0 +1,25 @@
+package validation
+
+import (
+	md "github.com/JMURv/seo/internal/models"
+	"time"
+)
+
+var statuses = map[string]struct{}{
+	"":                 {},
+	md.StatusDraft:     {},
+	md.StatusScheduled: {},
+	md.StatusPublished: {},
+	md.StatusArchived:  {},
+}
+
+func validatePublishing(status string, publishAt *time.Time) error {
+	if _, ok := statuses[status]; !ok {
+		return ErrInvalidStatus
+	}
+
+	if status == md.StatusScheduled && publishAt == nil {
+		return ErrMissingPublishAt
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package validation
+
+import (
+	md "github.com/JMURv/seo/internal/models"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+var redirectCodes = map[int]struct{}{
+	http.StatusMovedPermanently:  {},
+	http.StatusFound:             {},
+	http.StatusTemporaryRedirect: {},
+	http.StatusPermanentRedirect: {},
+}
+
+func ValidateRedirect(req *md.Redirect) error {
+	if req.Source == "" {
+		return ErrMissingSource
+	}
+
+	if hasLineBreak(req.Source) || hasLineBreak(req.Target) {
+		return ErrInvalidRedirectValue
+	}
+
+	if req.Regex {
+		if _, err := regexp.Compile(req.Source); err != nil {
+			return ErrInvalidSourceRegex
+		}
+	} else if !strings.HasPrefix(req.Source, "/") {
+		return ErrInvalidSource
+	}
+
+	if req.Target == "" {
+		return ErrMissingTarget
+	}
+
+	if !strings.HasPrefix(req.Target, "/") && !isAbsURL(req.Target) {
+		return ErrInvalidTarget
+	}
+
+	if !req.Regex && req.Source == req.Target {
+		return ErrSelfRedirect
+	}
+
+	if _, ok := redirectCodes[req.Code]; req.Code != 0 && !ok {
+		return ErrInvalidRedirectCode
+	}
+	return nil
+}
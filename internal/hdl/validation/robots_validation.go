@@ -0,0 +1,53 @@
+package validation
+
+import (
+	md "github.com/JMURv/seo/internal/models"
+	"net/url"
+	"strings"
+)
+
+func ValidateRobotsGroup(req *md.RobotsGroup) error {
+	if strings.TrimSpace(req.UserAgent) == "" {
+		return ErrMissingUserAgent
+	}
+
+	if hasLineBreak(req.UserAgent) {
+		return ErrInvalidRobotsValue
+	}
+
+	if req.CrawlDelay < 0 {
+		return ErrInvalidCrawlDelay
+	}
+
+	for _, r := range req.Rules {
+		if r.Type != md.RobotsAllow && r.Type != md.RobotsDisallow {
+			return ErrInvalidRuleType
+		}
+
+		if hasLineBreak(r.Path) {
+			return ErrInvalidRobotsValue
+		}
+
+		if r.Path != "" && !strings.HasPrefix(r.Path, "/") && !strings.HasPrefix(r.Path, "*") {
+			return ErrInvalidRulePath
+		}
+	}
+
+	return nil
+}
+
+func ValidateRobotsSitemap(req *md.RobotsSitemap) error {
+	if hasLineBreak(req.URL) || !isAbsURL(req.URL) {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
+func isAbsURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func hasLineBreak(s string) bool {
+	return strings.ContainsAny(s, "\r\n")
+}
@@ -1,6 +1,92 @@
 package validation
 
-import md "github.com/JMURv/seo/internal/models"
+import (
+	md "github.com/JMURv/seo/internal/models"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+var localeRe = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+var ogLocaleRe = regexp.MustCompile(`^[a-z]{2,3}_[A-Z]{2}$`)
+var twitterHandleRe = regexp.MustCompile(`^@\w{1,15}$`)
+
+const maxImageAlt = 420
+
+var ogTypes = map[string]struct{}{
+	"website":             {},
+	md.OGTypeArticle:      {},
+	"book":                {},
+	"profile":             {},
+	"product":             {},
+	"music.song":          {},
+	"music.album":         {},
+	"music.playlist":      {},
+	"music.radio_station": {},
+	"video.movie":         {},
+	"video.episode":       {},
+	"video.tv_show":       {},
+	"video.other":         {},
+}
+
+var twitterCards = map[string]struct{}{
+	md.TwitterCardSummary:           {},
+	md.TwitterCardSummaryLargeImage: {},
+	md.TwitterCardApp:               {},
+	md.TwitterCardPlayer:            {},
+}
+
+var seoSorts = map[string]struct{}{
+	"":               {},
+	md.SortOBJPK:     {},
+	md.SortTitle:     {},
+	md.SortCreatedAt: {},
+	md.SortUpdatedAt: {},
+}
+
+func ValidateSEOFilter(f *md.SEOFilter) error {
+	if f.Page < 0 || f.Size < 0 || f.Size > maxPageSize {
+		return ErrInvalidPagination
+	}
+
+	if _, ok := seoSorts[f.Sort]; !ok {
+		return ErrInvalidSort
+	}
+
+	if err := validateListParams(f.Order, f.Status); err != nil {
+		return err
+	}
+
+	if f.Locale != "" {
+		if err := ValidateLocale(f.Locale); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range f.Missing {
+		if !slices.Contains(md.SEOMissingFields, field) {
+			return ErrInvalidMissingField
+		}
+	}
+
+	if f.Cursor != "" {
+		sort := f.Sort
+		if sort == "" {
+			sort = md.SortOBJPK
+		}
+
+		cur, err := md.DecodeCursor(f.Cursor)
+		if (sort != md.SortOBJPK && sort != md.SortUpdatedAt) || err != nil || cur.Sort != sort {
+			return ErrInvalidSEOCursor
+		}
+	}
+
+	if !validRange(f.UpdatedFrom, f.UpdatedTo) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
 
 func ValidateSEO(seo *md.SEO) error {
 	if seo.Title == "" {
@@ -35,5 +121,94 @@ func ValidateSEO(seo *md.SEO) error {
 		return ErrMissingOBJPK
 	}
 
+	if err := ValidateLocale(seo.Locale); err != nil {
+		return err
+	}
+
+	if err := validatePublishing(seo.Status, seo.PublishAt); err != nil {
+		return err
+	}
+
+	if err := validateOpenGraph(seo); err != nil {
+		return err
+	}
+
+	if err := validateTwitterCard(seo); err != nil {
+		return err
+	}
+
+	if err := ValidateJSONLD(seo.JSONLD); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateOpenGraph(seo *md.SEO) error {
+	if _, ok := ogTypes[seo.OGType]; seo.OGType != "" && !ok {
+		return ErrInvalidOGType
+	}
+
+	if seo.OGURL != "" && !isAbsURL(seo.OGURL) {
+		return ErrInvalidOGURL
+	}
+
+	if seo.OGLocale != "" && !ogLocaleRe.MatchString(seo.OGLocale) {
+		return ErrInvalidOGLocale
+	}
+
+	if seo.OGImageWidth < 0 || seo.OGImageHeight < 0 {
+		return ErrInvalidOGImageSize
+	}
+
+	if seo.OGImageType != "" && !strings.HasPrefix(seo.OGImageType, "image/") {
+		return ErrInvalidOGImageType
+	}
+
+	if utf8.RuneCountInString(seo.OGImageAlt) > maxImageAlt {
+		return ErrImageAltTooLong
+	}
+
+	hasArticle := seo.ArticlePublishedTime != nil || seo.ArticleModifiedTime != nil ||
+		len(seo.ArticleAuthor) > 0 || seo.ArticleSection != "" || len(seo.ArticleTag) > 0
+	if hasArticle && seo.OGType != md.OGTypeArticle {
+		return ErrArticleFieldsNotArticle
+	}
+
+	if seo.ArticlePublishedTime != nil && seo.ArticleModifiedTime != nil &&
+		seo.ArticleModifiedTime.Before(*seo.ArticlePublishedTime) {
+		return ErrInvalidArticleTime
+	}
+
+	return nil
+}
+
+func validateTwitterCard(seo *md.SEO) error {
+	if _, ok := twitterCards[seo.TwitterCard]; seo.TwitterCard != "" && !ok {
+		return ErrInvalidTwitterCard
+	}
+
+	if seo.TwitterCard == md.TwitterCardSummaryLargeImage && (seo.OGImageWidth == 0 || seo.OGImageHeight == 0) {
+		return ErrMissingOGImageSize
+	}
+
+	for _, handle := range []string{seo.TwitterSite, seo.TwitterCreator} {
+		if handle != "" && !twitterHandleRe.MatchString(handle) {
+			return ErrInvalidTwitterHandle
+		}
+	}
+
+	if utf8.RuneCountInString(seo.TwitterImageAlt) > maxImageAlt {
+		return ErrImageAltTooLong
+	}
+
+	return nil
+}
+
+// ValidateLocale accepts an empty locale (the default record) or a BCP 47 tag.
+func ValidateLocale(locale string) error {
+	if locale != "" && !localeRe.MatchString(md.NormalizeLocale(locale)) {
+		return ErrInvalidLocale
+	}
 	return nil
 }
@@ -0,0 +1,71 @@
+package validation
+
+import (
+	md "github.com/JMURv/seo/internal/models"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxTemplateLen = 2048
+const maxTemplateVars = 50
+const maxTemplateVarLen = 1024
+
+var templateVarName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateSEOTemplate parses and test-renders every field so broken templates
+// are rejected on save rather than at request time.
+func ValidateSEOTemplate(req *md.SEOTemplate) error {
+	if req.OBJName == "" {
+		return ErrMissingOBJName
+	}
+
+	if err := ValidateLocale(req.Locale); err != nil {
+		return err
+	}
+
+	if req.Title == "" {
+		return ErrMissingTitle
+	}
+
+	fields := req.Fields()
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs FieldErrors
+	for _, name := range names {
+		text := fields[name]
+		switch {
+		case utf8.RuneCountInString(text) > maxTemplateLen:
+			errs = append(errs, FieldError{Field: name, Message: "must not exceed 2048 characters"})
+		case strings.ContainsAny(text, "<>"):
+			errs = append(errs, FieldError{Field: name, Message: "must not contain markup"})
+		default:
+			if _, err := md.RenderTemplateField(name, text, nil); err != nil {
+				errs = append(errs, FieldError{Field: name, Message: err.Error()})
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ValidateTemplateVars(vars map[string]string) error {
+	if len(vars) > maxTemplateVars {
+		return ErrInvalidTemplateVars
+	}
+
+	for k, v := range vars {
+		if !templateVarName.MatchString(k) || utf8.RuneCountInString(v) > maxTemplateVarLen {
+			return ErrInvalidTemplateVars
+		}
+	}
+	return nil
+}
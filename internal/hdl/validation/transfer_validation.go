@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+)
+
+// maxImportRows bounds a single import so it fits one transaction comfortably.
+const maxImportRows = 100000
+
+func ValidateImportMode(mode string) error {
+	if mode != "" && mode != md.ImportModeUpsert && mode != md.ImportModeInsert {
+		return ErrInvalidImportMode
+	}
+	return nil
+}
+
+// ValidateSEORows runs ValidateSEO on every row and rejects duplicate keys,
+// appending problems to errs. Rows are numbered from 1; nil rows failed to
+// decode and are expected to be reported already.
+func ValidateSEORows(rows []*md.SEO, errs []dto.ImportRowError) []dto.ImportRowError {
+	if len(rows) > maxImportRows {
+		return append(errs, dto.ImportRowError{Row: maxImportRows + 1, Message: ErrTooManyRows.Error()})
+	}
+
+	seen := make(map[[3]string]int, len(rows))
+	for i, row := range rows {
+		if row == nil {
+			continue
+		}
+
+		n := i + 1
+		if err := ValidateSEO(row); err != nil {
+			var fields FieldErrors
+			if errors.As(err, &fields) {
+				for _, f := range fields {
+					errs = append(errs, dto.ImportRowError{Row: n, Field: f.Field, Message: f.Message})
+				}
+			} else {
+				errs = append(errs, dto.ImportRowError{Row: n, Message: err.Error()})
+			}
+			continue
+		}
+
+		key := [3]string{row.OBJName, row.OBJPK, md.NormalizeLocale(row.Locale)}
+		if prev, ok := seen[key]; ok {
+			errs = append(errs, dto.ImportRowError{Row: n, Message: fmt.Sprintf("duplicates row %d", prev)})
+			continue
+		}
+		seen[key] = n
+	}
+	return errs
+}
@@ -0,0 +1,50 @@
+package validation
+
+import md "github.com/JMURv/seo/internal/models"
+
+const minWebhookSecret = 16
+
+var deliveryStatuses = map[string]struct{}{
+	"":                   {},
+	md.DeliveryPending:   {},
+	md.DeliveryDelivered: {},
+	md.DeliveryFailed:    {},
+}
+
+// ValidateWebhook checks a subscription. The secret may be omitted on update
+// to keep the stored one.
+func ValidateWebhook(req *md.Webhook, create bool) error {
+	if req.URL == "" {
+		return ErrMissingWebhookURL
+	}
+
+	if !isAbsURL(req.URL) {
+		return ErrInvalidURL
+	}
+
+	if (create || req.Secret != "") && len(req.Secret) < minWebhookSecret {
+		return ErrInvalidWebhookSecret
+	}
+
+	if len(req.Events) == 0 {
+		return ErrMissingWebhookEvents
+	}
+
+	for _, v := range req.Events {
+		if _, ok := md.WebhookEvents[v]; !ok {
+			return ErrInvalidWebhookEvent
+		}
+	}
+	return nil
+}
+
+func ValidateWebhookDeliveryFilter(f *md.WebhookDeliveryFilter) error {
+	if f.Page < 0 || f.Size < 0 || f.Size > maxPageSize {
+		return ErrInvalidPagination
+	}
+
+	if _, ok := deliveryStatuses[f.Status]; !ok {
+		return ErrInvalidDeliveryStatus
+	}
+	return nil
+}
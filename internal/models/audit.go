@@ -0,0 +1,14 @@
+package models
+
+// Audit rule names. They are the keys of audit.rules in the config and the
+// rule field of reported violations.
+const (
+	AuditTitleLength        = "title_length"
+	AuditDescriptionLength  = "description_length"
+	AuditKeywordStuffing    = "keyword_stuffing"
+	AuditTitleEqualsOGTitle = "title_equals_og_title"
+	AuditMissingOGImage     = "missing_og_image"
+	AuditDuplicateTitle     = "duplicate_title"
+	AuditDuplicateDesc      = "duplicate_description"
+	AuditUppercaseAbuse     = "uppercase_abuse"
+)
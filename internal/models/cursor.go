@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	SortSlug      = "slug"
+	SortOBJPK     = "obj_pk"
+	SortTitle     = "title"
+	SortPriority  = "priority"
+	SortCreatedAt = "created_at"
+	SortUpdatedAt = "updated_at"
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position of the last row of a keyset page: the value of the
+// sort column and the columns of the unique key that break ties.
+type Cursor struct {
+	Sort  string   `json:"s"`
+	Value string   `json:"v,omitempty"`
+	Key   []string `json:"k"`
+}
+
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(s string) (Cursor, error) {
+	var c Cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+
+	if err = json.Unmarshal(data, &c); err != nil || len(c.Key) == 0 {
+		return c, ErrInvalidCursor
+	}
+	return c, nil
+}
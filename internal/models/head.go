@@ -0,0 +1,138 @@
+package models
+
+import (
+	"encoding/json"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HeadTag is one element of a rendered <head>. Only the attributes relevant to
+// Tag are set: title carries Text, meta carries Name or Property with Content,
+// link carries Rel and Href, and script carries Type with Text.
+type HeadTag struct {
+	Tag      string `json:"tag"`
+	Name     string `json:"name,omitempty"`
+	Property string `json:"property,omitempty"`
+	Content  string `json:"content,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+	Href     string `json:"href,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Text     string `json:"text,omitempty"`
+}
+
+// HeadTags lists the tags for the record in a stable order. Empty values are
+// skipped, og:title, og:description and og:url fall back to the title,
+// description and canonical URL, and og:locale is derived from Locale.
+func (s *SEO) HeadTags(canonical string) []HeadTag {
+	res := make([]HeadTag, 0, 32)
+	name := func(n, v string) {
+		if v != "" {
+			res = append(res, HeadTag{Tag: "meta", Name: n, Content: v})
+		}
+	}
+	prop := func(p, v string) {
+		if v != "" {
+			res = append(res, HeadTag{Tag: "meta", Property: p, Content: v})
+		}
+	}
+	num := func(p string, v int) {
+		if v > 0 {
+			prop(p, strconv.Itoa(v))
+		}
+	}
+	at := func(p string, v *time.Time) {
+		if v != nil {
+			prop(p, v.UTC().Format(time.RFC3339))
+		}
+	}
+
+	if s.Title != "" {
+		res = append(res, HeadTag{Tag: "title", Text: s.Title})
+	}
+	name("description", s.Description)
+	name("keywords", s.Keywords)
+	if canonical != "" {
+		res = append(res, HeadTag{Tag: "link", Rel: "canonical", Href: canonical})
+	}
+
+	prop("og:title", fallback(s.OGTitle, s.Title))
+	prop("og:description", fallback(s.OGDescription, s.Description))
+	prop("og:type", s.OGType)
+	prop("og:url", fallback(s.OGURL, canonical))
+	prop("og:image", s.OGImage)
+	num("og:image:width", s.OGImageWidth)
+	num("og:image:height", s.OGImageHeight)
+	prop("og:image:alt", s.OGImageAlt)
+	prop("og:image:type", s.OGImageType)
+	prop("og:locale", fallback(s.OGLocale, strings.ReplaceAll(s.Locale, "-", "_")))
+	prop("og:site_name", s.OGSiteName)
+
+	at("article:published_time", s.ArticlePublishedTime)
+	at("article:modified_time", s.ArticleModifiedTime)
+	for _, v := range s.ArticleAuthor {
+		prop("article:author", v)
+	}
+	prop("article:section", s.ArticleSection)
+	for _, v := range s.ArticleTag {
+		prop("article:tag", v)
+	}
+
+	name("twitter:card", s.TwitterCard)
+	name("twitter:site", s.TwitterSite)
+	name("twitter:creator", s.TwitterCreator)
+	name("twitter:image:alt", s.TwitterImageAlt)
+
+	for _, v := range s.JSONLD {
+		// json.Marshal escapes <, > and &, so the block cannot close the script.
+		if b, err := json.Marshal(v); err == nil {
+			res = append(res, HeadTag{Tag: "script", Type: "application/ld+json", Text: string(b)})
+		}
+	}
+	return res
+}
+
+// RenderHead writes tags as an HTML fragment, one element per line, with all
+// attribute values and title text escaped.
+func RenderHead(tags []HeadTag) string {
+	var b strings.Builder
+	attr := func(k, v string) {
+		if v != "" {
+			b.WriteString(" " + k + `="` + html.EscapeString(v) + `"`)
+		}
+	}
+
+	for _, t := range tags {
+		switch t.Tag {
+		case "title":
+			b.WriteString("<title>" + html.EscapeString(t.Text) + "</title>")
+		case "meta":
+			b.WriteString("<meta")
+			attr("name", t.Name)
+			attr("property", t.Property)
+			attr("content", t.Content)
+			b.WriteString(">")
+		case "link":
+			b.WriteString("<link")
+			attr("rel", t.Rel)
+			attr("href", t.Href)
+			b.WriteString(">")
+		case "script":
+			b.WriteString("<script")
+			attr("type", t.Type)
+			b.WriteString(">" + t.Text + "</script>")
+		default:
+			continue
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func fallback(v, def string) string {
+	if v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/dto"
+)
+
+func SEOAuditToProto(req *dto.SEOAudit) *gen.SEOAuditMsg {
+	res := &gen.SEOAuditMsg{
+		ObjName:    req.OBJName,
+		ObjPk:      req.OBJPK,
+		Locale:     req.Locale,
+		Score:      int32(req.Score),
+		Violations: make([]*gen.AuditViolationMsg, 0, len(req.Violations)),
+	}
+	for _, v := range req.Violations {
+		res.Violations = append(
+			res.Violations, &gen.AuditViolationMsg{
+				Rule:    v.Rule,
+				Field:   v.Field,
+				Message: v.Message,
+				Weight:  int32(v.Weight),
+			},
+		)
+	}
+	return res
+}
+
+func AuditReportToProto(req *dto.AuditReport) *gen.AuditReportRes {
+	res := &gen.AuditReportRes{
+		Records:    int32(req.Records),
+		Score:      req.Score,
+		Violations: countsToProto(req.Violations),
+		Objects:    make([]*gen.AuditObjectReportMsg, 0, len(req.Objects)),
+	}
+	for _, v := range req.Objects {
+		res.Objects = append(
+			res.Objects, &gen.AuditObjectReportMsg{
+				ObjName:    v.OBJName,
+				Records:    int32(v.Records),
+				Score:      v.Score,
+				MinScore:   int32(v.MinScore),
+				Violations: countsToProto(v.Violations),
+			},
+		)
+	}
+	return res
+}
+
+func countsToProto(req map[string]int) map[string]int32 {
+	res := make(map[string]int32, len(req))
+	for k, v := range req {
+		res[k] = int32(v)
+	}
+	return res
+}
@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"encoding/json"
+	"github.com/JMURv/seo/api/grpc/v1/gen"
+	md "github.com/JMURv/seo/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func SEOChangeToProto(req *md.OutboxEvent) (*gen.SEOChangeMsg, error) {
+	head, ch := &md.ChangeEvent{}, &md.SEOChange{}
+	if err := json.Unmarshal(req.Payload, head); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(req.Payload, ch); err != nil {
+		return nil, err
+	}
+
+	res := &gen.SEOChangeMsg{
+		Id:         req.ID,
+		Event:      req.Event,
+		Uid:        head.UID,
+		OccurredAt: timestamppb.New(head.OccurredAt),
+	}
+	if ch.Before != nil {
+		res.Before = ModelToProto(ch.Before)
+	}
+	if ch.After != nil {
+		res.After = ModelToProto(ch.After)
+	}
+	return res, nil
+}
+
+func PageChangeToProto(req *md.OutboxEvent) (*gen.PageChangeMsg, error) {
+	head, ch := &md.ChangeEvent{}, &md.PageChange{}
+	if err := json.Unmarshal(req.Payload, head); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(req.Payload, ch); err != nil {
+		return nil, err
+	}
+
+	res := &gen.PageChangeMsg{
+		Id:         req.ID,
+		Event:      req.Event,
+		Uid:        head.UID,
+		OccurredAt: timestamppb.New(head.OccurredAt),
+	}
+	if ch.Before != nil {
+		res.Before = PageToProto(ch.Before)
+	}
+	if ch.After != nil {
+		res.After = PageToProto(ch.After)
+	}
+	return res, nil
+}
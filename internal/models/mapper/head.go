@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"github.com/JMURv/seo/api/grpc/v1/gen"
+	md "github.com/JMURv/seo/internal/models"
+)
+
+func HeadToProto(req []md.HeadTag) *gen.SEOHeadRes {
+	res := &gen.SEOHeadRes{
+		Html: md.RenderHead(req),
+		Tags: make([]*gen.HeadTagMsg, 0, len(req)),
+	}
+	for _, v := range req {
+		res.Tags = append(
+			res.Tags, &gen.HeadTagMsg{
+				Tag:      v.Tag,
+				Name:     v.Name,
+				Property: v.Property,
+				Content:  v.Content,
+				Rel:      v.Rel,
+				Href:     v.Href,
+				Type:     v.Type,
+				Text:     v.Text,
+			},
+		)
+	}
+	return res
+}
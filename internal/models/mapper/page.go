@@ -2,7 +2,10 @@ package mapper
 
 import (
 	"github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/dto"
 	md "github.com/JMURv/seo/internal/models"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -16,20 +19,106 @@ func PagesToProto(req []*md.Page) []*gen.PageMsg {
 
 func PageToProto(req *md.Page) *gen.PageMsg {
 	return &gen.PageMsg{
-		Slug:      req.Slug,
-		Title:     req.Title,
-		Href:      req.Href,
-		CreatedAt: timestamppb.New(req.CreatedAt),
-		UpdatedAt: timestamppb.New(req.UpdatedAt),
+		Slug:       req.Slug,
+		Title:      req.Title,
+		Href:       req.Href,
+		Changefreq: req.ChangeFreq,
+		Priority:   req.Priority,
+		ParentSlug: req.ParentSlug,
+		Position:   int32(req.Position),
+		Status:     req.Status,
+		PublishAt:  timeToProto(req.PublishAt),
+		CreatedAt:  timestamppb.New(req.CreatedAt),
+		UpdatedAt:  timestamppb.New(req.UpdatedAt),
 	}
 }
 
 func ProtoToPage(req *gen.PageMsg) *md.Page {
 	return &md.Page{
-		Slug:      req.Slug,
-		Title:     req.Title,
-		Href:      req.Href,
-		CreatedAt: req.CreatedAt.AsTime(),
-		UpdatedAt: req.UpdatedAt.AsTime(),
+		Slug:       req.Slug,
+		Title:      req.Title,
+		Href:       req.Href,
+		ChangeFreq: req.Changefreq,
+		Priority:   req.Priority,
+		ParentSlug: req.ParentSlug,
+		Position:   int(req.Position),
+		Status:     req.Status,
+		PublishAt:  protoToTime(req.PublishAt),
+		CreatedAt:  req.CreatedAt.AsTime(),
+		UpdatedAt:  req.UpdatedAt.AsTime(),
 	}
 }
+
+func ProtoToPageFilter(req *gen.ListPagesReq) *md.PageFilter {
+	return &md.PageFilter{
+		Page:        int(req.Page),
+		Size:        int(req.Size),
+		Cursor:      req.Cursor,
+		Sort:        req.Sort,
+		Order:       req.Order,
+		Title:       req.Title,
+		Href:        req.Href,
+		Status:      req.Status,
+		CreatedFrom: protoToTime(req.CreatedFrom),
+		CreatedTo:   protoToTime(req.CreatedTo),
+		UpdatedFrom: protoToTime(req.UpdatedFrom),
+		UpdatedTo:   protoToTime(req.UpdatedTo),
+	}
+}
+
+func PaginatedPagesToProto(req *dto.PaginatedPages) *gen.ListPageRes {
+	return &gen.ListPageRes{
+		Pages:       PagesToProto(req.Data),
+		Count:       req.Count,
+		TotalPages:  int32(req.TotalPages),
+		CurrentPage: int32(req.CurrentPage),
+		HasNextPage: req.HasNextPage,
+		NextCursor:  req.NextCursor,
+	}
+}
+
+func PageTreeToProto(req []*dto.PageNode) []*gen.PageNodeMsg {
+	res := make([]*gen.PageNodeMsg, 0, len(req))
+	for _, v := range req {
+		res = append(
+			res, &gen.PageNodeMsg{
+				Slug:     v.Slug,
+				Title:    v.Title,
+				Href:     v.Href,
+				Position: int32(v.Position),
+				Status:   v.Status,
+				Children: PageTreeToProto(v.Children),
+			},
+		)
+	}
+	return res
+}
+
+func BreadcrumbsToProto(req *dto.Breadcrumbs) *gen.BreadcrumbsRes {
+	res := &gen.BreadcrumbsRes{Items: make([]*gen.BreadcrumbMsg, 0, len(req.Items))}
+	for _, v := range req.Items {
+		res.Items = append(
+			res.Items, &gen.BreadcrumbMsg{
+				Slug:  v.Slug,
+				Title: v.Title,
+				Href:  v.Href,
+				Url:   v.URL,
+			},
+		)
+	}
+
+	if ld, err := structpb.NewStruct(req.JSONLD); err == nil {
+		res.JsonLd = ld
+	} else {
+		zap.L().Debug("failed to convert json-ld block", zap.Error(err))
+	}
+	return res
+}
+
+func ResolvePathToProto(req *dto.ResolvePathResponse) *gen.ResolvePathRes {
+	res := &gen.ResolvePathRes{Page: PageToProto(req.Page)}
+	if req.SEO != nil {
+		res.Seo = ModelToProto(req.SEO)
+	}
+	return res
+}
@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	"github.com/JMURv/seo/api/grpc/v1/gen"
+	md "github.com/JMURv/seo/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func RedirectsToProto(req []*md.Redirect) []*gen.RedirectMsg {
+	var res []*gen.RedirectMsg
+	for _, v := range req {
+		res = append(res, RedirectToProto(v))
+	}
+	return res
+}
+
+func RedirectToProto(req *md.Redirect) *gen.RedirectMsg {
+	return &gen.RedirectMsg{
+		Id:        req.ID,
+		Source:    req.Source,
+		Target:    req.Target,
+		Code:      int32(req.Code),
+		Regex:     req.Regex,
+		CreatedAt: timestamppb.New(req.CreatedAt),
+		UpdatedAt: timestamppb.New(req.UpdatedAt),
+	}
+}
+
+func ProtoToRedirect(req *gen.RedirectMsg) *md.Redirect {
+	return &md.Redirect{
+		ID:        req.Id,
+		Source:    req.Source,
+		Target:    req.Target,
+		Code:      int(req.Code),
+		Regex:     req.Regex,
+		CreatedAt: req.CreatedAt.AsTime(),
+		UpdatedAt: req.UpdatedAt.AsTime(),
+	}
+}
@@ -0,0 +1,55 @@
+package mapper
+
+import (
+	"github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/dto"
+	md "github.com/JMURv/seo/internal/models"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func RevisionToProto(req *md.SEORevision) *gen.SEORevisionMsg {
+	res := &gen.SEORevisionMsg{
+		Id:        req.ID,
+		ObjName:   req.OBJName,
+		ObjPk:     req.OBJPK,
+		Locale:    req.Locale,
+		Action:    req.Action,
+		Author:    req.Author,
+		CreatedAt: timestamppb.New(req.CreatedAt),
+	}
+	if req.Data != nil {
+		res.Data = ModelToProto(req.Data)
+	}
+	return res
+}
+
+func RevisionsToProto(req []*md.SEORevision) []*gen.SEORevisionMsg {
+	res := make([]*gen.SEORevisionMsg, 0, len(req))
+	for _, v := range req {
+		res = append(res, RevisionToProto(v))
+	}
+	return res
+}
+
+func FieldDiffsToProto(req []*dto.FieldDiff) []*gen.FieldDiffMsg {
+	res := make([]*gen.FieldDiffMsg, 0, len(req))
+	for _, v := range req {
+		res = append(res, &gen.FieldDiffMsg{
+			Field: v.Field,
+			From:  anyToValue(v.From),
+			To:    anyToValue(v.To),
+		})
+	}
+	return res
+}
+
+func anyToValue(v any) *structpb.Value {
+	res, err := structpb.NewValue(v)
+	if err != nil {
+		zap.L().Debug("failed to convert value", zap.Any("value", v), zap.Error(err))
+		return structpb.NewNullValue()
+	}
+	return res
+}
@@ -2,8 +2,12 @@ package mapper
 
 import (
 	"github.com/JMURv/seo/api/grpc/v1/gen"
+	"github.com/JMURv/seo/internal/dto"
 	md "github.com/JMURv/seo/internal/models"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 func ModelToProto(req *md.SEO) *gen.SEOMsg {
@@ -15,10 +19,35 @@ func ModelToProto(req *md.SEO) *gen.SEOMsg {
 		OGTitle:       req.OGTitle,
 		OGDescription: req.OGDescription,
 		OGImage:       req.OGImage,
-		ObjName:       req.OBJName,
-		ObjPk:         req.OBJPK,
-		CreatedAt:     timestamppb.New(req.CreatedAt),
-		UpdatedAt:     timestamppb.New(req.UpdatedAt),
+		OGType:        req.OGType,
+		OGURL:         req.OGURL,
+		OGLocale:      req.OGLocale,
+		OGSiteName:    req.OGSiteName,
+		OGImageWidth:  int32(req.OGImageWidth),
+		OGImageHeight: int32(req.OGImageHeight),
+		OGImageAlt:    req.OGImageAlt,
+		OGImageType:   req.OGImageType,
+
+		ArticlePublishedTime: timeToProto(req.ArticlePublishedTime),
+		ArticleModifiedTime:  timeToProto(req.ArticleModifiedTime),
+		ArticleAuthor:        req.ArticleAuthor,
+		ArticleSection:       req.ArticleSection,
+		ArticleTag:           req.ArticleTag,
+
+		TwitterCard:     req.TwitterCard,
+		TwitterSite:     req.TwitterSite,
+		TwitterCreator:  req.TwitterCreator,
+		TwitterImageAlt: req.TwitterImageAlt,
+		Status:          req.Status,
+		PublishAt:       timeToProto(req.PublishAt),
+		Generated:       req.Generated,
+
+		ObjName:   req.OBJName,
+		ObjPk:     req.OBJPK,
+		Locale:    req.Locale,
+		JsonLd:    JSONLDToProto(req.JSONLD),
+		CreatedAt: timestamppb.New(req.CreatedAt),
+		UpdatedAt: timestamppb.New(req.UpdatedAt),
 	}
 }
 
@@ -31,9 +60,138 @@ func ProtoToModel(req *gen.SEOMsg) *md.SEO {
 		OGTitle:       req.OGTitle,
 		OGDescription: req.OGDescription,
 		OGImage:       req.OGImage,
-		OBJName:       req.ObjName,
-		OBJPK:         req.ObjPk,
-		CreatedAt:     req.CreatedAt.AsTime(),
-		UpdatedAt:     req.UpdatedAt.AsTime(),
+		OGType:        req.OGType,
+		OGURL:         req.OGURL,
+		OGLocale:      req.OGLocale,
+		OGSiteName:    req.OGSiteName,
+		OGImageWidth:  int(req.OGImageWidth),
+		OGImageHeight: int(req.OGImageHeight),
+		OGImageAlt:    req.OGImageAlt,
+		OGImageType:   req.OGImageType,
+
+		ArticlePublishedTime: protoToTime(req.ArticlePublishedTime),
+		ArticleModifiedTime:  protoToTime(req.ArticleModifiedTime),
+		ArticleAuthor:        req.ArticleAuthor,
+		ArticleSection:       req.ArticleSection,
+		ArticleTag:           req.ArticleTag,
+
+		TwitterCard:     req.TwitterCard,
+		TwitterSite:     req.TwitterSite,
+		TwitterCreator:  req.TwitterCreator,
+		TwitterImageAlt: req.TwitterImageAlt,
+		Status:          req.Status,
+		PublishAt:       protoToTime(req.PublishAt),
+
+		OBJName:   req.ObjName,
+		OBJPK:     req.ObjPk,
+		Locale:    req.Locale,
+		JSONLD:    ProtoToJSONLD(req.JsonLd),
+		CreatedAt: req.CreatedAt.AsTime(),
+		UpdatedAt: req.UpdatedAt.AsTime(),
+	}
+}
+
+func ProtoToSEOFilter(req *gen.ListSEOReq) *md.SEOFilter {
+	return &md.SEOFilter{
+		Page:        int(req.Page),
+		Size:        int(req.Size),
+		Cursor:      req.Cursor,
+		Sort:        req.Sort,
+		Order:       req.Order,
+		OBJName:     req.ObjName,
+		PKPrefix:    req.PkPrefix,
+		Locale:      req.Locale,
+		Status:      req.Status,
+		UpdatedFrom: protoToTime(req.UpdatedFrom),
+		UpdatedTo:   protoToTime(req.UpdatedTo),
+		Missing:     req.Missing,
+	}
+}
+
+func PaginatedSEOToProto(req *dto.PaginatedSEO) *gen.ListSEORes {
+	res := &gen.ListSEORes{
+		Seo:         make([]*gen.SEOMsg, 0, len(req.Data)),
+		Count:       req.Count,
+		TotalPages:  int32(req.TotalPages),
+		CurrentPage: int32(req.CurrentPage),
+		HasNextPage: req.HasNextPage,
+		NextCursor:  req.NextCursor,
+	}
+	for _, v := range req.Data {
+		res.Seo = append(res.Seo, ModelToProto(v))
+	}
+	return res
+}
+
+func ImportSEOResToProto(req *dto.ImportSEOResponse) *gen.ImportSEORes {
+	res := &gen.ImportSEORes{
+		DryRun:  req.DryRun,
+		Total:   int32(req.Total),
+		Created: int32(req.Created),
+		Updated: int32(req.Updated),
+		Skipped: int32(req.Skipped),
+		Errors:  make([]*gen.ImportRowErrorMsg, 0, len(req.Errors)),
+	}
+	for _, v := range req.Errors {
+		res.Errors = append(
+			res.Errors, &gen.ImportRowErrorMsg{
+				Row:     int32(v.Row),
+				Field:   v.Field,
+				Message: v.Message,
+			},
+		)
+	}
+	return res
+}
+
+func AlternatesToProto(req []*dto.SEOAlternate) []*gen.AlternateMsg {
+	res := make([]*gen.AlternateMsg, 0, len(req))
+	for _, v := range req {
+		res = append(res, &gen.AlternateMsg{
+			Hreflang: v.Hreflang,
+			Href:     v.Href,
+		})
+	}
+	return res
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func protoToTime(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	res := t.AsTime()
+	return &res
+}
+
+// JSONLDToProto skips blocks that cannot be represented as a protobuf Struct.
+func JSONLDToProto(req []map[string]any) []*structpb.Struct {
+	res := make([]*structpb.Struct, 0, len(req))
+	for _, v := range req {
+		block, err := structpb.NewStruct(v)
+		if err != nil {
+			zap.L().Debug("failed to convert json-ld block", zap.Error(err))
+			continue
+		}
+		res = append(res, block)
+	}
+	return res
+}
+
+func ProtoToJSONLD(req []*structpb.Struct) []map[string]any {
+	if req == nil {
+		return nil
+	}
+
+	res := make([]map[string]any, 0, len(req))
+	for _, v := range req {
+		res = append(res, v.AsMap())
 	}
+	return res
 }
@@ -0,0 +1,189 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	md "github.com/JMURv/seo/internal/models"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type csvKind int
+
+const (
+	csvText csvKind = iota
+	csvInt
+	csvTime
+	csvList
+	csvJSON
+)
+
+// csvListSep separates values of list columns such as ArticleTag.
+const csvListSep = "|"
+
+// SEOCSVHeader lists SEO import/export columns. Names match the JSON fields of
+// models.SEO; list columns are pipe separated and json_ld holds a JSON array.
+var SEOCSVHeader = []string{
+	"obj_name",
+	"obj_pk",
+	"locale",
+	"status",
+	"publish_at",
+	"title",
+	"description",
+	"keywords",
+	"OGTitle",
+	"OGDescription",
+	"OGImage",
+	"OGType",
+	"OGURL",
+	"OGLocale",
+	"OGSiteName",
+	"OGImageWidth",
+	"OGImageHeight",
+	"OGImageAlt",
+	"OGImageType",
+	"ArticlePublishedTime",
+	"ArticleModifiedTime",
+	"ArticleAuthor",
+	"ArticleSection",
+	"ArticleTag",
+	"TwitterCard",
+	"TwitterSite",
+	"TwitterCreator",
+	"TwitterImageAlt",
+	"json_ld",
+}
+
+var csvKinds = map[string]csvKind{
+	"publish_at":           csvTime,
+	"OGImageWidth":         csvInt,
+	"OGImageHeight":        csvInt,
+	"ArticlePublishedTime": csvTime,
+	"ArticleModifiedTime":  csvTime,
+	"ArticleAuthor":        csvList,
+	"ArticleTag":           csvList,
+	"json_ld":              csvJSON,
+}
+
+// CheckSEOCSVHeader rejects unknown and repeated columns. Columns may come in
+// any order and be omitted.
+func CheckSEOCSVHeader(header []string) error {
+	seen := make(map[string]struct{}, len(header))
+	for _, col := range header {
+		if !slices.Contains(SEOCSVHeader, col) {
+			return fmt.Errorf("unknown column %q", col)
+		}
+		if _, ok := seen[col]; ok {
+			return fmt.Errorf("repeated column %q", col)
+		}
+		seen[col] = struct{}{}
+	}
+	return nil
+}
+
+// CSVToSEO decodes a record read under header. Empty cells are left unset.
+func CSVToSEO(header, record []string) (*md.SEO, error) {
+	fields := make(map[string]any, len(header))
+	for i, col := range header {
+		if i >= len(record) || record[i] == "" {
+			continue
+		}
+
+		cell := record[i]
+		switch csvKinds[col] {
+		case csvInt:
+			n, err := strconv.Atoi(cell)
+			if err != nil {
+				return nil, fmt.Errorf("%s: must be an integer", col)
+			}
+			fields[col] = n
+		case csvTime:
+			if _, err := time.Parse(time.RFC3339, cell); err != nil {
+				return nil, fmt.Errorf("%s: must be an RFC 3339 timestamp", col)
+			}
+			fields[col] = cell
+		case csvList:
+			fields[col] = strings.Split(cell, csvListSep)
+		case csvJSON:
+			var v any
+			if err := json.Unmarshal([]byte(cell), &v); err != nil {
+				return nil, fmt.Errorf("%s: must be a JSON array", col)
+			}
+			fields[col] = v
+		default:
+			fields[col] = cell
+		}
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &md.SEO{}
+	if err = json.Unmarshal(data, res); err != nil {
+		return nil, fmt.Errorf("invalid record: %w", err)
+	}
+	return res, nil
+}
+
+// SEOToCSV encodes req in SEOCSVHeader order.
+func SEOToCSV(req *md.SEO) ([]string, error) {
+	jsonLD := ""
+	if len(req.JSONLD) > 0 {
+		data, err := json.Marshal(req.JSONLD)
+		if err != nil {
+			return nil, err
+		}
+		jsonLD = string(data)
+	}
+
+	return []string{
+		req.OBJName,
+		req.OBJPK,
+		req.Locale,
+		req.Status,
+		csvTimeValue(req.PublishAt),
+		req.Title,
+		req.Description,
+		req.Keywords,
+		req.OGTitle,
+		req.OGDescription,
+		req.OGImage,
+		req.OGType,
+		req.OGURL,
+		req.OGLocale,
+		req.OGSiteName,
+		csvIntValue(req.OGImageWidth),
+		csvIntValue(req.OGImageHeight),
+		req.OGImageAlt,
+		req.OGImageType,
+		csvTimeValue(req.ArticlePublishedTime),
+		csvTimeValue(req.ArticleModifiedTime),
+		strings.Join(req.ArticleAuthor, csvListSep),
+		req.ArticleSection,
+		strings.Join(req.ArticleTag, csvListSep),
+		req.TwitterCard,
+		req.TwitterSite,
+		req.TwitterCreator,
+		req.TwitterImageAlt,
+		jsonLD,
+	}, nil
+}
+
+func csvIntValue(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+func csvTimeValue(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
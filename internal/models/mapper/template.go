@@ -0,0 +1,47 @@
+package mapper
+
+import (
+	"github.com/JMURv/seo/api/grpc/v1/gen"
+	md "github.com/JMURv/seo/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TemplateToProto(req *md.SEOTemplate) *gen.SEOTemplateMsg {
+	return &gen.SEOTemplateMsg{
+		ObjName:       req.OBJName,
+		Locale:        req.Locale,
+		Title:         req.Title,
+		Description:   req.Description,
+		Keywords:      req.Keywords,
+		OGTitle:       req.OGTitle,
+		OGDescription: req.OGDescription,
+		OGImage:       req.OGImage,
+		OGType:        req.OGType,
+		OGURL:         req.OGURL,
+		CreatedAt:     timestamppb.New(req.CreatedAt),
+		UpdatedAt:     timestamppb.New(req.UpdatedAt),
+	}
+}
+
+func TemplatesToProto(req []*md.SEOTemplate) []*gen.SEOTemplateMsg {
+	res := make([]*gen.SEOTemplateMsg, 0, len(req))
+	for _, v := range req {
+		res = append(res, TemplateToProto(v))
+	}
+	return res
+}
+
+func ProtoToTemplate(req *gen.SEOTemplateMsg) *md.SEOTemplate {
+	return &md.SEOTemplate{
+		OBJName:       req.ObjName,
+		Locale:        req.Locale,
+		Title:         req.Title,
+		Description:   req.Description,
+		Keywords:      req.Keywords,
+		OGTitle:       req.OGTitle,
+		OGDescription: req.OGDescription,
+		OGImage:       req.OGImage,
+		OGType:        req.OGType,
+		OGURL:         req.OGURL,
+	}
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Outbox events of records that are not sent to webhooks. SEO and page
+// events share their names with webhooks.
+const EventRobotsGroupCreated = "robots_group.created"
+const EventRobotsGroupUpdated = "robots_group.updated"
+const EventRobotsGroupDeleted = "robots_group.deleted"
+const EventRobotsSitemapCreated = "robots_sitemap.created"
+const EventRobotsSitemapDeleted = "robots_sitemap.deleted"
+const EventRedirectCreated = "redirect.created"
+const EventRedirectUpdated = "redirect.updated"
+const EventRedirectDeleted = "redirect.deleted"
+const EventSEOTemplateCreated = "seo_template.created"
+const EventSEOTemplateUpdated = "seo_template.updated"
+const EventSEOTemplateDeleted = "seo_template.deleted"
+
+// OutboxEvent is a change recorded in the same transaction as the write it
+// describes. Key groups events of one record so that brokers keep their order;
+// it is "seo:<obj_name>:<obj_pk>:<locale>", "page:<slug>",
+// "robots_group:<id>", "robots_sitemap:<id>", "redirect:<id>" or
+// "seo_template:<obj_name>:<locale>".
+type OutboxEvent struct {
+	ID        uint64          `json:"id"`
+	Event     string          `json:"event"`
+	Key       string          `json:"key"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// ChangeEvent is the payload of an OutboxEvent. Before is null for creations
+// and After is null for deletions; UID is the user who made the change.
+type ChangeEvent struct {
+	Event      string    `json:"event"`
+	UID        string    `json:"uid"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Before     any       `json:"before"`
+	After      any       `json:"after"`
+}
+
+// SEOChange decodes the states of a seo.* ChangeEvent.
+type SEOChange struct {
+	Before *SEO `json:"before"`
+	After  *SEO `json:"after"`
+}
+
+// PageChange decodes the states of a page.* ChangeEvent.
+type PageChange struct {
+	Before *Page `json:"before"`
+	After  *Page `json:"after"`
+}
+
+// SEOTemplateChange decodes the states of a seo_template.* ChangeEvent.
+type SEOTemplateChange struct {
+	Before *SEOTemplate `json:"before"`
+	After  *SEOTemplate `json:"after"`
+}
+
+// ChangeFilter selects events of a change feed. SEO and Pages pick the kinds
+// of records; OBJName and SlugPrefix narrow them when set.
+type ChangeFilter struct {
+	SEO        bool
+	Pages      bool
+	OBJName    string
+	SlugPrefix string
+}
+
+func (f *ChangeFilter) Match(e *OutboxEvent) bool {
+	switch {
+	case strings.HasPrefix(e.Key, "seo:"):
+		return f.SEO && (f.OBJName == "" || strings.HasPrefix(e.Key, "seo:"+f.OBJName+":"))
+	case strings.HasPrefix(e.Key, "page:"):
+		return f.Pages && strings.HasPrefix(e.Key, "page:"+f.SlugPrefix)
+	}
+	return false
+}
@@ -1,12 +1,74 @@
 package models
 
-import "time"
+import (
+	"regexp"
+	"strings"
+	"time"
+)
 
 type Page struct {
-	Slug  string `json:"slug"`
-	Title string `json:"title"`
-	Href  string `json:"href"`
+	Slug       string  `json:"slug"`
+	Title      string  `json:"title"`
+	Href       string  `json:"href"`
+	ChangeFreq string  `json:"changefreq"`
+	Priority   float64 `json:"priority"`
+
+	// ParentSlug places the page under another one; empty means a root page.
+	// Position orders siblings.
+	ParentSlug string `json:"parent_slug"`
+	Position   int    `json:"position"`
+
+	Status    string     `json:"status"`
+	PublishAt *time.Time `json:"publish_at,omitempty"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+// PageOBJName is the SEO obj_name under which a page's record is stored, with
+// the page slug as obj_pk.
+const PageOBJName = "page"
+
+var hrefOrigin = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://[^/]*`)
+
+// NormalizeHref reduces an href or request path to the key pages are resolved
+// by: scheme and host, query and fragment are dropped, the path is lower-cased
+// and has exactly one leading and no trailing slash. Hrefs with equal keys are
+// considered the same URL.
+func NormalizeHref(href string) string {
+	href = hrefOrigin.ReplaceAllString(href, "")
+	href, _, _ = strings.Cut(href, "#")
+	href, _, _ = strings.Cut(href, "?")
+	return "/" + strings.Trim(strings.ToLower(href), "/")
+}
+
+// Strategies for deleting a page that still has children.
+const (
+	PageDeleteReject   = "reject"
+	PageDeleteCascade  = "cascade"
+	PageDeleteReparent = "reparent"
+)
+
+// PageFilter narrows and orders ListPages. Cursor continues a keyset scan and
+// takes precedence over Page; it is only valid for slug and updated_at sorts.
+type PageFilter struct {
+	Page   int
+	Size   int
+	Cursor string
+	Sort   string
+	Order  string
+
+	Title       string
+	Href        string
+	Status      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+}
+
+type PageList struct {
+	Pages      []*Page
+	Total      int64
+	NextCursor string
+}
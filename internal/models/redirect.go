@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type Redirect struct {
+	ID     uint64 `json:"id"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Code   int    `json:"code"`
+	Regex  bool   `json:"regex"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+const RedirectFormatNginx = "nginx"
+const RedirectFormatApache = "apache"
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// SEORevision is a snapshot of an SEO record taken after each change.
+type SEORevision struct {
+	ID      uint64 `json:"id"`
+	OBJName string `json:"obj_name"`
+	OBJPK   string `json:"obj_pk"`
+	Locale  string `json:"locale"`
+	Action  string `json:"action"`
+	Author  string `json:"author"`
+	Data    *SEO   `json:"data"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+const (
+	RevisionCreate   = "create"
+	RevisionUpdate   = "update"
+	RevisionDelete   = "delete"
+	RevisionRollback = "rollback"
+	RevisionPublish  = "publish"
+)
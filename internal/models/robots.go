@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+const RobotsAllow = "allow"
+const RobotsDisallow = "disallow"
+
+type RobotsGroup struct {
+	ID         uint64       `json:"id"`
+	UserAgent  string       `json:"user_agent"`
+	Rules      []RobotsRule `json:"rules"`
+	CrawlDelay float64      `json:"crawl_delay"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type RobotsRule struct {
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+type RobotsSitemap struct {
+	ID  uint64 `json:"id"`
+	URL string `json:"url"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 )
 
@@ -13,10 +14,119 @@ type SEO struct {
 	OGTitle       string `json:"OGTitle"`
 	OGDescription string `json:"OGDescription"`
 	OGImage       string `json:"OGImage"`
+	OGType        string `json:"OGType"`
+	OGURL         string `json:"OGURL"`
+	OGLocale      string `json:"OGLocale"`
+	OGSiteName    string `json:"OGSiteName"`
+
+	OGImageWidth  int    `json:"OGImageWidth"`
+	OGImageHeight int    `json:"OGImageHeight"`
+	OGImageAlt    string `json:"OGImageAlt"`
+	OGImageType   string `json:"OGImageType"`
+
+	// Article fields are rendered as article:* tags and only apply to og:type "article".
+	ArticlePublishedTime *time.Time `json:"ArticlePublishedTime,omitempty"`
+	ArticleModifiedTime  *time.Time `json:"ArticleModifiedTime,omitempty"`
+	ArticleAuthor        []string   `json:"ArticleAuthor"`
+	ArticleSection       string     `json:"ArticleSection"`
+	ArticleTag           []string   `json:"ArticleTag"`
+
+	TwitterCard     string `json:"TwitterCard"`
+	TwitterSite     string `json:"TwitterSite"`
+	TwitterCreator  string `json:"TwitterCreator"`
+	TwitterImageAlt string `json:"TwitterImageAlt"`
 
 	OBJName string `json:"obj_name"`
 	OBJPK   string `json:"obj_pk"`
+	Locale  string `json:"locale"`
+
+	Status    string     `json:"status"`
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+
+	// JSONLD holds schema.org structured data blocks rendered as application/ld+json.
+	JSONLD []map[string]any `json:"json_ld"`
+
+	// Generated is set when the record was rendered from an SEOTemplate rather
+	// than stored.
+	Generated bool `json:"generated,omitempty"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+const (
+	OGTypeArticle = "article"
+
+	TwitterCardSummary           = "summary"
+	TwitterCardSummaryLargeImage = "summary_large_image"
+	TwitterCardApp               = "app"
+	TwitterCardPlayer            = "player"
+)
+
+// SEOFilter narrows and orders ListSEO. Missing selects records where any of
+// the listed fields is empty. Cursor is only valid for obj_pk and updated_at sorts.
+type SEOFilter struct {
+	Page   int
+	Size   int
+	Cursor string
+	Sort   string
+	Order  string
+
+	OBJName     string
+	PKPrefix    string
+	Locale      string
+	Status      string
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	Missing     []string
+}
+
+// SEOMissingFields are the fields a SEOFilter can report as empty.
+var SEOMissingFields = []string{
+	"description",
+	"keywords",
+	"og_title",
+	"og_description",
+	"og_image",
+	"og_type",
+	"og_url",
+	"og_image_alt",
+	"twitter_card",
+	"json_ld",
+}
+
+// Import modes: upsert overwrites existing records, insert skips them.
+const (
+	ImportModeUpsert = "upsert"
+	ImportModeInsert = "insert"
+)
+
+// Bulk transfer formats.
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+type SEOList struct {
+	SEO        []*SEO
+	Total      int64
+	NextCursor string
+}
+
+// NormalizeLocale brings a BCP 47 tag to its canonical casing, e.g. "ru_ru" -> "ru-RU".
+func NormalizeLocale(locale string) string {
+	parts := strings.Split(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"), "-")
+	for i, p := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(p)
+		case len(p) == 2:
+			parts[i] = strings.ToUpper(p)
+		case len(p) == 4:
+			parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+		default:
+			parts[i] = strings.ToLower(p)
+		}
+	}
+	return strings.Join(parts, "-")
+}
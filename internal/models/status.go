@@ -0,0 +1,10 @@
+package models
+
+// Publication statuses shared by SEO records and pages. Only published content
+// is served to anonymous readers.
+const (
+	StatusDraft     = "draft"
+	StatusScheduled = "scheduled"
+	StatusPublished = "published"
+	StatusArchived  = "archived"
+)
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"strings"
+	"text/template"
+	"time"
+)
+
+// SEOTemplate generates metadata for objects of OBJName that have no SEO
+// record. Fields are Go templates over the caller's variables, e.g.
+// "{{.name}} — buy in {{.city}} | Shop".
+type SEOTemplate struct {
+	OBJName string `json:"obj_name"`
+	Locale  string `json:"locale"`
+
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	Keywords      string `json:"keywords"`
+	OGTitle       string `json:"OGTitle"`
+	OGDescription string `json:"OGDescription"`
+	OGImage       string `json:"OGImage"`
+	OGType        string `json:"OGType"`
+	OGURL         string `json:"OGURL"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Fields returns the templated fields by their JSON name.
+func (t *SEOTemplate) Fields() map[string]string {
+	return map[string]string{
+		"title":         t.Title,
+		"description":   t.Description,
+		"keywords":      t.Keywords,
+		"OGTitle":       t.OGTitle,
+		"OGDescription": t.OGDescription,
+		"OGImage":       t.OGImage,
+		"OGType":        t.OGType,
+		"OGURL":         t.OGURL,
+	}
+}
+
+// Render executes every field with vars and returns the generated record.
+// Fields are plain text like stored records, so variables are inserted as is
+// and escaped only where the record is written out as HTML (RenderHead).
+// Missing variables render as empty strings.
+func (t *SEOTemplate) Render(name, pk, locale string, vars map[string]string) (*SEO, error) {
+	res := &SEO{OBJName: name, OBJPK: pk, Locale: locale, Status: StatusPublished, Generated: true}
+	out := map[string]*string{
+		"title":         &res.Title,
+		"description":   &res.Description,
+		"keywords":      &res.Keywords,
+		"OGTitle":       &res.OGTitle,
+		"OGDescription": &res.OGDescription,
+		"OGImage":       &res.OGImage,
+		"OGType":        &res.OGType,
+		"OGURL":         &res.OGURL,
+	}
+
+	for field, text := range t.Fields() {
+		v, err := RenderTemplateField(field, text, vars)
+		if err != nil {
+			return nil, err
+		}
+		*out[field] = v
+	}
+	return res, nil
+}
+
+// RenderTemplateField parses and executes a single template field.
+func RenderTemplateField(field, text string, vars map[string]string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	tpl, err := template.New(field).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	if err = tpl.Execute(&sb, vars); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
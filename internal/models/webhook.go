@@ -0,0 +1,94 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+const EventSEOCreated = "seo.created"
+const EventSEOUpdated = "seo.updated"
+const EventSEODeleted = "seo.deleted"
+const EventPageCreated = "page.created"
+const EventPageUpdated = "page.updated"
+const EventPageDeleted = "page.deleted"
+
+// EventAll subscribes a webhook to every event.
+const EventAll = "*"
+
+var WebhookEvents = map[string]struct{}{
+	EventSEOCreated:  {},
+	EventSEOUpdated:  {},
+	EventSEODeleted:  {},
+	EventPageCreated: {},
+	EventPageUpdated: {},
+	EventPageDeleted: {},
+	EventAll:         {},
+}
+
+const DeliveryPending = "pending"
+const DeliveryDelivered = "delivered"
+const DeliveryFailed = "failed"
+
+// Webhook is a subscription to change events. Secret is write-only: it is
+// accepted on create and update but never returned.
+type Webhook struct {
+	ID       uint64   `json:"id"`
+	URL      string   `json:"url"`
+	Secret   string   `json:"secret,omitempty"`
+	Events   []string `json:"events"`
+	Disabled bool     `json:"disabled"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WebhookEvent is the body posted to subscribers.
+type WebhookEvent struct {
+	Event      string    `json:"event"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// WebhookDelivery is one queued POST of an event to one webhook. URL and
+// Secret are filled in only when the delivery is claimed for sending.
+type WebhookDelivery struct {
+	ID            uint64          `json:"id"`
+	WebhookID     uint64          `json:"webhook_id"`
+	Event         string          `json:"event"`
+	Payload       json.RawMessage `json:"payload"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	ResponseCode  int             `json:"response_code"`
+	LastError     string          `json:"last_error"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	DeliveredAt   *time.Time      `json:"delivered_at"`
+	CreatedAt     time.Time       `json:"created_at"`
+
+	URL    string `json:"-"`
+	Secret string `json:"-"`
+}
+
+type WebhookDeliveryFilter struct {
+	WebhookID uint64
+	Status    string
+	Page      int
+	Size      int
+}
+
+type WebhookDeliveryList struct {
+	Deliveries []*WebhookDelivery
+	Total      int64
+}
+
+// SignWebhook returns the hex HMAC-SHA256 of "<ts>.<body>" keyed by secret.
+// Receivers recompute it from the X-Webhook-Timestamp header and the raw body.
+func SignWebhook(secret string, ts int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(ts, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
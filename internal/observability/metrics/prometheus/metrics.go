@@ -42,6 +42,8 @@ func (m *Metric) Start(ctx context.Context) {
 	m.reg.MustRegister(
 		SrvMetrics,
 		RequestMetrics,
+		CacheMetrics,
+		CacheTierMetrics,
 		collectors.NewGoCollector(),
 		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 	)
@@ -79,3 +81,35 @@ var RequestMetrics = promauto.NewSummaryVec(
 func ObserveRequest(d time.Duration, status int, endpoint string) {
 	RequestMetrics.WithLabelValues(strconv.Itoa(status), endpoint).Observe(d.Seconds())
 }
+
+// CacheMetrics counts cached reads by cache and result: hit, miss, stale (an
+// expired entry served while it is refreshed) and coalesced (a miss that
+// waited for the load of a concurrent request instead of running its own).
+var CacheMetrics = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "svc",
+		Name:      "cache_requests_total",
+	}, []string{"cache", "result"},
+)
+
+const CacheHit = "hit"
+const CacheMiss = "miss"
+const CacheStale = "stale"
+const CacheCoalesced = "coalesced"
+
+func ObserveCache(cache, result string) {
+	CacheMetrics.WithLabelValues(cache, result).Inc()
+}
+
+// CacheTierMetrics counts lookups per tier of the two-tier cache: l1 is the
+// in-process LRU and l2 Redis, which is only asked on an l1 miss.
+var CacheTierMetrics = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "svc",
+		Name:      "cache_tier_requests_total",
+	}, []string{"tier", "result"},
+)
+
+func ObserveCacheTier(tier, result string) {
+	CacheTierMetrics.WithLabelValues(tier, result).Inc()
+}
@@ -0,0 +1,55 @@
+package kafka
+
+import (
+	"context"
+	cfg "github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/segmentio/kafka-go"
+	"strconv"
+)
+
+// writer is the part of *kafka.Writer the publisher uses.
+type writer interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// Publisher writes events to a single topic keyed by record, so every change
+// of a record lands on the same partition in order.
+type Publisher struct {
+	w writer
+}
+
+func New(conf *cfg.KafkaConfig) *Publisher {
+	return &Publisher{
+		w: &kafka.Writer{
+			Addr:         kafka.TCP(conf.Brokers...),
+			Topic:        conf.Topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+func (p *Publisher) Close() error {
+	return p.w.Close()
+}
+
+func (p *Publisher) Publish(ctx context.Context, e *md.OutboxEvent) error {
+	const op = "publisher.kafka.Publish"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return p.w.WriteMessages(
+		ctx, kafka.Message{
+			Key:   []byte(e.Key),
+			Value: e.Payload,
+			Headers: []kafka.Header{
+				{Key: "event", Value: []byte(e.Event)},
+				{Key: "event-id", Value: []byte(strconv.FormatUint(e.ID, 10))},
+			},
+			Time: e.CreatedAt,
+		},
+	)
+}
@@ -0,0 +1,67 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type fakeWriter struct {
+	msgs []kafka.Message
+	err  error
+}
+
+func (w *fakeWriter) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	w.msgs = append(w.msgs, msgs...)
+	return w.err
+}
+
+func (w *fakeWriter) Close() error {
+	return nil
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	e := &md.OutboxEvent{
+		ID:        42,
+		Event:     md.EventSEOUpdated,
+		Key:       "seo:product:1:en",
+		Payload:   []byte(`{"event":"seo.updated"}`),
+		CreatedAt: at,
+	}
+
+	t.Run(
+		"Keyed by record", func(t *testing.T) {
+			w := &fakeWriter{}
+			p := &Publisher{w: w}
+
+			require.NoError(t, p.Publish(ctx, e))
+			require.Len(t, w.msgs, 1)
+
+			msg := w.msgs[0]
+			assert.Equal(t, "seo:product:1:en", string(msg.Key))
+			assert.Equal(t, `{"event":"seo.updated"}`, string(msg.Value))
+			assert.Equal(t, at, msg.Time)
+			assert.Equal(
+				t, []kafka.Header{
+					{Key: "event", Value: []byte(md.EventSEOUpdated)},
+					{Key: "event-id", Value: []byte("42")},
+				}, msg.Headers,
+			)
+		},
+	)
+
+	t.Run(
+		"Write error", func(t *testing.T) {
+			testErr := errors.New("write error")
+			p := &Publisher{w: &fakeWriter{err: testErr}}
+			assert.Equal(t, testErr, p.Publish(ctx, e))
+		},
+	)
+}
@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"context"
+	md "github.com/JMURv/seo/internal/models"
+	"go.uber.org/zap"
+	"sync"
+)
+
+// Publisher logs every event and keeps the last size of them in memory. It
+// stands in for a broker in tests and in setups that have none; a zero size
+// only logs.
+type Publisher struct {
+	mu     sync.Mutex
+	size   int
+	events []*md.OutboxEvent
+}
+
+func New(size int) *Publisher {
+	return &Publisher{size: size}
+}
+
+func (p *Publisher) Close() error {
+	return nil
+}
+
+func (p *Publisher) Publish(_ context.Context, e *md.OutboxEvent) error {
+	zap.L().Info(
+		"published outbox event",
+		zap.Uint64("id", e.ID),
+		zap.String("event", e.Event),
+		zap.String("key", e.Key),
+	)
+
+	if p.size == 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.events) == p.size {
+		p.events = p.events[1:]
+	}
+	p.events = append(p.events, e)
+	return nil
+}
+
+// Events returns the retained events, oldest first.
+func (p *Publisher) Events() []*md.OutboxEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*md.OutboxEvent(nil), p.events...)
+}
@@ -0,0 +1,61 @@
+package nats
+
+import (
+	"context"
+	cfg "github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/nats-io/nats.go"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"strconv"
+)
+
+// conn is the part of *nats.Conn the publisher uses.
+type conn interface {
+	PublishMsg(msg *nats.Msg) error
+	FlushWithContext(ctx context.Context) error
+	Drain() error
+}
+
+// Publisher sends every event to Subject.<event>. The outbox id goes into the
+// Nats-Msg-Id header, so a JetStream stream on those subjects drops the
+// duplicates a relay retry may produce.
+type Publisher struct {
+	nc      conn
+	subject string
+}
+
+func New(conf *cfg.NATSConfig) *Publisher {
+	nc, err := nats.Connect(conf.URL)
+	if err != nil {
+		zap.L().Fatal("Failed to connect to NATS", zap.Error(err))
+	}
+
+	return &Publisher{nc: nc, subject: conf.Subject}
+}
+
+func (p *Publisher) Close() error {
+	return p.nc.Drain()
+}
+
+// Publish returns once the server has received the message.
+func (p *Publisher) Publish(ctx context.Context, e *md.OutboxEvent) error {
+	const op = "publisher.nats.Publish"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	subject := e.Event
+	if p.subject != "" {
+		subject = p.subject + "." + e.Event
+	}
+
+	msg := nats.NewMsg(subject)
+	msg.Data = e.Payload
+	msg.Header.Set(nats.MsgIdHdr, strconv.FormatUint(e.ID, 10))
+	msg.Header.Set("Key", e.Key)
+
+	if err := p.nc.PublishMsg(msg); err != nil {
+		return err
+	}
+	return p.nc.FlushWithContext(ctx)
+}
@@ -0,0 +1,83 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type fakeConn struct {
+	msgs    []*nats.Msg
+	flushed int
+	err     error
+}
+
+func (c *fakeConn) PublishMsg(msg *nats.Msg) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.msgs = append(c.msgs, msg)
+	return nil
+}
+
+func (c *fakeConn) FlushWithContext(_ context.Context) error {
+	c.flushed++
+	return nil
+}
+
+func (c *fakeConn) Drain() error {
+	return nil
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	e := &md.OutboxEvent{
+		ID:      42,
+		Event:   md.EventPageUpdated,
+		Key:     "page:about",
+		Payload: []byte(`{"event":"page.updated"}`),
+	}
+
+	t.Run(
+		"Subject and headers", func(t *testing.T) {
+			nc := &fakeConn{}
+			p := &Publisher{nc: nc, subject: "seo.events"}
+
+			require.NoError(t, p.Publish(ctx, e))
+			require.Len(t, nc.msgs, 1)
+			assert.Equal(t, 1, nc.flushed)
+
+			msg := nc.msgs[0]
+			assert.Equal(t, "seo.events.page.updated", msg.Subject)
+			assert.Equal(t, `{"event":"page.updated"}`, string(msg.Data))
+			assert.Equal(t, "42", msg.Header.Get(nats.MsgIdHdr))
+			assert.Equal(t, "page:about", msg.Header.Get("Key"))
+		},
+	)
+
+	t.Run(
+		"No subject prefix", func(t *testing.T) {
+			nc := &fakeConn{}
+			p := &Publisher{nc: nc}
+
+			require.NoError(t, p.Publish(ctx, e))
+			require.Len(t, nc.msgs, 1)
+			assert.Equal(t, md.EventPageUpdated, nc.msgs[0].Subject)
+		},
+	)
+
+	t.Run(
+		"Publish error", func(t *testing.T) {
+			testErr := errors.New("publish error")
+			nc := &fakeConn{err: testErr}
+			p := &Publisher{nc: nc}
+
+			assert.Equal(t, testErr, p.Publish(ctx, e))
+			assert.Zero(t, nc.flushed)
+		},
+	)
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"errors"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+)
+
+func TestRepository_CountSEODuplicates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	req := &model.SEO{OBJName: "name", OBJPK: "pk", Locale: "en", Title: "title", Description: "description"}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(countSEODuplicates)).
+				WithArgs(req.OBJName, req.OBJPK, req.Locale, req.Title, req.Description).
+				WillReturnRows(sqlmock.NewRows([]string{"titles", "descriptions"}).AddRow(2, 0))
+
+			titles, descriptions, err := repo.CountSEODuplicates(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, titles)
+			assert.Equal(t, 0, descriptions)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			testErr := errors.New("db error")
+			mock.ExpectQuery(regexp.QuoteMeta(countSEODuplicates)).WillReturnError(testErr)
+
+			_, _, err := repo.CountSEODuplicates(ctx, req)
+			assert.Equal(t, testErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
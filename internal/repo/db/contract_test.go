@@ -0,0 +1,74 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/repo/repotest"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+const contractConfigPath = "../../../configs/test.config.yaml"
+
+const listContractTables = `
+SELECT tablename
+FROM pg_tables
+WHERE schemaname = 'public' AND tablename <> 'schema_migrations'
+`
+
+// TestContract runs the shared repository suite against the test database
+// and is skipped when it is not reachable.
+func TestContract(t *testing.T) {
+	conf := config.MustLoad(contractConfigPath)
+	conn, err := sql.Open(
+		"postgres", fmt.Sprintf(
+			"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+			conf.DB.User,
+			conf.DB.Password,
+			conf.DB.Host,
+			conf.DB.Port,
+			conf.DB.Database,
+		),
+	)
+	require.NoError(t, err)
+	t.Cleanup(
+		func() {
+			_ = conn.Close()
+		},
+	)
+
+	if err = conn.Ping(); err != nil {
+		t.Skipf("test database is not reachable: %v", err)
+	}
+	require.NoError(t, applyMigrations(conn, conf.DB))
+
+	repotest.Run(
+		t, func(t *testing.T) ctrl.AppRepo {
+			truncateTables(t, conn)
+			return &Repository{conn: conn}
+		},
+	)
+}
+
+func truncateTables(t *testing.T, conn *sql.DB) {
+	t.Helper()
+
+	rows, err := conn.Query(listContractTables)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		tables = append(tables, pq.QuoteIdentifier(name))
+	}
+	require.NoError(t, rows.Err())
+
+	_, err = conn.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", strings.Join(tables, ", ")))
+	require.NoError(t, err)
+}
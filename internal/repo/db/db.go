@@ -2,28 +2,21 @@ package db
 
 import (
 	"database/sql"
-	"fmt"
+	"errors"
 	conf "github.com/JMURv/seo/internal/config"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
+const uniqueViolation = "23505"
+const foreignKeyViolation = "23503"
+
 type Repository struct {
 	conn *sql.DB
 }
 
 func New(conf *conf.DBConfig) *Repository {
-	conn, err := sql.Open(
-		"postgres", fmt.Sprintf(
-			"postgres://%s:%s@%s:%d/%s?sslmode=disable",
-			conf.User,
-			conf.Password,
-			conf.Host,
-			conf.Port,
-			conf.Database,
-		),
-	)
+	conn, err := sql.Open("postgres", dsn(conf))
 	if err != nil {
 		zap.L().Fatal("Failed to connect to the database", zap.Error(err))
 	}
@@ -32,7 +25,9 @@ func New(conf *conf.DBConfig) *Repository {
 		zap.L().Fatal("Failed to ping the database", zap.Error(err))
 	}
 
-	if err = applyMigrations(conn, conf); err != nil {
+	if conf.SkipMigrations {
+		zap.L().Info("Skipping migrations on startup")
+	} else if err = applyMigrations(conn, conf); err != nil {
 		zap.L().Fatal("Failed to apply migrations", zap.Error(err))
 	}
 
@@ -42,3 +37,17 @@ func New(conf *conf.DBConfig) *Repository {
 func (r *Repository) Close() error {
 	return r.conn.Close()
 }
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation
+}
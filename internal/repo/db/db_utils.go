@@ -2,33 +2,62 @@ package db
 
 import (
 	"database/sql"
+	"embed"
 	"errors"
 	"fmt"
 	conf "github.com/JMURv/seo/internal/config"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"go.uber.org/zap"
-	"os"
-	"path/filepath"
 )
 
-func applyMigrations(db *sql.DB, conf *conf.DBConfig) error {
+//go:embed migration/*.sql
+var migrations embed.FS
+
+func dsn(conf *conf.DBConfig) string {
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		conf.User,
+		conf.Password,
+		conf.Host,
+		conf.Port,
+		conf.Database,
+	)
+}
+
+// NewMigrate connects to the database for the migrate subcommand. Closing the
+// returned instance closes the connection.
+func NewMigrate(conf *conf.DBConfig) (*migrate.Migrate, error) {
+	db, err := sql.Open("postgres", dsn(conf))
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := newMigrate(db, conf)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return m, nil
+}
+
+func newMigrate(db *sql.DB, conf *conf.DBConfig) (*migrate.Migrate, error) {
 	driver, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rootDir, err := findRootDir()
+	src, err := iofs.New(migrations, "migration")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	path := filepath.ToSlash(
-		filepath.Join(rootDir, "internal", "repo", "db", "migration"),
-	)
+	return migrate.NewWithInstance("iofs", src, conf.Database, driver)
+}
 
-	m, err := migrate.NewWithDatabaseInstance("file://"+path, conf.Database, driver)
+func applyMigrations(db *sql.DB, conf *conf.DBConfig) error {
+	m, err := newMigrate(db, conf)
 	if err != nil {
 		return err
 	}
@@ -43,21 +72,3 @@ func applyMigrations(db *sql.DB, conf *conf.DBConfig) error {
 	zap.L().Info("Applied migrations")
 	return nil
 }
-
-func findRootDir() (string, error) {
-	dir, err := os.Getwd()
-	if err != nil {
-		return "", err
-	}
-
-	for {
-		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
-			return dir, nil
-		}
-		if dir == "/" {
-			break
-		}
-		dir = filepath.Dir(dir)
-	}
-	return "", fmt.Errorf("go.mod not found")
-}
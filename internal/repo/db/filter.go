@@ -0,0 +1,41 @@
+package db
+
+import (
+	"strconv"
+	"strings"
+)
+
+// where accumulates AND-ed conditions with positional arguments.
+type where struct {
+	conds []string
+	args  []any
+}
+
+// arg appends v to the argument list and returns its placeholder.
+func (w *where) arg(v any) string {
+	w.args = append(w.args, v)
+	return "$" + strconv.Itoa(len(w.args))
+}
+
+func (w *where) add(cond string) {
+	w.conds = append(w.conds, cond)
+}
+
+func (w *where) String() string {
+	if len(w.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(w.conds, " AND ")
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// contains builds an ILIKE pattern matching s anywhere in the column.
+func contains(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
+
+// prefix builds a LIKE pattern matching columns starting with s.
+func prefix(s string) string {
+	return likeEscaper.Replace(s) + "%"
+}
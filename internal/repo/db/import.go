@@ -0,0 +1,282 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/lib/pq"
+	ot "github.com/opentracing/opentracing-go"
+	"slices"
+	"strings"
+	"time"
+)
+
+// seoColumnNames is seoColumns as a list for COPY.
+var seoColumnNames = splitColumns(seoColumns)
+
+var seoRevisionColumnNames = []string{"obj_name", "obj_pk", "locale", "action", "author", "data"}
+
+var outboxColumnNames = []string{"event", "aggregate_key", "payload"}
+
+var webhookDeliveryColumnNames = []string{"webhook_id", "event", "payload"}
+
+type seoKey struct {
+	name, pk, locale string
+}
+
+// ImportSEO loads rows into a temporary table with COPY and merges them into
+// seo in one statement. Existing records are overwritten when upsert is set and
+// left untouched otherwise. Every written record gets a revision, an outbox
+// event and a delivery for every webhook subscribed to it.
+func (r *Repository) ImportSEO(ctx context.Context, rows []*md.SEO, upsert bool) (int, int, error) {
+	const op = "seo.ImportSEO.repo"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, createSEOImportTable); err != nil {
+		return 0, 0, err
+	}
+
+	err = copyIn(
+		ctx, tx, "seo_import", seoColumnNames, len(rows), func(i int) ([]any, error) {
+			return seoArgs(rows[i])
+		},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	replaced := make(map[seoKey]*md.SEO)
+	q := insertSEOImport
+	if upsert {
+		q = upsertSEOImport
+		if err = scanSEOByKey(ctx, tx, replaced); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	res, err := tx.QueryContext(ctx, q)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	type written struct {
+		key    seoKey
+		row    *md.SEO
+		action string
+	}
+
+	var created, updated int
+	revisions := make([]written, 0, len(rows))
+	for res.Next() {
+		// Revisions and events carry the stored row, timestamps and defaults
+		// included, not the imported one.
+		var inserted bool
+		row, err := scanSEO(insertedScanner{res, &inserted})
+		if err != nil {
+			res.Close()
+			return 0, 0, err
+		}
+
+		action := md.RevisionUpdate
+		if inserted {
+			action = md.RevisionCreate
+			created++
+		} else {
+			updated++
+		}
+		revisions = append(revisions, written{seoKey{row.OBJName, row.OBJPK, row.Locale}, row, action})
+	}
+
+	if err = res.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	author, at := authorFromCtx(ctx), time.Now().UTC()
+	err = copyIn(
+		ctx, tx, "seo_revision", seoRevisionColumnNames, len(revisions), func(i int) ([]any, error) {
+			w := revisions[i]
+			data, err := json.Marshal(w.row)
+			if err != nil {
+				return nil, err
+			}
+			return []any{w.row.OBJName, w.row.OBJPK, w.row.Locale, w.action, author, data}, nil
+		},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	err = copyIn(
+		ctx, tx, "outbox", outboxColumnNames, len(revisions), func(i int) ([]any, error) {
+			w := revisions[i]
+			event := md.EventSEOUpdated
+			if w.action == md.RevisionCreate {
+				event = md.EventSEOCreated
+			}
+
+			payload, err := changeEvent(ctx, event, at, replaced[w.key], w.row)
+			if err != nil {
+				return nil, err
+			}
+			return []any{event, seoOutboxKey(w.row), payload}, nil
+		},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	subs, err := scanWebhookSubscriptions(ctx, tx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	type delivery struct {
+		webhookID uint64
+		event     string
+		row       *md.SEO
+	}
+
+	deliveries := make([]delivery, 0, len(revisions))
+	for _, w := range revisions {
+		event := md.EventSEOUpdated
+		if w.action == md.RevisionCreate {
+			event = md.EventSEOCreated
+		}
+
+		for _, sub := range subs {
+			if sub.receives(event) {
+				deliveries = append(deliveries, delivery{sub.id, event, w.row})
+			}
+		}
+	}
+
+	err = copyIn(
+		ctx, tx, "webhook_delivery", webhookDeliveryColumnNames, len(deliveries), func(i int) ([]any, error) {
+			d := deliveries[i]
+			payload, err := webhookEvent(d.event, at, nil, d.row)
+			if err != nil {
+				return nil, err
+			}
+			return []any{int64(d.webhookID), d.event, payload}, nil
+		},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+	return created, updated, nil
+}
+
+// insertedScanner reads the inserted flag that follows the seo columns
+// returned by an import.
+type insertedScanner struct {
+	rows     *sql.Rows
+	inserted *bool
+}
+
+func (s insertedScanner) Scan(dest ...any) error {
+	return s.rows.Scan(append(dest, s.inserted)...)
+}
+
+// scanSEOByKey fills res with the current state of the records being
+// imported.
+func scanSEOByKey(ctx context.Context, tx *sql.Tx, res map[seoKey]*md.SEO) error {
+	rows, err := tx.QueryContext(ctx, listReplacedSEO)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		seo, err := scanSEO(rows)
+		if err != nil {
+			return err
+		}
+		res[seoKey{seo.OBJName, seo.OBJPK, seo.Locale}] = seo
+	}
+	return rows.Err()
+}
+
+// copyIn streams n rows produced by args into table using COPY FROM STDIN.
+func copyIn(ctx context.Context, tx *sql.Tx, table string, columns []string, n int, args func(i int) ([]any, error)) error {
+	if n == 0 {
+		return nil
+	}
+
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(table, columns...))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for i := 0; i < n; i++ {
+		row, err := args(i)
+		if err != nil {
+			return err
+		}
+
+		// COPY sends []byte as bytea, so JSON documents go as text.
+		for j, v := range row {
+			if b, ok := v.([]byte); ok {
+				row[j] = string(b)
+			}
+		}
+
+		if _, err = stmt.ExecContext(ctx, row...); err != nil {
+			return err
+		}
+	}
+
+	_, err = stmt.ExecContext(ctx)
+	return err
+}
+
+func splitColumns(columns string) []string {
+	res := strings.Split(columns, ",")
+	for i := range res {
+		res[i] = strings.TrimSpace(res[i])
+	}
+	return res
+}
+
+// webhookSubscription is the list of events an enabled webhook receives.
+type webhookSubscription struct {
+	id     uint64
+	events []string
+}
+
+func (s webhookSubscription) receives(event string) bool {
+	return slices.Contains(s.events, event) || slices.Contains(s.events, md.EventAll)
+}
+
+// scanWebhookSubscriptions returns the subscriptions of enabled webhooks in
+// id order.
+func scanWebhookSubscriptions(ctx context.Context, tx *sql.Tx) ([]webhookSubscription, error) {
+	rows, err := tx.QueryContext(ctx, listWebhookSubscriptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []webhookSubscription
+	for rows.Next() {
+		var s webhookSubscription
+		if err = rows.Scan(&s.id, pq.Array(&s.events)); err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+
+	return res, rows.Err()
+}
@@ -0,0 +1,56 @@
+package db
+
+const createSEOImportTable = `
+CREATE TEMP TABLE seo_import (LIKE seo INCLUDING DEFAULTS) ON COMMIT DROP
+`
+
+// listReplacedSEO locks the records an upsert import is about to overwrite.
+const listReplacedSEO = `
+SELECT ` + seoColumns + `, created_at, updated_at
+FROM seo
+WHERE (obj_name, obj_pk, locale) IN (SELECT obj_name, obj_pk, locale FROM seo_import)
+FOR UPDATE
+`
+
+const insertSEOImport = `
+INSERT INTO seo (` + seoColumns + `)
+SELECT ` + seoColumns + `
+FROM seo_import
+ON CONFLICT (obj_name, obj_pk, locale) DO NOTHING
+RETURNING ` + seoColumns + `, created_at, updated_at, TRUE
+`
+
+const upsertSEOImport = `
+INSERT INTO seo (` + seoColumns + `)
+SELECT ` + seoColumns + `
+FROM seo_import
+ON CONFLICT (obj_name, obj_pk, locale) DO UPDATE
+SET title = EXCLUDED.title,
+	description = EXCLUDED.description,
+	keywords = EXCLUDED.keywords,
+	og_title = EXCLUDED.og_title,
+	og_description = EXCLUDED.og_description,
+	og_image = EXCLUDED.og_image,
+	og_type = EXCLUDED.og_type,
+	og_url = EXCLUDED.og_url,
+	og_locale = EXCLUDED.og_locale,
+	og_site_name = EXCLUDED.og_site_name,
+	og_image_width = EXCLUDED.og_image_width,
+	og_image_height = EXCLUDED.og_image_height,
+	og_image_alt = EXCLUDED.og_image_alt,
+	og_image_type = EXCLUDED.og_image_type,
+	article_published_time = EXCLUDED.article_published_time,
+	article_modified_time = EXCLUDED.article_modified_time,
+	article_author = EXCLUDED.article_author,
+	article_section = EXCLUDED.article_section,
+	article_tag = EXCLUDED.article_tag,
+	twitter_card = EXCLUDED.twitter_card,
+	twitter_site = EXCLUDED.twitter_site,
+	twitter_creator = EXCLUDED.twitter_creator,
+	twitter_image_alt = EXCLUDED.twitter_image_alt,
+	json_ld = EXCLUDED.json_ld,
+	status = EXCLUDED.status,
+	publish_at = EXCLUDED.publish_at,
+	updated_at = CURRENT_TIMESTAMP
+RETURNING ` + seoColumns + `, created_at, updated_at, xmax = 0
+`
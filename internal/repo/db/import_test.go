@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	model "github.com/JMURv/seo/internal/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRepository_ImportSEO(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	rows := []*model.SEO{
+		{OBJName: "product", OBJPK: "1", Title: "one", Status: model.StatusPublished},
+		{OBJName: "product", OBJPK: "2", Title: "two", Status: model.StatusPublished},
+	}
+	now := time.Now()
+	writtenColumns := append(slices.Clone(seoTestColumns), "inserted")
+	writtenRow := func(pk string, inserted bool) []driver.Value {
+		row := &model.SEO{OBJName: "product", OBJPK: pk, CreatedAt: now, UpdatedAt: now}
+		return append(seoTestRow(row), inserted)
+	}
+
+	expectCopy := func(table string, columns []string, n int) {
+		mock.ExpectPrepare(regexp.QuoteMeta(pq.CopyIn(table, columns...)))
+		for i := 0; i <= n; i++ {
+			mock.ExpectExec(regexp.QuoteMeta(pq.CopyIn(table, columns...))).WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+	}
+
+	t.Run(
+		"Upsert", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(createSEOImportTable)).WillReturnResult(sqlmock.NewResult(0, 0))
+			expectCopy("seo_import", seoColumnNames, len(rows))
+			mock.ExpectQuery(regexp.QuoteMeta(listReplacedSEO)).
+				WillReturnRows(
+					sqlmock.NewRows(seoTestColumns).
+						AddRow(seoTestRow(&model.SEO{OBJName: "product", OBJPK: "2", Title: "old"})...),
+				)
+			mock.ExpectQuery(regexp.QuoteMeta(upsertSEOImport)).
+				WillReturnRows(
+					sqlmock.NewRows(writtenColumns).
+						AddRow(writtenRow("1", true)...).
+						AddRow(writtenRow("2", false)...),
+				)
+			expectCopy("seo_revision", seoRevisionColumnNames, 2)
+			expectCopy("outbox", outboxColumnNames, 2)
+			mock.ExpectQuery(regexp.QuoteMeta(listWebhookSubscriptions)).
+				WillReturnRows(
+					sqlmock.NewRows([]string{"id", "events"}).
+						AddRow(1, "{*}").
+						AddRow(2, "{page.updated}").
+						AddRow(3, "{seo.created,seo.updated}"),
+				)
+			expectCopy("webhook_delivery", webhookDeliveryColumnNames, 4)
+			mock.ExpectCommit()
+
+			created, updated, err := repo.ImportSEO(ctx, rows, true)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, created)
+			assert.Equal(t, 1, updated)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Insert skips existing", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(createSEOImportTable)).WillReturnResult(sqlmock.NewResult(0, 0))
+			expectCopy("seo_import", seoColumnNames, len(rows))
+			mock.ExpectQuery(regexp.QuoteMeta(insertSEOImport)).
+				WillReturnRows(sqlmock.NewRows(writtenColumns).AddRow(writtenRow("2", true)...))
+			expectCopy("seo_revision", seoRevisionColumnNames, 1)
+			expectCopy("outbox", outboxColumnNames, 1)
+			mock.ExpectQuery(regexp.QuoteMeta(listWebhookSubscriptions)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "events"}))
+			mock.ExpectCommit()
+
+			created, updated, err := repo.ImportSEO(ctx, rows, false)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, created)
+			assert.Equal(t, 0, updated)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Copy error rolls back", func(t *testing.T) {
+			testErr := errors.New("copy error")
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(createSEOImportTable)).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectPrepare(regexp.QuoteMeta(pq.CopyIn("seo_import", seoColumnNames...))).WillReturnError(testErr)
+			mock.ExpectRollback()
+
+			created, updated, err := repo.ImportSEO(ctx, rows, true)
+			assert.Equal(t, testErr, err)
+			assert.Zero(t, created+updated)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
@@ -0,0 +1,2 @@
+ALTER TABLE page DROP COLUMN IF EXISTS changefreq;
+ALTER TABLE page DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,2 @@
+ALTER TABLE page ADD COLUMN IF NOT EXISTS changefreq VARCHAR(16) NOT NULL DEFAULT '';
+ALTER TABLE page ADD COLUMN IF NOT EXISTS priority   REAL        NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS robots_group CASCADE;
+DROP TABLE IF EXISTS robots_sitemap CASCADE;
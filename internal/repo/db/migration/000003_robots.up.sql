@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS robots_group (
+    id          BIGSERIAL PRIMARY KEY,
+    user_agent  VARCHAR(255) NOT NULL UNIQUE,
+    rules       JSONB        NOT NULL DEFAULT '[]',
+    crawl_delay REAL         NOT NULL DEFAULT 0,
+
+    created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS robots_sitemap (
+    id         BIGSERIAL PRIMARY KEY,
+    url        VARCHAR(2048) NOT NULL UNIQUE,
+
+    created_at TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
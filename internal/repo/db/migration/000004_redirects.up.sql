@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS redirect (
+    id         BIGSERIAL PRIMARY KEY,
+    source     VARCHAR(2048) NOT NULL UNIQUE,
+    target     VARCHAR(2048) NOT NULL,
+    code       SMALLINT      NOT NULL DEFAULT 301,
+    regex      BOOLEAN       NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,4 @@
+DELETE FROM seo WHERE locale <> '';
+ALTER TABLE seo DROP CONSTRAINT IF EXISTS seo_pkey;
+ALTER TABLE seo ADD PRIMARY KEY (obj_name, obj_pk);
+ALTER TABLE seo DROP COLUMN IF EXISTS locale;
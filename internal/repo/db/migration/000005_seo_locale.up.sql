@@ -0,0 +1,3 @@
+ALTER TABLE seo ADD COLUMN IF NOT EXISTS locale VARCHAR(35) NOT NULL DEFAULT '';
+ALTER TABLE seo DROP CONSTRAINT IF EXISTS seo_pkey;
+ALTER TABLE seo ADD PRIMARY KEY (obj_name, obj_pk, locale);
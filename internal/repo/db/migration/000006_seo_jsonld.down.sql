@@ -0,0 +1 @@
+ALTER TABLE seo DROP COLUMN IF EXISTS json_ld;
@@ -0,0 +1 @@
+ALTER TABLE seo ADD COLUMN IF NOT EXISTS json_ld JSONB NOT NULL DEFAULT '[]';
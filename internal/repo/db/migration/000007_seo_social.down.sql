@@ -0,0 +1,18 @@
+ALTER TABLE seo
+    DROP COLUMN IF EXISTS og_type,
+    DROP COLUMN IF EXISTS og_url,
+    DROP COLUMN IF EXISTS og_locale,
+    DROP COLUMN IF EXISTS og_site_name,
+    DROP COLUMN IF EXISTS og_image_width,
+    DROP COLUMN IF EXISTS og_image_height,
+    DROP COLUMN IF EXISTS og_image_alt,
+    DROP COLUMN IF EXISTS og_image_type,
+    DROP COLUMN IF EXISTS article_published_time,
+    DROP COLUMN IF EXISTS article_modified_time,
+    DROP COLUMN IF EXISTS article_author,
+    DROP COLUMN IF EXISTS article_section,
+    DROP COLUMN IF EXISTS article_tag,
+    DROP COLUMN IF EXISTS twitter_card,
+    DROP COLUMN IF EXISTS twitter_site,
+    DROP COLUMN IF EXISTS twitter_creator,
+    DROP COLUMN IF EXISTS twitter_image_alt;
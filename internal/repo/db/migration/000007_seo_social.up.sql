@@ -0,0 +1,18 @@
+ALTER TABLE seo
+    ADD COLUMN IF NOT EXISTS og_type                VARCHAR(64)   NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS og_url                 VARCHAR(2048) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS og_locale              VARCHAR(35)   NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS og_site_name           VARCHAR(255)  NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS og_image_width         INTEGER       NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS og_image_height        INTEGER       NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS og_image_alt           VARCHAR(420)  NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS og_image_type          VARCHAR(64)   NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS article_published_time TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS article_modified_time  TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS article_author         TEXT[]        NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS article_section        VARCHAR(255)  NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS article_tag            TEXT[]        NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS twitter_card           VARCHAR(32)   NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS twitter_site           VARCHAR(255)  NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS twitter_creator        VARCHAR(255)  NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS twitter_image_alt      VARCHAR(420)  NOT NULL DEFAULT '';
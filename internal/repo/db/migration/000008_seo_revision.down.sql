@@ -0,0 +1 @@
+DROP TABLE IF EXISTS seo_revision CASCADE;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS seo_revision (
+    id         BIGSERIAL PRIMARY KEY,
+    obj_name   VARCHAR(255) NOT NULL,
+    obj_pk     VARCHAR(255) NOT NULL,
+    locale     VARCHAR(35)  NOT NULL DEFAULT '',
+    action     VARCHAR(16)  NOT NULL,
+    author     VARCHAR(255) NOT NULL DEFAULT '',
+    data       JSONB        NOT NULL,
+
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_seo_revision_obj ON seo_revision (obj_name, obj_pk, id DESC);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_seo_scheduled;
+DROP INDEX IF EXISTS idx_page_scheduled;
+
+ALTER TABLE seo
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS publish_at;
+
+ALTER TABLE page
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS publish_at;
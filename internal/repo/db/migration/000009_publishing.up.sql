@@ -0,0 +1,10 @@
+ALTER TABLE seo
+    ADD COLUMN IF NOT EXISTS status     VARCHAR(16) NOT NULL DEFAULT 'published',
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP;
+
+ALTER TABLE page
+    ADD COLUMN IF NOT EXISTS status     VARCHAR(16) NOT NULL DEFAULT 'published',
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_seo_scheduled ON seo (publish_at) WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS idx_page_scheduled ON page (publish_at) WHERE status = 'scheduled';
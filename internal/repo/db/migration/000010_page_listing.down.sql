@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_page_updated_at;
+DROP INDEX IF EXISTS idx_page_created_at;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_page_updated_at ON page (updated_at, slug);
+CREATE INDEX IF NOT EXISTS idx_page_created_at ON page (created_at, slug);
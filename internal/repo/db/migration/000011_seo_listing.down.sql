@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_seo_name_pk_pattern;
+DROP INDEX IF EXISTS idx_seo_updated_at;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_seo_name_pk_pattern ON seo (obj_name, obj_pk text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_seo_updated_at ON seo (updated_at, obj_name, obj_pk, locale);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS seo_template (
+    obj_name       VARCHAR(255)  NOT NULL,
+    locale         VARCHAR(35)   NOT NULL DEFAULT '',
+    title          VARCHAR(1024) NOT NULL DEFAULT '',
+    description    TEXT          NOT NULL DEFAULT '',
+    keywords       TEXT          NOT NULL DEFAULT '',
+    og_title       VARCHAR(1024) NOT NULL DEFAULT '',
+    og_description TEXT          NOT NULL DEFAULT '',
+    og_image       VARCHAR(2048) NOT NULL DEFAULT '',
+    og_type        VARCHAR(255)  NOT NULL DEFAULT '',
+    og_url         VARCHAR(2048) NOT NULL DEFAULT '',
+
+    created_at     TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at     TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (obj_name, locale)
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_page_parent;
+
+ALTER TABLE page
+    DROP COLUMN IF EXISTS position,
+    DROP COLUMN IF EXISTS parent_slug;
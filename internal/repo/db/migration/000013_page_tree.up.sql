@@ -0,0 +1,5 @@
+ALTER TABLE page
+    ADD COLUMN IF NOT EXISTS parent_slug VARCHAR(255) REFERENCES page (slug) ON UPDATE CASCADE,
+    ADD COLUMN IF NOT EXISTS position    INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_page_parent ON page (parent_slug, position, slug);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_page_href_key;
+
+ALTER TABLE page DROP COLUMN IF EXISTS href_key;
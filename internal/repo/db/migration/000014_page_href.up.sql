@@ -0,0 +1,15 @@
+-- href_key is the href as resolved by path: no scheme/host, query or fragment,
+-- lower case, no trailing slash. It mirrors models.NormalizeHref.
+ALTER TABLE page ADD COLUMN IF NOT EXISTS href_key TEXT;
+
+UPDATE page
+SET href_key = '/' || trim(BOTH '/' FROM lower(split_part(split_part(regexp_replace(href, '^[a-zA-Z][a-zA-Z0-9+.-]*://[^/]*', ''), '#', 1), '?', 1)));
+
+-- Existing duplicates cannot be merged automatically: the oldest page keeps the
+-- key and the others stop resolving until their href is changed.
+UPDATE page p
+SET href_key = NULL
+FROM page o
+WHERE o.href_key = p.href_key AND (o.created_at, o.slug) < (p.created_at, p.slug);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_page_href_key ON page (href_key);
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS webhook (
+    id         BIGSERIAL PRIMARY KEY,
+    url        VARCHAR(2048) NOT NULL,
+    secret     VARCHAR(255)  NOT NULL,
+    events     TEXT[]        NOT NULL DEFAULT '{}',
+    disabled   BOOLEAN       NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id              BIGSERIAL PRIMARY KEY,
+    webhook_id      BIGINT       NOT NULL REFERENCES webhook (id) ON DELETE CASCADE,
+    event           VARCHAR(64)  NOT NULL,
+    payload         JSONB        NOT NULL,
+    status          VARCHAR(16)  NOT NULL DEFAULT 'pending',
+    attempts        INT          NOT NULL DEFAULT 0,
+    response_code   INT          NOT NULL DEFAULT 0,
+    last_error      TEXT         NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at    TIMESTAMP,
+
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_due ON webhook_delivery (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_hook ON webhook_delivery (webhook_id, id DESC);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    id            BIGSERIAL PRIMARY KEY,
+    event         VARCHAR(64)  NOT NULL,
+    aggregate_key VARCHAR(512) NOT NULL,
+    payload       JSONB        NOT NULL,
+    locked_until  TIMESTAMP,
+    published_at  TIMESTAMP,
+
+    created_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_published ON outbox (published_at) WHERE published_at IS NOT NULL;
@@ -0,0 +1,45 @@
+package db
+
+const createOutboxEvent = `
+INSERT INTO outbox (event, aggregate_key, payload)
+VALUES ($1, $2, $3)
+`
+
+// claimOutboxEvents leases unpublished events by pushing locked_until to $2,
+// so a relay that dies mid-batch only delays them.
+const claimOutboxEvents = `
+UPDATE outbox
+SET locked_until = $2
+WHERE id IN (
+    SELECT id FROM outbox
+    WHERE published_at IS NULL AND (locked_until IS NULL OR locked_until <= $1)
+    ORDER BY id
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, event, aggregate_key, payload, created_at
+`
+
+const markOutboxPublished = `
+UPDATE outbox
+SET published_at = $1, locked_until = NULL
+WHERE id = ANY($2)
+`
+
+const purgeOutbox = `
+DELETE FROM outbox
+WHERE published_at < $1
+`
+
+// listOutboxEvents reads committed events whether or not they were published.
+const listOutboxEvents = `
+SELECT id, event, aggregate_key, payload, created_at
+FROM outbox
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+const lastOutboxEventID = `
+SELECT COALESCE(MAX(id), 0) FROM outbox
+`
@@ -0,0 +1,292 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var outboxColumns = []string{"id", "event", "aggregate_key", "payload", "created_at"}
+
+// expectOutbox expects the change event written in the same transaction as the mutation.
+func expectOutbox(mock sqlmock.Sqlmock, event, key string) {
+	mock.ExpectExec(regexp.QuoteMeta(createOutboxEvent)).
+		WithArgs(event, key, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	if _, ok := md.WebhookEvents[event]; ok {
+		mock.ExpectExec(regexp.QuoteMeta(enqueueWebhookEvent)).
+			WithArgs(event, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+}
+
+// payloadArg captures the payload argument so tests can inspect it.
+type payloadArg struct {
+	data []byte
+}
+
+func (p *payloadArg) Match(v driver.Value) bool {
+	p.data, _ = v.([]byte)
+	return p.data != nil
+}
+
+func TestRepository_ClaimOutboxEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	lease := 30 * time.Second
+
+	t.Run(
+		"Success, sorted by id", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(claimOutboxEvents)).
+				WithArgs(now, now.Add(lease), 10).
+				WillReturnRows(
+					sqlmock.NewRows(outboxColumns).
+						AddRow(7, md.EventPageUpdated, "page:about", []byte(`{}`), now).
+						AddRow(3, md.EventSEOCreated, "seo:product:1:en", []byte(`{}`), now),
+				)
+
+			res, err := repo.ClaimOutboxEvents(ctx, now, lease, 10)
+			assert.NoError(t, err)
+			require.Len(t, res, 2)
+			assert.Equal(t, uint64(3), res[0].ID)
+			assert.Equal(t, "seo:product:1:en", res[0].Key)
+			assert.Equal(t, uint64(7), res[1].ID)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Unexpected error", func(t *testing.T) {
+			testErr := errors.New("unexpected error")
+			mock.ExpectQuery(regexp.QuoteMeta(claimOutboxEvents)).WillReturnError(testErr)
+
+			res, err := repo.ClaimOutboxEvents(ctx, now, lease, 10)
+			assert.Equal(t, testErr, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_MarkOutboxPublished(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(markOutboxPublished)).
+				WithArgs(now, "{3,7}").
+				WillReturnResult(sqlmock.NewResult(0, 2))
+
+			assert.NoError(t, repo.MarkOutboxPublished(ctx, []uint64{3, 7}, now))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Nothing to mark", func(t *testing.T) {
+			assert.NoError(t, repo.MarkOutboxPublished(ctx, nil, now))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_PurgeOutbox(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	before := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(purgeOutbox)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+
+	n, err := repo.PurgeOutbox(context.Background(), before)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWriteOutbox(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "uid", "editor")
+	before := &md.SEO{Title: "old", OBJName: "product", OBJPK: "1", Locale: "en"}
+	after := &md.SEO{Title: "new", OBJName: "product", OBJPK: "1", Locale: "en"}
+
+	t.Run(
+		"Queues webhooks", func(t *testing.T) {
+			payload, delivery := &payloadArg{}, &payloadArg{}
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(createOutboxEvent)).
+				WithArgs(md.EventSEOUpdated, "seo:product:1:en", payload).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(regexp.QuoteMeta(enqueueWebhookEvent)).
+				WithArgs(md.EventSEOUpdated, delivery).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectCommit()
+
+			tx, err := db.Begin()
+			require.NoError(t, err)
+			require.NoError(t, writeOutbox(ctx, tx, md.EventSEOUpdated, seoOutboxKey(after), before, after))
+			require.NoError(t, tx.Commit())
+			assert.NoError(t, mock.ExpectationsWereMet())
+
+			event, change := &md.ChangeEvent{}, &md.SEOChange{}
+			require.NoError(t, json.Unmarshal(payload.data, event))
+			require.NoError(t, json.Unmarshal(payload.data, change))
+			assert.Equal(t, md.EventSEOUpdated, event.Event)
+			assert.Equal(t, "editor", event.UID)
+			assert.Equal(t, "old", change.Before.Title)
+			assert.Equal(t, "new", change.After.Title)
+
+			body := &struct {
+				md.WebhookEvent
+				Data *md.SEO `json:"data"`
+			}{}
+			require.NoError(t, json.Unmarshal(delivery.data, body))
+			assert.Equal(t, md.EventSEOUpdated, body.Event)
+			assert.Equal(t, event.OccurredAt, body.OccurredAt)
+			assert.Equal(t, "new", body.Data.Title)
+		},
+	)
+
+	t.Run(
+		"Deletion sends the removed record", func(t *testing.T) {
+			delivery := &payloadArg{}
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(createOutboxEvent)).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(regexp.QuoteMeta(enqueueWebhookEvent)).
+				WithArgs(md.EventSEODeleted, delivery).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			tx, err := db.Begin()
+			require.NoError(t, err)
+			require.NoError(t, writeOutbox(ctx, tx, md.EventSEODeleted, seoOutboxKey(before), before, nil))
+			require.NoError(t, tx.Commit())
+			assert.NoError(t, mock.ExpectationsWereMet())
+
+			body := &struct {
+				Data *md.SEO `json:"data"`
+			}{}
+			require.NoError(t, json.Unmarshal(delivery.data, body))
+			assert.Equal(t, "old", body.Data.Title)
+		},
+	)
+
+	t.Run(
+		"Other events queue no webhooks", func(t *testing.T) {
+			rd := &md.Redirect{ID: 1, Source: "/old", Target: "/new", Code: 301}
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(createOutboxEvent)).
+				WithArgs(md.EventRedirectCreated, "redirect:1", sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			tx, err := db.Begin()
+			require.NoError(t, err)
+			require.NoError(t, writeOutbox(ctx, tx, md.EventRedirectCreated, redirectOutboxKey(rd.ID), nil, rd))
+			require.NoError(t, tx.Commit())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Enqueue error", func(t *testing.T) {
+			testErr := errors.New("enqueue error")
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(createOutboxEvent)).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(regexp.QuoteMeta(enqueueWebhookEvent)).
+				WillReturnError(testErr)
+			mock.ExpectRollback()
+
+			tx, err := db.Begin()
+			require.NoError(t, err)
+			assert.Equal(t, testErr, writeOutbox(ctx, tx, md.EventSEOCreated, seoOutboxKey(after), nil, after))
+			require.NoError(t, tx.Rollback())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_ListOutboxEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listOutboxEvents)).
+				WithArgs(uint64(2), 10).
+				WillReturnRows(
+					sqlmock.NewRows(outboxColumns).
+						AddRow(3, md.EventSEOCreated, "seo:product:1:en", []byte(`{}`), now).
+						AddRow(4, md.EventPageDeleted, "page:about", []byte(`{}`), now),
+				)
+
+			res, err := repo.ListOutboxEvents(ctx, 2, 10)
+			assert.NoError(t, err)
+			require.Len(t, res, 2)
+			assert.Equal(t, uint64(3), res[0].ID)
+			assert.Equal(t, "page:about", res[1].Key)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Unexpected error", func(t *testing.T) {
+			testErr := errors.New("unexpected error")
+			mock.ExpectQuery(regexp.QuoteMeta(listOutboxEvents)).WillReturnError(testErr)
+
+			res, err := repo.ListOutboxEvents(ctx, 0, 10)
+			assert.Equal(t, testErr, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_LastOutboxEventID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta(lastOutboxEventID)).
+		WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(12))
+
+	id, err := repo.LastOutboxEventID(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12), id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
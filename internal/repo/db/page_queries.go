@@ -1,30 +1,128 @@
 package db
 
 const listPage = `
-SELECT slug, title, href, created_at, updated_at 
+SELECT slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at 
+FROM page
+`
+
+const countPage = `
+SELECT COUNT(*) 
 FROM page
 `
 
 const getPageBySlug = `
-SELECT slug, title, href, created_at, updated_at 
+SELECT slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at 
 FROM page
 WHERE slug = $1
 `
 
+// getPageForUpdate reads the state a write is about to replace and holds the
+// row until the transaction ends.
+const getPageForUpdate = getPageBySlug + `FOR UPDATE
+`
+
+const getPageByHref = `
+SELECT slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at 
+FROM page
+WHERE href_key = $1
+`
+
 const createPage = `
-INSERT INTO page (slug, title, href) 
-VALUES ($1, $2, $3)
+INSERT INTO page (slug, title, href, changefreq, priority, parent_slug, position, status, publish_at, href_key) 
+VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $9, $10)
 ON CONFLICT (slug) DO NOTHING 
-RETURNING slug
+RETURNING slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at
 `
 
 const updatePage = `
 UPDATE page 
-SET title = $1, href = $2 
-WHERE slug = $3
+SET title = $1, href = $2, changefreq = $3, priority = $4, parent_slug = NULLIF($5, ''), position = $6, status = $7, publish_at = $8, href_key = $10, updated_at = CURRENT_TIMESTAMP 
+WHERE slug = $9
+RETURNING slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at
 `
 
 const deletePage = `
 DELETE FROM page 
 WHERE slug = $1
+RETURNING slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at
+`
+
+const listPageTree = `
+SELECT slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at 
+FROM page
+ORDER BY position, slug
+`
+
+// listPageAncestors walks parent_slug upwards from $1. UpdatePage keeps the
+// table free of cycles, so the walk ends at a root.
+const listPageAncestors = `
+WITH RECURSIVE chain AS (
+    SELECT slug, parent_slug, 0 AS depth
+    FROM page
+    WHERE slug = $1
+    UNION ALL
+    SELECT p.slug, p.parent_slug, c.depth + 1
+    FROM page p
+    JOIN chain c ON p.slug = c.parent_slug
+)
+SELECT p.slug, p.title, p.href, p.changefreq, p.priority, COALESCE(p.parent_slug, ''), p.position, p.status, p.publish_at, p.created_at, p.updated_at 
+FROM chain c
+JOIN page p ON p.slug = c.slug
+ORDER BY c.depth DESC
+`
+
+// getPageParentForUpdate reads the parent of a page on the chain a move walks
+// and holds the row until the transaction ends.
+const getPageParentForUpdate = `
+SELECT COALESCE(parent_slug, '')
+FROM page
+WHERE slug = $1
+FOR UPDATE
+`
+
+const pageHasChildren = `
+SELECT EXISTS(SELECT 1 FROM page WHERE parent_slug = $1)
+`
+
+const listPageChildren = `
+SELECT slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at 
+FROM page
+WHERE parent_slug = $1
+FOR UPDATE
+`
+
+const reparentPageChildren = `
+UPDATE page
+SET parent_slug = (SELECT parent_slug FROM page WHERE slug = $1), updated_at = CURRENT_TIMESTAMP
+WHERE parent_slug = $1
+RETURNING slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at
+`
+
+const deletePageTree = `
+WITH RECURSIVE tree AS (
+    SELECT slug
+    FROM page
+    WHERE slug = $1
+    UNION
+    SELECT p.slug
+    FROM page p
+    JOIN tree t ON p.parent_slug = t.slug
+)
+DELETE FROM page
+WHERE slug IN (SELECT slug FROM tree)
+RETURNING slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at
+`
+
+const listDuePages = `
+SELECT slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at 
+FROM page
+WHERE status = 'scheduled' AND publish_at <= $1
+FOR UPDATE
+`
+
+const publishScheduledPages = `
+UPDATE page
+SET status = 'published', updated_at = CURRENT_TIMESTAMP
+WHERE status = 'scheduled' AND publish_at <= $1
+RETURNING slug, title, href, changefreq, priority, COALESCE(parent_slug, ''), position, status, publish_at, created_at, updated_at
 `
@@ -3,9 +3,11 @@ package db
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	md "github.com/JMURv/seo/internal/models"
 	rrepo "github.com/JMURv/seo/internal/repo"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/DATA-DOG/go-sqlmock.v1"
@@ -14,6 +16,13 @@ import (
 	"time"
 )
 
+var pageTestColumns = []string{"slug", "title", "href", "changefreq", "priority", "parent_slug", "position", "status", "publish_at", "created_at", "updated_at"}
+
+func pageTestRow(slug, parent, status string) []driver.Value {
+	now := time.Now()
+	return []driver.Value{slug, slug, "/" + slug, "", 0.5, parent, 0, status, nil, now, now}
+}
+
 func TestRepository_ListPages(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -22,43 +31,117 @@ func TestRepository_ListPages(t *testing.T) {
 	repo := Repository{conn: db}
 	expectedPages := []*md.Page{
 		{
-			Slug:      "page-slug-1",
-			Title:     "Page Title 1",
-			Href:      "/page-1",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+			Slug:       "page-slug-1",
+			Title:      "Page Title 1",
+			Href:       "/page-1",
+			ChangeFreq: "daily",
+			Priority:   0.8,
+			Status:     md.StatusPublished,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
 		},
 		{
-			Slug:      "page-slug-2",
-			Title:     "Page Title 2",
-			Href:      "/page-2",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+			Slug:       "page-slug-2",
+			Title:      "Page Title 2",
+			Href:       "/page-2",
+			ChangeFreq: "weekly",
+			Priority:   0.5,
+			Status:     md.StatusDraft,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
 		},
 	}
+	pageRow := func(rows *sqlmock.Rows, p *md.Page) *sqlmock.Rows {
+		return rows.AddRow(
+			p.Slug, p.Title, p.Href, p.ChangeFreq, p.Priority, p.ParentSlug, p.Position, p.Status, p.PublishAt, p.CreatedAt, p.UpdatedAt,
+		)
+	}
+	pageColumns := []string{"slug", "title", "href", "changefreq", "priority", "parent_slug", "position", "status", "publish_at", "created_at", "updated_at"}
+	countQ := regexp.QuoteMeta(countPage)
+	listQ := regexp.QuoteMeta(listPage)
+
 	t.Run(
 		"Success", func(t *testing.T) {
-			rows := sqlmock.NewRows([]string{"slug", "title", "href", "created_at", "updated_at"}).
-				AddRow(
-					expectedPages[0].Slug,
-					expectedPages[0].Title,
-					expectedPages[0].Href,
-					expectedPages[0].CreatedAt,
-					expectedPages[0].UpdatedAt,
-				).
-				AddRow(
-					expectedPages[1].Slug,
-					expectedPages[1].Title,
-					expectedPages[1].Href,
-					expectedPages[1].CreatedAt,
-					expectedPages[1].UpdatedAt,
-				)
+			rows := sqlmock.NewRows(pageColumns)
+			pageRow(rows, expectedPages[0])
+			pageRow(rows, expectedPages[1])
 
-			mock.ExpectQuery(regexp.QuoteMeta(listPage)).WillReturnRows(rows)
+			mock.ExpectQuery(countQ).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			mock.ExpectQuery(listQ+regexp.QuoteMeta(" ORDER BY slug ASC, slug ASC LIMIT $1 OFFSET $2")).
+				WithArgs(41, 0).
+				WillReturnRows(rows)
 
-			res, err := repo.ListPages(context.Background())
+			res, err := repo.ListPages(context.Background(), &md.PageFilter{})
 			assert.NoError(t, err)
-			assert.Equal(t, expectedPages, res)
+			assert.Equal(t, &md.PageList{Pages: expectedPages, Total: 2}, res)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Filters and offset", func(t *testing.T) {
+			from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			where := " WHERE title ILIKE $1 AND href ILIKE $2 AND status = $3 AND updated_at >= $4"
+
+			mock.ExpectQuery(countQ+regexp.QuoteMeta(where)).
+				WithArgs(`%50\%%`, "%/page%", md.StatusPublished, from).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+			mock.ExpectQuery(listQ+regexp.QuoteMeta(where+" ORDER BY title DESC, slug DESC LIMIT $5 OFFSET $6")).
+				WithArgs(`%50\%%`, "%/page%", md.StatusPublished, from, 3, 2).
+				WillReturnRows(sqlmock.NewRows(pageColumns))
+
+			res, err := repo.ListPages(
+				context.Background(), &md.PageFilter{
+					Page: 2, Size: 2, Sort: md.SortTitle, Order: md.OrderDesc,
+					Title: "50%", Href: "/page", Status: md.StatusPublished, UpdatedFrom: &from,
+				},
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(3), res.Total)
+			assert.Empty(t, res.NextCursor)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Keyset on updated_at", func(t *testing.T) {
+			at := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+			cursor := md.Cursor{Sort: "updated_at", Value: at.Format(time.RFC3339Nano), Key: []string{"a"}}.Encode()
+
+			rows := sqlmock.NewRows(pageColumns)
+			pageRow(rows, expectedPages[0])
+			pageRow(rows, expectedPages[1])
+
+			mock.ExpectQuery(countQ).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(10))
+			mock.ExpectQuery(listQ+regexp.QuoteMeta(" WHERE (updated_at, slug) > ($1, $2) ORDER BY updated_at ASC, slug ASC LIMIT $3 OFFSET $4")).
+				WithArgs(at, "a", 2, 0).
+				WillReturnRows(rows)
+
+			res, err := repo.ListPages(
+				context.Background(), &md.PageFilter{Page: 3, Size: 1, Sort: md.SortUpdatedAt, Cursor: cursor},
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, []*md.Page{expectedPages[0]}, res.Pages)
+
+			next, err := md.DecodeCursor(res.NextCursor)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{expectedPages[0].Slug}, next.Key)
+			assert.Equal(t, expectedPages[0].UpdatedAt.Format(time.RFC3339Nano), next.Value)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Cursor does not match sort", func(t *testing.T) {
+			cursor := md.Cursor{Sort: "slug", Key: []string{"a"}}.Encode()
+			mock.ExpectQuery(countQ).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(10))
+
+			res, err := repo.ListPages(context.Background(), &md.PageFilter{Sort: md.SortUpdatedAt, Cursor: cursor})
+			assert.ErrorIs(t, err, md.ErrInvalidCursor)
+			assert.Nil(t, res)
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
 		},
@@ -66,10 +149,23 @@ func TestRepository_ListPages(t *testing.T) {
 
 	t.Run(
 		"QueryError", func(t *testing.T) {
-			mock.ExpectQuery(regexp.QuoteMeta(listPage)).
+			mock.ExpectQuery(countQ).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			mock.ExpectQuery(listQ).
 				WillReturnError(errors.New("query failed"))
 
-			res, err := repo.ListPages(context.Background())
+			res, err := repo.ListPages(context.Background(), &md.PageFilter{})
+			assert.Error(t, err)
+			assert.Nil(t, res)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"CountError", func(t *testing.T) {
+			mock.ExpectQuery(countQ).WillReturnError(errors.New("query failed"))
+
+			res, err := repo.ListPages(context.Background(), &md.PageFilter{})
 			assert.Error(t, err)
 			assert.Nil(t, res)
 			err = mock.ExpectationsWereMet()
@@ -79,13 +175,14 @@ func TestRepository_ListPages(t *testing.T) {
 
 	t.Run(
 		"ScanError", func(t *testing.T) {
-			rows := sqlmock.NewRows([]string{"slug", "title", "href", "created_at", "updated_at"}).
-				AddRow("invalid-slug", "Page Title", "/page", "invalid-created-at", time.Now())
+			rows := sqlmock.NewRows(pageColumns).
+				AddRow("invalid-slug", "Page Title", "/page", "daily", 0.5, "", 0, md.StatusPublished, nil, "invalid-created-at", time.Now())
 
-			mock.ExpectQuery(regexp.QuoteMeta(listPage)).
+			mock.ExpectQuery(countQ).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			mock.ExpectQuery(listQ).
 				WillReturnRows(rows)
 
-			res, err := repo.ListPages(context.Background())
+			res, err := repo.ListPages(context.Background(), &md.PageFilter{})
 			assert.Error(t, err)
 			assert.Nil(t, res)
 			err = mock.ExpectationsWereMet()
@@ -120,6 +217,12 @@ func TestRepository_GetPage(t *testing.T) {
 							"slug",
 							"title",
 							"href",
+							"changefreq",
+							"priority",
+							"parent_slug",
+							"position",
+							"status",
+							"publish_at",
 							"created_at",
 							"updated_at",
 						},
@@ -128,6 +231,12 @@ func TestRepository_GetPage(t *testing.T) {
 							testOBJ.Slug,
 							testOBJ.Title,
 							testOBJ.Href,
+							testOBJ.ChangeFreq,
+							testOBJ.Priority,
+							testOBJ.ParentSlug,
+							testOBJ.Position,
+							testOBJ.Status,
+							testOBJ.PublishAt,
 							testOBJ.CreatedAt,
 							testOBJ.UpdatedAt,
 						),
@@ -176,6 +285,46 @@ func TestRepository_GetPage(t *testing.T) {
 	)
 }
 
+func TestRepository_GetPageByHref(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	q := regexp.QuoteMeta(getPageByHref)
+	columns := []string{"slug", "title", "href", "changefreq", "priority", "parent_slug", "position", "status", "publish_at", "created_at", "updated_at"}
+
+	t.Run(
+		"Normalizes path", func(t *testing.T) {
+			now := time.Now()
+			mock.ExpectQuery(q).
+				WithArgs("/catalog/shoes").
+				WillReturnRows(
+					sqlmock.NewRows(columns).
+						AddRow("shoes", "Shoes", "/catalog/shoes/", "", 0.5, "catalog", 0, md.StatusPublished, nil, now, now),
+				)
+
+			res, err := repo.GetPageByHref(context.Background(), "/Catalog/Shoes/?utm_source=x#top")
+			assert.NoError(t, err)
+			assert.Equal(t, "shoes", res.Slug)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectQuery(q).
+				WithArgs("/").
+				WillReturnError(sql.ErrNoRows)
+
+			res, err := repo.GetPageByHref(context.Background(), "https://example.com")
+			assert.Nil(t, res)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
 func TestRepository_CreatePage(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -193,14 +342,18 @@ func TestRepository_CreatePage(t *testing.T) {
 
 	t.Run(
 		"Success case", func(t *testing.T) {
+			mock.ExpectBegin()
 			mock.ExpectQuery(regexp.QuoteMeta(createPage)).
 				WillReturnRows(
-					sqlmock.NewRows([]string{"slug"}).
-						AddRow(testOBJ.Slug),
+					sqlmock.NewRows(pageTestColumns).
+						AddRow(pageTestRow(slug, "", md.StatusPublished)...),
 				)
+			expectOutbox(mock, md.EventPageCreated, "page:slug")
+			mock.ExpectCommit()
 
-			_, err := repo.CreatePage(ctx, testOBJ)
+			res, err := repo.CreatePage(ctx, testOBJ)
 			assert.NoError(t, err)
+			assert.Equal(t, slug, res)
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
 		},
@@ -208,10 +361,12 @@ func TestRepository_CreatePage(t *testing.T) {
 
 	t.Run(
 		"ErrAlreadyExists", func(t *testing.T) {
+			mock.ExpectBegin()
 			mock.ExpectQuery(regexp.QuoteMeta(createPage)).
 				WillReturnError(
 					sql.ErrNoRows,
 				)
+			mock.ExpectRollback()
 
 			_, err := repo.CreatePage(ctx, testOBJ)
 			assert.Equal(t, rrepo.ErrAlreadyExists, err)
@@ -220,11 +375,42 @@ func TestRepository_CreatePage(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"ErrDuplicateHref", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(createPage)).
+				WithArgs(slug, "title", "href", "", 0.0, "", 0, "", nil, "/href").
+				WillReturnError(&pq.Error{Code: uniqueViolation})
+			mock.ExpectRollback()
+
+			_, err := repo.CreatePage(ctx, testOBJ)
+			assert.Equal(t, rrepo.ErrDuplicateHref, err)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"ErrParentNotFound", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(createPage)).
+				WillReturnError(&pq.Error{Code: foreignKeyViolation})
+			mock.ExpectRollback()
+
+			_, err := repo.CreatePage(ctx, testOBJ)
+			assert.Equal(t, rrepo.ErrParentNotFound, err)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
 	t.Run(
 		"ErrInternal", func(t *testing.T) {
 			internalErr := errors.New("internal error")
+			mock.ExpectBegin()
 			mock.ExpectQuery(regexp.QuoteMeta(createPage)).
 				WillReturnError(internalErr)
+			mock.ExpectRollback()
 
 			_, err := repo.CreatePage(ctx, testOBJ)
 			assert.Equal(t, internalErr, err)
@@ -232,6 +418,23 @@ func TestRepository_CreatePage(t *testing.T) {
 			assert.NoError(t, err)
 		},
 	)
+
+	t.Run(
+		"Outbox error rolls back", func(t *testing.T) {
+			testErr := errors.New("outbox error")
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(createPage)).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusPublished)...))
+			mock.ExpectExec(regexp.QuoteMeta(createOutboxEvent)).
+				WillReturnError(testErr)
+			mock.ExpectRollback()
+
+			_, err := repo.CreatePage(ctx, testOBJ)
+			assert.Equal(t, testErr, err)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
 }
 
 func TestRepository_UpdatePage(t *testing.T) {
@@ -246,18 +449,19 @@ func TestRepository_UpdatePage(t *testing.T) {
 		Title: "title",
 		Href:  "href",
 	}
-
-	mock.ExpectQuery(regexp.QuoteMeta(createPage)).
-		WillReturnRows(sqlmock.NewRows([]string{"slug"}).AddRow(slug))
-
-	_, err = repo.CreatePage(context.Background(), testOBJ)
-	require.NoError(t, err)
+	getQ := regexp.QuoteMeta(getPageForUpdate)
 
 	t.Run(
 		"Success", func(t *testing.T) {
-			mock.ExpectExec(regexp.QuoteMeta(updatePage)).
-				WithArgs(testOBJ.Title, testOBJ.Href, testOBJ.Slug).
-				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectBegin()
+			mock.ExpectQuery(getQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusDraft)...))
+			mock.ExpectQuery(regexp.QuoteMeta(updatePage)).
+				WithArgs(testOBJ.Title, testOBJ.Href, testOBJ.ChangeFreq, testOBJ.Priority, testOBJ.ParentSlug, testOBJ.Position, testOBJ.Status, testOBJ.PublishAt, testOBJ.Slug, "/href").
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusPublished)...))
+			expectOutbox(mock, md.EventPageUpdated, "page:slug")
+			mock.ExpectCommit()
 
 			err := repo.UpdatePage(context.Background(), slug, testOBJ)
 			assert.NoError(t, err)
@@ -266,11 +470,67 @@ func TestRepository_UpdatePage(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"Moves under parent, locking the chain", func(t *testing.T) {
+			moved := &md.Page{Slug: slug, Title: "title", Href: "href", ParentSlug: "parent"}
+			parentQ := regexp.QuoteMeta(getPageParentForUpdate)
+			mock.ExpectBegin()
+			mock.ExpectQuery(getQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusDraft)...))
+			mock.ExpectQuery(parentQ).WithArgs("parent").WillReturnRows(sqlmock.NewRows([]string{"parent_slug"}).AddRow("root"))
+			mock.ExpectQuery(parentQ).WithArgs("root").WillReturnRows(sqlmock.NewRows([]string{"parent_slug"}).AddRow(""))
+			mock.ExpectQuery(regexp.QuoteMeta(updatePage)).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "parent", md.StatusDraft)...))
+			expectOutbox(mock, md.EventPageUpdated, "page:slug")
+			mock.ExpectCommit()
+
+			assert.NoError(t, repo.UpdatePage(context.Background(), slug, moved))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrPageCycle", func(t *testing.T) {
+			moved := &md.Page{Slug: slug, Title: "title", Href: "href", ParentSlug: "child"}
+			mock.ExpectBegin()
+			mock.ExpectQuery(getQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusDraft)...))
+			mock.ExpectQuery(regexp.QuoteMeta(getPageParentForUpdate)).
+				WithArgs("child").
+				WillReturnRows(sqlmock.NewRows([]string{"parent_slug"}).AddRow(slug))
+			mock.ExpectRollback()
+
+			assert.ErrorIs(t, repo.UpdatePage(context.Background(), slug, moved), rrepo.ErrPageCycle)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Missing parent", func(t *testing.T) {
+			moved := &md.Page{Slug: slug, Title: "title", Href: "href", ParentSlug: "missing"}
+			mock.ExpectBegin()
+			mock.ExpectQuery(getQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusDraft)...))
+			mock.ExpectQuery(regexp.QuoteMeta(getPageParentForUpdate)).
+				WithArgs("missing").
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			assert.ErrorIs(t, repo.UpdatePage(context.Background(), slug, moved), rrepo.ErrParentNotFound)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
-			mock.ExpectExec(regexp.QuoteMeta(updatePage)).
-				WithArgs(testOBJ.Title, testOBJ.Href, testOBJ.Slug).
-				WillReturnResult(sqlmock.NewResult(1, 0))
+			mock.ExpectBegin()
+			mock.ExpectQuery(getQ).
+				WithArgs(slug).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
 
 			err := repo.UpdatePage(context.Background(), slug, testOBJ)
 			assert.ErrorIs(t, err, rrepo.ErrNotFound)
@@ -279,12 +539,34 @@ func TestRepository_UpdatePage(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"ErrDuplicateHref", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(getQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusDraft)...))
+			mock.ExpectQuery(regexp.QuoteMeta(updatePage)).
+				WillReturnError(&pq.Error{Code: uniqueViolation})
+			mock.ExpectRollback()
+
+			err := repo.UpdatePage(context.Background(), slug, testOBJ)
+			assert.ErrorIs(t, err, rrepo.ErrDuplicateHref)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
 	t.Run(
 		"ErrInternal", func(t *testing.T) {
 			ErrInternal := errors.New("internal error")
-			mock.ExpectExec(regexp.QuoteMeta(updatePage)).
-				WithArgs(testOBJ.Title, testOBJ.Href, testOBJ.Slug).
+			mock.ExpectBegin()
+			mock.ExpectQuery(getQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusDraft)...))
+			mock.ExpectQuery(regexp.QuoteMeta(updatePage)).
+				WithArgs(testOBJ.Title, testOBJ.Href, testOBJ.ChangeFreq, testOBJ.Priority, testOBJ.ParentSlug, testOBJ.Position, testOBJ.Status, testOBJ.PublishAt, testOBJ.Slug, "/href").
 				WillReturnError(ErrInternal)
+			mock.ExpectRollback()
 
 			err := repo.UpdatePage(context.Background(), slug, testOBJ)
 			assert.ErrorIs(t, err, ErrInternal)
@@ -301,15 +583,88 @@ func TestRepository_DeletePage(t *testing.T) {
 
 	repo := Repository{conn: db}
 	slug := "slug"
+	hasChildrenQ := regexp.QuoteMeta(pageHasChildren)
+	deleteQ := regexp.QuoteMeta(deletePage)
 
 	t.Run(
 		"Success", func(t *testing.T) {
-			mock.ExpectExec(regexp.QuoteMeta(deletePage)).
+			mock.ExpectBegin()
+			mock.ExpectQuery(hasChildrenQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			mock.ExpectQuery(deleteQ).
 				WithArgs(slug).
-				WillReturnResult(sqlmock.NewResult(0, 1))
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusPublished)...))
+			expectOutbox(mock, md.EventPageDeleted, "page:slug")
+			mock.ExpectCommit()
 
-			err := repo.DeletePage(context.Background(), slug)
+			res, err := repo.DeletePage(context.Background(), slug, "")
 			assert.NoError(t, err)
+			assert.Equal(t, []string{slug}, res)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"ErrHasChildren", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(hasChildrenQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			mock.ExpectRollback()
+
+			res, err := repo.DeletePage(context.Background(), slug, md.PageDeleteReject)
+			assert.ErrorIs(t, err, rrepo.ErrHasChildren)
+			assert.Nil(t, res)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Cascade", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(deletePageTree)).
+				WithArgs(slug).
+				WillReturnRows(
+					sqlmock.NewRows(pageTestColumns).
+						AddRow(pageTestRow(slug, "", md.StatusPublished)...).
+						AddRow(pageTestRow("child", slug, md.StatusPublished)...).
+						AddRow(pageTestRow("grandchild", "child", md.StatusPublished)...),
+				)
+			expectOutbox(mock, md.EventPageDeleted, "page:slug")
+			expectOutbox(mock, md.EventPageDeleted, "page:child")
+			expectOutbox(mock, md.EventPageDeleted, "page:grandchild")
+			mock.ExpectCommit()
+
+			res, err := repo.DeletePage(context.Background(), slug, md.PageDeleteCascade)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{slug, "child", "grandchild"}, res)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Reparent", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(listPageChildren)).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow("child", slug, md.StatusPublished)...))
+			mock.ExpectQuery(regexp.QuoteMeta(reparentPageChildren)).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow("child", "", md.StatusPublished)...))
+			expectOutbox(mock, md.EventPageUpdated, "page:child")
+			mock.ExpectQuery(deleteQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns).AddRow(pageTestRow(slug, "", md.StatusPublished)...))
+			expectOutbox(mock, md.EventPageDeleted, "page:slug")
+			mock.ExpectCommit()
+
+			res, err := repo.DeletePage(context.Background(), slug, md.PageDeleteReparent)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{slug, "child"}, res)
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
 		},
@@ -317,12 +672,18 @@ func TestRepository_DeletePage(t *testing.T) {
 
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
-			mock.ExpectExec(regexp.QuoteMeta(deletePage)).
+			mock.ExpectBegin()
+			mock.ExpectQuery(hasChildrenQ).
 				WithArgs(slug).
-				WillReturnResult(sqlmock.NewResult(1, 0))
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			mock.ExpectQuery(deleteQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns))
+			mock.ExpectRollback()
 
-			err := repo.DeletePage(context.Background(), slug)
+			res, err := repo.DeletePage(context.Background(), slug, "")
 			assert.ErrorIs(t, err, rrepo.ErrNotFound)
+			assert.Nil(t, res)
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
 		},
@@ -330,14 +691,145 @@ func TestRepository_DeletePage(t *testing.T) {
 
 	t.Run(
 		"ErrInternal", func(t *testing.T) {
-			mock.ExpectExec(regexp.QuoteMeta(deletePage)).
+			mock.ExpectBegin()
+			mock.ExpectQuery(hasChildrenQ).
+				WithArgs(slug).
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			mock.ExpectQuery(deleteQ).
 				WithArgs(slug).
 				WillReturnError(errors.New("db error"))
+			mock.ExpectRollback()
 
-			err := repo.DeletePage(context.Background(), slug)
+			_, err := repo.DeletePage(context.Background(), slug, "")
 			assert.Error(t, err)
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
 		},
 	)
 }
+
+func TestRepository_ListPageAncestors(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	columns := []string{"slug", "title", "href", "changefreq", "priority", "parent_slug", "position", "status", "publish_at", "created_at", "updated_at"}
+	q := regexp.QuoteMeta(listPageAncestors)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			now := time.Now()
+			mock.ExpectQuery(q).
+				WithArgs("chairs").
+				WillReturnRows(
+					sqlmock.NewRows(columns).
+						AddRow("home", "Home", "/", "", 0.5, "", 0, md.StatusPublished, nil, now, now).
+						AddRow("chairs", "Chairs", "/chairs", "", 0.5, "home", 2, md.StatusPublished, nil, now, now),
+				)
+
+			res, err := repo.ListPageAncestors(context.Background(), "chairs")
+			assert.NoError(t, err)
+			assert.Len(t, res, 2)
+			assert.Equal(t, "home", res[0].Slug)
+			assert.Equal(t, "home", res[1].ParentSlug)
+			assert.Equal(t, 2, res[1].Position)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			newErr := errors.New("db error")
+			mock.ExpectQuery(q).
+				WithArgs("chairs").
+				WillReturnError(newErr)
+
+			res, err := repo.ListPageAncestors(context.Background(), "chairs")
+			assert.Equal(t, newErr, err)
+			assert.Nil(t, res)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestRepository_ListPageTree(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	columns := []string{"slug", "title", "href", "changefreq", "priority", "parent_slug", "position", "status", "publish_at", "created_at", "updated_at"}
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(listPageTree)).
+		WillReturnRows(
+			sqlmock.NewRows(columns).
+				AddRow("home", "Home", "/", "", 0.5, "", 0, md.StatusPublished, nil, now, now).
+				AddRow("about", "About", "/about", "", 0.5, "home", 1, md.StatusDraft, nil, now, now),
+		)
+
+	res, err := repo.ListPageTree(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, md.StatusDraft, res[1].Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_PublishScheduledPages(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(listDuePages)).
+				WithArgs(now).
+				WillReturnRows(
+					sqlmock.NewRows(pageTestColumns).
+						AddRow(pageTestRow("launch", "", md.StatusScheduled)...).
+						AddRow(pageTestRow("promo", "", md.StatusScheduled)...),
+				)
+			mock.ExpectQuery(regexp.QuoteMeta(publishScheduledPages)).
+				WithArgs(now).
+				WillReturnRows(
+					sqlmock.NewRows(pageTestColumns).
+						AddRow(pageTestRow("launch", "", md.StatusPublished)...).
+						AddRow(pageTestRow("promo", "", md.StatusPublished)...),
+				)
+			expectOutbox(mock, md.EventPageUpdated, "page:launch")
+			expectOutbox(mock, md.EventPageUpdated, "page:promo")
+			mock.ExpectCommit()
+
+			res, err := repo.PublishScheduledPages(context.Background(), now)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"launch", "promo"}, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			testErr := errors.New("db error")
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(listDuePages)).
+				WithArgs(now).
+				WillReturnRows(sqlmock.NewRows(pageTestColumns))
+			mock.ExpectQuery(regexp.QuoteMeta(publishScheduledPages)).
+				WithArgs(now).
+				WillReturnError(testErr)
+			mock.ExpectRollback()
+
+			res, err := repo.PublishScheduledPages(context.Background(), now)
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
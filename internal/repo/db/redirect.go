@@ -0,0 +1,183 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListRedirects(ctx context.Context) ([]*md.Redirect, error) {
+	const op = "redirect.ListRedirects.repo"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listRedirects)
+	if err != nil {
+		return nil, err
+	}
+	return scanRedirects(rows)
+}
+
+func (r *Repository) GetRedirect(ctx context.Context, id uint64) (*md.Redirect, error) {
+	const op = "redirect.GetRedirect.repo"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanRedirect(r.conn.QueryRowContext(ctx, getRedirect, id))
+	if err == sql.ErrNoRows {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateRedirect(ctx context.Context, req *md.Redirect, check func([]*md.Redirect) error) (uint64, error) {
+	const op = "redirect.CreateRedirect.repo"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if err = checkRedirects(ctx, tx, check); err != nil {
+		return 0, err
+	}
+
+	after, err := scanRedirect(tx.QueryRowContext(ctx, createRedirect, req.Source, req.Target, req.Code, req.Regex))
+	if err == sql.ErrNoRows {
+		return 0, repo.ErrAlreadyExists
+	} else if err != nil {
+		return 0, err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventRedirectCreated, redirectOutboxKey(after.ID), nil, after); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return after.ID, nil
+}
+
+func (r *Repository) UpdateRedirect(ctx context.Context, id uint64, req *md.Redirect, check func([]*md.Redirect) error) error {
+	const op = "redirect.UpdateRedirect.repo"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := scanRedirect(tx.QueryRowContext(ctx, getRedirectForUpdate, id))
+	if err == sql.ErrNoRows {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err = checkRedirects(ctx, tx, check); err != nil {
+		return err
+	}
+
+	after, err := scanRedirect(tx.QueryRowContext(ctx, updateRedirect, req.Source, req.Target, req.Code, req.Regex, id))
+	if err != nil && isUniqueViolation(err) {
+		return repo.ErrAlreadyExists
+	} else if err != nil {
+		return err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventRedirectUpdated, redirectOutboxKey(id), before, after); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) DeleteRedirect(ctx context.Context, id uint64) error {
+	const op = "redirect.DeleteRedirect.repo"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := scanRedirect(tx.QueryRowContext(ctx, deleteRedirect, id))
+	if err == sql.ErrNoRows {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventRedirectDeleted, redirectOutboxKey(id), before, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// checkRedirects passes the stored rules to check. Whether a rule loops
+// depends on every other rule, and row locks would not stop a concurrent
+// insert, so the table is locked against other writers until the
+// transaction ends.
+func checkRedirects(ctx context.Context, tx *sql.Tx, check func([]*md.Redirect) error) error {
+	if _, err := tx.ExecContext(ctx, lockRedirects); err != nil {
+		return err
+	}
+	rows, err := tx.QueryContext(ctx, listRedirects)
+	if err != nil {
+		return err
+	}
+
+	list, err := scanRedirects(rows)
+	if err != nil {
+		return err
+	}
+	return check(list)
+}
+
+func scanRedirects(rows *sql.Rows) ([]*md.Redirect, error) {
+	defer rows.Close()
+
+	res := make([]*md.Redirect, 0)
+	for rows.Next() {
+		rd, err := scanRedirect(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rd)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func scanRedirect(row scanner) (*md.Redirect, error) {
+	res := &md.Redirect{}
+	if err := row.Scan(
+		&res.ID,
+		&res.Source,
+		&res.Target,
+		&res.Code,
+		&res.Regex,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
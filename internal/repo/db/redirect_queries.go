@@ -0,0 +1,38 @@
+package db
+
+const listRedirects = `
+SELECT id, source, target, code, regex, created_at, updated_at
+FROM redirect
+ORDER BY id
+`
+
+const lockRedirects = `LOCK TABLE redirect IN SHARE ROW EXCLUSIVE MODE`
+
+const getRedirect = `
+SELECT id, source, target, code, regex, created_at, updated_at
+FROM redirect
+WHERE id = $1
+`
+
+const getRedirectForUpdate = getRedirect + `FOR UPDATE
+`
+
+const createRedirect = `
+INSERT INTO redirect (source, target, code, regex)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (source) DO NOTHING
+RETURNING id, source, target, code, regex, created_at, updated_at
+`
+
+const updateRedirect = `
+UPDATE redirect
+SET source = $1, target = $2, code = $3, regex = $4, updated_at = CURRENT_TIMESTAMP
+WHERE id = $5
+RETURNING id, source, target, code, regex, created_at, updated_at
+`
+
+const deleteRedirect = `
+DELETE FROM redirect
+WHERE id = $1
+RETURNING id, source, target, code, regex, created_at, updated_at
+`
@@ -0,0 +1,284 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	md "github.com/JMURv/seo/internal/models"
+	rrepo "github.com/JMURv/seo/internal/repo"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var redirectColumns = []string{"id", "source", "target", "code", "regex", "created_at", "updated_at"}
+
+func acceptRedirects([]*md.Redirect) error {
+	return nil
+}
+
+// expectCheckRedirects expects the redirect table to be locked and listed.
+func expectCheckRedirects(mock sqlmock.Sqlmock, now time.Time) {
+	mock.ExpectExec(regexp.QuoteMeta(lockRedirects)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(listRedirects)).
+		WillReturnRows(sqlmock.NewRows(redirectColumns).AddRow(2, "/a", "/old", 301, false, now, now))
+}
+
+func TestRepository_ListRedirects(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listRedirects)).
+				WillReturnRows(
+					sqlmock.NewRows(redirectColumns).
+						AddRow(1, "/old", "/new", 301, false, now, now).
+						AddRow(2, "^/blog/(.*)$", "/news/$1", 302, true, now, now),
+				)
+
+			res, err := repo.ListRedirects(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, res, 2)
+			assert.Equal(t, "/new", res[0].Target)
+			assert.True(t, res[1].Regex)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Unexpected error", func(t *testing.T) {
+			testErr := errors.New("unexpected error")
+			mock.ExpectQuery(regexp.QuoteMeta(listRedirects)).WillReturnError(testErr)
+
+			res, err := repo.ListRedirects(ctx)
+			assert.Equal(t, testErr, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_GetRedirect(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	id := uint64(1)
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getRedirect)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(redirectColumns).AddRow(id, "/old", "/new", 308, false, now, now))
+
+			res, err := repo.GetRedirect(ctx, id)
+			assert.NoError(t, err)
+			assert.Equal(t, 308, res.Code)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getRedirect)).
+				WithArgs(id).
+				WillReturnError(sql.ErrNoRows)
+
+			res, err := repo.GetRedirect(ctx, id)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_CreateRedirect(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	req := &md.Redirect{Source: "/old", Target: "/new", Code: 301}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			expectCheckRedirects(mock, now)
+			mock.ExpectQuery(regexp.QuoteMeta(createRedirect)).
+				WithArgs(req.Source, req.Target, req.Code, req.Regex).
+				WillReturnRows(sqlmock.NewRows(redirectColumns).AddRow(1, req.Source, req.Target, req.Code, false, now, now))
+			expectOutbox(mock, md.EventRedirectCreated, "redirect:1")
+			mock.ExpectCommit()
+
+			id, err := repo.CreateRedirect(ctx, req, acceptRedirects)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(1), id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			mock.ExpectBegin()
+			expectCheckRedirects(mock, now)
+			mock.ExpectQuery(regexp.QuoteMeta(createRedirect)).
+				WithArgs(req.Source, req.Target, req.Code, req.Regex).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			id, err := repo.CreateRedirect(ctx, req, acceptRedirects)
+			assert.Equal(t, rrepo.ErrAlreadyExists, err)
+			assert.Zero(t, id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Check error", func(t *testing.T) {
+			testErr := errors.New("check error")
+			mock.ExpectBegin()
+			expectCheckRedirects(mock, now)
+			mock.ExpectRollback()
+
+			id, err := repo.CreateRedirect(
+				ctx, req, func(list []*md.Redirect) error {
+					require.Len(t, list, 1)
+					assert.Equal(t, "/a", list[0].Source)
+					return testErr
+				},
+			)
+			assert.Equal(t, testErr, err)
+			assert.Zero(t, id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_UpdateRedirect(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	id := uint64(1)
+	req := &md.Redirect{Source: "/old", Target: "/new", Code: 302}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getRedirectForUpdate)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(redirectColumns).AddRow(id, req.Source, req.Target, 301, false, now, now))
+			expectCheckRedirects(mock, now)
+			mock.ExpectQuery(regexp.QuoteMeta(updateRedirect)).
+				WithArgs(req.Source, req.Target, req.Code, req.Regex, id).
+				WillReturnRows(sqlmock.NewRows(redirectColumns).AddRow(id, req.Source, req.Target, req.Code, false, now, now))
+			expectOutbox(mock, md.EventRedirectUpdated, "redirect:1")
+			mock.ExpectCommit()
+
+			assert.NoError(t, repo.UpdateRedirect(ctx, id, req, acceptRedirects))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getRedirectForUpdate)).
+				WithArgs(id).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			assert.Equal(t, rrepo.ErrNotFound, repo.UpdateRedirect(ctx, id, req, acceptRedirects))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getRedirectForUpdate)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(redirectColumns).AddRow(id, req.Source, req.Target, 301, false, now, now))
+			expectCheckRedirects(mock, now)
+			mock.ExpectQuery(regexp.QuoteMeta(updateRedirect)).
+				WithArgs(req.Source, req.Target, req.Code, req.Regex, id).
+				WillReturnError(&pq.Error{Code: uniqueViolation})
+			mock.ExpectRollback()
+
+			assert.Equal(t, rrepo.ErrAlreadyExists, repo.UpdateRedirect(ctx, id, req, acceptRedirects))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Check error", func(t *testing.T) {
+			testErr := errors.New("check error")
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getRedirectForUpdate)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(redirectColumns).AddRow(id, req.Source, req.Target, 301, false, now, now))
+			expectCheckRedirects(mock, now)
+			mock.ExpectRollback()
+
+			err := repo.UpdateRedirect(ctx, id, req, func([]*md.Redirect) error { return testErr })
+			assert.Equal(t, testErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_DeleteRedirect(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	id := uint64(1)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(deleteRedirect)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(redirectColumns).AddRow(id, "/old", "/new", 301, false, now, now))
+			expectOutbox(mock, md.EventRedirectDeleted, "redirect:1")
+			mock.ExpectCommit()
+
+			assert.NoError(t, repo.DeleteRedirect(ctx, id))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(deleteRedirect)).
+				WithArgs(id).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			assert.Equal(t, rrepo.ErrNotFound, repo.DeleteRedirect(ctx, id))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListSEORevisions(ctx context.Context, name, pk string) ([]*md.SEORevision, error) {
+	const op = "revision.ListSEORevisions.repo"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listSEORevisions, name, pk)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.SEORevision, 0)
+	for rows.Next() {
+		rev, err := scanSEORevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rev)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetSEORevision(ctx context.Context, name, pk string, id uint64) (*md.SEORevision, error) {
+	const op = "revision.GetSEORevision.repo"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanSEORevision(r.conn.QueryRowContext(ctx, getSEORevision, id, name, pk))
+	if err == sql.ErrNoRows {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RollbackSEO restores the snapshot of revision id, recreating the record if it
+// was deleted since, and records the restore as a new revision.
+func (r *Repository) RollbackSEO(ctx context.Context, name, pk string, id uint64) (*md.SEO, error) {
+	const op = "revision.RollbackSEO.repo"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rev, err := scanSEORevision(tx.QueryRowContext(ctx, getSEORevision, id, name, pk))
+	if err == sql.ErrNoRows {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	args, err := seoArgs(rev.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := scanSEO(tx.QueryRowContext(ctx, getSEOForUpdate, rev.OBJName, rev.OBJPK, rev.Locale))
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	event := md.EventSEOUpdated
+	if before != nil {
+		if _, err = tx.ExecContext(ctx, updateSEO, append(args, rev.OBJName, rev.OBJPK, rev.Locale)...); err != nil {
+			return nil, err
+		}
+	} else {
+		event = md.EventSEOCreated
+		var n, p string
+		if err = tx.QueryRowContext(ctx, createSEO, args...).Scan(&n, &p); err != nil {
+			return nil, err
+		}
+	}
+
+	seo, err := writeSEORevision(ctx, tx, md.RevisionRollback, rev.OBJName, rev.OBJPK, rev.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = writeOutbox(ctx, tx, event, seoOutboxKey(seo), before, seo); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return seo, nil
+}
+
+// writeSEORevision snapshots the current state of the record inside tx.
+func writeSEORevision(ctx context.Context, tx *sql.Tx, action, name, pk, locale string) (*md.SEO, error) {
+	seo, err := scanSEO(tx.QueryRowContext(ctx, getSEO, name, pk, locale))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = insertSEORevision(ctx, tx, action, seo); err != nil {
+		return nil, err
+	}
+	return seo, nil
+}
+
+func insertSEORevision(ctx context.Context, tx *sql.Tx, action string, seo *md.SEO) error {
+	data, err := json.Marshal(seo)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		createSEORevision,
+		seo.OBJName,
+		seo.OBJPK,
+		seo.Locale,
+		action,
+		authorFromCtx(ctx),
+		data,
+	)
+	return err
+}
+
+// authorFromCtx returns the uid put into the context by the auth middleware.
+func authorFromCtx(ctx context.Context) string {
+	uid, _ := ctx.Value("uid").(string)
+	return uid
+}
+
+func scanSEORevision(row scanner) (*md.SEORevision, error) {
+	res := &md.SEORevision{}
+	var data []byte
+	err := row.Scan(
+		&res.ID,
+		&res.OBJName,
+		&res.OBJPK,
+		&res.Locale,
+		&res.Action,
+		&res.Author,
+		&data,
+		&res.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &res.Data); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
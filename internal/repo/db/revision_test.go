@@ -0,0 +1,186 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	model "github.com/JMURv/seo/internal/models"
+	rrepo "github.com/JMURv/seo/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var revisionColumns = []string{"id", "obj_name", "obj_pk", "locale", "action", "author", "data", "created_at"}
+
+func TestRepository_ListSEORevisions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	name, pk, now := "name", "pk", time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listSEORevisions)).
+				WithArgs(name, pk).
+				WillReturnRows(
+					sqlmock.NewRows(revisionColumns).
+						AddRow(2, name, pk, "", model.RevisionUpdate, "uid", []byte(`{"title":"new"}`), now).
+						AddRow(1, name, pk, "", model.RevisionCreate, "uid", []byte(`{"title":"old"}`), now),
+				)
+
+			res, err := repo.ListSEORevisions(ctx, name, pk)
+			assert.NoError(t, err)
+			assert.Len(t, res, 2)
+			assert.Equal(t, "new", res[0].Data.Title)
+			assert.Equal(t, model.RevisionCreate, res[1].Action)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			testErr := errors.New("db error")
+			mock.ExpectQuery(regexp.QuoteMeta(listSEORevisions)).
+				WithArgs(name, pk).
+				WillReturnError(testErr)
+
+			res, err := repo.ListSEORevisions(ctx, name, pk)
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_GetSEORevision(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	name, pk, id := "name", "pk", uint64(1)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getSEORevision)).
+				WithArgs(id, name, pk).
+				WillReturnRows(
+					sqlmock.NewRows(revisionColumns).
+						AddRow(id, name, pk, "ru", model.RevisionCreate, "", []byte(`{"title":"t"}`), time.Now()),
+				)
+
+			res, err := repo.GetSEORevision(ctx, name, pk, id)
+			assert.NoError(t, err)
+			assert.Equal(t, "ru", res.Locale)
+			assert.Equal(t, "t", res.Data.Title)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getSEORevision)).
+				WithArgs(id, name, pk).
+				WillReturnError(sql.ErrNoRows)
+
+			res, err := repo.GetSEORevision(ctx, name, pk, id)
+			assert.Nil(t, res)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_RollbackSEO(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.WithValue(context.Background(), "uid", "editor")
+	name, pk, id := "name", "pk", uint64(3)
+	snapshot := &model.SEO{Title: "old title", OBJName: name, OBJPK: pk}
+	data := []byte(`{"title":"old title","obj_name":"name","obj_pk":"pk","locale":""}`)
+
+	expectRevision := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(getSEO)).
+			WithArgs(name, pk, "").
+			WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(snapshot)...))
+		mock.ExpectExec(regexp.QuoteMeta(createSEORevision)).
+			WithArgs(name, pk, "", model.RevisionRollback, "editor", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(4, 1))
+	}
+
+	t.Run(
+		"Success, record exists", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEORevision)).
+				WithArgs(id, name, pk).
+				WillReturnRows(
+					sqlmock.NewRows(revisionColumns).
+						AddRow(id, name, pk, "", model.RevisionUpdate, "", data, time.Now()),
+				)
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOForUpdate)).
+				WithArgs(name, pk, "").
+				WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(&model.SEO{Title: "new title", OBJName: name, OBJPK: pk})...))
+			mock.ExpectExec(regexp.QuoteMeta(updateSEO)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			expectRevision()
+			expectOutbox(mock, model.EventSEOUpdated, "seo:name:pk:")
+			mock.ExpectCommit()
+
+			res, err := repo.RollbackSEO(ctx, name, pk, id)
+			assert.NoError(t, err)
+			assert.Equal(t, "old title", res.Title)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Success, record deleted since", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEORevision)).
+				WithArgs(id, name, pk).
+				WillReturnRows(
+					sqlmock.NewRows(revisionColumns).
+						AddRow(id, name, pk, "", model.RevisionDelete, "", data, time.Now()),
+				)
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOForUpdate)).
+				WithArgs(name, pk, "").
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectQuery(regexp.QuoteMeta(createSEO)).
+				WillReturnRows(sqlmock.NewRows([]string{"obj_name", "obj_pk"}).AddRow(name, pk))
+			expectRevision()
+			expectOutbox(mock, model.EventSEOCreated, "seo:name:pk:")
+			mock.ExpectCommit()
+
+			res, err := repo.RollbackSEO(ctx, name, pk, id)
+			assert.NoError(t, err)
+			assert.Equal(t, "old title", res.Title)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEORevision)).
+				WithArgs(id, name, pk).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			res, err := repo.RollbackSEO(ctx, name, pk, id)
+			assert.Nil(t, res)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
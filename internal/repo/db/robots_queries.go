@@ -0,0 +1,55 @@
+package db
+
+const listRobotsGroups = `
+SELECT id, user_agent, rules, crawl_delay, created_at, updated_at
+FROM robots_group
+ORDER BY id
+`
+
+const getRobotsGroup = `
+SELECT id, user_agent, rules, crawl_delay, created_at, updated_at
+FROM robots_group
+WHERE id = $1
+`
+
+const getRobotsGroupForUpdate = getRobotsGroup + `FOR UPDATE
+`
+
+const createRobotsGroup = `
+INSERT INTO robots_group (user_agent, rules, crawl_delay)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_agent) DO NOTHING
+RETURNING id, user_agent, rules, crawl_delay, created_at, updated_at
+`
+
+const updateRobotsGroup = `
+UPDATE robots_group
+SET user_agent = $1, rules = $2, crawl_delay = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $4
+RETURNING id, user_agent, rules, crawl_delay, created_at, updated_at
+`
+
+const deleteRobotsGroup = `
+DELETE FROM robots_group
+WHERE id = $1
+RETURNING id, user_agent, rules, crawl_delay, created_at, updated_at
+`
+
+const listRobotsSitemaps = `
+SELECT id, url, created_at
+FROM robots_sitemap
+ORDER BY id
+`
+
+const createRobotsSitemap = `
+INSERT INTO robots_sitemap (url)
+VALUES ($1)
+ON CONFLICT (url) DO NOTHING
+RETURNING id, url, created_at
+`
+
+const deleteRobotsSitemap = `
+DELETE FROM robots_sitemap
+WHERE id = $1
+RETURNING id, url, created_at
+`
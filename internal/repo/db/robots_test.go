@@ -0,0 +1,340 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	md "github.com/JMURv/seo/internal/models"
+	rrepo "github.com/JMURv/seo/internal/repo"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var robotsGroupColumns = []string{"id", "user_agent", "rules", "crawl_delay", "created_at", "updated_at"}
+
+func TestRepository_ListRobotsGroups(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listRobotsGroups)).
+				WillReturnRows(
+					sqlmock.NewRows(robotsGroupColumns).
+						AddRow(1, "*", []byte(`[{"type":"disallow","path":"/admin"}]`), 0, now, now).
+						AddRow(2, "Googlebot", []byte(`[]`), 1.5, now, now),
+				)
+
+			res, err := repo.ListRobotsGroups(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, res, 2)
+			assert.Equal(t, []md.RobotsRule{{Type: md.RobotsDisallow, Path: "/admin"}}, res[0].Rules)
+			assert.Equal(t, 1.5, res[1].CrawlDelay)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Invalid rules", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listRobotsGroups)).
+				WillReturnRows(sqlmock.NewRows(robotsGroupColumns).AddRow(1, "*", []byte(`{`), 0, now, now))
+
+			res, err := repo.ListRobotsGroups(ctx)
+			assert.Error(t, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Unexpected error", func(t *testing.T) {
+			testErr := errors.New("unexpected error")
+			mock.ExpectQuery(regexp.QuoteMeta(listRobotsGroups)).WillReturnError(testErr)
+
+			res, err := repo.ListRobotsGroups(ctx)
+			assert.Equal(t, testErr, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_GetRobotsGroup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	id := uint64(1)
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getRobotsGroup)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(robotsGroupColumns).AddRow(id, "*", []byte(`[]`), 0, now, now))
+
+			res, err := repo.GetRobotsGroup(ctx, id)
+			assert.NoError(t, err)
+			assert.Equal(t, id, res.ID)
+			assert.Equal(t, "*", res.UserAgent)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getRobotsGroup)).
+				WithArgs(id).
+				WillReturnError(sql.ErrNoRows)
+
+			res, err := repo.GetRobotsGroup(ctx, id)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_CreateRobotsGroup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	req := &md.RobotsGroup{
+		UserAgent:  "*",
+		Rules:      []md.RobotsRule{{Type: md.RobotsAllow, Path: "/"}},
+		CrawlDelay: 2,
+	}
+	rules := []byte(`[{"type":"allow","path":"/"}]`)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(createRobotsGroup)).
+				WithArgs(req.UserAgent, rules, req.CrawlDelay).
+				WillReturnRows(sqlmock.NewRows(robotsGroupColumns).AddRow(1, req.UserAgent, rules, req.CrawlDelay, now, now))
+			expectOutbox(mock, md.EventRobotsGroupCreated, "robots_group:1")
+			mock.ExpectCommit()
+
+			id, err := repo.CreateRobotsGroup(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(1), id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(createRobotsGroup)).
+				WithArgs(req.UserAgent, rules, req.CrawlDelay).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			id, err := repo.CreateRobotsGroup(ctx, req)
+			assert.Equal(t, rrepo.ErrAlreadyExists, err)
+			assert.Zero(t, id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_UpdateRobotsGroup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	id := uint64(1)
+	req := &md.RobotsGroup{UserAgent: "Googlebot", Rules: []md.RobotsRule{}}
+	rules := []byte(`[]`)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getRobotsGroupForUpdate)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(robotsGroupColumns).AddRow(id, "*", rules, 0, now, now))
+			mock.ExpectQuery(regexp.QuoteMeta(updateRobotsGroup)).
+				WithArgs(req.UserAgent, rules, req.CrawlDelay, id).
+				WillReturnRows(sqlmock.NewRows(robotsGroupColumns).AddRow(id, req.UserAgent, rules, 0, now, now))
+			expectOutbox(mock, md.EventRobotsGroupUpdated, "robots_group:1")
+			mock.ExpectCommit()
+
+			err := repo.UpdateRobotsGroup(ctx, id, req)
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getRobotsGroupForUpdate)).
+				WithArgs(id).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			err := repo.UpdateRobotsGroup(ctx, id, req)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getRobotsGroupForUpdate)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(robotsGroupColumns).AddRow(id, "*", rules, 0, now, now))
+			mock.ExpectQuery(regexp.QuoteMeta(updateRobotsGroup)).
+				WithArgs(req.UserAgent, rules, req.CrawlDelay, id).
+				WillReturnError(&pq.Error{Code: uniqueViolation})
+			mock.ExpectRollback()
+
+			err := repo.UpdateRobotsGroup(ctx, id, req)
+			assert.Equal(t, rrepo.ErrAlreadyExists, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_DeleteRobotsGroup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	id := uint64(1)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(deleteRobotsGroup)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(robotsGroupColumns).AddRow(id, "*", []byte(`[]`), 0, now, now))
+			expectOutbox(mock, md.EventRobotsGroupDeleted, "robots_group:1")
+			mock.ExpectCommit()
+
+			err := repo.DeleteRobotsGroup(ctx, id)
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(deleteRobotsGroup)).
+				WithArgs(id).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			err := repo.DeleteRobotsGroup(ctx, id)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_RobotsSitemaps(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	url := "https://example.com/sitemap.xml"
+	columns := []string{"id", "url", "created_at"}
+
+	t.Run(
+		"List", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listRobotsSitemaps)).
+				WillReturnRows(sqlmock.NewRows(columns).AddRow(1, url, now))
+
+			res, err := repo.ListRobotsSitemaps(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, res, 1)
+			assert.Equal(t, url, res[0].URL)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Create", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(createRobotsSitemap)).
+				WithArgs(url).
+				WillReturnRows(sqlmock.NewRows(columns).AddRow(3, url, now))
+			expectOutbox(mock, md.EventRobotsSitemapCreated, "robots_sitemap:3")
+			mock.ExpectCommit()
+
+			id, err := repo.CreateRobotsSitemap(ctx, url)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(3), id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Create ErrAlreadyExists", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(createRobotsSitemap)).
+				WithArgs(url).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			_, err := repo.CreateRobotsSitemap(ctx, url)
+			assert.Equal(t, rrepo.ErrAlreadyExists, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Delete", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(deleteRobotsSitemap)).
+				WithArgs(uint64(3)).
+				WillReturnRows(sqlmock.NewRows(columns).AddRow(3, url, now))
+			expectOutbox(mock, md.EventRobotsSitemapDeleted, "robots_sitemap:3")
+			mock.ExpectCommit()
+
+			assert.NoError(t, repo.DeleteRobotsSitemap(ctx, 3))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Delete ErrNotFound", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(deleteRobotsSitemap)).
+				WithArgs(uint64(3)).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			err := repo.DeleteRobotsSitemap(ctx, 3)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
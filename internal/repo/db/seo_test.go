@@ -3,16 +3,53 @@ package db
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	model "github.com/JMURv/seo/internal/models"
 	rrepo "github.com/JMURv/seo/internal/repo"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/DATA-DOG/go-sqlmock.v1"
 	"regexp"
 	"testing"
+	"time"
 )
 
+var seoTestColumns = []string{
+	"title",
+	"description",
+	"keywords",
+	"og_title",
+	"og_description",
+	"og_image",
+	"og_type",
+	"og_url",
+	"og_locale",
+	"og_site_name",
+	"og_image_width",
+	"og_image_height",
+	"og_image_alt",
+	"og_image_type",
+	"article_published_time",
+	"article_modified_time",
+	"article_author",
+	"article_section",
+	"article_tag",
+	"twitter_card",
+	"twitter_site",
+	"twitter_creator",
+	"twitter_image_alt",
+	"obj_name",
+	"obj_pk",
+	"locale",
+	"json_ld",
+	"status",
+	"publish_at",
+	"created_at",
+	"updated_at",
+}
+
 func TestRepository_GetSEO(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -32,45 +69,61 @@ func TestRepository_GetSEO(t *testing.T) {
 		OBJName:       name,
 		OBJPK:         pk,
 	}
+	published := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
 
 	t.Run(
 		"Success case", func(t *testing.T) {
 			mock.ExpectQuery(regexp.QuoteMeta(getSEO)).
-				WithArgs(name, pk).
+				WithArgs(name, pk, "").
 				WillReturnRows(
-					sqlmock.NewRows(
-						[]string{
-							"title",
-							"description",
-							"keywords",
-							"og_title",
-							"og_description",
-							"og_image",
-							"obj_name",
-							"obj_pk",
-							"created_at",
-							"updated_at",
-						},
-					).AddRow(
+					sqlmock.NewRows(seoTestColumns).AddRow(
 						testOBJ.Title,
 						testOBJ.Description,
 						testOBJ.Keywords,
 						testOBJ.OGTitle,
 						testOBJ.OGDescription,
 						testOBJ.OGImage,
+						"article",
+						"https://example.com/a",
+						"en_US",
+						"Example",
+						1200,
+						630,
+						"alt",
+						"image/png",
+						published,
+						nil,
+						[]byte(`{"https://example.com/me"}`),
+						"news",
+						[]byte(`{go,seo}`),
+						"summary_large_image",
+						"@example",
+						"@me",
+						"alt",
 						testOBJ.OBJName,
 						testOBJ.OBJPK,
+						testOBJ.Locale,
+						[]byte(`[{"@context":"https://schema.org","@type":"Organization","name":"Acme"}]`),
+						model.StatusPublished,
+						nil,
 						testOBJ.CreatedAt,
 						testOBJ.UpdatedAt,
 					),
 				)
 
-			result, err := repo.GetSEO(ctx, name, pk)
+			result, err := repo.GetSEO(ctx, name, pk, "")
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
 			assert.Equal(t, testOBJ.ID, result.ID)
 			assert.Equal(t, testOBJ.Title, result.Title)
 			assert.Equal(t, testOBJ.Description, result.Description)
+			assert.Len(t, result.JSONLD, 1)
+			assert.Equal(t, "Organization", result.JSONLD[0]["@type"])
+			assert.Equal(t, 1200, result.OGImageWidth)
+			assert.Equal(t, published, *result.ArticlePublishedTime)
+			assert.Nil(t, result.ArticleModifiedTime)
+			assert.Equal(t, []string{"go", "seo"}, result.ArticleTag)
+			assert.Equal(t, "summary_large_image", result.TwitterCard)
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
 		},
@@ -79,10 +132,10 @@ func TestRepository_GetSEO(t *testing.T) {
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
 			mock.ExpectQuery(regexp.QuoteMeta(getSEO)).
-				WithArgs(name, pk).
+				WithArgs(name, pk, "").
 				WillReturnError(sql.ErrNoRows)
 
-			result, err := repo.GetSEO(ctx, name, pk)
+			result, err := repo.GetSEO(ctx, name, pk, "")
 			assert.Nil(t, result)
 			assert.Equal(t, rrepo.ErrNotFound, err)
 			err = mock.ExpectationsWereMet()
@@ -95,10 +148,10 @@ func TestRepository_GetSEO(t *testing.T) {
 			notExpectedError := errors.New("not expected error")
 
 			mock.ExpectQuery(regexp.QuoteMeta(getSEO)).
-				WithArgs(name, pk).
+				WithArgs(name, pk, "").
 				WillReturnError(notExpectedError)
 
-			result, err := repo.GetSEO(ctx, name, pk)
+			result, err := repo.GetSEO(ctx, name, pk, "")
 			assert.Nil(t, result)
 			assert.Equal(t, notExpectedError, err)
 			assert.NotEqual(t, rrepo.ErrNotFound, err)
@@ -108,6 +161,31 @@ func TestRepository_GetSEO(t *testing.T) {
 	)
 }
 
+// seoTestRow returns a getSEO row for obj in seoTestColumns order.
+func seoTestRow(obj *model.SEO) []driver.Value {
+	return []driver.Value{
+		obj.Title, obj.Description, obj.Keywords,
+		obj.OGTitle, obj.OGDescription, obj.OGImage,
+		obj.OGType, obj.OGURL, obj.OGLocale, obj.OGSiteName,
+		obj.OGImageWidth, obj.OGImageHeight, obj.OGImageAlt, obj.OGImageType,
+		nil, nil, []byte(`{}`), obj.ArticleSection, []byte(`{}`),
+		obj.TwitterCard, obj.TwitterSite, obj.TwitterCreator, obj.TwitterImageAlt,
+		obj.OBJName, obj.OBJPK, obj.Locale, []byte(`[]`),
+		obj.Status, obj.PublishAt,
+		obj.CreatedAt, obj.UpdatedAt,
+	}
+}
+
+// expectSEORevision expects the snapshot read and revision insert done in the same transaction.
+func expectSEORevision(mock sqlmock.Sqlmock, obj *model.SEO, action string) {
+	mock.ExpectQuery(regexp.QuoteMeta(getSEO)).
+		WithArgs(obj.OBJName, obj.OBJPK, obj.Locale).
+		WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(obj)...))
+	mock.ExpectExec(regexp.QuoteMeta(createSEORevision)).
+		WithArgs(obj.OBJName, obj.OBJPK, obj.Locale, action, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
 func TestRepository_CreateSEO(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -132,12 +210,16 @@ func TestRepository_CreateSEO(t *testing.T) {
 
 	t.Run(
 		"Success case", func(t *testing.T) {
+			mock.ExpectBegin()
 			mock.ExpectQuery(
 				regexp.QuoteMeta(createSEO),
 			).WillReturnRows(
 				sqlmock.NewRows([]string{"obj_name", "obj_pk"}).
 					AddRow(testOBJ.OBJName, testOBJ.OBJPK),
 			)
+			expectSEORevision(mock, testOBJ, model.RevisionCreate)
+			expectOutbox(mock, model.EventSEOCreated, "seo:name:pk:")
+			mock.ExpectCommit()
 
 			_, _, err := repo.CreateSEO(ctx, testOBJ)
 			assert.NoError(t, err)
@@ -146,10 +228,45 @@ func TestRepository_CreateSEO(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(createSEO)).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			_, _, err := repo.CreateSEO(ctx, testOBJ)
+			assert.Equal(t, rrepo.ErrAlreadyExists, err)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
 	t.Run(
 		"ErrInternal", func(t *testing.T) {
+			mock.ExpectBegin()
 			mock.ExpectQuery(regexp.QuoteMeta(createSEO)).
 				WillReturnError(testErr)
+			mock.ExpectRollback()
+
+			_, _, err := repo.CreateSEO(ctx, testOBJ)
+			assert.Equal(t, testErr, err)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Revision error rolls back", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(createSEO)).
+				WillReturnRows(sqlmock.NewRows([]string{"obj_name", "obj_pk"}).AddRow(name, pk))
+			mock.ExpectQuery(regexp.QuoteMeta(getSEO)).
+				WithArgs(name, pk, "").
+				WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(testOBJ)...))
+			mock.ExpectExec(regexp.QuoteMeta(createSEORevision)).
+				WillReturnError(testErr)
+			mock.ExpectRollback()
 
 			_, _, err := repo.CreateSEO(ctx, testOBJ)
 			assert.Equal(t, testErr, err)
@@ -177,29 +294,53 @@ func TestRepository_UpdateSEO(t *testing.T) {
 		OBJName:       name,
 		OBJPK:         pk,
 	}
-
-	mock.ExpectQuery(regexp.QuoteMeta(createSEO)).
-		WillReturnRows(sqlmock.NewRows([]string{"obj_name", "obj_pk"}).AddRow(testOBJ.OBJName, testOBJ.OBJPK))
-
-	_, _, err = repo.CreateSEO(context.Background(), testOBJ)
-	require.NoError(t, err)
+	args := []driver.Value{
+		testOBJ.Title,
+		testOBJ.Description,
+		testOBJ.Keywords,
+		testOBJ.OGTitle,
+		testOBJ.OGDescription,
+		testOBJ.OGImage,
+		testOBJ.OGType,
+		testOBJ.OGURL,
+		testOBJ.OGLocale,
+		testOBJ.OGSiteName,
+		testOBJ.OGImageWidth,
+		testOBJ.OGImageHeight,
+		testOBJ.OGImageAlt,
+		testOBJ.OGImageType,
+		testOBJ.ArticlePublishedTime,
+		testOBJ.ArticleModifiedTime,
+		"{}",
+		testOBJ.ArticleSection,
+		"{}",
+		testOBJ.TwitterCard,
+		testOBJ.TwitterSite,
+		testOBJ.TwitterCreator,
+		testOBJ.TwitterImageAlt,
+		testOBJ.OBJName,
+		testOBJ.OBJPK,
+		testOBJ.Locale,
+		[]byte("[]"),
+		testOBJ.Status,
+		testOBJ.PublishAt,
+		testOBJ.OBJName,
+		testOBJ.OBJPK,
+		testOBJ.Locale,
+	}
 
 	t.Run(
 		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOForUpdate)).
+				WithArgs(name, pk, "").
+				WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(testOBJ)...))
 			mock.ExpectExec(regexp.QuoteMeta(updateSEO)).
-				WithArgs(
-					testOBJ.Title,
-					testOBJ.Description,
-					testOBJ.Keywords,
-					testOBJ.OGTitle,
-					testOBJ.OGDescription,
-					testOBJ.OGImage,
-					testOBJ.OBJName,
-					testOBJ.OBJPK,
-					testOBJ.OBJName,
-					testOBJ.OBJPK,
-				).
+				WithArgs(args...).
 				WillReturnResult(sqlmock.NewResult(1, 1))
+			expectSEORevision(mock, testOBJ, model.RevisionUpdate)
+			expectOutbox(mock, model.EventSEOUpdated, "seo:name:pk:")
+			mock.ExpectCommit()
 
 			err := repo.UpdateSEO(context.Background(), testOBJ)
 			assert.NoError(t, err)
@@ -210,20 +351,11 @@ func TestRepository_UpdateSEO(t *testing.T) {
 
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
-			mock.ExpectExec(regexp.QuoteMeta(updateSEO)).
-				WithArgs(
-					testOBJ.Title,
-					testOBJ.Description,
-					testOBJ.Keywords,
-					testOBJ.OGTitle,
-					testOBJ.OGDescription,
-					testOBJ.OGImage,
-					testOBJ.OBJName,
-					testOBJ.OBJPK,
-					testOBJ.OBJName,
-					testOBJ.OBJPK,
-				).
-				WillReturnResult(sqlmock.NewResult(1, 0))
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOForUpdate)).
+				WithArgs(name, pk, "").
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
 
 			err := repo.UpdateSEO(context.Background(), testOBJ)
 			assert.ErrorIs(t, err, rrepo.ErrNotFound)
@@ -235,20 +367,14 @@ func TestRepository_UpdateSEO(t *testing.T) {
 	t.Run(
 		"ErrInternal", func(t *testing.T) {
 			ErrInternal := errors.New("internal error")
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOForUpdate)).
+				WithArgs(name, pk, "").
+				WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(testOBJ)...))
 			mock.ExpectExec(regexp.QuoteMeta(updateSEO)).
-				WithArgs(
-					testOBJ.Title,
-					testOBJ.Description,
-					testOBJ.Keywords,
-					testOBJ.OGTitle,
-					testOBJ.OGDescription,
-					testOBJ.OGImage,
-					testOBJ.OBJName,
-					testOBJ.OBJPK,
-					testOBJ.OBJName,
-					testOBJ.OBJPK,
-				).
+				WithArgs(args...).
 				WillReturnError(ErrInternal)
+			mock.ExpectRollback()
 
 			err := repo.UpdateSEO(context.Background(), testOBJ)
 			assert.ErrorIs(t, err, ErrInternal)
@@ -265,14 +391,24 @@ func TestRepository_DeleteSEO(t *testing.T) {
 
 	repo := Repository{conn: db}
 	name, pk := "name", "pk"
+	testOBJ := &model.SEO{Title: "title", OBJName: name, OBJPK: pk}
 
 	t.Run(
 		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOForUpdate)).
+				WithArgs(name, pk, "").
+				WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(testOBJ)...))
 			mock.ExpectExec(regexp.QuoteMeta(deleteSEO)).
-				WithArgs(name, pk).
+				WithArgs(name, pk, "").
 				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(regexp.QuoteMeta(createSEORevision)).
+				WithArgs(name, pk, "", model.RevisionDelete, "", sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			expectOutbox(mock, model.EventSEODeleted, "seo:name:pk:")
+			mock.ExpectCommit()
 
-			err := repo.DeleteSEO(context.Background(), name, pk)
+			err := repo.DeleteSEO(context.Background(), name, pk, "")
 			assert.NoError(t, err)
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
@@ -281,11 +417,13 @@ func TestRepository_DeleteSEO(t *testing.T) {
 
 	t.Run(
 		"ErrNotFound", func(t *testing.T) {
-			mock.ExpectExec(regexp.QuoteMeta(deleteSEO)).
-				WithArgs(name, pk).
-				WillReturnResult(sqlmock.NewResult(1, 0))
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOForUpdate)).
+				WithArgs(name, pk, "").
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
 
-			err := repo.DeleteSEO(context.Background(), name, pk)
+			err := repo.DeleteSEO(context.Background(), name, pk, "")
 			assert.ErrorIs(t, err, rrepo.ErrNotFound)
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
@@ -294,14 +432,276 @@ func TestRepository_DeleteSEO(t *testing.T) {
 
 	t.Run(
 		"ErrInternal", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOForUpdate)).
+				WithArgs(name, pk, "").
+				WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(testOBJ)...))
 			mock.ExpectExec(regexp.QuoteMeta(deleteSEO)).
-				WithArgs(name, pk).
+				WithArgs(name, pk, "").
 				WillReturnError(errors.New("db error"))
+			mock.ExpectRollback()
 
-			err := repo.DeleteSEO(context.Background(), name, pk)
+			err := repo.DeleteSEO(context.Background(), name, pk, "")
 			assert.Error(t, err)
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
 		},
 	)
 }
+
+func TestRepository_ListSEO(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	countQ := regexp.QuoteMeta(countSEO)
+	listQ := regexp.QuoteMeta(listSEO)
+	updated := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	first := &model.SEO{OBJName: "product", OBJPK: "1", Status: model.StatusPublished, UpdatedAt: updated}
+	second := &model.SEO{OBJName: "product", OBJPK: "2", Status: model.StatusPublished, UpdatedAt: updated}
+
+	t.Run(
+		"Filters and next cursor", func(t *testing.T) {
+			where := " WHERE obj_name = $1 AND obj_pk LIKE $2 AND status = $3" +
+				" AND (COALESCE(og_image, '') = '' OR json_ld = '[]'::jsonb)"
+
+			mock.ExpectQuery(countQ+regexp.QuoteMeta(where)).
+				WithArgs("product", `1\_%`, model.StatusPublished).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+			mock.ExpectQuery(listQ+regexp.QuoteMeta(where+" ORDER BY obj_name ASC, obj_pk ASC, locale ASC LIMIT $4 OFFSET $5")).
+				WithArgs("product", `1\_%`, model.StatusPublished, 2, 0).
+				WillReturnRows(
+					sqlmock.NewRows(seoTestColumns).
+						AddRow(seoTestRow(first)...).
+						AddRow(seoTestRow(second)...),
+				)
+
+			res, err := repo.ListSEO(
+				ctx, &model.SEOFilter{
+					Size: 1, OBJName: "product", PKPrefix: "1_", Status: model.StatusPublished,
+					Missing: []string{"og_image", "json_ld"},
+				},
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(5), res.Total)
+			assert.Len(t, res.SEO, 1)
+
+			next, err := model.DecodeCursor(res.NextCursor)
+			assert.NoError(t, err)
+			assert.Equal(t, model.Cursor{Sort: model.SortOBJPK, Key: []string{"product", "1", ""}}, next)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Keyset on updated_at", func(t *testing.T) {
+			cursor := model.Cursor{
+				Sort: model.SortUpdatedAt, Value: updated.Format(time.RFC3339Nano), Key: []string{"product", "1", ""},
+			}.Encode()
+
+			mock.ExpectQuery(countQ).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+			mock.ExpectQuery(
+				listQ+regexp.QuoteMeta(
+					" WHERE (updated_at, obj_name, obj_pk, locale) < ($4, $1, $2, $3)"+
+						" ORDER BY updated_at DESC, obj_name DESC, obj_pk DESC, locale DESC LIMIT $5 OFFSET $6",
+				),
+			).
+				WithArgs("product", "1", "", updated, 41, 0).
+				WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(second)...))
+
+			res, err := repo.ListSEO(
+				ctx, &model.SEOFilter{Sort: model.SortUpdatedAt, Order: model.OrderDesc, Cursor: cursor},
+			)
+			assert.NoError(t, err)
+			assert.Len(t, res.SEO, 1)
+			assert.Equal(t, second.OBJPK, res.SEO[0].OBJPK)
+			assert.Empty(t, res.NextCursor)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Invalid cursor", func(t *testing.T) {
+			mock.ExpectQuery(countQ).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+
+			res, err := repo.ListSEO(ctx, &model.SEOFilter{Sort: model.SortTitle, Cursor: "garbage"})
+			assert.ErrorIs(t, err, model.ErrInvalidCursor)
+			assert.Nil(t, res)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"QueryError", func(t *testing.T) {
+			testErr := errors.New("query failed")
+			mock.ExpectQuery(countQ).WillReturnError(testErr)
+
+			res, err := repo.ListSEO(ctx, &model.SEOFilter{})
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestRepository_ListSEOForSitemap(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	names := []string{"product"}
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listSEOForSitemap)).
+				WithArgs(pq.Array(names)).
+				WillReturnRows(
+					sqlmock.NewRows([]string{"obj_name", "obj_pk", "updated_at"}).
+						AddRow("product", "1", now).
+						AddRow("product", "2", now),
+				)
+
+			res, err := repo.ListSEOForSitemap(ctx, names)
+			assert.NoError(t, err)
+			assert.Len(t, res, 2)
+			assert.Equal(t, "1", res[0].OBJPK)
+			assert.Equal(t, now, res[1].UpdatedAt)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"QueryError", func(t *testing.T) {
+			testErr := errors.New("query failed")
+			mock.ExpectQuery(regexp.QuoteMeta(listSEOForSitemap)).
+				WithArgs(pq.Array(names)).
+				WillReturnError(testErr)
+
+			res, err := repo.ListSEOForSitemap(ctx, names)
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestRepository_ListSEOLocales(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	name, pk := "name", "pk"
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listSEOLocales)).
+				WithArgs(name, pk).
+				WillReturnRows(
+					sqlmock.NewRows([]string{"locale", "updated_at"}).
+						AddRow("", time.Now()).
+						AddRow("ru", time.Now()),
+				)
+
+			res, err := repo.ListSEOLocales(ctx, name, pk)
+			assert.NoError(t, err)
+			assert.Len(t, res, 2)
+			assert.Equal(t, "ru", res[1].Locale)
+			assert.Equal(t, name, res[1].OBJName)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			testErr := errors.New("test error")
+			mock.ExpectQuery(regexp.QuoteMeta(listSEOLocales)).
+				WithArgs(name, pk).
+				WillReturnError(testErr)
+
+			res, err := repo.ListSEOLocales(ctx, name, pk)
+			assert.Equal(t, testErr, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_PublishScheduledSEO(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+	scheduled := &model.SEO{Title: "title", OBJName: "product", OBJPK: "1", Locale: "en", Status: model.StatusPublished}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			before := *scheduled
+			before.Status = model.StatusScheduled
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(listDueSEO)).
+				WithArgs(now).
+				WillReturnRows(sqlmock.NewRows(seoTestColumns).AddRow(seoTestRow(&before)...))
+			mock.ExpectQuery(regexp.QuoteMeta(publishScheduledSEO)).
+				WithArgs(now).
+				WillReturnRows(sqlmock.NewRows([]string{"obj_name", "obj_pk", "locale"}).AddRow("product", "1", "en"))
+			expectSEORevision(mock, scheduled, model.RevisionPublish)
+			expectOutbox(mock, model.EventSEOUpdated, "seo:product:1:en")
+			mock.ExpectCommit()
+
+			res, err := repo.PublishScheduledSEO(context.Background(), now)
+			assert.NoError(t, err)
+			assert.Len(t, res, 1)
+			assert.Equal(t, "en", res[0].Locale)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Nothing to publish", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(listDueSEO)).
+				WithArgs(now).
+				WillReturnRows(sqlmock.NewRows(seoTestColumns))
+			mock.ExpectQuery(regexp.QuoteMeta(publishScheduledSEO)).
+				WithArgs(now).
+				WillReturnRows(sqlmock.NewRows([]string{"obj_name", "obj_pk", "locale"}))
+			mock.ExpectCommit()
+
+			res, err := repo.PublishScheduledSEO(context.Background(), now)
+			assert.NoError(t, err)
+			assert.Empty(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			testErr := errors.New("db error")
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(listDueSEO)).
+				WithArgs(now).
+				WillReturnError(testErr)
+			mock.ExpectRollback()
+
+			res, err := repo.PublishScheduledSEO(context.Background(), now)
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
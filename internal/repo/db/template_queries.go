@@ -0,0 +1,51 @@
+package db
+
+const templateColumns = `
+	obj_name,
+	locale,
+	title,
+	description,
+	keywords,
+	og_title,
+	og_description,
+	og_image,
+	og_type,
+	og_url`
+
+const listSEOTemplates = `
+SELECT ` + templateColumns + `, created_at, updated_at
+FROM seo_template
+ORDER BY obj_name, locale
+`
+
+const getSEOTemplate = `
+SELECT ` + templateColumns + `, created_at, updated_at
+FROM seo_template
+WHERE obj_name = $1 AND locale = $2
+`
+
+const getSEOTemplateForUpdate = getSEOTemplate + `FOR UPDATE
+`
+
+const saveSEOTemplate = `
+INSERT INTO seo_template (` + templateColumns + `
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (obj_name, locale) DO UPDATE SET
+	title = EXCLUDED.title,
+	description = EXCLUDED.description,
+	keywords = EXCLUDED.keywords,
+	og_title = EXCLUDED.og_title,
+	og_description = EXCLUDED.og_description,
+	og_image = EXCLUDED.og_image,
+	og_type = EXCLUDED.og_type,
+	og_url = EXCLUDED.og_url,
+	updated_at = CURRENT_TIMESTAMP
+RETURNING ` + templateColumns + `, created_at, updated_at, xmax = 0
+`
+
+const deleteSEOTemplate = `
+DELETE FROM seo_template
+WHERE obj_name = $1 AND locale = $2
+RETURNING ` + templateColumns + `, created_at, updated_at
+`
@@ -0,0 +1,217 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	model "github.com/JMURv/seo/internal/models"
+	rrepo "github.com/JMURv/seo/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var templateColumnNames = []string{
+	"obj_name", "locale", "title", "description", "keywords", "og_title", "og_description", "og_image", "og_type",
+	"og_url", "created_at", "updated_at",
+}
+
+func TestRepository_ListSEOTemplates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listSEOTemplates)).
+				WillReturnRows(
+					sqlmock.NewRows(templateColumnNames).
+						AddRow("product", "", "{{.name}}", "", "", "", "", "", "", "", now, now).
+						AddRow("product", "ru", "{{.name}} ru", "", "", "", "", "", "", "", now, now),
+				)
+
+			res, err := repo.ListSEOTemplates(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, res, 2)
+			assert.Equal(t, "ru", res[1].Locale)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			testErr := errors.New("db error")
+			mock.ExpectQuery(regexp.QuoteMeta(listSEOTemplates)).WillReturnError(testErr)
+
+			res, err := repo.ListSEOTemplates(ctx)
+			assert.Nil(t, res)
+			assert.Equal(t, testErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_GetSEOTemplate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOTemplate)).
+				WithArgs("product", "en").
+				WillReturnRows(
+					sqlmock.NewRows(templateColumnNames).
+						AddRow("product", "en", "{{.name}}", "d", "k", "og", "ogd", "img", "product", "url", now, now),
+				)
+
+			res, err := repo.GetSEOTemplate(ctx, "product", "en")
+			assert.NoError(t, err)
+			assert.Equal(t, "{{.name}}", res.Title)
+			assert.Equal(t, "url", res.OGURL)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOTemplate)).
+				WithArgs("product", "").
+				WillReturnError(sql.ErrNoRows)
+
+			res, err := repo.GetSEOTemplate(ctx, "product", "")
+			assert.Nil(t, res)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_SaveSEOTemplate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	req := &model.SEOTemplate{OBJName: "product", Title: "{{.name}}"}
+	args := []driver.Value{"product", "", "{{.name}}", "", "", "", "", "", "", ""}
+	savedColumns := append(templateColumnNames, "created")
+
+	t.Run(
+		"Created", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOTemplateForUpdate)).
+				WithArgs("product", "").
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectQuery(regexp.QuoteMeta(saveSEOTemplate)).
+				WithArgs(args...).
+				WillReturnRows(
+					sqlmock.NewRows(savedColumns).
+						AddRow("product", "", "{{.name}}", "", "", "", "", "", "", "", now, now, true),
+				)
+			expectOutbox(mock, model.EventSEOTemplateCreated, "seo_template:product:")
+			mock.ExpectCommit()
+
+			created, err := repo.SaveSEOTemplate(ctx, req)
+			assert.NoError(t, err)
+			assert.True(t, created)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Updated", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOTemplateForUpdate)).
+				WithArgs("product", "").
+				WillReturnRows(
+					sqlmock.NewRows(templateColumnNames).
+						AddRow("product", "", "{{.title}}", "", "", "", "", "", "", "", now, now),
+				)
+			mock.ExpectQuery(regexp.QuoteMeta(saveSEOTemplate)).
+				WithArgs(args...).
+				WillReturnRows(
+					sqlmock.NewRows(savedColumns).
+						AddRow("product", "", "{{.name}}", "", "", "", "", "", "", "", now, now, false),
+				)
+			expectOutbox(mock, model.EventSEOTemplateUpdated, "seo_template:product:")
+			mock.ExpectCommit()
+
+			created, err := repo.SaveSEOTemplate(ctx, req)
+			assert.NoError(t, err)
+			assert.False(t, created)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			testErr := errors.New("db error")
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(getSEOTemplateForUpdate)).
+				WithArgs("product", "").
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectQuery(regexp.QuoteMeta(saveSEOTemplate)).WillReturnError(testErr)
+			mock.ExpectRollback()
+
+			created, err := repo.SaveSEOTemplate(ctx, req)
+			assert.False(t, created)
+			assert.Equal(t, testErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_DeleteSEOTemplate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(deleteSEOTemplate)).
+				WithArgs("product", "").
+				WillReturnRows(
+					sqlmock.NewRows(templateColumnNames).
+						AddRow("product", "", "{{.name}}", "", "", "", "", "", "", "", now, now),
+				)
+			expectOutbox(mock, model.EventSEOTemplateDeleted, "seo_template:product:")
+			mock.ExpectCommit()
+
+			assert.NoError(t, repo.DeleteSEOTemplate(ctx, "product", ""))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(deleteSEOTemplate)).
+				WithArgs("product", "").
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			assert.Equal(t, rrepo.ErrNotFound, repo.DeleteSEOTemplate(ctx, "product", ""))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
@@ -0,0 +1,80 @@
+package db
+
+const listWebhooks = `
+SELECT id, url, events, disabled, created_at, updated_at
+FROM webhook
+ORDER BY id
+`
+
+const getWebhook = `
+SELECT id, url, events, disabled, created_at, updated_at
+FROM webhook
+WHERE id = $1
+`
+
+const createWebhook = `
+INSERT INTO webhook (url, secret, events, disabled)
+VALUES ($1, $2, $3, $4)
+RETURNING id
+`
+
+const updateWebhook = `
+UPDATE webhook
+SET url = $1, secret = COALESCE(NULLIF($2, ''), secret), events = $3, disabled = $4, updated_at = CURRENT_TIMESTAMP
+WHERE id = $5
+`
+
+const deleteWebhook = `
+DELETE FROM webhook
+WHERE id = $1
+`
+
+const enqueueWebhookEvent = `
+INSERT INTO webhook_delivery (webhook_id, event, payload)
+SELECT id, $1, $2
+FROM webhook
+WHERE NOT disabled AND ($1 = ANY(events) OR '*' = ANY(events))
+`
+
+const listWebhookSubscriptions = `
+SELECT id, events
+FROM webhook
+WHERE NOT disabled
+ORDER BY id
+`
+
+// claimWebhookDeliveries leases due deliveries by pushing next_attempt_at to
+// $2, so a worker that dies mid-send only delays them. SKIP LOCKED lets
+// several instances claim from the same queue.
+const claimWebhookDeliveries = `
+UPDATE webhook_delivery d
+SET next_attempt_at = $2
+FROM webhook w
+WHERE d.webhook_id = w.id AND d.id IN (
+    SELECT id FROM webhook_delivery
+    WHERE status = 'pending' AND next_attempt_at <= $1
+    ORDER BY next_attempt_at
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING d.id, d.webhook_id, d.event, d.payload, d.attempts, d.created_at, w.url, w.secret
+`
+
+const finishWebhookDelivery = `
+UPDATE webhook_delivery
+SET status = $1, attempts = $2, response_code = $3, last_error = $4, next_attempt_at = $5, delivered_at = $6
+WHERE id = $7
+`
+
+const retryWebhookDelivery = `
+UPDATE webhook_delivery
+SET status = 'pending', attempts = 0, next_attempt_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+const countWebhookDeliveries = `SELECT COUNT(*) FROM webhook_delivery`
+
+const listWebhookDeliveries = `
+SELECT id, webhook_id, event, payload, status, attempts, response_code, last_error, next_attempt_at, delivered_at, created_at
+FROM webhook_delivery
+`
@@ -0,0 +1,339 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	md "github.com/JMURv/seo/internal/models"
+	rrepo "github.com/JMURv/seo/internal/repo"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var webhookColumns = []string{"id", "url", "events", "disabled", "created_at", "updated_at"}
+
+func TestRepository_ListWebhooks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listWebhooks)).
+				WillReturnRows(
+					sqlmock.NewRows(webhookColumns).
+						AddRow(1, "https://cdn.example.com/purge", "{seo.updated,page.deleted}", false, now, now).
+						AddRow(2, "https://search.example.com/hook", "{*}", true, now, now),
+				)
+
+			res, err := repo.ListWebhooks(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, res, 2)
+			assert.Equal(t, []string{md.EventSEOUpdated, md.EventPageDeleted}, res[0].Events)
+			assert.Empty(t, res[0].Secret)
+			assert.True(t, res[1].Disabled)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Unexpected error", func(t *testing.T) {
+			testErr := errors.New("unexpected error")
+			mock.ExpectQuery(regexp.QuoteMeta(listWebhooks)).WillReturnError(testErr)
+
+			res, err := repo.ListWebhooks(ctx)
+			assert.Equal(t, testErr, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_GetWebhook(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	id := uint64(1)
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getWebhook)).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(webhookColumns).AddRow(id, "https://example.com", "{*}", false, now, now))
+
+			res, err := repo.GetWebhook(ctx, id)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{md.EventAll}, res.Events)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getWebhook)).
+				WithArgs(id).
+				WillReturnError(sql.ErrNoRows)
+
+			res, err := repo.GetWebhook(ctx, id)
+			assert.Equal(t, rrepo.ErrNotFound, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_CreateWebhook(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	req := &md.Webhook{URL: "https://example.com", Secret: "0123456789abcdef", Events: []string{md.EventSEOUpdated}}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(createWebhook)).
+				WithArgs(req.URL, req.Secret, pq.Array(req.Events), false).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+
+			id, err := repo.CreateWebhook(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(7), id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Unexpected error", func(t *testing.T) {
+			testErr := errors.New("unexpected error")
+			mock.ExpectQuery(regexp.QuoteMeta(createWebhook)).WillReturnError(testErr)
+
+			id, err := repo.CreateWebhook(ctx, req)
+			assert.Equal(t, testErr, err)
+			assert.Zero(t, id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_UpdateWebhook(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	id := uint64(1)
+	req := &md.Webhook{URL: "https://example.com", Events: []string{md.EventAll}, Disabled: true}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(updateWebhook)).
+				WithArgs(req.URL, "", pq.Array(req.Events), true, id).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			assert.NoError(t, repo.UpdateWebhook(ctx, id, req))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(updateWebhook)).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			assert.Equal(t, rrepo.ErrNotFound, repo.UpdateWebhook(ctx, id, req))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_DeleteWebhook(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	id := uint64(1)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(deleteWebhook)).
+				WithArgs(id).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			assert.NoError(t, repo.DeleteWebhook(ctx, id))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(deleteWebhook)).
+				WithArgs(id).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			assert.Equal(t, rrepo.ErrNotFound, repo.DeleteWebhook(ctx, id))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_ClaimWebhookDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	lease := 20 * time.Second
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(claimWebhookDeliveries)).
+				WithArgs(now, now.Add(lease), 10).
+				WillReturnRows(
+					sqlmock.NewRows(
+						[]string{"id", "webhook_id", "event", "payload", "attempts", "created_at", "url", "secret"},
+					).AddRow(5, 1, md.EventPageDeleted, []byte(`{}`), 2, now, "https://example.com", "secret"),
+				)
+
+			res, err := repo.ClaimWebhookDeliveries(ctx, now, lease, 10)
+			assert.NoError(t, err)
+			assert.Len(t, res, 1)
+			assert.Equal(t, 2, res[0].Attempts)
+			assert.Equal(t, "secret", res[0].Secret)
+			assert.Equal(t, md.DeliveryPending, res[0].Status)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Unexpected error", func(t *testing.T) {
+			testErr := errors.New("unexpected error")
+			mock.ExpectQuery(regexp.QuoteMeta(claimWebhookDeliveries)).WillReturnError(testErr)
+
+			res, err := repo.ClaimWebhookDeliveries(ctx, now, lease, 10)
+			assert.Equal(t, testErr, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_FinishWebhookDelivery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	d := &md.WebhookDelivery{
+		ID: 5, Status: md.DeliveryDelivered, Attempts: 1, ResponseCode: 204, NextAttemptAt: now, DeliveredAt: &now,
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(finishWebhookDelivery)).
+		WithArgs(d.Status, d.Attempts, d.ResponseCode, "", now, &now, d.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.FinishWebhookDelivery(ctx, d))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RetryWebhookDelivery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	id := uint64(5)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(retryWebhookDelivery)).
+				WithArgs(id).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			assert.NoError(t, repo.RetryWebhookDelivery(ctx, id))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(retryWebhookDelivery)).
+				WithArgs(id).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			assert.Equal(t, rrepo.ErrNotFound, repo.RetryWebhookDelivery(ctx, id))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_ListWebhookDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	now := time.Now()
+	f := &md.WebhookDeliveryFilter{WebhookID: 1, Status: md.DeliveryFailed, Page: 2, Size: 10}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(countWebhookDeliveries+" WHERE webhook_id = $1 AND status = $2")).
+				WithArgs(f.WebhookID, f.Status).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+			mock.ExpectQuery(regexp.QuoteMeta(listWebhookDeliveries+" WHERE webhook_id = $1 AND status = $2 ORDER BY id DESC LIMIT $3 OFFSET $4")).
+				WithArgs(f.WebhookID, f.Status, 10, 10).
+				WillReturnRows(
+					sqlmock.NewRows(
+						[]string{
+							"id", "webhook_id", "event", "payload", "status", "attempts", "response_code",
+							"last_error", "next_attempt_at", "delivered_at", "created_at",
+						},
+					).AddRow(1, 1, md.EventSEOCreated, []byte(`{}`), md.DeliveryFailed, 10, 500, "unexpected response status 500", now, nil, now),
+				)
+
+			res, err := repo.ListWebhookDeliveries(ctx, f)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(11), res.Total)
+			assert.Len(t, res.Deliveries, 1)
+			assert.Equal(t, 500, res.Deliveries[0].ResponseCode)
+			assert.Nil(t, res.Deliveries[0].DeliveredAt)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"Unexpected error", func(t *testing.T) {
+			testErr := errors.New("unexpected error")
+			mock.ExpectQuery(regexp.QuoteMeta(countWebhookDeliveries)).WillReturnError(testErr)
+
+			res, err := repo.ListWebhookDeliveries(ctx, f)
+			assert.Equal(t, testErr, err)
+			assert.Nil(t, res)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
@@ -4,3 +4,7 @@ import "errors"
 
 var ErrNotFound = errors.New("not found")
 var ErrAlreadyExists = errors.New("already exists")
+var ErrParentNotFound = errors.New("parent not found")
+var ErrHasChildren = errors.New("has children")
+var ErrPageCycle = errors.New("page cycle")
+var ErrDuplicateHref = errors.New("duplicate href")
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+// CountSEODuplicates returns how many other non-archived records of the same
+// locale share req's title and description.
+func (r *Repository) CountSEODuplicates(ctx context.Context, req *md.SEO) (int, int, error) {
+	const op = "seo.CountSEODuplicates.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var titles, descriptions int
+	for key, seo := range r.seo {
+		if key.locale != req.Locale || seo.Status == md.StatusArchived ||
+			(key.name == req.OBJName && key.pk == req.OBJPK) {
+			continue
+		}
+
+		if seo.Title == req.Title {
+			titles++
+		}
+		if seo.Description == req.Description {
+			descriptions++
+		}
+	}
+
+	return titles, descriptions, nil
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"context"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+// ImportSEO writes rows in one go. Existing records are overwritten when upsert
+// is set and left untouched otherwise. Every written record gets a revision
+// and an outbox event.
+func (r *Repository) ImportSEO(ctx context.Context, rows []*md.SEO, upsert bool) (int, int, error) {
+	const op = "seo.ImportSEO.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	at := currentTimestamp()
+	records := make([]*md.SEO, len(rows))
+	for i, row := range rows {
+		seo, err := newSEO(row, at, at)
+		if err != nil {
+			return 0, 0, err
+		}
+		records[i] = seo
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var created, updated int
+	for _, seo := range records {
+		key := seoKey{seo.OBJName, seo.OBJPK, seo.Locale}
+		before, ok := r.seo[key]
+		if ok && !upsert {
+			continue
+		}
+
+		action, event := md.RevisionCreate, md.EventSEOCreated
+		if ok {
+			action, event = md.RevisionUpdate, md.EventSEOUpdated
+			seo = copyOf(seo)
+			seo.CreatedAt = before.CreatedAt
+			updated++
+		} else {
+			created++
+		}
+
+		r.seo[key] = seo
+		r.writeSEORevision(ctx, action, seo)
+		if err := r.writeOutbox(ctx, event, seoOutboxKey(seo), before, seo); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return created, updated, nil
+}
@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	md "github.com/JMURv/seo/internal/models"
+	"sync"
+	"time"
+)
+
+// Repository keeps every table in maps guarded by one lock, so each method is
+// a transaction of its own. It follows the constraints and error semantics of
+// the Postgres repository and is meant for tests and setups without one;
+// nothing survives a restart.
+type Repository struct {
+	mu sync.RWMutex
+
+	seo       map[seoKey]*md.SEO
+	revisions []*md.SEORevision
+	templates map[templateKey]*md.SEOTemplate
+
+	pages map[string]*md.Page
+	// hrefs maps the normalized href of every page to its slug.
+	hrefs map[string]string
+
+	robotsGroups   map[uint64]*md.RobotsGroup
+	robotsSitemaps map[uint64]*md.RobotsSitemap
+	redirects      map[uint64]*md.Redirect
+	webhooks       map[uint64]*webhook
+	deliveries     map[uint64]*md.WebhookDelivery
+	outbox         []*outboxEvent
+
+	// seq holds the last id handed out per table, like BIGSERIAL sequences.
+	seq map[string]uint64
+}
+
+type seoKey struct {
+	name, pk, locale string
+}
+
+type templateKey struct {
+	name, locale string
+}
+
+// webhook keeps the secret that md.Webhook values read back never carry.
+type webhook struct {
+	md.Webhook
+	secret string
+}
+
+type outboxEvent struct {
+	md.OutboxEvent
+	lockedUntil *time.Time
+	publishedAt *time.Time
+}
+
+func New() *Repository {
+	return &Repository{
+		seo:            make(map[seoKey]*md.SEO),
+		templates:      make(map[templateKey]*md.SEOTemplate),
+		pages:          make(map[string]*md.Page),
+		hrefs:          make(map[string]string),
+		robotsGroups:   make(map[uint64]*md.RobotsGroup),
+		robotsSitemaps: make(map[uint64]*md.RobotsSitemap),
+		redirects:      make(map[uint64]*md.Redirect),
+		webhooks:       make(map[uint64]*webhook),
+		deliveries:     make(map[uint64]*md.WebhookDelivery),
+		seq:            make(map[string]uint64),
+	}
+}
+
+func (r *Repository) Close() error {
+	return nil
+}
+
+// nextID returns the next id of table. The caller holds the write lock.
+func (r *Repository) nextID(table string) uint64 {
+	r.seq[table]++
+	return r.seq[table]
+}
+
+// currentTimestamp is the CURRENT_TIMESTAMP of a write.
+func currentTimestamp() time.Time {
+	return time.Now().UTC()
+}
+
+// clone deep-copies v through JSON, the way a row leaves the database as a
+// new value that does not share memory with what is stored.
+func clone[T any](v *T) (*T, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(T)
+	if err = json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// copyOf clones a stored value. Stored values went through clone when they
+// were written, so encoding them again cannot fail.
+func copyOf[T any](v *T) *T {
+	res, err := clone(v)
+	if err != nil {
+		panic("memory: failed to copy a stored value: " + err.Error())
+	}
+	return res
+}
+
+// nonNilStrings keeps NOT NULL array columns from reading back as nil.
+func nonNilStrings(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
+
+// authorFromCtx returns the uid put into the context by the auth middleware.
+func authorFromCtx(ctx context.Context) string {
+	uid, _ := ctx.Value("uid").(string)
+	return uid
+}
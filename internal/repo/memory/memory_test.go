@@ -0,0 +1,15 @@
+package memory
+
+import (
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/repo/repotest"
+	"testing"
+)
+
+func TestContract(t *testing.T) {
+	repotest.Run(
+		t, func(t *testing.T) ctrl.AppRepo {
+			return New()
+		},
+	)
+}
@@ -0,0 +1,190 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// ClaimOutboxEvents returns up to limit unpublished events in commit order and
+// hides them from other relays until now+lease.
+func (r *Repository) ClaimOutboxEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*md.OutboxEvent, error) {
+	const op = "outbox.ClaimOutboxEvents.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	until := now.Add(lease)
+	res := make([]*md.OutboxEvent, 0, limit)
+	for _, e := range r.outbox {
+		if len(res) == limit {
+			break
+		}
+		if e.publishedAt != nil || (e.lockedUntil != nil && e.lockedUntil.After(now)) {
+			continue
+		}
+
+		e.lockedUntil = &until
+		res = append(res, e.copy())
+	}
+	return res, nil
+}
+
+func (r *Repository) MarkOutboxPublished(ctx context.Context, ids []uint64, now time.Time) error {
+	const op = "outbox.MarkOutboxPublished.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, e := range r.outbox {
+		if slices.Contains(ids, e.ID) {
+			e.publishedAt, e.lockedUntil = &now, nil
+		}
+	}
+	return nil
+}
+
+// ListOutboxEvents returns up to limit events with an id above after in id
+// order, published or not.
+func (r *Repository) ListOutboxEvents(ctx context.Context, after uint64, limit int) ([]*md.OutboxEvent, error) {
+	const op = "outbox.ListOutboxEvents.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*md.OutboxEvent, 0, limit)
+	for _, e := range r.outbox {
+		if len(res) == limit {
+			break
+		}
+		if e.ID > after {
+			res = append(res, e.copy())
+		}
+	}
+	return res, nil
+}
+
+func (r *Repository) LastOutboxEventID(ctx context.Context) (uint64, error) {
+	const op = "outbox.LastOutboxEventID.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.outbox) == 0 {
+		return 0, nil
+	}
+	return r.outbox[len(r.outbox)-1].ID, nil
+}
+
+// PurgeOutbox removes events published before the given time.
+func (r *Repository) PurgeOutbox(ctx context.Context, before time.Time) (int64, error) {
+	const op = "outbox.PurgeOutbox.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := len(r.outbox)
+	r.outbox = slices.DeleteFunc(
+		r.outbox, func(e *outboxEvent) bool {
+			return e.publishedAt != nil && e.publishedAt.Before(before)
+		},
+	)
+	return int64(n - len(r.outbox)), nil
+}
+
+// writeOutbox records a change and queues it for the webhooks subscribed to
+// it. A nil before or after marks a creation or a deletion. The caller holds
+// the write lock, so the event is visible exactly when the change is.
+func (r *Repository) writeOutbox(ctx context.Context, event, key string, before, after any) error {
+	occurred := time.Now().UTC()
+	payload, err := json.Marshal(
+		&md.ChangeEvent{
+			Event:      event,
+			UID:        authorFromCtx(ctx),
+			OccurredAt: occurred,
+			Before:     before,
+			After:      after,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	at := currentTimestamp()
+	r.outbox = append(
+		r.outbox, &outboxEvent{
+			OutboxEvent: md.OutboxEvent{
+				ID:        r.nextID("outbox"),
+				Event:     event,
+				Key:       key,
+				Payload:   payload,
+				CreatedAt: at,
+			},
+		},
+	)
+
+	if _, ok := md.WebhookEvents[event]; !ok {
+		return nil
+	}
+
+	data := after
+	if after == nil {
+		data = before
+	}
+
+	payload, err = json.Marshal(&md.WebhookEvent{Event: event, OccurredAt: occurred, Data: data})
+	if err != nil {
+		return err
+	}
+
+	r.enqueueWebhookEvent(event, payload, at)
+	return nil
+}
+
+func (e *outboxEvent) copy() *md.OutboxEvent {
+	res := e.OutboxEvent
+	res.Payload = slices.Clone(e.Payload)
+	return &res
+}
+
+func seoOutboxKey(seo *md.SEO) string {
+	return "seo:" + seo.OBJName + ":" + seo.OBJPK + ":" + seo.Locale
+}
+
+func pageOutboxKey(slug string) string {
+	return "page:" + slug
+}
+
+func robotsGroupOutboxKey(id uint64) string {
+	return "robots_group:" + strconv.FormatUint(id, 10)
+}
+
+func robotsSitemapOutboxKey(id uint64) string {
+	return "robots_sitemap:" + strconv.FormatUint(id, 10)
+}
+
+func redirectOutboxKey(id uint64) string {
+	return "redirect:" + strconv.FormatUint(id, 10)
+}
+
+func seoTemplateOutboxKey(name, locale string) string {
+	return "seo_template:" + name + ":" + locale
+}
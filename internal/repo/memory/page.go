@@ -0,0 +1,404 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// pageSorts compares pages by the column of each sort; ties are broken by slug.
+var pageSorts = map[string]func(a, b *md.Page) int{
+	md.SortSlug: func(a, b *md.Page) int {
+		return 0
+	},
+	md.SortTitle: func(a, b *md.Page) int {
+		return cmp.Compare(a.Title, b.Title)
+	},
+	md.SortPriority: func(a, b *md.Page) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	},
+	md.SortCreatedAt: func(a, b *md.Page) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	},
+	md.SortUpdatedAt: func(a, b *md.Page) int {
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	},
+}
+
+func (r *Repository) ListPages(ctx context.Context, f *md.PageFilter) (*md.PageList, error) {
+	const op = "pages.ListPages.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rows := make([]*md.Page, 0)
+	for _, page := range r.pages {
+		if matchPage(f, page) {
+			rows = append(rows, page)
+		}
+	}
+	res := &md.PageList{Total: int64(len(rows))}
+
+	sortName := f.Sort
+	sortFn, ok := pageSorts[sortName]
+	if !ok {
+		sortName, sortFn = md.SortSlug, pageSorts[md.SortSlug]
+	}
+
+	order := func(a, b *md.Page) int {
+		c := cmp.Or(sortFn(a, b), cmp.Compare(a.Slug, b.Slug))
+		if f.Order == md.OrderDesc {
+			return -c
+		}
+		return c
+	}
+	slices.SortFunc(rows, order)
+
+	size := f.Size
+	if size <= 0 {
+		size = config.DefaultSize
+	}
+
+	offset := 0
+	if f.Cursor != "" {
+		cur, err := md.DecodeCursor(f.Cursor)
+		if err != nil || cur.Sort != sortName || len(cur.Key) != 1 {
+			return nil, md.ErrInvalidCursor
+		}
+
+		last := &md.Page{Slug: cur.Key[0]}
+		switch sortName {
+		case md.SortSlug:
+		case md.SortUpdatedAt:
+			if last.UpdatedAt, err = time.Parse(time.RFC3339Nano, cur.Value); err != nil {
+				return nil, md.ErrInvalidCursor
+			}
+		default:
+			return nil, md.ErrInvalidCursor
+		}
+
+		offset = len(rows)
+		if i := slices.IndexFunc(rows, func(p *md.Page) bool { return order(p, last) > 0 }); i >= 0 {
+			offset = i
+		}
+	} else if f.Page > 1 {
+		offset = (f.Page - 1) * size
+	}
+
+	rows = rows[min(offset, len(rows)):]
+	res.Pages = make([]*md.Page, 0, size)
+	for _, page := range rows[:min(size, len(rows))] {
+		res.Pages = append(res.Pages, copyOf(page))
+	}
+
+	if len(rows) > size {
+		last := res.Pages[size-1]
+		switch sortName {
+		case md.SortSlug:
+			res.NextCursor = md.Cursor{Sort: sortName, Key: []string{last.Slug}}.Encode()
+		case md.SortUpdatedAt:
+			res.NextCursor = md.Cursor{
+				Sort: sortName, Value: last.UpdatedAt.Format(time.RFC3339Nano), Key: []string{last.Slug},
+			}.Encode()
+		}
+	}
+
+	return res, nil
+}
+
+func matchPage(f *md.PageFilter, page *md.Page) bool {
+	switch {
+	case f.Title != "" && !containsFold(page.Title, f.Title):
+		return false
+	case f.Href != "" && !containsFold(page.Href, f.Href):
+		return false
+	case f.Status != "" && page.Status != f.Status:
+		return false
+	case f.CreatedFrom != nil && page.CreatedAt.Before(*f.CreatedFrom):
+		return false
+	case f.CreatedTo != nil && page.CreatedAt.After(*f.CreatedTo):
+		return false
+	case f.UpdatedFrom != nil && page.UpdatedAt.Before(*f.UpdatedFrom):
+		return false
+	case f.UpdatedTo != nil && page.UpdatedAt.After(*f.UpdatedTo):
+		return false
+	}
+	return true
+}
+
+// containsFold reports whether substr is within s ignoring case, like ILIKE.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func (r *Repository) GetPage(ctx context.Context, slug string) (*md.Page, error) {
+	const op = "pages.GetPage.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	page, ok := r.pages[slug]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	return copyOf(page), nil
+}
+
+// GetPageByHref finds the page whose normalized href equals path.
+func (r *Repository) GetPageByHref(ctx context.Context, path string) (*md.Page, error) {
+	const op = "pages.GetPageByHref.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	slug, ok := r.hrefs[md.NormalizeHref(path)]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	return copyOf(r.pages[slug]), nil
+}
+
+func (r *Repository) CreatePage(ctx context.Context, req *md.Page) (string, error) {
+	const op = "pages.CreatePage.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	at := currentTimestamp()
+	page, err := newPage(req, req.Slug, at, at)
+	if err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.pages[page.Slug]; ok {
+		return "", repo.ErrAlreadyExists
+	}
+	if err = r.checkPage(page); err != nil {
+		return "", err
+	}
+
+	r.pages[page.Slug] = page
+	r.hrefs[md.NormalizeHref(page.Href)] = page.Slug
+	if err = r.writeOutbox(ctx, md.EventPageCreated, pageOutboxKey(page.Slug), nil, page); err != nil {
+		return "", err
+	}
+
+	return page.Slug, nil
+}
+
+func (r *Repository) UpdatePage(ctx context.Context, slug string, req *md.Page) error {
+	const op = "pages.UpdatePage.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	before, ok := r.pages[slug]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	after, err := newPage(req, slug, before.CreatedAt, currentTimestamp())
+	if err != nil {
+		return err
+	}
+	if err = r.checkPage(after); err != nil {
+		return err
+	}
+	for v := after.ParentSlug; v != ""; v = r.pages[v].ParentSlug {
+		if v == slug {
+			return repo.ErrPageCycle
+		}
+	}
+
+	delete(r.hrefs, md.NormalizeHref(before.Href))
+	r.pages[slug] = after
+	r.hrefs[md.NormalizeHref(after.Href)] = slug
+	return r.writeOutbox(ctx, md.EventPageUpdated, pageOutboxKey(slug), before, after)
+}
+
+// checkPage enforces the parent reference and the unique normalized href of
+// page. The caller holds the lock.
+func (r *Repository) checkPage(page *md.Page) error {
+	if _, ok := r.pages[page.ParentSlug]; page.ParentSlug != "" && !ok {
+		return repo.ErrParentNotFound
+	}
+	if slug, ok := r.hrefs[md.NormalizeHref(page.Href)]; ok && slug != page.Slug {
+		return repo.ErrDuplicateHref
+	}
+	return nil
+}
+
+// DeletePage removes the page according to strategy and returns the slugs of
+// every removed or re-parented page. Reject refuses pages with children,
+// cascade removes the whole subtree and reparent moves the children up to the
+// page's own parent.
+func (r *Repository) DeletePage(ctx context.Context, slug, strategy string) ([]string, error) {
+	const op = "pages.DeletePage.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	page, ok := r.pages[slug]
+	children := r.children(slug)
+	switch {
+	case !ok:
+		return nil, repo.ErrNotFound
+	case strategy != md.PageDeleteCascade && strategy != md.PageDeleteReparent && len(children) > 0:
+		return nil, repo.ErrHasChildren
+	}
+
+	deleted := []*md.Page{page}
+	if strategy == md.PageDeleteCascade {
+		for level := children; len(level) > 0; {
+			deleted = append(deleted, level...)
+			next := make([]*md.Page, 0)
+			for _, v := range level {
+				next = append(next, r.children(v.Slug)...)
+			}
+			level = next
+		}
+	}
+
+	res := make([]string, 0, len(deleted)+len(children))
+	var moved []string
+	if strategy == md.PageDeleteReparent {
+		for _, before := range children {
+			after := copyOf(before)
+			after.ParentSlug = page.ParentSlug
+			after.UpdatedAt = currentTimestamp()
+			r.pages[after.Slug] = after
+
+			if err := r.writeOutbox(ctx, md.EventPageUpdated, pageOutboxKey(after.Slug), before, after); err != nil {
+				return nil, err
+			}
+			moved = append(moved, after.Slug)
+		}
+	}
+
+	for _, v := range deleted {
+		delete(r.pages, v.Slug)
+		delete(r.hrefs, md.NormalizeHref(v.Href))
+		if err := r.writeOutbox(ctx, md.EventPageDeleted, pageOutboxKey(v.Slug), v, nil); err != nil {
+			return nil, err
+		}
+		res = append(res, v.Slug)
+	}
+
+	return append(res, moved...), nil
+}
+
+// children returns the direct children of slug ordered by slug. The caller
+// holds the lock.
+func (r *Repository) children(slug string) []*md.Page {
+	res := make([]*md.Page, 0)
+	for _, key := range slices.Sorted(maps.Keys(r.pages)) {
+		if r.pages[key].ParentSlug == slug && slug != "" {
+			res = append(res, r.pages[key])
+		}
+	}
+	return res
+}
+
+// ListPageTree returns every page ordered by position so that callers can
+// assemble the hierarchy in one pass.
+func (r *Repository) ListPageTree(ctx context.Context) ([]*md.Page, error) {
+	const op = "pages.ListPageTree.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*md.Page, 0, len(r.pages))
+	for _, page := range r.pages {
+		res = append(res, copyOf(page))
+	}
+	slices.SortFunc(
+		res, func(a, b *md.Page) int {
+			return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.Slug, b.Slug))
+		},
+	)
+	return res, nil
+}
+
+// ListPageAncestors returns the chain from the root down to slug, inclusive.
+// The result is empty when slug does not exist.
+func (r *Repository) ListPageAncestors(ctx context.Context, slug string) ([]*md.Page, error) {
+	const op = "pages.ListPageAncestors.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*md.Page, 0)
+	for page, ok := r.pages[slug]; ok; page, ok = r.pages[page.ParentSlug] {
+		res = append(res, copyOf(page))
+	}
+
+	slices.Reverse(res)
+	return res, nil
+}
+
+// PublishScheduledPages promotes scheduled pages whose publish_at has passed
+// and returns their slugs.
+func (r *Repository) PublishScheduledPages(ctx context.Context, now time.Time) ([]string, error) {
+	const op = "pages.PublishScheduledPages.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]string, 0)
+	for _, slug := range slices.Sorted(maps.Keys(r.pages)) {
+		before := r.pages[slug]
+		if before.Status != md.StatusScheduled || before.PublishAt == nil || before.PublishAt.After(now) {
+			continue
+		}
+
+		after := copyOf(before)
+		after.Status = md.StatusPublished
+		after.UpdatedAt = currentTimestamp()
+		r.pages[slug] = after
+
+		if err := r.writeOutbox(ctx, md.EventPageUpdated, pageOutboxKey(slug), before, after); err != nil {
+			return nil, err
+		}
+		res = append(res, slug)
+	}
+
+	return res, nil
+}
+
+// newPage copies req into a stored page under slug with the given timestamps.
+func newPage(req *md.Page, slug string, created, updated time.Time) (*md.Page, error) {
+	res, err := clone(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Slug = slug
+	res.CreatedAt = created
+	res.UpdatedAt = updated
+	return res, nil
+}
@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"context"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"maps"
+	"slices"
+)
+
+func (r *Repository) ListRedirects(ctx context.Context) ([]*md.Redirect, error) {
+	const op = "redirect.ListRedirects.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.listRedirects(), nil
+}
+
+// listRedirects copies the redirects ordered by id. The caller holds r.mu.
+func (r *Repository) listRedirects() []*md.Redirect {
+	res := make([]*md.Redirect, 0, len(r.redirects))
+	for _, id := range slices.Sorted(maps.Keys(r.redirects)) {
+		rd := *r.redirects[id]
+		res = append(res, &rd)
+	}
+	return res
+}
+
+func (r *Repository) GetRedirect(ctx context.Context, id uint64) (*md.Redirect, error) {
+	const op = "redirect.GetRedirect.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rd, ok := r.redirects[id]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+
+	res := *rd
+	return &res, nil
+}
+
+func (r *Repository) CreateRedirect(ctx context.Context, req *md.Redirect, check func([]*md.Redirect) error) (uint64, error) {
+	const op = "redirect.CreateRedirect.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := check(r.listRedirects()); err != nil {
+		return 0, err
+	}
+	if r.redirectBySource(req.Source) != nil {
+		return 0, repo.ErrAlreadyExists
+	}
+
+	rd := *req
+	rd.ID = r.nextID("redirect")
+	rd.CreatedAt = currentTimestamp()
+	rd.UpdatedAt = rd.CreatedAt
+	r.redirects[rd.ID] = &rd
+	if err := r.writeOutbox(ctx, md.EventRedirectCreated, redirectOutboxKey(rd.ID), nil, &rd); err != nil {
+		return 0, err
+	}
+	return rd.ID, nil
+}
+
+func (r *Repository) UpdateRedirect(ctx context.Context, id uint64, req *md.Redirect, check func([]*md.Redirect) error) error {
+	const op = "redirect.UpdateRedirect.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, ok := r.redirects[id]
+	if !ok {
+		return repo.ErrNotFound
+	}
+	if err := check(r.listRedirects()); err != nil {
+		return err
+	}
+	if other := r.redirectBySource(req.Source); other != nil && other.ID != id {
+		return repo.ErrAlreadyExists
+	}
+
+	rd := *req
+	rd.ID = id
+	rd.CreatedAt = prev.CreatedAt
+	rd.UpdatedAt = currentTimestamp()
+	r.redirects[id] = &rd
+	return r.writeOutbox(ctx, md.EventRedirectUpdated, redirectOutboxKey(id), prev, &rd)
+}
+
+func (r *Repository) DeleteRedirect(ctx context.Context, id uint64) error {
+	const op = "redirect.DeleteRedirect.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rd, ok := r.redirects[id]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	delete(r.redirects, id)
+	return r.writeOutbox(ctx, md.EventRedirectDeleted, redirectOutboxKey(id), rd, nil)
+}
+
+func (r *Repository) redirectBySource(source string) *md.Redirect {
+	for _, rd := range r.redirects {
+		if rd.Source == source {
+			return rd
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListSEORevisions(ctx context.Context, name, pk string) ([]*md.SEORevision, error) {
+	const op = "revision.ListSEORevisions.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*md.SEORevision, 0)
+	for i := len(r.revisions) - 1; i >= 0; i-- {
+		if rev := r.revisions[i]; rev.OBJName == name && rev.OBJPK == pk {
+			res = append(res, copyOf(rev))
+		}
+	}
+	return res, nil
+}
+
+func (r *Repository) GetSEORevision(ctx context.Context, name, pk string, id uint64) (*md.SEORevision, error) {
+	const op = "revision.GetSEORevision.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rev := r.findSEORevision(name, pk, id)
+	if rev == nil {
+		return nil, repo.ErrNotFound
+	}
+	return copyOf(rev), nil
+}
+
+// RollbackSEO restores the snapshot of revision id, recreating the record if it
+// was deleted since, and records the restore as a new revision.
+func (r *Repository) RollbackSEO(ctx context.Context, name, pk string, id uint64) (*md.SEO, error) {
+	const op = "revision.RollbackSEO.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rev := r.findSEORevision(name, pk, id)
+	if rev == nil {
+		return nil, repo.ErrNotFound
+	}
+
+	key := seoKey{rev.OBJName, rev.OBJPK, rev.Locale}
+	before, ok := r.seo[key]
+
+	event, created := md.EventSEOUpdated, currentTimestamp()
+	if ok {
+		created = before.CreatedAt
+	} else {
+		event = md.EventSEOCreated
+	}
+
+	seo, err := newSEO(rev.Data, created, currentTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	seo.OBJName, seo.OBJPK, seo.Locale = key.name, key.pk, key.locale
+
+	r.seo[key] = seo
+	r.writeSEORevision(ctx, md.RevisionRollback, seo)
+	if err = r.writeOutbox(ctx, event, seoOutboxKey(seo), before, seo); err != nil {
+		return nil, err
+	}
+
+	return copyOf(seo), nil
+}
+
+func (r *Repository) findSEORevision(name, pk string, id uint64) *md.SEORevision {
+	for _, rev := range r.revisions {
+		if rev.ID == id && rev.OBJName == name && rev.OBJPK == pk {
+			return rev
+		}
+	}
+	return nil
+}
+
+// writeSEORevision snapshots seo. The caller holds the write lock.
+func (r *Repository) writeSEORevision(ctx context.Context, action string, seo *md.SEO) {
+	r.revisions = append(
+		r.revisions, &md.SEORevision{
+			ID:        r.nextID("seo_revision"),
+			OBJName:   seo.OBJName,
+			OBJPK:     seo.OBJPK,
+			Locale:    seo.Locale,
+			Action:    action,
+			Author:    authorFromCtx(ctx),
+			Data:      copyOf(seo),
+			CreatedAt: currentTimestamp(),
+		},
+	)
+}
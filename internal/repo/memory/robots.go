@@ -0,0 +1,176 @@
+package memory
+
+import (
+	"context"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"maps"
+	"slices"
+)
+
+func (r *Repository) ListRobotsGroups(ctx context.Context) ([]*md.RobotsGroup, error) {
+	const op = "robots.ListRobotsGroups.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*md.RobotsGroup, 0, len(r.robotsGroups))
+	for _, id := range slices.Sorted(maps.Keys(r.robotsGroups)) {
+		res = append(res, copyOf(r.robotsGroups[id]))
+	}
+	return res, nil
+}
+
+func (r *Repository) GetRobotsGroup(ctx context.Context, id uint64) (*md.RobotsGroup, error) {
+	const op = "robots.GetRobotsGroup.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	group, ok := r.robotsGroups[id]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	return copyOf(group), nil
+}
+
+func (r *Repository) CreateRobotsGroup(ctx context.Context, req *md.RobotsGroup) (uint64, error) {
+	const op = "robots.CreateRobotsGroup.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	group, err := clone(req)
+	if err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.robotsGroupByAgent(group.UserAgent) != nil {
+		return 0, repo.ErrAlreadyExists
+	}
+
+	group.ID = r.nextID("robots_group")
+	group.CreatedAt = currentTimestamp()
+	group.UpdatedAt = group.CreatedAt
+	r.robotsGroups[group.ID] = group
+	if err = r.writeOutbox(ctx, md.EventRobotsGroupCreated, robotsGroupOutboxKey(group.ID), nil, group); err != nil {
+		return 0, err
+	}
+	return group.ID, nil
+}
+
+func (r *Repository) UpdateRobotsGroup(ctx context.Context, id uint64, req *md.RobotsGroup) error {
+	const op = "robots.UpdateRobotsGroup.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	group, err := clone(req)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, ok := r.robotsGroups[id]
+	if !ok {
+		return repo.ErrNotFound
+	}
+	if other := r.robotsGroupByAgent(group.UserAgent); other != nil && other.ID != id {
+		return repo.ErrAlreadyExists
+	}
+
+	group.ID = id
+	group.CreatedAt = prev.CreatedAt
+	group.UpdatedAt = currentTimestamp()
+	r.robotsGroups[id] = group
+	return r.writeOutbox(ctx, md.EventRobotsGroupUpdated, robotsGroupOutboxKey(id), prev, group)
+}
+
+func (r *Repository) DeleteRobotsGroup(ctx context.Context, id uint64) error {
+	const op = "robots.DeleteRobotsGroup.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	group, ok := r.robotsGroups[id]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	delete(r.robotsGroups, id)
+	return r.writeOutbox(ctx, md.EventRobotsGroupDeleted, robotsGroupOutboxKey(id), group, nil)
+}
+
+func (r *Repository) robotsGroupByAgent(agent string) *md.RobotsGroup {
+	for _, group := range r.robotsGroups {
+		if group.UserAgent == agent {
+			return group
+		}
+	}
+	return nil
+}
+
+func (r *Repository) ListRobotsSitemaps(ctx context.Context) ([]*md.RobotsSitemap, error) {
+	const op = "robots.ListRobotsSitemaps.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*md.RobotsSitemap, 0, len(r.robotsSitemaps))
+	for _, id := range slices.Sorted(maps.Keys(r.robotsSitemaps)) {
+		sm := *r.robotsSitemaps[id]
+		res = append(res, &sm)
+	}
+	return res, nil
+}
+
+func (r *Repository) CreateRobotsSitemap(ctx context.Context, url string) (uint64, error) {
+	const op = "robots.CreateRobotsSitemap.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, sm := range r.robotsSitemaps {
+		if sm.URL == url {
+			return 0, repo.ErrAlreadyExists
+		}
+	}
+
+	sm := &md.RobotsSitemap{ID: r.nextID("robots_sitemap"), URL: url, CreatedAt: currentTimestamp()}
+	r.robotsSitemaps[sm.ID] = sm
+	if err := r.writeOutbox(ctx, md.EventRobotsSitemapCreated, robotsSitemapOutboxKey(sm.ID), nil, sm); err != nil {
+		return 0, err
+	}
+	return sm.ID, nil
+}
+
+func (r *Repository) DeleteRobotsSitemap(ctx context.Context, id uint64) error {
+	const op = "robots.DeleteRobotsSitemap.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sm, ok := r.robotsSitemaps[id]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	delete(r.robotsSitemaps, id)
+	return r.writeOutbox(ctx, md.EventRobotsSitemapDeleted, robotsSitemapOutboxKey(id), sm, nil)
+}
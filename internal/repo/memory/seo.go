@@ -0,0 +1,364 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+func (r *Repository) GetSEO(ctx context.Context, name, pk, locale string) (*md.SEO, error) {
+	const op = "seo.GetSEO.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seo, ok := r.seo[seoKey{name, pk, locale}]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	return copyOf(seo), nil
+}
+
+func (r *Repository) CreateSEO(ctx context.Context, req *md.SEO) (string, string, error) {
+	const op = "seo.CreateSEO.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	at := currentTimestamp()
+	seo, err := newSEO(req, at, at)
+	if err != nil {
+		return "", "", err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := seoKey{seo.OBJName, seo.OBJPK, seo.Locale}
+	if _, ok := r.seo[key]; ok {
+		return "", "", repo.ErrAlreadyExists
+	}
+
+	r.seo[key] = seo
+	r.writeSEORevision(ctx, md.RevisionCreate, seo)
+	if err = r.writeOutbox(ctx, md.EventSEOCreated, seoOutboxKey(seo), nil, seo); err != nil {
+		return "", "", err
+	}
+
+	return seo.OBJName, seo.OBJPK, nil
+}
+
+func (r *Repository) UpdateSEO(ctx context.Context, req *md.SEO) error {
+	const op = "seo.UpdateSEO.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := seoKey{req.OBJName, req.OBJPK, req.Locale}
+	before, ok := r.seo[key]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	after, err := newSEO(req, before.CreatedAt, currentTimestamp())
+	if err != nil {
+		return err
+	}
+
+	r.seo[key] = after
+	r.writeSEORevision(ctx, md.RevisionUpdate, after)
+	return r.writeOutbox(ctx, md.EventSEOUpdated, seoOutboxKey(after), before, after)
+}
+
+func (r *Repository) DeleteSEO(ctx context.Context, name, pk, locale string) error {
+	const op = "seo.DeleteSEO.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := seoKey{name, pk, locale}
+	seo, ok := r.seo[key]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	delete(r.seo, key)
+	r.writeSEORevision(ctx, md.RevisionDelete, seo)
+	return r.writeOutbox(ctx, md.EventSEODeleted, seoOutboxKey(seo), seo, nil)
+}
+
+// PublishScheduledSEO promotes scheduled records whose publish_at has passed
+// and returns their keys.
+func (r *Repository) PublishScheduledSEO(ctx context.Context, now time.Time) ([]*md.SEO, error) {
+	const op = "seo.PublishScheduledSEO.repo.memory"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*md.SEO, 0)
+	for _, key := range slices.SortedFunc(maps.Keys(r.seo), compareSEOKeys) {
+		before := r.seo[key]
+		if before.Status != md.StatusScheduled || before.PublishAt == nil || before.PublishAt.After(now) {
+			continue
+		}
+
+		after := copyOf(before)
+		after.Status = md.StatusPublished
+		after.UpdatedAt = currentTimestamp()
+		r.seo[key] = after
+
+		r.writeSEORevision(ctx, md.RevisionPublish, after)
+		if err := r.writeOutbox(ctx, md.EventSEOUpdated, seoOutboxKey(after), before, after); err != nil {
+			return nil, err
+		}
+		res = append(res, &md.SEO{OBJName: key.name, OBJPK: key.pk, Locale: key.locale})
+	}
+
+	return res, nil
+}
+
+// seoSorts compares records by the column of each sort; ties are broken by
+// the record key.
+var seoSorts = map[string]func(a, b *md.SEO) int{
+	md.SortOBJPK: func(a, b *md.SEO) int {
+		return 0
+	},
+	md.SortTitle: func(a, b *md.SEO) int {
+		return cmp.Compare(a.Title, b.Title)
+	},
+	md.SortCreatedAt: func(a, b *md.SEO) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	},
+	md.SortUpdatedAt: func(a, b *md.SEO) int {
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	},
+}
+
+// seoMissing maps SEOFilter.Missing entries to their emptiness check.
+var seoMissing = map[string]func(*md.SEO) bool{
+	"description":    func(s *md.SEO) bool { return s.Description == "" },
+	"keywords":       func(s *md.SEO) bool { return s.Keywords == "" },
+	"og_title":       func(s *md.SEO) bool { return s.OGTitle == "" },
+	"og_description": func(s *md.SEO) bool { return s.OGDescription == "" },
+	"og_image":       func(s *md.SEO) bool { return s.OGImage == "" },
+	"og_type":        func(s *md.SEO) bool { return s.OGType == "" },
+	"og_url":         func(s *md.SEO) bool { return s.OGURL == "" },
+	"og_image_alt":   func(s *md.SEO) bool { return s.OGImageAlt == "" },
+	"twitter_card":   func(s *md.SEO) bool { return s.TwitterCard == "" },
+	"json_ld":        func(s *md.SEO) bool { return len(s.JSONLD) == 0 },
+}
+
+func (r *Repository) ListSEO(ctx context.Context, f *md.SEOFilter) (*md.SEOList, error) {
+	const op = "seo.ListSEO.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	missing := make([]func(*md.SEO) bool, 0, len(f.Missing))
+	for _, field := range f.Missing {
+		fn, ok := seoMissing[field]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", field)
+		}
+		missing = append(missing, fn)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rows := make([]*md.SEO, 0)
+	for _, seo := range r.seo {
+		if matchSEO(f, missing, seo) {
+			rows = append(rows, seo)
+		}
+	}
+	res := &md.SEOList{Total: int64(len(rows))}
+
+	sortName := f.Sort
+	sortFn, ok := seoSorts[sortName]
+	if !ok {
+		sortName, sortFn = md.SortOBJPK, seoSorts[md.SortOBJPK]
+	}
+
+	order := func(a, b *md.SEO) int {
+		c := cmp.Or(sortFn(a, b), compareSEOKeys(seoKey{a.OBJName, a.OBJPK, a.Locale}, seoKey{b.OBJName, b.OBJPK, b.Locale}))
+		if f.Order == md.OrderDesc {
+			return -c
+		}
+		return c
+	}
+	slices.SortFunc(rows, order)
+
+	size := f.Size
+	if size <= 0 {
+		size = config.DefaultSize
+	}
+
+	offset := 0
+	if f.Cursor != "" {
+		cur, err := md.DecodeCursor(f.Cursor)
+		if err != nil || cur.Sort != sortName || len(cur.Key) != 3 {
+			return nil, md.ErrInvalidCursor
+		}
+
+		last := &md.SEO{OBJName: cur.Key[0], OBJPK: cur.Key[1], Locale: cur.Key[2]}
+		switch sortName {
+		case md.SortOBJPK:
+		case md.SortUpdatedAt:
+			if last.UpdatedAt, err = time.Parse(time.RFC3339Nano, cur.Value); err != nil {
+				return nil, md.ErrInvalidCursor
+			}
+		default:
+			return nil, md.ErrInvalidCursor
+		}
+
+		offset = len(rows)
+		if i := slices.IndexFunc(rows, func(s *md.SEO) bool { return order(s, last) > 0 }); i >= 0 {
+			offset = i
+		}
+	} else if f.Page > 1 {
+		offset = (f.Page - 1) * size
+	}
+
+	rows = rows[min(offset, len(rows)):]
+	res.SEO = make([]*md.SEO, 0, size)
+	for _, seo := range rows[:min(size, len(rows))] {
+		res.SEO = append(res.SEO, copyOf(seo))
+	}
+
+	if len(rows) > size {
+		last := res.SEO[size-1]
+		key := []string{last.OBJName, last.OBJPK, last.Locale}
+		switch sortName {
+		case md.SortOBJPK:
+			res.NextCursor = md.Cursor{Sort: md.SortOBJPK, Key: key}.Encode()
+		case md.SortUpdatedAt:
+			res.NextCursor = md.Cursor{
+				Sort: md.SortUpdatedAt, Value: last.UpdatedAt.Format(time.RFC3339Nano), Key: key,
+			}.Encode()
+		}
+	}
+
+	return res, nil
+}
+
+func matchSEO(f *md.SEOFilter, missing []func(*md.SEO) bool, seo *md.SEO) bool {
+	switch {
+	case f.OBJName != "" && seo.OBJName != f.OBJName:
+		return false
+	case f.PKPrefix != "" && !strings.HasPrefix(seo.OBJPK, f.PKPrefix):
+		return false
+	case f.Locale != "" && seo.Locale != f.Locale:
+		return false
+	case f.Status != "" && seo.Status != f.Status:
+		return false
+	case f.UpdatedFrom != nil && seo.UpdatedAt.Before(*f.UpdatedFrom):
+		return false
+	case f.UpdatedTo != nil && seo.UpdatedAt.After(*f.UpdatedTo):
+		return false
+	}
+
+	if len(missing) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(missing, func(fn func(*md.SEO) bool) bool { return fn(seo) })
+}
+
+// ListSEOForSitemap returns one entry per published object of names with the
+// latest update of its locales.
+func (r *Repository) ListSEOForSitemap(ctx context.Context, names []string) ([]*md.SEO, error) {
+	const op = "seo.ListSEOForSitemap.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	type object struct {
+		name, pk string
+	}
+
+	latest := make(map[object]time.Time)
+	for _, seo := range r.seo {
+		if seo.Status != md.StatusPublished || !slices.Contains(names, seo.OBJName) {
+			continue
+		}
+
+		obj := object{seo.OBJName, seo.OBJPK}
+		if at, ok := latest[obj]; !ok || seo.UpdatedAt.After(at) {
+			latest[obj] = seo.UpdatedAt
+		}
+	}
+
+	res := make([]*md.SEO, 0, len(latest))
+	for obj, at := range latest {
+		res = append(res, &md.SEO{OBJName: obj.name, OBJPK: obj.pk, UpdatedAt: at})
+	}
+	slices.SortFunc(
+		res, func(a, b *md.SEO) int {
+			return cmp.Or(cmp.Compare(a.OBJName, b.OBJName), cmp.Compare(a.OBJPK, b.OBJPK))
+		},
+	)
+	return res, nil
+}
+
+func (r *Repository) ListSEOLocales(ctx context.Context, name, pk string) ([]*md.SEO, error) {
+	const op = "seo.ListSEOLocales.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*md.SEO, 0)
+	for key, seo := range r.seo {
+		if key.name == name && key.pk == pk && seo.Status == md.StatusPublished {
+			res = append(res, &md.SEO{OBJName: name, OBJPK: pk, Locale: key.locale, UpdatedAt: seo.UpdatedAt})
+		}
+	}
+	slices.SortFunc(
+		res, func(a, b *md.SEO) int {
+			return cmp.Compare(a.Locale, b.Locale)
+		},
+	)
+	return res, nil
+}
+
+// newSEO copies req into a stored record with the given timestamps. Like the
+// table, it has no id, keeps empty lists rather than nil and never stores the
+// generated flag.
+func newSEO(req *md.SEO, created, updated time.Time) (*md.SEO, error) {
+	res, err := clone(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res.ID = 0
+	res.Generated = false
+	res.ArticleAuthor = nonNilStrings(res.ArticleAuthor)
+	res.ArticleTag = nonNilStrings(res.ArticleTag)
+	if res.JSONLD == nil {
+		res.JSONLD = []map[string]any{}
+	}
+	res.CreatedAt = created
+	res.UpdatedAt = updated
+	return res, nil
+}
+
+func compareSEOKeys(a, b seoKey) int {
+	return cmp.Or(cmp.Compare(a.name, b.name), cmp.Compare(a.pk, b.pk), cmp.Compare(a.locale, b.locale))
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"slices"
+)
+
+func (r *Repository) ListSEOTemplates(ctx context.Context) ([]*md.SEOTemplate, error) {
+	const op = "template.ListSEOTemplates.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*md.SEOTemplate, 0, len(r.templates))
+	for _, tpl := range r.templates {
+		res = append(res, copyOf(tpl))
+	}
+	slices.SortFunc(
+		res, func(a, b *md.SEOTemplate) int {
+			return cmp.Or(cmp.Compare(a.OBJName, b.OBJName), cmp.Compare(a.Locale, b.Locale))
+		},
+	)
+	return res, nil
+}
+
+func (r *Repository) GetSEOTemplate(ctx context.Context, name, locale string) (*md.SEOTemplate, error) {
+	const op = "template.GetSEOTemplate.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tpl, ok := r.templates[templateKey{name, locale}]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	return copyOf(tpl), nil
+}
+
+// SaveSEOTemplate creates or replaces the template and reports whether it was
+// created.
+func (r *Repository) SaveSEOTemplate(ctx context.Context, req *md.SEOTemplate) (bool, error) {
+	const op = "template.SaveSEOTemplate.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tpl, err := clone(req)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := templateKey{tpl.OBJName, tpl.Locale}
+	prev, ok := r.templates[key]
+
+	tpl.UpdatedAt = currentTimestamp()
+	tpl.CreatedAt = tpl.UpdatedAt
+	event := md.EventSEOTemplateCreated
+	if ok {
+		tpl.CreatedAt = prev.CreatedAt
+		event = md.EventSEOTemplateUpdated
+	} else {
+		prev = nil
+	}
+
+	r.templates[key] = tpl
+	if err = r.writeOutbox(ctx, event, seoTemplateOutboxKey(tpl.OBJName, tpl.Locale), prev, tpl); err != nil {
+		return false, err
+	}
+	return !ok, nil
+}
+
+func (r *Repository) DeleteSEOTemplate(ctx context.Context, name, locale string) error {
+	const op = "template.DeleteSEOTemplate.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := templateKey{name, locale}
+	tpl, ok := r.templates[key]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	delete(r.templates, key)
+	return r.writeOutbox(ctx, md.EventSEOTemplateDeleted, seoTemplateOutboxKey(name, locale), tpl, nil)
+}
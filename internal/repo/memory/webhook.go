@@ -0,0 +1,280 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"maps"
+	"slices"
+	"time"
+)
+
+func (r *Repository) ListWebhooks(ctx context.Context) ([]*md.Webhook, error) {
+	const op = "webhook.ListWebhooks.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*md.Webhook, 0, len(r.webhooks))
+	for _, id := range slices.Sorted(maps.Keys(r.webhooks)) {
+		res = append(res, r.webhooks[id].copy())
+	}
+	return res, nil
+}
+
+func (r *Repository) GetWebhook(ctx context.Context, id uint64) (*md.Webhook, error) {
+	const op = "webhook.GetWebhook.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	wh, ok := r.webhooks[id]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	return wh.copy(), nil
+}
+
+func (r *Repository) CreateWebhook(ctx context.Context, req *md.Webhook) (uint64, error) {
+	const op = "webhook.CreateWebhook.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wh := newWebhook(req)
+	wh.ID = r.nextID("webhook")
+	wh.CreatedAt = currentTimestamp()
+	wh.UpdatedAt = wh.CreatedAt
+	r.webhooks[wh.ID] = wh
+	return wh.ID, nil
+}
+
+// UpdateWebhook replaces the subscription; an empty Secret keeps the stored one.
+func (r *Repository) UpdateWebhook(ctx context.Context, id uint64, req *md.Webhook) error {
+	const op = "webhook.UpdateWebhook.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, ok := r.webhooks[id]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	wh := newWebhook(req)
+	if wh.secret == "" {
+		wh.secret = prev.secret
+	}
+	wh.ID = id
+	wh.CreatedAt = prev.CreatedAt
+	wh.UpdatedAt = currentTimestamp()
+	r.webhooks[id] = wh
+	return nil
+}
+
+// DeleteWebhook removes the webhook together with its deliveries.
+func (r *Repository) DeleteWebhook(ctx context.Context, id uint64) error {
+	const op = "webhook.DeleteWebhook.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.webhooks[id]; !ok {
+		return repo.ErrNotFound
+	}
+
+	delete(r.webhooks, id)
+	maps.DeleteFunc(
+		r.deliveries, func(_ uint64, d *md.WebhookDelivery) bool {
+			return d.WebhookID == id
+		},
+	)
+	return nil
+}
+
+// enqueueWebhookEvent queues payload once for every enabled webhook subscribed
+// to event. The caller holds r.mu.
+func (r *Repository) enqueueWebhookEvent(event string, payload []byte, at time.Time) {
+	for _, id := range slices.Sorted(maps.Keys(r.webhooks)) {
+		wh := r.webhooks[id]
+		if wh.Disabled || !(slices.Contains(wh.Events, event) || slices.Contains(wh.Events, md.EventAll)) {
+			continue
+		}
+
+		d := &md.WebhookDelivery{
+			ID:            r.nextID("webhook_delivery"),
+			WebhookID:     id,
+			Event:         event,
+			Payload:       payload,
+			Status:        md.DeliveryPending,
+			NextAttemptAt: at,
+			CreatedAt:     at,
+		}
+		r.deliveries[d.ID] = d
+	}
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries due by now,
+// with the target URL and secret, and hides them from other workers until
+// now+lease.
+func (r *Repository) ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*md.WebhookDelivery, error) {
+	const op = "webhook.ClaimWebhookDeliveries.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	due := make([]*md.WebhookDelivery, 0)
+	for _, d := range r.deliveries {
+		if d.Status == md.DeliveryPending && !d.NextAttemptAt.After(now) {
+			due = append(due, d)
+		}
+	}
+	slices.SortFunc(
+		due, func(a, b *md.WebhookDelivery) int {
+			return cmp.Or(a.NextAttemptAt.Compare(b.NextAttemptAt), cmp.Compare(a.ID, b.ID))
+		},
+	)
+
+	res := make([]*md.WebhookDelivery, 0, limit)
+	for _, d := range due[:min(limit, len(due))] {
+		d.NextAttemptAt = now.Add(lease)
+
+		wh := r.webhooks[d.WebhookID]
+		res = append(
+			res, &md.WebhookDelivery{
+				ID:        d.ID,
+				WebhookID: d.WebhookID,
+				Event:     d.Event,
+				Payload:   slices.Clone(d.Payload),
+				Status:    md.DeliveryPending,
+				Attempts:  d.Attempts,
+				CreatedAt: d.CreatedAt,
+				URL:       wh.URL,
+				Secret:    wh.secret,
+			},
+		)
+	}
+
+	return res, nil
+}
+
+// FinishWebhookDelivery stores the outcome of an attempt.
+func (r *Repository) FinishWebhookDelivery(ctx context.Context, d *md.WebhookDelivery) error {
+	const op = "webhook.FinishWebhookDelivery.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, ok := r.deliveries[d.ID]
+	if !ok {
+		return nil
+	}
+
+	next := *prev
+	next.Status = d.Status
+	next.Attempts = d.Attempts
+	next.ResponseCode = d.ResponseCode
+	next.LastError = d.LastError
+	next.NextAttemptAt = d.NextAttemptAt
+	next.DeliveredAt = nil
+	if d.DeliveredAt != nil {
+		at := *d.DeliveredAt
+		next.DeliveredAt = &at
+	}
+	r.deliveries[d.ID] = &next
+	return nil
+}
+
+// RetryWebhookDelivery puts a delivery back in the queue with a fresh attempt
+// budget, whatever its current status.
+func (r *Repository) RetryWebhookDelivery(ctx context.Context, id uint64) error {
+	const op = "webhook.RetryWebhookDelivery.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.deliveries[id]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	d.Status = md.DeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = currentTimestamp()
+	return nil
+}
+
+// ListWebhookDeliveries returns the delivery log of a webhook, newest first.
+func (r *Repository) ListWebhookDeliveries(ctx context.Context, f *md.WebhookDeliveryFilter) (*md.WebhookDeliveryList, error) {
+	const op = "webhook.ListWebhookDeliveries.repo.memory"
+	span, _ := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rows := make([]*md.WebhookDelivery, 0)
+	for _, d := range r.deliveries {
+		if d.WebhookID == f.WebhookID && (f.Status == "" || d.Status == f.Status) {
+			rows = append(rows, d)
+		}
+	}
+	slices.SortFunc(
+		rows, func(a, b *md.WebhookDelivery) int {
+			return cmp.Compare(b.ID, a.ID)
+		},
+	)
+
+	page, size := f.Page, f.Size
+	if page <= 0 {
+		page = config.DefaultPage
+	}
+	if size <= 0 {
+		size = config.DefaultSize
+	}
+
+	res := &md.WebhookDeliveryList{Total: int64(len(rows))}
+	rows = rows[min((page-1)*size, len(rows)):]
+	res.Deliveries = make([]*md.WebhookDelivery, 0, size)
+	for _, d := range rows[:min(size, len(rows))] {
+		v := *d
+		v.Payload = slices.Clone(d.Payload)
+		res.Deliveries = append(res.Deliveries, &v)
+	}
+
+	return res, nil
+}
+
+// newWebhook stores req with its secret kept aside.
+func newWebhook(req *md.Webhook) *webhook {
+	wh := &webhook{Webhook: *req, secret: req.Secret}
+	wh.Secret = ""
+	wh.Events = slices.Clone(nonNilStrings(req.Events))
+	return wh
+}
+
+// copy returns the webhook the way it is read back, without its secret.
+func (wh *webhook) copy() *md.Webhook {
+	res := wh.Webhook
+	res.Events = slices.Clone(wh.Events)
+	return &res
+}
@@ -0,0 +1,80 @@
+// Package migrator implements the migrate subcommand on top of a
+// golang-migrate instance, whichever driver it was built for.
+package migrator
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang-migrate/migrate/v4"
+	"io"
+	"strconv"
+)
+
+const Usage = `usage: main migrate <command>
+
+commands:
+  up         apply all pending migrations
+  down N     roll back N migrations
+  goto V     migrate up or down to version V
+  version    print the current version
+  force V    set the version to V without running anything (-1 for none)`
+
+var ErrUsage = errors.New(Usage)
+
+// Run executes args against m and reports the resulting version to out.
+// Having nothing to do is not an error.
+func Run(m *migrate.Migrate, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return ErrUsage
+	}
+
+	var err error
+	switch cmd := args[0]; {
+	case cmd == "up" && len(args) == 1:
+		err = m.Up()
+	case cmd == "down" && len(args) == 2:
+		var n int
+		if n, err = strconv.Atoi(args[1]); err != nil || n < 1 {
+			return fmt.Errorf("down: N must be a positive number, got %q", args[1])
+		}
+		err = m.Steps(-n)
+	case cmd == "goto" && len(args) == 2:
+		var v uint64
+		if v, err = strconv.ParseUint(args[1], 10, 0); err != nil {
+			return fmt.Errorf("goto: V must be a version number, got %q", args[1])
+		}
+		err = m.Migrate(uint(v))
+	case cmd == "version" && len(args) == 1:
+	case cmd == "force" && len(args) == 2:
+		var v int
+		if v, err = strconv.Atoi(args[1]); err != nil || v < -1 {
+			return fmt.Errorf("force: V must be a version number or -1, got %q", args[1])
+		}
+		err = m.Force(v)
+	default:
+		return ErrUsage
+	}
+
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return printVersion(m, out)
+}
+
+func printVersion(m *migrate.Migrate, out io.Writer) error {
+	v, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		_, err = fmt.Fprintln(out, "version: none")
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		_, err = fmt.Fprintf(out, "version: %d (dirty)\n", v)
+		return err
+	}
+	_, err = fmt.Fprintf(out, "version: %d\n", v)
+	return err
+}
@@ -0,0 +1,60 @@
+package migrator
+
+import (
+	"bytes"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/repo/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	m, err := sqlite.NewMigrate(&config.DBConfig{Driver: "sqlite", Database: filepath.Join(t.TempDir(), "seo.db")})
+	require.NoError(t, err)
+	t.Cleanup(
+		func() {
+			_, _ = m.Close()
+		},
+	)
+
+	tests := []struct {
+		name string
+		args []string
+		out  string
+		err  string
+	}{
+		{name: "Version before up", args: []string{"version"}, out: "version: none\n"},
+		{name: "Up", args: []string{"up"}, out: "version: 1\n"},
+		{name: "Up again", args: []string{"up"}, out: "version: 1\n"},
+		{name: "Goto current", args: []string{"goto", "1"}, out: "version: 1\n"},
+		{name: "Down", args: []string{"down", "1"}, out: "version: none\n"},
+		{name: "Down past the first", args: []string{"down", "1"}, err: "file does not exist"},
+		{name: "Goto", args: []string{"goto", "1"}, out: "version: 1\n"},
+		{name: "Force", args: []string{"force", "-1"}, out: "version: none\n"},
+		{name: "Force back", args: []string{"force", "1"}, out: "version: 1\n"},
+		{name: "No command", args: nil, err: "usage"},
+		{name: "Unknown command", args: []string{"drop"}, err: "usage"},
+		{name: "Missing argument", args: []string{"down"}, err: "usage"},
+		{name: "Bad step count", args: []string{"down", "0"}, err: "positive number"},
+		{name: "Bad version", args: []string{"goto", "v1"}, err: "version number"},
+		{name: "Bad forced version", args: []string{"force", "-2"}, err: "version number or -1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var out bytes.Buffer
+				err := Run(m, tt.args, &out)
+				if tt.err != "" {
+					require.Error(t, err)
+					assert.Contains(t, err.Error(), tt.err)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tt.out, out.String())
+			},
+		)
+	}
+}
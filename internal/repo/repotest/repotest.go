@@ -0,0 +1,1221 @@
+// Package repotest is the behaviour every ctrl.AppRepo implementation has to
+// share: the same ordering, constraints and repo errors, revisions and outbox
+// events. Implementations call Run from their own tests.
+package repotest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/JMURv/seo/internal/ctrl"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// Run checks the repository returned by newRepo against the contract. Every
+// subtest gets a new, empty repository.
+func Run(t *testing.T, newRepo func(t *testing.T) ctrl.AppRepo) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r ctrl.AppRepo)
+	}{
+		{"SEO", testSEO},
+		{"SEORevisions", testSEORevisions},
+		{"ListSEO", testListSEO},
+		{"SEOReads", testSEOReads},
+		{"PublishScheduledSEO", testPublishScheduledSEO},
+		{"ImportSEO", testImportSEO},
+		{"SEOTemplates", testSEOTemplates},
+		{"Pages", testPages},
+		{"DeletePage", testDeletePage},
+		{"ListPages", testListPages},
+		{"PublishScheduledPages", testPublishScheduledPages},
+		{"Robots", testRobots},
+		{"Redirects", testRedirects},
+		{"Webhooks", testWebhooks},
+		{"Outbox", testOutbox},
+		{"OutboxSettings", testOutboxSettings},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.fn(t, newRepo(t))
+			},
+		)
+	}
+}
+
+// ctx carries the uid revisions and change events are attributed to.
+var ctx = context.WithValue(context.Background(), "uid", "editor")
+
+func newSEO(name, pk, locale, title string) *md.SEO {
+	return &md.SEO{
+		Title:       title,
+		Description: "description of " + title,
+		OBJName:     name,
+		OBJPK:       pk,
+		Locale:      locale,
+		Status:      md.StatusPublished,
+		JSONLD:      []map[string]any{{"@type": "Product", "name": title}},
+	}
+}
+
+func newPage(slug, href, parent string) *md.Page {
+	return &md.Page{
+		Slug:       slug,
+		Title:      "Title " + slug,
+		Href:       href,
+		ChangeFreq: "daily",
+		Priority:   0.5,
+		ParentSlug: parent,
+		Status:     md.StatusPublished,
+	}
+}
+
+func mustCreateSEO(t *testing.T, r ctrl.AppRepo, seo *md.SEO) {
+	t.Helper()
+	_, _, err := r.CreateSEO(ctx, seo)
+	require.NoError(t, err)
+}
+
+func mustCreatePage(t *testing.T, r ctrl.AppRepo, page *md.Page) {
+	t.Helper()
+	_, err := r.CreatePage(ctx, page)
+	require.NoError(t, err)
+}
+
+// events returns every outbox event written so far.
+func events(t *testing.T, r ctrl.AppRepo) []*md.OutboxEvent {
+	t.Helper()
+	res, err := r.ListOutboxEvents(ctx, 0, 1000)
+	require.NoError(t, err)
+	return res
+}
+
+// mustCreateWebhook subscribes a webhook to events and returns its id.
+func mustCreateWebhook(t *testing.T, r ctrl.AppRepo, events ...string) uint64 {
+	t.Helper()
+	id, err := r.CreateWebhook(ctx, &md.Webhook{URL: "https://hooks.example.com", Secret: "secret-hooks", Events: events})
+	require.NoError(t, err)
+	return id
+}
+
+// deliveries returns every delivery queued for webhook, newest first.
+func deliveries(t *testing.T, r ctrl.AppRepo, webhook uint64) []*md.WebhookDelivery {
+	t.Helper()
+	res, err := r.ListWebhookDeliveries(ctx, &md.WebhookDeliveryFilter{WebhookID: webhook, Size: 100})
+	require.NoError(t, err)
+	return res.Deliveries
+}
+
+func testSEO(t *testing.T, r ctrl.AppRepo) {
+	name, pk, err := r.CreateSEO(ctx, newSEO("product", "1", "en", "Oak table"))
+	require.NoError(t, err)
+	assert.Equal(t, "product", name)
+	assert.Equal(t, "1", pk)
+
+	_, _, err = r.CreateSEO(ctx, newSEO("product", "1", "en", "Another"))
+	assert.ErrorIs(t, err, repo.ErrAlreadyExists)
+
+	mustCreateSEO(t, r, newSEO("product", "1", "ru", "Дубовый стол"))
+
+	created, err := r.GetSEO(ctx, "product", "1", "en")
+	require.NoError(t, err)
+	assert.Equal(t, "Oak table", created.Title)
+	assert.Equal(t, "description of Oak table", created.Description)
+	assert.Equal(t, md.StatusPublished, created.Status)
+	require.Len(t, created.JSONLD, 1)
+	assert.Equal(t, "Product", created.JSONLD[0]["@type"])
+	assert.False(t, created.CreatedAt.IsZero())
+
+	_, err = r.GetSEO(ctx, "product", "1", "de")
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	upd := newSEO("product", "1", "en", "Solid oak table")
+	require.NoError(t, r.UpdateSEO(ctx, upd))
+
+	updated, err := r.GetSEO(ctx, "product", "1", "en")
+	require.NoError(t, err)
+	assert.Equal(t, "Solid oak table", updated.Title)
+	assert.True(t, updated.CreatedAt.Equal(created.CreatedAt))
+	assert.False(t, updated.UpdatedAt.Before(created.UpdatedAt))
+
+	// Values read back do not share memory with the stored record.
+	updated.Title = "changed by the caller"
+	again, err := r.GetSEO(ctx, "product", "1", "en")
+	require.NoError(t, err)
+	assert.Equal(t, "Solid oak table", again.Title)
+
+	assert.ErrorIs(t, r.UpdateSEO(ctx, newSEO("product", "2", "en", "Missing")), repo.ErrNotFound)
+
+	require.NoError(t, r.DeleteSEO(ctx, "product", "1", "en"))
+	_, err = r.GetSEO(ctx, "product", "1", "en")
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+	assert.ErrorIs(t, r.DeleteSEO(ctx, "product", "1", "en"), repo.ErrNotFound)
+
+	_, err = r.GetSEO(ctx, "product", "1", "ru")
+	assert.NoError(t, err)
+
+	got := events(t, r)
+	require.Len(t, got, 4)
+	assert.Equal(t, md.EventSEOCreated, got[0].Event)
+	assert.Equal(t, "seo:product:1:en", got[0].Key)
+	assert.Equal(t, md.EventSEOCreated, got[1].Event)
+	assert.Equal(t, "seo:product:1:ru", got[1].Key)
+	assert.Equal(t, md.EventSEOUpdated, got[2].Event)
+	assert.Equal(t, md.EventSEODeleted, got[3].Event)
+
+	change := &md.SEOChange{}
+	payload := &md.ChangeEvent{}
+	require.NoError(t, json.Unmarshal(got[2].Payload, change))
+	require.NoError(t, json.Unmarshal(got[2].Payload, payload))
+	assert.Equal(t, "editor", payload.UID)
+	assert.Equal(t, "Oak table", change.Before.Title)
+	assert.Equal(t, "Solid oak table", change.After.Title)
+
+	change = &md.SEOChange{}
+	require.NoError(t, json.Unmarshal(got[3].Payload, change))
+	assert.Equal(t, "Solid oak table", change.Before.Title)
+	assert.Nil(t, change.After)
+}
+
+func testSEORevisions(t *testing.T, r ctrl.AppRepo) {
+	mustCreateSEO(t, r, newSEO("product", "1", "en", "First"))
+	require.NoError(t, r.UpdateSEO(ctx, newSEO("product", "1", "en", "Second")))
+	require.NoError(t, r.DeleteSEO(ctx, "product", "1", "en"))
+	mustCreateSEO(t, r, newSEO("product", "2", "en", "Other"))
+
+	revs, err := r.ListSEORevisions(ctx, "product", "1")
+	require.NoError(t, err)
+	require.Len(t, revs, 3)
+	assert.Equal(t, md.RevisionDelete, revs[0].Action)
+	assert.Equal(t, md.RevisionUpdate, revs[1].Action)
+	assert.Equal(t, md.RevisionCreate, revs[2].Action)
+	assert.Greater(t, revs[0].ID, revs[1].ID)
+	assert.Equal(t, "editor", revs[2].Author)
+	assert.Equal(t, "First", revs[2].Data.Title)
+
+	rev, err := r.GetSEORevision(ctx, "product", "1", revs[2].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "First", rev.Data.Title)
+	assert.Equal(t, "en", rev.Locale)
+
+	_, err = r.GetSEORevision(ctx, "product", "2", revs[2].ID)
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	// The record was deleted, so the rollback creates it again.
+	restored, err := r.RollbackSEO(ctx, "product", "1", revs[2].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "First", restored.Title)
+
+	got, err := r.GetSEO(ctx, "product", "1", "en")
+	require.NoError(t, err)
+	assert.Equal(t, "First", got.Title)
+
+	require.NoError(t, r.UpdateSEO(ctx, newSEO("product", "1", "en", "Third")))
+	restored, err = r.RollbackSEO(ctx, "product", "1", revs[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Second", restored.Title)
+
+	revs, err = r.ListSEORevisions(ctx, "product", "1")
+	require.NoError(t, err)
+	require.Len(t, revs, 6)
+	assert.Equal(t, md.RevisionRollback, revs[0].Action)
+	assert.Equal(t, "Second", revs[0].Data.Title)
+
+	_, err = r.RollbackSEO(ctx, "product", "1", revs[0].ID+100)
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	all := events(t, r)
+	require.Len(t, all, 7)
+	assert.Equal(t, md.EventSEOCreated, all[4].Event)
+	assert.Equal(t, md.EventSEOUpdated, all[6].Event)
+}
+
+func testListSEO(t *testing.T, r ctrl.AppRepo) {
+	draft := newSEO("product", "12", "en", "Draft")
+	draft.Status = md.StatusDraft
+	bare := newSEO("category", "1", "", "Bare")
+	bare.Description = ""
+	bare.JSONLD = nil
+
+	for _, seo := range []*md.SEO{
+		newSEO("product", "10", "en", "Charlie"),
+		newSEO("product", "10", "ru", "Alpha"),
+		newSEO("product", "11", "en", "Bravo"),
+		newSEO("product", "2", "en", "Delta"),
+		draft,
+		bare,
+	} {
+		mustCreateSEO(t, r, seo)
+	}
+
+	keys := func(list []*md.SEO) []string {
+		res := make([]string, 0, len(list))
+		for _, v := range list {
+			res = append(res, v.OBJName+":"+v.OBJPK+":"+v.Locale)
+		}
+		return res
+	}
+
+	t.Run(
+		"Default order", func(t *testing.T) {
+			res, err := r.ListSEO(ctx, &md.SEOFilter{})
+			require.NoError(t, err)
+			assert.Equal(t, int64(6), res.Total)
+			assert.Equal(
+				t, []string{
+					"category:1:", "product:10:en", "product:10:ru", "product:11:en", "product:12:en", "product:2:en",
+				}, keys(res.SEO),
+			)
+			assert.Empty(t, res.NextCursor)
+		},
+	)
+
+	t.Run(
+		"Filters", func(t *testing.T) {
+			res, err := r.ListSEO(ctx, &md.SEOFilter{OBJName: "product", PKPrefix: "1", Locale: "en"})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"product:10:en", "product:11:en", "product:12:en"}, keys(res.SEO))
+
+			res, err = r.ListSEO(ctx, &md.SEOFilter{Status: md.StatusDraft})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"product:12:en"}, keys(res.SEO))
+
+			res, err = r.ListSEO(ctx, &md.SEOFilter{Missing: []string{"description", "json_ld"}})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"category:1:"}, keys(res.SEO))
+
+			future := time.Now().Add(24 * time.Hour)
+			res, err = r.ListSEO(ctx, &md.SEOFilter{UpdatedFrom: &future})
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), res.Total)
+
+			_, err = r.ListSEO(ctx, &md.SEOFilter{Missing: []string{"nope"}})
+			assert.Error(t, err)
+		},
+	)
+
+	t.Run(
+		"Sort and pages", func(t *testing.T) {
+			res, err := r.ListSEO(ctx, &md.SEOFilter{Sort: md.SortTitle, Order: md.OrderDesc, Size: 2, Page: 2})
+			require.NoError(t, err)
+			assert.Equal(t, int64(6), res.Total)
+			assert.Equal(t, []string{"product:10:en", "product:11:en"}, keys(res.SEO))
+		},
+	)
+
+	for _, sort := range []string{md.SortOBJPK, md.SortUpdatedAt} {
+		for _, order := range []string{md.OrderAsc, md.OrderDesc} {
+			t.Run(
+				"Cursor by "+sort+" "+order, func(t *testing.T) {
+					full, err := r.ListSEO(ctx, &md.SEOFilter{Sort: sort, Order: order})
+					require.NoError(t, err)
+
+					var walked []string
+					f := &md.SEOFilter{Sort: sort, Order: order, Size: 4}
+					for {
+						res, err := r.ListSEO(ctx, f)
+						require.NoError(t, err)
+						assert.Equal(t, int64(6), res.Total)
+						walked = append(walked, keys(res.SEO)...)
+						if res.NextCursor == "" {
+							break
+						}
+						f.Cursor = res.NextCursor
+					}
+					assert.Equal(t, keys(full.SEO), walked)
+				},
+			)
+		}
+	}
+
+	t.Run(
+		"Invalid cursor", func(t *testing.T) {
+			res, err := r.ListSEO(ctx, &md.SEOFilter{Size: 1})
+			require.NoError(t, err)
+
+			_, err = r.ListSEO(ctx, &md.SEOFilter{Size: 1, Sort: md.SortUpdatedAt, Cursor: res.NextCursor})
+			assert.ErrorIs(t, err, md.ErrInvalidCursor)
+
+			_, err = r.ListSEO(ctx, &md.SEOFilter{Cursor: "not a cursor"})
+			assert.ErrorIs(t, err, md.ErrInvalidCursor)
+		},
+	)
+}
+
+func testSEOReads(t *testing.T, r ctrl.AppRepo) {
+	archived := newSEO("product", "3", "en", "Oak table")
+	archived.Status = md.StatusArchived
+	draft := newSEO("product", "1", "de", "Eichentisch")
+	draft.Status = md.StatusDraft
+
+	for _, seo := range []*md.SEO{
+		newSEO("product", "1", "ru", "Дубовый стол"),
+		newSEO("product", "1", "en", "Oak table"),
+		newSEO("product", "1", "", "Oak table"),
+		newSEO("product", "2", "en", "Oak table"),
+		newSEO("category", "1", "en", "Tables"),
+		newSEO("blog", "1", "en", "Post"),
+		draft,
+		archived,
+	} {
+		mustCreateSEO(t, r, seo)
+	}
+
+	t.Run(
+		"ListSEOLocales", func(t *testing.T) {
+			res, err := r.ListSEOLocales(ctx, "product", "1")
+			require.NoError(t, err)
+			require.Len(t, res, 3)
+			assert.Equal(t, "", res[0].Locale)
+			assert.Equal(t, "en", res[1].Locale)
+			assert.Equal(t, "ru", res[2].Locale)
+			assert.Equal(t, "product", res[1].OBJName)
+			assert.False(t, res[1].UpdatedAt.IsZero())
+
+			res, err = r.ListSEOLocales(ctx, "product", "404")
+			require.NoError(t, err)
+			assert.Empty(t, res)
+		},
+	)
+
+	t.Run(
+		"ListSEOForSitemap", func(t *testing.T) {
+			res, err := r.ListSEOForSitemap(ctx, []string{"product", "category"})
+			require.NoError(t, err)
+			require.Len(t, res, 3)
+			assert.Equal(t, "category", res[0].OBJName)
+			assert.Equal(t, "product", res[1].OBJName)
+			assert.Equal(t, "1", res[1].OBJPK)
+			assert.Equal(t, "2", res[2].OBJPK)
+		},
+	)
+
+	t.Run(
+		"CountSEODuplicates", func(t *testing.T) {
+			titles, descriptions, err := r.CountSEODuplicates(ctx, newSEO("product", "1", "en", "Oak table"))
+			require.NoError(t, err)
+			assert.Equal(t, 1, titles)
+			assert.Equal(t, 1, descriptions)
+
+			titles, descriptions, err = r.CountSEODuplicates(ctx, newSEO("product", "9", "en", "Unique"))
+			require.NoError(t, err)
+			assert.Equal(t, 0, titles)
+			assert.Equal(t, 0, descriptions)
+		},
+	)
+}
+
+func testPublishScheduledSEO(t *testing.T, r ctrl.AppRepo) {
+	now := time.Now().UTC()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	due := newSEO("product", "1", "en", "Due")
+	due.Status, due.PublishAt = md.StatusScheduled, &past
+	later := newSEO("product", "2", "en", "Later")
+	later.Status, later.PublishAt = md.StatusScheduled, &future
+
+	mustCreateSEO(t, r, due)
+	mustCreateSEO(t, r, later)
+	webhook := mustCreateWebhook(t, r, md.EventSEOUpdated)
+
+	res, err := r.PublishScheduledSEO(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "product", res[0].OBJName)
+	assert.Equal(t, "1", res[0].OBJPK)
+	assert.Equal(t, "en", res[0].Locale)
+
+	got, err := r.GetSEO(ctx, "product", "1", "en")
+	require.NoError(t, err)
+	assert.Equal(t, md.StatusPublished, got.Status)
+
+	got, err = r.GetSEO(ctx, "product", "2", "en")
+	require.NoError(t, err)
+	assert.Equal(t, md.StatusScheduled, got.Status)
+
+	revs, err := r.ListSEORevisions(ctx, "product", "1")
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, md.RevisionPublish, revs[0].Action)
+
+	all := events(t, r)
+	require.Len(t, all, 3)
+	assert.Equal(t, md.EventSEOUpdated, all[2].Event)
+	assert.Equal(t, "seo:product:1:en", all[2].Key)
+
+	queued := deliveries(t, r, webhook)
+	require.Len(t, queued, 1)
+	body := &struct {
+		Data *md.SEO `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(queued[0].Payload, body))
+	assert.Equal(t, "1", body.Data.OBJPK)
+	assert.Equal(t, md.StatusPublished, body.Data.Status)
+
+	res, err = r.PublishScheduledSEO(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, res)
+	assert.Len(t, deliveries(t, r, webhook), 1)
+}
+
+func testImportSEO(t *testing.T, r ctrl.AppRepo) {
+	mustCreateSEO(t, r, newSEO("product", "1", "en", "Existing"))
+	webhook := mustCreateWebhook(t, r, md.EventAll)
+
+	created, updated, err := r.ImportSEO(
+		ctx, []*md.SEO{
+			newSEO("product", "1", "en", "Imported"),
+			newSEO("product", "2", "en", "New"),
+		}, false,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+	assert.Equal(t, 0, updated)
+
+	// Skipped rows queue nothing.
+	queued := deliveries(t, r, webhook)
+	require.Len(t, queued, 1)
+	assert.Equal(t, md.EventSEOCreated, queued[0].Event)
+
+	got, err := r.GetSEO(ctx, "product", "1", "en")
+	require.NoError(t, err)
+	assert.Equal(t, "Existing", got.Title)
+
+	created, updated, err = r.ImportSEO(
+		ctx, []*md.SEO{
+			newSEO("product", "1", "en", "Imported"),
+			newSEO("product", "3", "en", "Newer"),
+		}, true,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+	assert.Equal(t, 1, updated)
+
+	got, err = r.GetSEO(ctx, "product", "1", "en")
+	require.NoError(t, err)
+	assert.Equal(t, "Imported", got.Title)
+
+	revs, err := r.ListSEORevisions(ctx, "product", "1")
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, md.RevisionUpdate, revs[0].Action)
+	assert.Equal(t, "Imported", revs[0].Data.Title)
+	assert.False(t, revs[0].Data.CreatedAt.IsZero())
+	assert.False(t, revs[0].Data.UpdatedAt.IsZero())
+
+	revs, err = r.ListSEORevisions(ctx, "product", "3")
+	require.NoError(t, err)
+	require.Len(t, revs, 1)
+	assert.Equal(t, md.RevisionCreate, revs[0].Action)
+	assert.False(t, revs[0].Data.CreatedAt.IsZero())
+
+	all := events(t, r)
+	require.Len(t, all, 4)
+
+	byKey := make(map[string]*md.OutboxEvent)
+	for _, e := range all[2:] {
+		byKey[e.Key] = e
+	}
+	require.Contains(t, byKey, "seo:product:1:en")
+	assert.Equal(t, md.EventSEOUpdated, byKey["seo:product:1:en"].Event)
+
+	change := &md.SEOChange{}
+	require.NoError(t, json.Unmarshal(byKey["seo:product:1:en"].Payload, change))
+	assert.Equal(t, "Existing", change.Before.Title)
+	assert.Equal(t, "Imported", change.After.Title)
+	assert.False(t, change.After.CreatedAt.IsZero())
+	assert.False(t, change.After.UpdatedAt.IsZero())
+
+	queued = deliveries(t, r, webhook)
+	require.Len(t, queued, 3)
+
+	counts := make(map[string]int)
+	for _, d := range queued {
+		counts[d.Event]++
+	}
+	assert.Equal(t, map[string]int{md.EventSEOCreated: 2, md.EventSEOUpdated: 1}, counts)
+
+	body := &struct {
+		Data *md.SEO `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(queued[0].Payload, body))
+	assert.False(t, body.Data.CreatedAt.IsZero())
+}
+
+func testSEOTemplates(t *testing.T, r ctrl.AppRepo) {
+	created, err := r.SaveSEOTemplate(ctx, &md.SEOTemplate{OBJName: "product", Locale: "en", Title: "{{.name}}"})
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	created, err = r.SaveSEOTemplate(ctx, &md.SEOTemplate{OBJName: "product", Locale: "en", Title: "Buy {{.name}}"})
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	_, err = r.SaveSEOTemplate(ctx, &md.SEOTemplate{OBJName: "category", Locale: "", Title: "{{.name}}"})
+	require.NoError(t, err)
+
+	tpl, err := r.GetSEOTemplate(ctx, "product", "en")
+	require.NoError(t, err)
+	assert.Equal(t, "Buy {{.name}}", tpl.Title)
+
+	_, err = r.GetSEOTemplate(ctx, "product", "ru")
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	list, err := r.ListSEOTemplates(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "category", list[0].OBJName)
+	assert.Equal(t, "product", list[1].OBJName)
+
+	require.NoError(t, r.DeleteSEOTemplate(ctx, "product", "en"))
+	assert.ErrorIs(t, r.DeleteSEOTemplate(ctx, "product", "en"), repo.ErrNotFound)
+}
+
+func testPages(t *testing.T, r ctrl.AppRepo) {
+	slug, err := r.CreatePage(ctx, newPage("catalog", "/catalog", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "catalog", slug)
+
+	_, err = r.CreatePage(ctx, newPage("catalog", "/other", ""))
+	assert.ErrorIs(t, err, repo.ErrAlreadyExists)
+
+	_, err = r.CreatePage(ctx, newPage("orphan", "/orphan", "missing"))
+	assert.ErrorIs(t, err, repo.ErrParentNotFound)
+
+	_, err = r.CreatePage(ctx, newPage("copy", "https://example.com/Catalog/?utm=1", ""))
+	assert.ErrorIs(t, err, repo.ErrDuplicateHref)
+
+	shoes := newPage("shoes", "/catalog/shoes", "catalog")
+	shoes.Position = 2
+	mustCreatePage(t, r, shoes)
+	boots := newPage("boots", "/catalog/boots", "catalog")
+	boots.Position = 1
+	mustCreatePage(t, r, boots)
+	mustCreatePage(t, r, newPage("winter", "/catalog/boots/winter", "boots"))
+
+	got, err := r.GetPage(ctx, "shoes")
+	require.NoError(t, err)
+	assert.Equal(t, "catalog", got.ParentSlug)
+	assert.Equal(t, 2, got.Position)
+	assert.Equal(t, "Title shoes", got.Title)
+
+	_, err = r.GetPage(ctx, "missing")
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	got, err = r.GetPageByHref(ctx, "https://example.com/Catalog/Shoes/?page=2#top")
+	require.NoError(t, err)
+	assert.Equal(t, "shoes", got.Slug)
+
+	_, err = r.GetPageByHref(ctx, "/nowhere")
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	upd := newPage("shoes", "/shoes", "")
+	upd.Title = "Shoes"
+	require.NoError(t, r.UpdatePage(ctx, "shoes", upd))
+
+	got, err = r.GetPage(ctx, "shoes")
+	require.NoError(t, err)
+	assert.Equal(t, "Shoes", got.Title)
+	assert.Equal(t, "", got.ParentSlug)
+
+	got, err = r.GetPageByHref(ctx, "/shoes")
+	require.NoError(t, err)
+	assert.Equal(t, "shoes", got.Slug)
+
+	_, err = r.GetPageByHref(ctx, "/catalog/shoes")
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	// A page keeps its own href on update.
+	require.NoError(t, r.UpdatePage(ctx, "shoes", upd))
+
+	assert.ErrorIs(t, r.UpdatePage(ctx, "missing", newPage("missing", "/missing", "")), repo.ErrNotFound)
+	assert.ErrorIs(t, r.UpdatePage(ctx, "shoes", newPage("shoes", "/catalog", "")), repo.ErrDuplicateHref)
+	assert.ErrorIs(t, r.UpdatePage(ctx, "shoes", newPage("shoes", "/shoes", "missing")), repo.ErrParentNotFound)
+	assert.ErrorIs(t, r.UpdatePage(ctx, "catalog", newPage("catalog", "/catalog", "winter")), repo.ErrPageCycle)
+	assert.ErrorIs(t, r.UpdatePage(ctx, "boots", newPage("boots", "/catalog/boots", "boots")), repo.ErrPageCycle)
+
+	tree, err := r.ListPageTree(ctx)
+	require.NoError(t, err)
+	slugs := make([]string, 0, len(tree))
+	for _, v := range tree {
+		slugs = append(slugs, v.Slug)
+	}
+	assert.Equal(t, []string{"catalog", "shoes", "winter", "boots"}, slugs)
+
+	chain, err := r.ListPageAncestors(ctx, "winter")
+	require.NoError(t, err)
+	require.Len(t, chain, 3)
+	assert.Equal(t, "catalog", chain[0].Slug)
+	assert.Equal(t, "boots", chain[1].Slug)
+	assert.Equal(t, "winter", chain[2].Slug)
+
+	chain, err = r.ListPageAncestors(ctx, "missing")
+	require.NoError(t, err)
+	assert.Empty(t, chain)
+
+	all := events(t, r)
+	require.Len(t, all, 6)
+	assert.Equal(t, md.EventPageCreated, all[0].Event)
+	assert.Equal(t, "page:catalog", all[0].Key)
+	assert.Equal(t, md.EventPageUpdated, all[4].Event)
+	assert.Equal(t, "page:shoes", all[4].Key)
+
+	change := &md.PageChange{}
+	require.NoError(t, json.Unmarshal(all[4].Payload, change))
+	assert.Equal(t, "/catalog/shoes", change.Before.Href)
+	assert.Equal(t, "/shoes", change.After.Href)
+}
+
+func testDeletePage(t *testing.T, r ctrl.AppRepo) {
+	mustCreatePage(t, r, newPage("root", "/", ""))
+	mustCreatePage(t, r, newPage("catalog", "/catalog", "root"))
+	mustCreatePage(t, r, newPage("shoes", "/catalog/shoes", "catalog"))
+	mustCreatePage(t, r, newPage("boots", "/catalog/boots", "catalog"))
+	mustCreatePage(t, r, newPage("winter", "/catalog/boots/winter", "boots"))
+	mustCreatePage(t, r, newPage("about", "/about", ""))
+
+	_, err := r.DeletePage(ctx, "catalog", md.PageDeleteReject)
+	assert.ErrorIs(t, err, repo.ErrHasChildren)
+
+	_, err = r.DeletePage(ctx, "catalog", "")
+	assert.ErrorIs(t, err, repo.ErrHasChildren)
+
+	for _, strategy := range []string{md.PageDeleteReject, md.PageDeleteCascade, md.PageDeleteReparent} {
+		_, err = r.DeletePage(ctx, "missing", strategy)
+		assert.ErrorIs(t, err, repo.ErrNotFound, strategy)
+	}
+
+	before := len(events(t, r))
+
+	res, err := r.DeletePage(ctx, "catalog", md.PageDeleteReparent)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, "catalog", res[0])
+	assert.ElementsMatch(t, []string{"shoes", "boots"}, res[1:])
+
+	got, err := r.GetPage(ctx, "boots")
+	require.NoError(t, err)
+	assert.Equal(t, "root", got.ParentSlug)
+
+	all := events(t, r)[before:]
+	require.Len(t, all, 3)
+	assert.Equal(t, md.EventPageUpdated, all[0].Event)
+	assert.Equal(t, md.EventPageUpdated, all[1].Event)
+	assert.Equal(t, md.EventPageDeleted, all[2].Event)
+	assert.Equal(t, "page:catalog", all[2].Key)
+
+	res, err = r.DeletePage(ctx, "boots", md.PageDeleteCascade)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"boots", "winter"}, res)
+
+	_, err = r.GetPage(ctx, "winter")
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	// The href of a deleted page is free again.
+	mustCreatePage(t, r, newPage("boots-new", "/catalog/boots", ""))
+
+	res, err = r.DeletePage(ctx, "about", md.PageDeleteReject)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"about"}, res)
+
+	tree, err := r.ListPageTree(ctx)
+	require.NoError(t, err)
+	assert.Len(t, tree, 3)
+
+	// Neither the ancestor walk nor the cascade stops at a fixed depth.
+	parent := ""
+	for i := range 80 {
+		slug := fmt.Sprintf("level-%d", i)
+		mustCreatePage(t, r, newPage(slug, "/"+slug, parent))
+		parent = slug
+	}
+
+	chain, err := r.ListPageAncestors(ctx, parent)
+	require.NoError(t, err)
+	assert.Len(t, chain, 80)
+
+	res, err = r.DeletePage(ctx, "level-0", md.PageDeleteCascade)
+	require.NoError(t, err)
+	assert.Len(t, res, 80)
+}
+
+func testListPages(t *testing.T, r ctrl.AppRepo) {
+	for _, p := range []*md.Page{
+		newPage("delta", "/d", ""),
+		newPage("alpha", "/a", ""),
+		newPage("charlie", "/c", ""),
+		newPage("bravo", "/Blog/b", ""),
+		newPage("echo", "/e", ""),
+	} {
+		mustCreatePage(t, r, p)
+	}
+
+	draft := newPage("foxtrot", "/blog/f", "")
+	draft.Status = md.StatusDraft
+	draft.Priority = 1
+	mustCreatePage(t, r, draft)
+
+	slugs := func(list []*md.Page) []string {
+		res := make([]string, 0, len(list))
+		for _, v := range list {
+			res = append(res, v.Slug)
+		}
+		return res
+	}
+
+	res, err := r.ListPages(ctx, &md.PageFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), res.Total)
+	assert.Equal(t, []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot"}, slugs(res.Pages))
+
+	res, err = r.ListPages(ctx, &md.PageFilter{Href: "BLOG"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bravo", "foxtrot"}, slugs(res.Pages))
+
+	res, err = r.ListPages(ctx, &md.PageFilter{Title: "title CHAR", Status: md.StatusPublished})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"charlie"}, slugs(res.Pages))
+
+	res, err = r.ListPages(ctx, &md.PageFilter{Sort: md.SortPriority, Order: md.OrderDesc, Size: 2, Page: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), res.Total)
+	assert.Equal(t, []string{"foxtrot", "echo"}, slugs(res.Pages))
+
+	res, err = r.ListPages(ctx, &md.PageFilter{Size: 4, Page: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"echo", "foxtrot"}, slugs(res.Pages))
+
+	for _, sort := range []string{md.SortSlug, md.SortUpdatedAt} {
+		for _, order := range []string{md.OrderAsc, md.OrderDesc} {
+			t.Run(
+				"Cursor by "+sort+" "+order, func(t *testing.T) {
+					full, err := r.ListPages(ctx, &md.PageFilter{Sort: sort, Order: order})
+					require.NoError(t, err)
+
+					var walked []string
+					f := &md.PageFilter{Sort: sort, Order: order, Size: 4}
+					for {
+						res, err := r.ListPages(ctx, f)
+						require.NoError(t, err)
+						walked = append(walked, slugs(res.Pages)...)
+						if res.NextCursor == "" {
+							break
+						}
+						f.Cursor = res.NextCursor
+					}
+					assert.Equal(t, slugs(full.Pages), walked)
+				},
+			)
+		}
+	}
+
+	res, err = r.ListPages(ctx, &md.PageFilter{Size: 1})
+	require.NoError(t, err)
+	_, err = r.ListPages(ctx, &md.PageFilter{Sort: md.SortTitle, Cursor: res.NextCursor})
+	assert.ErrorIs(t, err, md.ErrInvalidCursor)
+}
+
+func testPublishScheduledPages(t *testing.T, r ctrl.AppRepo) {
+	now := time.Now().UTC()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	due := newPage("due", "/due", "")
+	due.Status, due.PublishAt = md.StatusScheduled, &past
+	later := newPage("later", "/later", "")
+	later.Status, later.PublishAt = md.StatusScheduled, &future
+	mustCreatePage(t, r, due)
+	mustCreatePage(t, r, later)
+	webhook := mustCreateWebhook(t, r, md.EventPageUpdated)
+
+	res, err := r.PublishScheduledPages(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"due"}, res)
+
+	got, err := r.GetPage(ctx, "due")
+	require.NoError(t, err)
+	assert.Equal(t, md.StatusPublished, got.Status)
+
+	got, err = r.GetPage(ctx, "later")
+	require.NoError(t, err)
+	assert.Equal(t, md.StatusScheduled, got.Status)
+
+	all := events(t, r)
+	require.Len(t, all, 3)
+	assert.Equal(t, md.EventPageUpdated, all[2].Event)
+	assert.Equal(t, "page:due", all[2].Key)
+
+	queued := deliveries(t, r, webhook)
+	require.Len(t, queued, 1)
+	body := &struct {
+		Data *md.Page `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(queued[0].Payload, body))
+	assert.Equal(t, "due", body.Data.Slug)
+	assert.Equal(t, md.StatusPublished, body.Data.Status)
+}
+
+func testRobots(t *testing.T, r ctrl.AppRepo) {
+	rules := []md.RobotsRule{{Type: md.RobotsDisallow, Path: "/admin"}}
+	first, err := r.CreateRobotsGroup(ctx, &md.RobotsGroup{UserAgent: "*", Rules: rules})
+	require.NoError(t, err)
+	second, err := r.CreateRobotsGroup(ctx, &md.RobotsGroup{UserAgent: "Googlebot", CrawlDelay: 2})
+	require.NoError(t, err)
+	assert.Greater(t, second, first)
+
+	_, err = r.CreateRobotsGroup(ctx, &md.RobotsGroup{UserAgent: "*"})
+	assert.ErrorIs(t, err, repo.ErrAlreadyExists)
+
+	group, err := r.GetRobotsGroup(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, "*", group.UserAgent)
+	assert.Equal(t, rules, group.Rules)
+
+	_, err = r.GetRobotsGroup(ctx, second+100)
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	require.NoError(t, r.UpdateRobotsGroup(ctx, second, &md.RobotsGroup{UserAgent: "Bingbot", CrawlDelay: 1}))
+	assert.ErrorIs(t, r.UpdateRobotsGroup(ctx, second, &md.RobotsGroup{UserAgent: "*"}), repo.ErrAlreadyExists)
+	assert.ErrorIs(t, r.UpdateRobotsGroup(ctx, second+100, &md.RobotsGroup{UserAgent: "Yandex"}), repo.ErrNotFound)
+
+	groups, err := r.ListRobotsGroups(ctx)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, first, groups[0].ID)
+	assert.Equal(t, "Bingbot", groups[1].UserAgent)
+	assert.Equal(t, 1.0, groups[1].CrawlDelay)
+
+	require.NoError(t, r.DeleteRobotsGroup(ctx, first))
+	assert.ErrorIs(t, r.DeleteRobotsGroup(ctx, first), repo.ErrNotFound)
+
+	id, err := r.CreateRobotsSitemap(ctx, "https://example.com/sitemap.xml")
+	require.NoError(t, err)
+	_, err = r.CreateRobotsSitemap(ctx, "https://example.com/sitemap.xml")
+	assert.ErrorIs(t, err, repo.ErrAlreadyExists)
+	_, err = r.CreateRobotsSitemap(ctx, "https://example.com/news.xml")
+	require.NoError(t, err)
+
+	sitemaps, err := r.ListRobotsSitemaps(ctx)
+	require.NoError(t, err)
+	require.Len(t, sitemaps, 2)
+	assert.Equal(t, "https://example.com/sitemap.xml", sitemaps[0].URL)
+
+	require.NoError(t, r.DeleteRobotsSitemap(ctx, id))
+	assert.ErrorIs(t, r.DeleteRobotsSitemap(ctx, id), repo.ErrNotFound)
+}
+
+func testRedirects(t *testing.T, r ctrl.AppRepo) {
+	first, err := r.CreateRedirect(ctx, &md.Redirect{Source: "/old", Target: "/new", Code: 301}, acceptRedirects)
+	require.NoError(t, err)
+	second, err := r.CreateRedirect(ctx, &md.Redirect{Source: "^/blog/(.*)$", Target: "/news/$1", Code: 302, Regex: true}, acceptRedirects)
+	require.NoError(t, err)
+
+	_, err = r.CreateRedirect(ctx, &md.Redirect{Source: "/old", Target: "/other", Code: 301}, acceptRedirects)
+	assert.ErrorIs(t, err, repo.ErrAlreadyExists)
+
+	rd, err := r.GetRedirect(ctx, second)
+	require.NoError(t, err)
+	assert.True(t, rd.Regex)
+	assert.Equal(t, 302, rd.Code)
+
+	_, err = r.GetRedirect(ctx, second+100)
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	require.NoError(t, r.UpdateRedirect(ctx, first, &md.Redirect{Source: "/old", Target: "/newer", Code: 308}, acceptRedirects))
+	assert.ErrorIs(
+		t, r.UpdateRedirect(ctx, second, &md.Redirect{Source: "/old", Target: "/x", Code: 301}, acceptRedirects), repo.ErrAlreadyExists,
+	)
+	assert.ErrorIs(
+		t, r.UpdateRedirect(ctx, second+100, &md.Redirect{Source: "/y", Target: "/x", Code: 301}, acceptRedirects), repo.ErrNotFound,
+	)
+
+	list, err := r.ListRedirects(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "/newer", list[0].Target)
+	assert.Equal(t, 308, list[0].Code)
+
+	testErr := errors.New("check error")
+	var checked []*md.Redirect
+	reject := func(list []*md.Redirect) error {
+		checked = list
+		return testErr
+	}
+	_, err = r.CreateRedirect(ctx, &md.Redirect{Source: "/new", Target: "/old", Code: 301}, reject)
+	assert.ErrorIs(t, err, testErr)
+	assert.Equal(t, list, checked)
+	assert.ErrorIs(t, r.UpdateRedirect(ctx, first, &md.Redirect{Source: "/old", Target: "/x", Code: 301}, reject), testErr)
+
+	after, err := r.ListRedirects(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, list, after)
+
+	require.NoError(t, r.DeleteRedirect(ctx, first))
+	assert.ErrorIs(t, r.DeleteRedirect(ctx, first), repo.ErrNotFound)
+}
+
+func acceptRedirects([]*md.Redirect) error {
+	return nil
+}
+
+func testWebhooks(t *testing.T, r ctrl.AppRepo) {
+	// Written before any webhook exists, so it queues nothing.
+	mustCreatePage(t, r, newPage("about", "/about", ""))
+
+	all, err := r.CreateWebhook(ctx, &md.Webhook{URL: "https://a.example.com", Secret: "secret-a", Events: []string{md.EventAll}})
+	require.NoError(t, err)
+	pages, err := r.CreateWebhook(
+		ctx, &md.Webhook{URL: "https://b.example.com", Secret: "secret-b", Events: []string{md.EventPageUpdated}},
+	)
+	require.NoError(t, err)
+	_, err = r.CreateWebhook(
+		ctx, &md.Webhook{URL: "https://c.example.com", Secret: "secret-c", Events: []string{md.EventAll}, Disabled: true},
+	)
+	require.NoError(t, err)
+
+	wh, err := r.GetWebhook(ctx, pages)
+	require.NoError(t, err)
+	assert.Equal(t, "https://b.example.com", wh.URL)
+	assert.Equal(t, []string{md.EventPageUpdated}, wh.Events)
+	assert.Empty(t, wh.Secret)
+
+	_, err = r.GetWebhook(ctx, pages+100)
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+
+	// An empty secret keeps the stored one.
+	require.NoError(
+		t, r.UpdateWebhook(ctx, pages, &md.Webhook{URL: "https://b2.example.com", Events: []string{md.EventPageUpdated}}),
+	)
+	assert.ErrorIs(t, r.UpdateWebhook(ctx, pages+100, &md.Webhook{URL: "https://x.example.com"}), repo.ErrNotFound)
+
+	list, err := r.ListWebhooks(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.Equal(t, "https://b2.example.com", list[1].URL)
+
+	// Writes queue their own deliveries: the page update reaches both enabled
+	// webhooks, the SEO record only the catch-all one and the redirect none.
+	page := newPage("about", "/about", "")
+	page.Title = "About us"
+	require.NoError(t, r.UpdatePage(ctx, "about", page))
+	mustCreateSEO(t, r, newSEO("product", "1", "en", "Product"))
+	_, err = r.CreateRedirect(ctx, &md.Redirect{Source: "/old", Target: "/new", Code: 301}, acceptRedirects)
+	require.NoError(t, err)
+
+	// Deliveries default to the current time; a day ahead keeps the check
+	// independent of the database clock.
+	now := time.Now().UTC().Add(24 * time.Hour)
+	claimed, err := r.ClaimWebhookDeliveries(ctx, now, time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 3)
+
+	secrets := make(map[uint64]string)
+	for _, d := range claimed {
+		secrets[d.WebhookID] = d.Secret
+		assert.Equal(t, md.DeliveryPending, d.Status)
+	}
+	assert.Equal(t, "secret-b", secrets[pages])
+	assert.Equal(t, "secret-a", secrets[all])
+
+	again, err := r.ClaimWebhookDeliveries(ctx, now, time.Minute, 10)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+
+	var delivered *md.WebhookDelivery
+	for _, d := range claimed {
+		if d.WebhookID == pages {
+			delivered = d
+		}
+	}
+	require.NotNil(t, delivered)
+
+	at := time.Now().UTC()
+	delivered.Status = md.DeliveryDelivered
+	delivered.Attempts = 1
+	delivered.ResponseCode = 204
+	delivered.DeliveredAt = &at
+	require.NoError(t, r.FinishWebhookDelivery(ctx, delivered))
+
+	log, err := r.ListWebhookDeliveries(ctx, &md.WebhookDeliveryFilter{WebhookID: pages})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), log.Total)
+	require.Len(t, log.Deliveries, 1)
+	assert.Equal(t, md.DeliveryDelivered, log.Deliveries[0].Status)
+	assert.Equal(t, 204, log.Deliveries[0].ResponseCode)
+	assert.NotNil(t, log.Deliveries[0].DeliveredAt)
+
+	var body struct {
+		Event string   `json:"event"`
+		Data  *md.Page `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(log.Deliveries[0].Payload, &body))
+	assert.Equal(t, md.EventPageUpdated, body.Event)
+	require.NotNil(t, body.Data)
+	assert.Equal(t, "about", body.Data.Slug)
+	assert.Equal(t, "About us", body.Data.Title)
+
+	log, err = r.ListWebhookDeliveries(ctx, &md.WebhookDeliveryFilter{WebhookID: all, Size: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), log.Total)
+	require.Len(t, log.Deliveries, 1)
+	assert.Equal(t, md.EventSEOCreated, log.Deliveries[0].Event)
+
+	log, err = r.ListWebhookDeliveries(ctx, &md.WebhookDeliveryFilter{WebhookID: all, Status: md.DeliveryDelivered})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), log.Total)
+
+	require.NoError(t, r.RetryWebhookDelivery(ctx, delivered.ID))
+	assert.ErrorIs(t, r.RetryWebhookDelivery(ctx, delivered.ID+100), repo.ErrNotFound)
+
+	log, err = r.ListWebhookDeliveries(ctx, &md.WebhookDeliveryFilter{WebhookID: pages})
+	require.NoError(t, err)
+	require.Len(t, log.Deliveries, 1)
+	assert.Equal(t, md.DeliveryPending, log.Deliveries[0].Status)
+	assert.Equal(t, 0, log.Deliveries[0].Attempts)
+
+	require.NoError(t, r.DeleteWebhook(ctx, pages))
+	assert.ErrorIs(t, r.DeleteWebhook(ctx, pages), repo.ErrNotFound)
+
+	log, err = r.ListWebhookDeliveries(ctx, &md.WebhookDeliveryFilter{WebhookID: pages})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), log.Total)
+}
+
+func testOutbox(t *testing.T, r ctrl.AppRepo) {
+	last, err := r.LastOutboxEventID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), last)
+
+	mustCreateSEO(t, r, newSEO("product", "1", "en", "First"))
+	mustCreatePage(t, r, newPage("about", "/about", ""))
+	mustCreateSEO(t, r, newSEO("product", "2", "en", "Second"))
+
+	all := events(t, r)
+	require.Len(t, all, 3)
+	assert.Less(t, all[0].ID, all[1].ID)
+	assert.Less(t, all[1].ID, all[2].ID)
+
+	last, err = r.LastOutboxEventID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, all[2].ID, last)
+
+	after, err := r.ListOutboxEvents(ctx, all[0].ID, 1)
+	require.NoError(t, err)
+	require.Len(t, after, 1)
+	assert.Equal(t, "page:about", after[0].Key)
+
+	now := time.Now().UTC()
+	claimed, err := r.ClaimOutboxEvents(ctx, now, time.Minute, 2)
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	assert.Equal(t, all[0].ID, claimed[0].ID)
+	assert.Equal(t, all[1].ID, claimed[1].ID)
+
+	rest, err := r.ClaimOutboxEvents(ctx, now, time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Equal(t, all[2].ID, rest[0].ID)
+
+	require.NoError(t, r.MarkOutboxPublished(ctx, []uint64{claimed[0].ID, claimed[1].ID}, now))
+	require.NoError(t, r.MarkOutboxPublished(ctx, nil, now))
+
+	// The lease of the unpublished one has run out.
+	expired, err := r.ClaimOutboxEvents(ctx, now.Add(2*time.Minute), time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, all[2].ID, expired[0].ID)
+
+	n, err := r.PurgeOutbox(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	n, err = r.PurgeOutbox(ctx, now.Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	left := events(t, r)
+	require.Len(t, left, 1)
+	assert.Equal(t, all[2].ID, left[0].ID)
+}
+
+// testOutboxSettings checks that robots, redirect and template writes record
+// their change events alongside the write.
+func testOutboxSettings(t *testing.T, r ctrl.AppRepo) {
+	groupID, err := r.CreateRobotsGroup(ctx, &md.RobotsGroup{UserAgent: "*", Rules: []md.RobotsRule{}})
+	require.NoError(t, err)
+	require.NoError(t, r.UpdateRobotsGroup(ctx, groupID, &md.RobotsGroup{UserAgent: "Googlebot", Rules: []md.RobotsRule{}}))
+	require.NoError(t, r.DeleteRobotsGroup(ctx, groupID))
+
+	sitemapID, err := r.CreateRobotsSitemap(ctx, "https://example.com/sitemap.xml")
+	require.NoError(t, err)
+	require.NoError(t, r.DeleteRobotsSitemap(ctx, sitemapID))
+
+	redirectID, err := r.CreateRedirect(ctx, &md.Redirect{Source: "/old", Target: "/new", Code: 301}, acceptRedirects)
+	require.NoError(t, err)
+	require.NoError(t, r.UpdateRedirect(ctx, redirectID, &md.Redirect{Source: "/old", Target: "/newer", Code: 302}, acceptRedirects))
+	require.NoError(t, r.DeleteRedirect(ctx, redirectID))
+
+	_, err = r.SaveSEOTemplate(ctx, &md.SEOTemplate{OBJName: "product", Locale: "en", Title: "{{.name}}"})
+	require.NoError(t, err)
+	_, err = r.SaveSEOTemplate(ctx, &md.SEOTemplate{OBJName: "product", Locale: "en", Title: "Buy {{.name}}"})
+	require.NoError(t, err)
+	require.NoError(t, r.DeleteSEOTemplate(ctx, "product", "en"))
+
+	// Failed writes leave no event behind.
+	assert.ErrorIs(t, r.DeleteRedirect(ctx, redirectID), repo.ErrNotFound)
+
+	group := fmt.Sprintf("robots_group:%d", groupID)
+	sitemap := fmt.Sprintf("robots_sitemap:%d", sitemapID)
+	redirect := fmt.Sprintf("redirect:%d", redirectID)
+	all := events(t, r)
+	got := make([][2]string, 0, len(all))
+	for _, e := range all {
+		got = append(got, [2]string{e.Event, e.Key})
+	}
+	assert.Equal(
+		t, [][2]string{
+			{md.EventRobotsGroupCreated, group},
+			{md.EventRobotsGroupUpdated, group},
+			{md.EventRobotsGroupDeleted, group},
+			{md.EventRobotsSitemapCreated, sitemap},
+			{md.EventRobotsSitemapDeleted, sitemap},
+			{md.EventRedirectCreated, redirect},
+			{md.EventRedirectUpdated, redirect},
+			{md.EventRedirectDeleted, redirect},
+			{md.EventSEOTemplateCreated, "seo_template:product:en"},
+			{md.EventSEOTemplateUpdated, "seo_template:product:en"},
+			{md.EventSEOTemplateDeleted, "seo_template:product:en"},
+		}, got,
+	)
+
+	var rd struct {
+		Before *md.Redirect `json:"before"`
+		After  *md.Redirect `json:"after"`
+	}
+	require.NoError(t, json.Unmarshal(all[6].Payload, &rd))
+	require.NotNil(t, rd.Before)
+	require.NotNil(t, rd.After)
+	assert.Equal(t, "/new", rd.Before.Target)
+	assert.Equal(t, "/newer", rd.After.Target)
+	assert.Equal(t, 302, rd.After.Code)
+
+	tpl := &md.SEOTemplateChange{}
+	require.NoError(t, json.Unmarshal(all[9].Payload, tpl))
+	require.NotNil(t, tpl.Before)
+	require.NotNil(t, tpl.After)
+	assert.Equal(t, "{{.name}}", tpl.Before.Title)
+	assert.Equal(t, "Buy {{.name}}", tpl.After.Title)
+
+	tpl = &md.SEOTemplateChange{}
+	require.NoError(t, json.Unmarshal(all[10].Payload, tpl))
+	assert.Nil(t, tpl.After)
+	require.NotNil(t, tpl.Before)
+	assert.Equal(t, "product", tpl.Before.OBJName)
+}
@@ -0,0 +1,25 @@
+package sqlite
+
+import (
+	"context"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+// CountSEODuplicates returns how many other non-archived records of the same
+// locale share req's title and description.
+func (r *Repository) CountSEODuplicates(ctx context.Context, req *md.SEO) (int, int, error) {
+	const op = "seo.CountSEODuplicates.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var titles, descriptions int
+	err := r.conn.QueryRowContext(
+		ctx, countSEODuplicates, req.OBJName, req.OBJPK, req.Locale, req.Title, req.Description,
+	).Scan(&titles, &descriptions)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return titles, descriptions, nil
+}
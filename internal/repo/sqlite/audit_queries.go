@@ -0,0 +1,12 @@
+package sqlite
+
+const countSEODuplicates = `
+SELECT
+	COUNT(*) FILTER (WHERE title = ?4),
+	COUNT(*) FILTER (WHERE description = ?5)
+FROM seo
+WHERE locale = ?3
+	AND status <> 'archived'
+	AND NOT (obj_name = ?1 AND obj_pk = ?2)
+	AND (title = ?4 OR description = ?5)
+`
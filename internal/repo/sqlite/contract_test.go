@@ -0,0 +1,27 @@
+package sqlite
+
+import (
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/repo/repotest"
+	"path/filepath"
+	"testing"
+)
+
+func newTestRepo(t *testing.T) *Repository {
+	r := New(&config.DBConfig{Driver: "sqlite", Database: filepath.Join(t.TempDir(), "seo.db")})
+	t.Cleanup(
+		func() {
+			_ = r.Close()
+		},
+	)
+	return r
+}
+
+func TestContract(t *testing.T) {
+	repotest.Run(
+		t, func(t *testing.T) ctrl.AppRepo {
+			return newTestRepo(t)
+		},
+	)
+}
@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	conf "github.com/JMURv/seo/internal/config"
+	"go.uber.org/zap"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+	"time"
+)
+
+// dsnParams enforce foreign keys on every pooled connection, let writers wait
+// for each other instead of failing and take the write lock when a
+// transaction begins, which stands in for SELECT ... FOR UPDATE.
+const dsnParams = "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate"
+
+// timeLayout is how timestamps are stored. SQLite compares them as text, so
+// every value is UTC with millisecond precision, the same as currentTimestamp.
+const timeLayout = "2006-01-02 15:04:05.000-07:00"
+
+// currentTimestamp is CURRENT_TIMESTAMP in timeLayout.
+const currentTimestamp = `strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')`
+
+type Repository struct {
+	conn *sql.DB
+}
+
+func New(conf *conf.DBConfig) *Repository {
+	conn, err := sql.Open("sqlite", "file:"+conf.Database+dsnParams)
+	if err != nil {
+		zap.L().Fatal("Failed to open the database", zap.Error(err))
+	}
+
+	if err = conn.Ping(); err != nil {
+		zap.L().Fatal("Failed to ping the database", zap.Error(err))
+	}
+
+	if conf.SkipMigrations {
+		zap.L().Info("Skipping migrations on startup")
+	} else if err = applyMigrations(conn, conf); err != nil {
+		zap.L().Fatal("Failed to apply migrations", zap.Error(err))
+	}
+
+	return &Repository{conn: conn}
+}
+
+func (r *Repository) Close() error {
+	return r.conn.Close()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func isUniqueViolation(err error) bool {
+	var e *sqlite.Error
+	return errors.As(err, &e) &&
+		(e.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE || e.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY)
+}
+
+func isForeignKeyViolation(err error) bool {
+	var e *sqlite.Error
+	return errors.As(err, &e) && e.Code() == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY
+}
+
+// ts binds t in timeLayout.
+func ts(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// nullTS binds an optional timestamp.
+func nullTS(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return ts(*t)
+}
+
+// parseTS reads a timestamp the driver could not convert itself, such as the
+// result of MAX().
+func parseTS(s string) (time.Time, error) {
+	return time.Parse("2006-01-02 15:04:05.999999999-07:00", s)
+}
+
+// stringArray stores a string list as a JSON array in place of TEXT[].
+type stringArray []string
+
+func (a stringArray) Value() (driver.Value, error) {
+	if a == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal([]string(a))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (a *stringArray) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	case []byte:
+		return json.Unmarshal(v, a)
+	default:
+		return fmt.Errorf("cannot scan %T into a string array", src)
+	}
+}
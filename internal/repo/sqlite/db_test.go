@@ -0,0 +1,93 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNew_Reopen(t *testing.T) {
+	conf := &config.DBConfig{Driver: "sqlite", Database: filepath.Join(t.TempDir(), "seo.db")}
+	ctx := context.Background()
+
+	r := New(conf)
+	_, _, err := r.CreateSEO(ctx, &md.SEO{Title: "Oak table", OBJName: "product", OBJPK: "1"})
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	r = New(conf)
+	defer r.Close()
+
+	seo, err := r.GetSEO(ctx, "product", "1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Oak table", seo.Title)
+}
+
+func TestNew_SkipMigrations(t *testing.T) {
+	conf := &config.DBConfig{Driver: "sqlite", Database: filepath.Join(t.TempDir(), "seo.db"), SkipMigrations: true}
+
+	r := New(conf)
+	defer r.Close()
+
+	_, err := r.GetSEO(context.Background(), "product", "1", "")
+	require.ErrorContains(t, err, "no such table")
+
+	m, err := NewMigrate(conf)
+	require.NoError(t, err)
+	require.NoError(t, m.Up())
+	_, _ = m.Close()
+
+	_, err = r.GetSEO(context.Background(), "product", "1", "")
+	assert.ErrorIs(t, err, repo.ErrNotFound)
+}
+
+func TestConcurrentWrites(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, err := r.CreateSEO(ctx, &md.SEO{Title: "Oak table", OBJName: "product", OBJPK: strconv.Itoa(i)})
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	res, err := r.ListSEO(ctx, &md.SEOFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), res.Total)
+
+	last, err := r.LastOutboxEventID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), last)
+}
+
+func TestTS(t *testing.T) {
+	at := time.Date(2026, 5, 1, 12, 30, 0, 0, time.FixedZone("", 3*60*60))
+
+	assert.Equal(t, "2026-05-01 09:30:00.000+00:00", ts(at))
+	assert.Less(t, ts(at), ts(at.Add(time.Millisecond)))
+	assert.Less(t, ts(at.Add(999*time.Millisecond)), ts(at.Add(time.Second)))
+
+	parsed, err := parseTS(ts(at))
+	require.NoError(t, err)
+	assert.True(t, parsed.Equal(at))
+	assert.Nil(t, nullTS(nil))
+}
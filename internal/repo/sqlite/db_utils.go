@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	conf "github.com/JMURv/seo/internal/config"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"go.uber.org/zap"
+)
+
+//go:embed migration/*.sql
+var migrations embed.FS
+
+// NewMigrate opens the database file for the migrate subcommand. Closing the
+// returned instance closes the file.
+func NewMigrate(conf *conf.DBConfig) (*migrate.Migrate, error) {
+	db, err := sql.Open("sqlite", "file:"+conf.Database+dsnParams)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := newMigrate(db, conf)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return m, nil
+}
+
+func newMigrate(db *sql.DB, conf *conf.DBConfig) (*migrate.Migrate, error) {
+	driver, err := sqlite.WithInstance(db, &sqlite.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := iofs.New(migrations, "migration")
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewWithInstance("iofs", src, conf.Database, driver)
+}
+
+func applyMigrations(db *sql.DB, conf *conf.DBConfig) error {
+	m, err := newMigrate(db, conf)
+	if err != nil {
+		return err
+	}
+
+	if err = m.Up(); err != nil && errors.Is(err, migrate.ErrNoChange) {
+		zap.L().Info("No migrations to apply")
+		return nil
+	} else if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+
+	zap.L().Info("Applied migrations")
+	return nil
+}
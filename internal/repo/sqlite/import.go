@@ -0,0 +1,84 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+type seoKey struct {
+	name, pk, locale string
+}
+
+// ImportSEO merges rows into seo one by one inside a single transaction.
+// Existing records are overwritten when upsert is set and left untouched
+// otherwise. Every written record gets a revision and an outbox event.
+func (r *Repository) ImportSEO(ctx context.Context, rows []*md.SEO, upsert bool) (int, int, error) {
+	const op = "seo.ImportSEO.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	create, err := tx.PrepareContext(ctx, createSEO)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer create.Close()
+
+	update, err := tx.PrepareContext(ctx, updateSEO)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer update.Close()
+
+	var created, updated int
+	for _, row := range rows {
+		args, err := seoArgs(row)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		before, err := scanSEO(tx.QueryRowContext(ctx, getSEO, row.OBJName, row.OBJPK, row.Locale))
+		if err != nil && err != sql.ErrNoRows {
+			return 0, 0, err
+		}
+
+		action, event := md.RevisionCreate, md.EventSEOCreated
+		switch {
+		case before == nil:
+			var name, pk string
+			if err = create.QueryRowContext(ctx, args...).Scan(&name, &pk); err != nil {
+				return 0, 0, err
+			}
+			created++
+		case upsert:
+			if _, err = update.ExecContext(ctx, append(args, row.OBJName, row.OBJPK, row.Locale)...); err != nil {
+				return 0, 0, err
+			}
+			action, event = md.RevisionUpdate, md.EventSEOUpdated
+			updated++
+		default:
+			continue
+		}
+
+		after, err := writeSEORevision(ctx, tx, action, row.OBJName, row.OBJPK, row.Locale)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if err = writeOutbox(ctx, tx, event, seoOutboxKey(after), before, after); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+	return created, updated, nil
+}
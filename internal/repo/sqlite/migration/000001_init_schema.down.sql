@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS outbox;
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook;
+DROP TABLE IF EXISTS redirect;
+DROP TABLE IF EXISTS robots_sitemap;
+DROP TABLE IF EXISTS robots_group;
+DROP TABLE IF EXISTS seo_template;
+DROP TABLE IF EXISTS seo_revision;
+DROP TABLE IF EXISTS seo;
+DROP TABLE IF EXISTS page;
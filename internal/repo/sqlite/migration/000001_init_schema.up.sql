@@ -0,0 +1,176 @@
+-- The schema internal/repo/db reaches after all of its migrations. Timestamps
+-- are UTC text in one layout so that they compare correctly, JSONB and TEXT[]
+-- columns hold JSON text.
+
+-- pages
+CREATE TABLE IF NOT EXISTS page (
+    slug        VARCHAR(255) PRIMARY KEY,
+    title       VARCHAR(255),
+    href        VARCHAR(255),
+    changefreq  VARCHAR(16)  NOT NULL DEFAULT '',
+    priority    REAL         NOT NULL DEFAULT 0,
+    status      VARCHAR(16)  NOT NULL DEFAULT 'published',
+    publish_at  TIMESTAMP,
+    parent_slug VARCHAR(255) REFERENCES page (slug) ON UPDATE CASCADE,
+    position    INT          NOT NULL DEFAULT 0,
+    -- href_key mirrors models.NormalizeHref.
+    href_key    TEXT,
+
+    created_at  TIMESTAMP    NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at  TIMESTAMP    NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_page_scheduled ON page (publish_at) WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS idx_page_updated_at ON page (updated_at, slug);
+CREATE INDEX IF NOT EXISTS idx_page_created_at ON page (created_at, slug);
+CREATE INDEX IF NOT EXISTS idx_page_parent ON page (parent_slug, position, slug);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_page_href_key ON page (href_key);
+
+-- SEO
+CREATE TABLE IF NOT EXISTS seo (
+    title                  VARCHAR(255)  NOT NULL,
+    description            TEXT,
+    keywords               TEXT,
+    og_title               VARCHAR(255),
+    og_description         TEXT,
+    og_image               VARCHAR(255),
+    og_type                VARCHAR(64)   NOT NULL DEFAULT '',
+    og_url                 VARCHAR(2048) NOT NULL DEFAULT '',
+    og_locale              VARCHAR(35)   NOT NULL DEFAULT '',
+    og_site_name           VARCHAR(255)  NOT NULL DEFAULT '',
+    og_image_width         INTEGER       NOT NULL DEFAULT 0,
+    og_image_height        INTEGER       NOT NULL DEFAULT 0,
+    og_image_alt           VARCHAR(420)  NOT NULL DEFAULT '',
+    og_image_type          VARCHAR(64)   NOT NULL DEFAULT '',
+    article_published_time TIMESTAMP,
+    article_modified_time  TIMESTAMP,
+    article_author         TEXT          NOT NULL DEFAULT '[]',
+    article_section        VARCHAR(255)  NOT NULL DEFAULT '',
+    article_tag            TEXT          NOT NULL DEFAULT '[]',
+    twitter_card           VARCHAR(32)   NOT NULL DEFAULT '',
+    twitter_site           VARCHAR(255)  NOT NULL DEFAULT '',
+    twitter_creator        VARCHAR(255)  NOT NULL DEFAULT '',
+    twitter_image_alt      VARCHAR(420)  NOT NULL DEFAULT '',
+    obj_name               VARCHAR(255)  NOT NULL,
+    obj_pk                 VARCHAR(255)  NOT NULL,
+    locale                 VARCHAR(35)   NOT NULL DEFAULT '',
+    json_ld                TEXT          NOT NULL DEFAULT '[]',
+    status                 VARCHAR(16)   NOT NULL DEFAULT 'published',
+    publish_at             TIMESTAMP,
+
+    created_at             TIMESTAMP     NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at             TIMESTAMP     NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (obj_name, obj_pk, locale)
+);
+
+CREATE INDEX IF NOT EXISTS idx_seo_name ON seo (obj_name);
+CREATE INDEX IF NOT EXISTS idx_seo_pk ON seo (obj_pk);
+CREATE INDEX IF NOT EXISTS idx_seo_scheduled ON seo (publish_at) WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS idx_seo_updated_at ON seo (updated_at, obj_name, obj_pk, locale);
+
+CREATE TABLE IF NOT EXISTS seo_revision (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    obj_name   VARCHAR(255) NOT NULL,
+    obj_pk     VARCHAR(255) NOT NULL,
+    locale     VARCHAR(35)  NOT NULL DEFAULT '',
+    action     VARCHAR(16)  NOT NULL,
+    author     VARCHAR(255) NOT NULL DEFAULT '',
+    data       TEXT         NOT NULL,
+
+    created_at TIMESTAMP    NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_seo_revision_obj ON seo_revision (obj_name, obj_pk, id DESC);
+
+CREATE TABLE IF NOT EXISTS seo_template (
+    obj_name       VARCHAR(255)  NOT NULL,
+    locale         VARCHAR(35)   NOT NULL DEFAULT '',
+    title          VARCHAR(1024) NOT NULL DEFAULT '',
+    description    TEXT          NOT NULL DEFAULT '',
+    keywords       TEXT          NOT NULL DEFAULT '',
+    og_title       VARCHAR(1024) NOT NULL DEFAULT '',
+    og_description TEXT          NOT NULL DEFAULT '',
+    og_image       VARCHAR(2048) NOT NULL DEFAULT '',
+    og_type        VARCHAR(255)  NOT NULL DEFAULT '',
+    og_url         VARCHAR(2048) NOT NULL DEFAULT '',
+
+    created_at     TIMESTAMP     NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at     TIMESTAMP     NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+
+    PRIMARY KEY (obj_name, locale)
+);
+
+-- robots.txt
+CREATE TABLE IF NOT EXISTS robots_group (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_agent  VARCHAR(255) NOT NULL UNIQUE,
+    rules       TEXT         NOT NULL DEFAULT '[]',
+    crawl_delay REAL         NOT NULL DEFAULT 0,
+
+    created_at  TIMESTAMP    NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at  TIMESTAMP    NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE TABLE IF NOT EXISTS robots_sitemap (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    url        VARCHAR(2048) NOT NULL UNIQUE,
+
+    created_at TIMESTAMP     NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+-- redirects
+CREATE TABLE IF NOT EXISTS redirect (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    source     VARCHAR(2048) NOT NULL UNIQUE,
+    target     VARCHAR(2048) NOT NULL,
+    code       SMALLINT      NOT NULL DEFAULT 301,
+    regex      BOOLEAN       NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP     NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at TIMESTAMP     NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+-- webhooks
+CREATE TABLE IF NOT EXISTS webhook (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    url        VARCHAR(2048) NOT NULL,
+    secret     VARCHAR(255)  NOT NULL,
+    events     TEXT          NOT NULL DEFAULT '[]',
+    disabled   BOOLEAN       NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP     NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at TIMESTAMP     NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    webhook_id      BIGINT       NOT NULL REFERENCES webhook (id) ON DELETE CASCADE,
+    event           VARCHAR(64)  NOT NULL,
+    payload         TEXT         NOT NULL,
+    status          VARCHAR(16)  NOT NULL DEFAULT 'pending',
+    attempts        INT          NOT NULL DEFAULT 0,
+    response_code   INT          NOT NULL DEFAULT 0,
+    last_error      TEXT         NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP    NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    delivered_at    TIMESTAMP,
+
+    created_at      TIMESTAMP    NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_due ON webhook_delivery (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_hook ON webhook_delivery (webhook_id, id DESC);
+
+-- outbox
+CREATE TABLE IF NOT EXISTS outbox (
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    event         VARCHAR(64)  NOT NULL,
+    aggregate_key VARCHAR(512) NOT NULL,
+    payload       TEXT         NOT NULL,
+    locked_until  TIMESTAMP,
+    published_at  TIMESTAMP,
+
+    created_at    TIMESTAMP    NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_published ON outbox (published_at) WHERE published_at IS NOT NULL;
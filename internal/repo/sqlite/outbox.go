@@ -0,0 +1,198 @@
+package sqlite
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	md "github.com/JMURv/seo/internal/models"
+	ot "github.com/opentracing/opentracing-go"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// ClaimOutboxEvents returns up to limit unpublished events in commit order and
+// hides them from other relays until now+lease.
+func (r *Repository) ClaimOutboxEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*md.OutboxEvent, error) {
+	const op = "outbox.ClaimOutboxEvents.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, claimOutboxEvents, ts(now), ts(now.Add(lease)), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.OutboxEvent, 0, limit)
+	for rows.Next() {
+		e := &md.OutboxEvent{}
+		// JSON is stored as TEXT, which database/sql only scans into *[]byte.
+		if err = rows.Scan(&e.ID, &e.Event, &e.Key, (*[]byte)(&e.Payload), &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the subquery.
+	slices.SortFunc(
+		res, func(a, b *md.OutboxEvent) int {
+			return cmp.Compare(a.ID, b.ID)
+		},
+	)
+	return res, nil
+}
+
+func (r *Repository) MarkOutboxPublished(ctx context.Context, ids []uint64, now time.Time) error {
+	const op = "outbox.MarkOutboxPublished.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	arr, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.ExecContext(ctx, markOutboxPublished, ts(now), string(arr))
+	return err
+}
+
+// ListOutboxEvents returns up to limit events with an id above after in id
+// order, published or not.
+func (r *Repository) ListOutboxEvents(ctx context.Context, after uint64, limit int) ([]*md.OutboxEvent, error) {
+	const op = "outbox.ListOutboxEvents.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listOutboxEvents, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.OutboxEvent, 0, limit)
+	for rows.Next() {
+		e := &md.OutboxEvent{}
+		if err = rows.Scan(&e.ID, &e.Event, &e.Key, (*[]byte)(&e.Payload), &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) LastOutboxEventID(ctx context.Context) (uint64, error) {
+	const op = "outbox.LastOutboxEventID.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var id uint64
+	if err := r.conn.QueryRowContext(ctx, lastOutboxEventID).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// PurgeOutbox removes events published before the given time.
+func (r *Repository) PurgeOutbox(ctx context.Context, before time.Time) (int64, error) {
+	const op = "outbox.PurgeOutbox.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, purgeOutbox, ts(before))
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// writeOutbox records a change inside tx, so the event exists if and only if
+// the change commits. Events webhooks can subscribe to are queued for delivery
+// in the same transaction. A nil before or after marks a creation or a
+// deletion.
+func writeOutbox(ctx context.Context, tx *sql.Tx, event, key string, before, after any) error {
+	at := time.Now().UTC()
+	payload, err := changeEvent(ctx, event, at, before, after)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, createOutboxEvent, event, key, string(payload)); err != nil {
+		return err
+	}
+
+	if _, ok := md.WebhookEvents[event]; !ok {
+		return nil
+	}
+
+	payload, err = webhookEvent(event, at, before, after)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, enqueueWebhookEvent, event, string(payload))
+	return err
+}
+
+// changeEvent encodes the payload of an outbox event with the acting user
+// taken from ctx.
+func changeEvent(ctx context.Context, event string, at time.Time, before, after any) ([]byte, error) {
+	return json.Marshal(
+		&md.ChangeEvent{
+			Event:      event,
+			UID:        authorFromCtx(ctx),
+			OccurredAt: at,
+			Before:     before,
+			After:      after,
+		},
+	)
+}
+
+// webhookEvent encodes the body of a webhook delivery. It carries the record
+// after the change, or before it for a deletion.
+func webhookEvent(event string, at time.Time, before, after any) ([]byte, error) {
+	data := after
+	if after == nil {
+		data = before
+	}
+
+	return json.Marshal(&md.WebhookEvent{Event: event, OccurredAt: at, Data: data})
+}
+
+func seoOutboxKey(seo *md.SEO) string {
+	return "seo:" + seo.OBJName + ":" + seo.OBJPK + ":" + seo.Locale
+}
+
+func pageOutboxKey(slug string) string {
+	return "page:" + slug
+}
+
+func robotsGroupOutboxKey(id uint64) string {
+	return "robots_group:" + strconv.FormatUint(id, 10)
+}
+
+func robotsSitemapOutboxKey(id uint64) string {
+	return "robots_sitemap:" + strconv.FormatUint(id, 10)
+}
+
+func redirectOutboxKey(id uint64) string {
+	return "redirect:" + strconv.FormatUint(id, 10)
+}
+
+func seoTemplateOutboxKey(name, locale string) string {
+	return "seo_template:" + name + ":" + locale
+}
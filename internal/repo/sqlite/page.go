@@ -0,0 +1,482 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"time"
+)
+
+var pageSorts = map[string]string{
+	md.SortSlug:      "slug",
+	md.SortTitle:     "title",
+	md.SortPriority:  "priority",
+	md.SortCreatedAt: "created_at",
+	md.SortUpdatedAt: "updated_at",
+}
+
+func (r *Repository) ListPages(ctx context.Context, f *md.PageFilter) (*md.PageList, error) {
+	const op = "pages.ListPages.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	w := &where{}
+	if f.Title != "" {
+		w.add("title LIKE " + w.arg(contains(f.Title)) + " ESCAPE '\\'")
+	}
+	if f.Href != "" {
+		w.add("href LIKE " + w.arg(contains(f.Href)) + " ESCAPE '\\'")
+	}
+	if f.Status != "" {
+		w.add("status = " + w.arg(f.Status))
+	}
+	if f.CreatedFrom != nil {
+		w.add("created_at >= " + w.arg(ts(*f.CreatedFrom)))
+	}
+	if f.CreatedTo != nil {
+		w.add("created_at <= " + w.arg(ts(*f.CreatedTo)))
+	}
+	if f.UpdatedFrom != nil {
+		w.add("updated_at >= " + w.arg(ts(*f.UpdatedFrom)))
+	}
+	if f.UpdatedTo != nil {
+		w.add("updated_at <= " + w.arg(ts(*f.UpdatedTo)))
+	}
+
+	res := &md.PageList{}
+	if err := r.conn.QueryRowContext(ctx, countPage+w.String(), w.args...).Scan(&res.Total); err != nil {
+		return nil, err
+	}
+
+	sort, ok := pageSorts[f.Sort]
+	if !ok {
+		sort = pageSorts[md.SortSlug]
+	}
+
+	dir, cmp := "ASC", ">"
+	if f.Order == md.OrderDesc {
+		dir, cmp = "DESC", "<"
+	}
+
+	size := f.Size
+	if size <= 0 {
+		size = config.DefaultSize
+	}
+
+	offset := 0
+	if f.Cursor != "" {
+		cur, err := md.DecodeCursor(f.Cursor)
+		if err != nil || cur.Sort != sort || len(cur.Key) != 1 {
+			return nil, md.ErrInvalidCursor
+		}
+
+		switch sort {
+		case "slug":
+			w.add("slug " + cmp + " " + w.arg(cur.Key[0]))
+		case "updated_at":
+			at, err := time.Parse(time.RFC3339Nano, cur.Value)
+			if err != nil {
+				return nil, md.ErrInvalidCursor
+			}
+			w.add("(updated_at, slug) " + cmp + " (" + w.arg(ts(at)) + ", " + w.arg(cur.Key[0]) + ")")
+		default:
+			return nil, md.ErrInvalidCursor
+		}
+	} else if f.Page > 1 {
+		offset = (f.Page - 1) * size
+	}
+
+	q := listPage + w.String() +
+		" ORDER BY " + sort + " " + dir + ", slug " + dir +
+		" LIMIT " + w.arg(size+1) + " OFFSET " + w.arg(offset)
+
+	rows, err := r.conn.QueryContext(ctx, q, w.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res.Pages = make([]*md.Page, 0, size)
+	for rows.Next() {
+		page, err := scanPage(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Pages = append(res.Pages, page)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(res.Pages) > size {
+		res.Pages = res.Pages[:size]
+		last := res.Pages[size-1]
+		switch sort {
+		case "slug":
+			res.NextCursor = md.Cursor{Sort: sort, Key: []string{last.Slug}}.Encode()
+		case "updated_at":
+			res.NextCursor = md.Cursor{
+				Sort: sort, Value: last.UpdatedAt.Format(time.RFC3339Nano), Key: []string{last.Slug},
+			}.Encode()
+		}
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetPage(ctx context.Context, slug string) (*md.Page, error) {
+	const op = "pages.GetPage.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanPage(r.conn.QueryRowContext(ctx, getPageBySlug, slug))
+	if err == sql.ErrNoRows {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetPageByHref finds the page whose normalized href equals path.
+func (r *Repository) GetPageByHref(ctx context.Context, path string) (*md.Page, error) {
+	const op = "pages.GetPageByHref.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanPage(r.conn.QueryRowContext(ctx, getPageByHref, md.NormalizeHref(path)))
+	if err == sql.ErrNoRows {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreatePage(ctx context.Context, req *md.Page) (string, error) {
+	const op = "pages.CreatePage.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	page, err := scanPage(
+		tx.QueryRowContext(
+			ctx,
+			createPage,
+			req.Slug,
+			req.Title,
+			req.Href,
+			req.ChangeFreq,
+			req.Priority,
+			req.ParentSlug,
+			req.Position,
+			req.Status,
+			nullTS(req.PublishAt),
+			md.NormalizeHref(req.Href),
+		),
+	)
+	if err == sql.ErrNoRows {
+		return "", repo.ErrAlreadyExists
+	} else if err != nil && isForeignKeyViolation(err) {
+		return "", repo.ErrParentNotFound
+	} else if err != nil && isUniqueViolation(err) {
+		return "", repo.ErrDuplicateHref
+	} else if err != nil {
+		return "", err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventPageCreated, pageOutboxKey(page.Slug), nil, page); err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return page.Slug, nil
+}
+
+func (r *Repository) UpdatePage(ctx context.Context, slug string, req *md.Page) error {
+	const op = "pages.UpdatePage.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := scanPage(tx.QueryRowContext(ctx, getPageBySlug, slug))
+	if err == sql.ErrNoRows {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if req.ParentSlug != before.ParentSlug {
+		if err = checkPageParent(ctx, tx, slug, req.ParentSlug); err != nil {
+			return err
+		}
+	}
+
+	after, err := scanPage(
+		tx.QueryRowContext(
+			ctx,
+			updatePage,
+			req.Title,
+			req.Href,
+			req.ChangeFreq,
+			req.Priority,
+			req.ParentSlug,
+			req.Position,
+			req.Status,
+			nullTS(req.PublishAt),
+			slug,
+			md.NormalizeHref(req.Href),
+		),
+	)
+	if err != nil && isForeignKeyViolation(err) {
+		return repo.ErrParentNotFound
+	} else if err != nil && isUniqueViolation(err) {
+		return repo.ErrDuplicateHref
+	} else if err != nil {
+		return err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventPageUpdated, pageOutboxKey(slug), before, after); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// checkPageParent walks up from parent and rejects placing slug under itself
+// or one of its descendants. Writes are serialized, so the chain cannot change
+// under the walk.
+func checkPageParent(ctx context.Context, tx *sql.Tx, slug, parent string) error {
+	for v := parent; v != ""; {
+		if v == slug {
+			return repo.ErrPageCycle
+		}
+
+		err := tx.QueryRowContext(ctx, getPageParent, v).Scan(&v)
+		if err == sql.ErrNoRows {
+			return repo.ErrParentNotFound
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeletePage removes the page according to strategy and returns the slugs of
+// every removed or re-parented page. Reject refuses pages with children,
+// cascade removes the whole subtree and reparent moves the children up to the
+// page's own parent.
+func (r *Repository) DeletePage(ctx context.Context, slug, strategy string) ([]string, error) {
+	const op = "pages.DeletePage.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var moved []*md.Page
+	q := deletePage
+	switch strategy {
+	case md.PageDeleteCascade:
+		q = deletePageTree
+	case md.PageDeleteReparent:
+		before, err := queryPages(ctx, tx, listPageChildren, slug)
+		if err != nil {
+			return nil, err
+		}
+
+		if moved, err = queryPages(ctx, tx, reparentPageChildren, slug); err != nil {
+			return nil, err
+		}
+
+		prev := make(map[string]*md.Page, len(before))
+		for _, v := range before {
+			prev[v.Slug] = v
+		}
+		for _, v := range moved {
+			if err = writeOutbox(ctx, tx, md.EventPageUpdated, pageOutboxKey(v.Slug), prev[v.Slug], v); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		var has bool
+		if err = tx.QueryRowContext(ctx, pageHasChildren, slug).Scan(&has); err != nil {
+			return nil, err
+		}
+		if has {
+			return nil, repo.ErrHasChildren
+		}
+	}
+
+	deleted, err := queryPages(ctx, tx, q, slug)
+	if err != nil && isForeignKeyViolation(err) {
+		return nil, repo.ErrHasChildren
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(deleted) == 0 {
+		return nil, repo.ErrNotFound
+	}
+
+	res := make([]string, 0, len(deleted)+len(moved))
+	for _, v := range deleted {
+		if err = writeOutbox(ctx, tx, md.EventPageDeleted, pageOutboxKey(v.Slug), v, nil); err != nil {
+			return nil, err
+		}
+		res = append(res, v.Slug)
+	}
+	for _, v := range moved {
+		res = append(res, v.Slug)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListPageTree returns every page ordered by position so that callers can
+// assemble the hierarchy in one pass.
+func (r *Repository) ListPageTree(ctx context.Context) ([]*md.Page, error) {
+	const op = "pages.ListPageTree.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return r.listPages(ctx, listPageTree)
+}
+
+// ListPageAncestors returns the chain from the root down to slug, inclusive.
+// The result is empty when slug does not exist.
+func (r *Repository) ListPageAncestors(ctx context.Context, slug string) ([]*md.Page, error) {
+	const op = "pages.ListPageAncestors.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return r.listPages(ctx, listPageAncestors, slug)
+}
+
+func (r *Repository) listPages(ctx context.Context, q string, args ...any) ([]*md.Page, error) {
+	rows, err := r.conn.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.Page, 0)
+	for rows.Next() {
+		page, err := scanPage(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, page)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func queryPages(ctx context.Context, tx *sql.Tx, q string, args ...any) ([]*md.Page, error) {
+	rows, err := tx.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.Page, 0)
+	for rows.Next() {
+		page, err := scanPage(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, page)
+	}
+	return res, rows.Err()
+}
+
+func scanPage(row scanner) (*md.Page, error) {
+	res := &md.Page{}
+	if err := row.Scan(
+		&res.Slug,
+		&res.Title,
+		&res.Href,
+		&res.ChangeFreq,
+		&res.Priority,
+		&res.ParentSlug,
+		&res.Position,
+		&res.Status,
+		&res.PublishAt,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PublishScheduledPages promotes scheduled pages whose publish_at has passed
+// and returns their slugs.
+func (r *Repository) PublishScheduledPages(ctx context.Context, now time.Time) ([]string, error) {
+	const op = "pages.PublishScheduledPages.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	before, err := queryPages(ctx, tx, listDuePages, ts(now))
+	if err != nil {
+		return nil, err
+	}
+
+	published, err := queryPages(ctx, tx, publishScheduledPages, ts(now))
+	if err != nil {
+		return nil, err
+	}
+
+	prev := make(map[string]*md.Page, len(before))
+	for _, v := range before {
+		prev[v.Slug] = v
+	}
+
+	res := make([]string, 0, len(published))
+	for _, v := range published {
+		if err = writeOutbox(ctx, tx, md.EventPageUpdated, pageOutboxKey(v.Slug), prev[v.Slug], v); err != nil {
+			return nil, err
+		}
+		res = append(res, v.Slug)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,19 @@
+package sqlite
+
+const createSEORevision = `
+INSERT INTO seo_revision (obj_name, obj_pk, locale, action, author, data)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6)
+`
+
+const listSEORevisions = `
+SELECT id, obj_name, obj_pk, locale, action, author, data, created_at
+FROM seo_revision
+WHERE obj_name = ?1 AND obj_pk = ?2
+ORDER BY id DESC
+`
+
+const getSEORevision = `
+SELECT id, obj_name, obj_pk, locale, action, author, data, created_at
+FROM seo_revision
+WHERE id = ?1 AND obj_name = ?2 AND obj_pk = ?3
+`
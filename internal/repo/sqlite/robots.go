@@ -0,0 +1,248 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListRobotsGroups(ctx context.Context) ([]*md.RobotsGroup, error) {
+	const op = "robots.ListRobotsGroups.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listRobotsGroups)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.RobotsGroup, 0)
+	for rows.Next() {
+		group, err := scanRobotsGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, group)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetRobotsGroup(ctx context.Context, id uint64) (*md.RobotsGroup, error) {
+	const op = "robots.GetRobotsGroup.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanRobotsGroup(r.conn.QueryRowContext(ctx, getRobotsGroup, id))
+	if err == sql.ErrNoRows {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateRobotsGroup(ctx context.Context, req *md.RobotsGroup) (uint64, error) {
+	const op = "robots.CreateRobotsGroup.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rules, err := json.Marshal(req.Rules)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	after, err := scanRobotsGroup(tx.QueryRowContext(ctx, createRobotsGroup, req.UserAgent, string(rules), req.CrawlDelay))
+	if err == sql.ErrNoRows {
+		return 0, repo.ErrAlreadyExists
+	} else if err != nil {
+		return 0, err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventRobotsGroupCreated, robotsGroupOutboxKey(after.ID), nil, after); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return after.ID, nil
+}
+
+func (r *Repository) UpdateRobotsGroup(ctx context.Context, id uint64, req *md.RobotsGroup) error {
+	const op = "robots.UpdateRobotsGroup.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rules, err := json.Marshal(req.Rules)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := scanRobotsGroup(tx.QueryRowContext(ctx, getRobotsGroup, id))
+	if err == sql.ErrNoRows {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	after, err := scanRobotsGroup(tx.QueryRowContext(ctx, updateRobotsGroup, req.UserAgent, string(rules), req.CrawlDelay, id))
+	if err != nil && isUniqueViolation(err) {
+		return repo.ErrAlreadyExists
+	} else if err != nil {
+		return err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventRobotsGroupUpdated, robotsGroupOutboxKey(id), before, after); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) DeleteRobotsGroup(ctx context.Context, id uint64) error {
+	const op = "robots.DeleteRobotsGroup.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := scanRobotsGroup(tx.QueryRowContext(ctx, deleteRobotsGroup, id))
+	if err == sql.ErrNoRows {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventRobotsGroupDeleted, robotsGroupOutboxKey(id), before, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) ListRobotsSitemaps(ctx context.Context) ([]*md.RobotsSitemap, error) {
+	const op = "robots.ListRobotsSitemaps.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listRobotsSitemaps)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.RobotsSitemap, 0)
+	for rows.Next() {
+		sm, err := scanRobotsSitemap(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, sm)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateRobotsSitemap(ctx context.Context, url string) (uint64, error) {
+	const op = "robots.CreateRobotsSitemap.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	after, err := scanRobotsSitemap(tx.QueryRowContext(ctx, createRobotsSitemap, url))
+	if err == sql.ErrNoRows {
+		return 0, repo.ErrAlreadyExists
+	} else if err != nil {
+		return 0, err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventRobotsSitemapCreated, robotsSitemapOutboxKey(after.ID), nil, after); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return after.ID, nil
+}
+
+func (r *Repository) DeleteRobotsSitemap(ctx context.Context, id uint64) error {
+	const op = "robots.DeleteRobotsSitemap.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := scanRobotsSitemap(tx.QueryRowContext(ctx, deleteRobotsSitemap, id))
+	if err == sql.ErrNoRows {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventRobotsSitemapDeleted, robotsSitemapOutboxKey(id), before, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func scanRobotsGroup(row scanner) (*md.RobotsGroup, error) {
+	res := &md.RobotsGroup{}
+	var rules []byte
+	if err := row.Scan(&res.ID, &res.UserAgent, &rules, &res.CrawlDelay, &res.CreatedAt, &res.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(rules, &res.Rules); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func scanRobotsSitemap(row scanner) (*md.RobotsSitemap, error) {
+	res := &md.RobotsSitemap{}
+	if err := row.Scan(&res.ID, &res.URL, &res.CreatedAt); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
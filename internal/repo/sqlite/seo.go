@@ -0,0 +1,513 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"strings"
+	"time"
+)
+
+func (r *Repository) GetSEO(ctx context.Context, name, pk, locale string) (*md.SEO, error) {
+	const op = "seo.GetSEO.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanSEO(r.conn.QueryRowContext(ctx, getSEO, name, pk, locale))
+	if err == sql.ErrNoRows {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateSEO(ctx context.Context, req *md.SEO) (string, string, error) {
+	const op = "seo.CreateSEO.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	args, err := seoArgs(req)
+	if err != nil {
+		return "", "", err
+	}
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", "", err
+	}
+	defer tx.Rollback()
+
+	var name, pk string
+	err = tx.QueryRowContext(ctx, createSEO, args...).Scan(&name, &pk)
+	if err == sql.ErrNoRows {
+		return "", "", repo.ErrAlreadyExists
+	} else if err != nil {
+		return "", "", err
+	}
+
+	seo, err := writeSEORevision(ctx, tx, md.RevisionCreate, name, pk, req.Locale)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventSEOCreated, seoOutboxKey(seo), nil, seo); err != nil {
+		return "", "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", "", err
+	}
+
+	return name, pk, nil
+}
+
+func (r *Repository) UpdateSEO(ctx context.Context, req *md.SEO) error {
+	const op = "seo.UpdateSEO.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	args, err := seoArgs(req)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := scanSEO(tx.QueryRowContext(ctx, getSEO, req.OBJName, req.OBJPK, req.Locale))
+	if err == sql.ErrNoRows {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, updateSEO, append(args, req.OBJName, req.OBJPK, req.Locale)...); err != nil {
+		return err
+	}
+
+	after, err := writeSEORevision(ctx, tx, md.RevisionUpdate, req.OBJName, req.OBJPK, req.Locale)
+	if err != nil {
+		return err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventSEOUpdated, seoOutboxKey(after), before, after); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) DeleteSEO(ctx context.Context, name, pk, locale string) error {
+	const op = "seo.DeleteSEO.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	seo, err := scanSEO(tx.QueryRowContext(ctx, getSEO, name, pk, locale))
+	if err == sql.ErrNoRows {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteSEO, name, pk, locale); err != nil {
+		return err
+	}
+
+	if err = insertSEORevision(ctx, tx, md.RevisionDelete, seo); err != nil {
+		return err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventSEODeleted, seoOutboxKey(seo), seo, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PublishScheduledSEO promotes scheduled records whose publish_at has passed
+// and returns their keys.
+func (r *Repository) PublishScheduledSEO(ctx context.Context, now time.Time) ([]*md.SEO, error) {
+	const op = "seo.PublishScheduledSEO.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	before := make(map[seoKey]*md.SEO)
+	rows, err := tx.QueryContext(ctx, listDueSEO, ts(now))
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		seo, err := scanSEO(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		before[seoKey{seo.OBJName, seo.OBJPK, seo.Locale}] = seo
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = tx.QueryContext(ctx, publishScheduledSEO, ts(now))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*md.SEO, 0)
+	for rows.Next() {
+		seo := &md.SEO{}
+		if err = rows.Scan(&seo.OBJName, &seo.OBJPK, &seo.Locale); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		res = append(res, seo)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, seo := range res {
+		after, err := writeSEORevision(ctx, tx, md.RevisionPublish, seo.OBJName, seo.OBJPK, seo.Locale)
+		if err != nil {
+			return nil, err
+		}
+
+		prev := before[seoKey{seo.OBJName, seo.OBJPK, seo.Locale}]
+		if err = writeOutbox(ctx, tx, md.EventSEOUpdated, seoOutboxKey(after), prev, after); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+var seoSorts = map[string]string{
+	md.SortOBJPK:     "",
+	md.SortTitle:     "title",
+	md.SortCreatedAt: "created_at",
+	md.SortUpdatedAt: "updated_at",
+}
+
+// seoMissing maps SEOFilter.Missing entries to their emptiness condition.
+var seoMissing = map[string]string{
+	"description":    "COALESCE(description, '') = ''",
+	"keywords":       "COALESCE(keywords, '') = ''",
+	"og_title":       "COALESCE(og_title, '') = ''",
+	"og_description": "COALESCE(og_description, '') = ''",
+	"og_image":       "COALESCE(og_image, '') = ''",
+	"og_type":        "og_type = ''",
+	"og_url":         "og_url = ''",
+	"og_image_alt":   "og_image_alt = ''",
+	"twitter_card":   "twitter_card = ''",
+	"json_ld":        "json_ld = '[]'",
+}
+
+func (r *Repository) ListSEO(ctx context.Context, f *md.SEOFilter) (*md.SEOList, error) {
+	const op = "seo.ListSEO.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	w := &where{}
+	if f.OBJName != "" {
+		w.add("obj_name = " + w.arg(f.OBJName))
+	}
+	if f.PKPrefix != "" {
+		// LIKE would ignore case here.
+		p := w.arg(f.PKPrefix)
+		w.add("substr(obj_pk, 1, length(" + p + ")) = " + p)
+	}
+	if f.Locale != "" {
+		w.add("locale = " + w.arg(f.Locale))
+	}
+	if f.Status != "" {
+		w.add("status = " + w.arg(f.Status))
+	}
+	if f.UpdatedFrom != nil {
+		w.add("updated_at >= " + w.arg(ts(*f.UpdatedFrom)))
+	}
+	if f.UpdatedTo != nil {
+		w.add("updated_at <= " + w.arg(ts(*f.UpdatedTo)))
+	}
+	if len(f.Missing) > 0 {
+		conds := make([]string, 0, len(f.Missing))
+		for _, field := range f.Missing {
+			cond, ok := seoMissing[field]
+			if !ok {
+				return nil, fmt.Errorf("unknown field %q", field)
+			}
+			conds = append(conds, cond)
+		}
+		w.add("(" + strings.Join(conds, " OR ") + ")")
+	}
+
+	res := &md.SEOList{}
+	if err := r.conn.QueryRowContext(ctx, countSEO+w.String(), w.args...).Scan(&res.Total); err != nil {
+		return nil, err
+	}
+
+	sortName := f.Sort
+	sort, ok := seoSorts[sortName]
+	if !ok {
+		sortName, sort = md.SortOBJPK, seoSorts[md.SortOBJPK]
+	}
+
+	dir, cmp := "ASC", ">"
+	if f.Order == md.OrderDesc {
+		dir, cmp = "DESC", "<"
+	}
+
+	size := f.Size
+	if size <= 0 {
+		size = config.DefaultSize
+	}
+
+	offset := 0
+	if f.Cursor != "" {
+		cur, err := md.DecodeCursor(f.Cursor)
+		if err != nil || cur.Sort != sortName || len(cur.Key) != 3 {
+			return nil, md.ErrInvalidCursor
+		}
+
+		key := w.arg(cur.Key[0]) + ", " + w.arg(cur.Key[1]) + ", " + w.arg(cur.Key[2])
+		switch sort {
+		case "":
+			w.add("(obj_name, obj_pk, locale) " + cmp + " (" + key + ")")
+		case "updated_at":
+			at, err := time.Parse(time.RFC3339Nano, cur.Value)
+			if err != nil {
+				return nil, md.ErrInvalidCursor
+			}
+			w.add("(updated_at, obj_name, obj_pk, locale) " + cmp + " (" + w.arg(ts(at)) + ", " + key + ")")
+		default:
+			return nil, md.ErrInvalidCursor
+		}
+	} else if f.Page > 1 {
+		offset = (f.Page - 1) * size
+	}
+
+	order := "obj_name " + dir + ", obj_pk " + dir + ", locale " + dir
+	if sort != "" {
+		order = sort + " " + dir + ", " + order
+	}
+
+	q := listSEO + w.String() +
+		" ORDER BY " + order +
+		" LIMIT " + w.arg(size+1) + " OFFSET " + w.arg(offset)
+
+	rows, err := r.conn.QueryContext(ctx, q, w.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res.SEO = make([]*md.SEO, 0, size)
+	for rows.Next() {
+		seo, err := scanSEO(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.SEO = append(res.SEO, seo)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(res.SEO) > size {
+		res.SEO = res.SEO[:size]
+		last := res.SEO[size-1]
+		key := []string{last.OBJName, last.OBJPK, last.Locale}
+		switch sort {
+		case "":
+			res.NextCursor = md.Cursor{Sort: md.SortOBJPK, Key: key}.Encode()
+		case "updated_at":
+			res.NextCursor = md.Cursor{
+				Sort: md.SortUpdatedAt, Value: last.UpdatedAt.Format(time.RFC3339Nano), Key: key,
+			}.Encode()
+		}
+	}
+
+	return res, nil
+}
+
+func (r *Repository) ListSEOForSitemap(ctx context.Context, names []string) ([]*md.SEO, error) {
+	const op = "seo.ListSEOForSitemap.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listSEOForSitemap, stringArray(names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.SEO, 0, len(names))
+	for rows.Next() {
+		seo := &md.SEO{}
+		var updated string
+		if err = rows.Scan(&seo.OBJName, &seo.OBJPK, &updated); err != nil {
+			return nil, err
+		}
+		if seo.UpdatedAt, err = parseTS(updated); err != nil {
+			return nil, err
+		}
+		res = append(res, seo)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) ListSEOLocales(ctx context.Context, name, pk string) ([]*md.SEO, error) {
+	const op = "seo.ListSEOLocales.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listSEOLocales, name, pk)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.SEO, 0)
+	for rows.Next() {
+		seo := &md.SEO{OBJName: name, OBJPK: pk}
+		if err = rows.Scan(&seo.Locale, &seo.UpdatedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, seo)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanSEO(row scanner) (*md.SEO, error) {
+	res := &md.SEO{}
+	var jsonLD []byte
+	err := row.Scan(
+		&res.Title,
+		&res.Description,
+		&res.Keywords,
+		&res.OGTitle,
+		&res.OGDescription,
+		&res.OGImage,
+		&res.OGType,
+		&res.OGURL,
+		&res.OGLocale,
+		&res.OGSiteName,
+		&res.OGImageWidth,
+		&res.OGImageHeight,
+		&res.OGImageAlt,
+		&res.OGImageType,
+		&res.ArticlePublishedTime,
+		&res.ArticleModifiedTime,
+		(*stringArray)(&res.ArticleAuthor),
+		&res.ArticleSection,
+		(*stringArray)(&res.ArticleTag),
+		&res.TwitterCard,
+		&res.TwitterSite,
+		&res.TwitterCreator,
+		&res.TwitterImageAlt,
+		&res.OBJName,
+		&res.OBJPK,
+		&res.Locale,
+		&jsonLD,
+		&res.Status,
+		&res.PublishAt,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(jsonLD, &res.JSONLD); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// seoArgs returns query arguments in seoColumns order.
+func seoArgs(req *md.SEO) ([]any, error) {
+	jsonLD, err := marshalJSONLD(req.JSONLD)
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{
+		req.Title,
+		req.Description,
+		req.Keywords,
+		req.OGTitle,
+		req.OGDescription,
+		req.OGImage,
+		req.OGType,
+		req.OGURL,
+		req.OGLocale,
+		req.OGSiteName,
+		req.OGImageWidth,
+		req.OGImageHeight,
+		req.OGImageAlt,
+		req.OGImageType,
+		nullTS(req.ArticlePublishedTime),
+		nullTS(req.ArticleModifiedTime),
+		stringArray(req.ArticleAuthor),
+		req.ArticleSection,
+		stringArray(req.ArticleTag),
+		req.TwitterCard,
+		req.TwitterSite,
+		req.TwitterCreator,
+		req.TwitterImageAlt,
+		req.OBJName,
+		req.OBJPK,
+		req.Locale,
+		string(jsonLD),
+		req.Status,
+		nullTS(req.PublishAt),
+	}, nil
+}
+
+// marshalJSONLD stores a missing block list as an empty JSON array rather than null.
+func marshalJSONLD(blocks []map[string]any) ([]byte, error) {
+	if blocks == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(blocks)
+}
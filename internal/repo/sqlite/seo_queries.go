@@ -0,0 +1,126 @@
+package sqlite
+
+// seoColumns lists SEO columns in the order expected by scanSEO and seoArgs.
+const seoColumns = `
+	title,
+	description,
+	keywords,
+	og_title,
+	og_description,
+	og_image,
+	og_type,
+	og_url,
+	og_locale,
+	og_site_name,
+	og_image_width,
+	og_image_height,
+	og_image_alt,
+	og_image_type,
+	article_published_time,
+	article_modified_time,
+	article_author,
+	article_section,
+	article_tag,
+	twitter_card,
+	twitter_site,
+	twitter_creator,
+	twitter_image_alt,
+	obj_name,
+	obj_pk,
+	locale,
+	json_ld,
+	status,
+	publish_at`
+
+const listSEO = `
+SELECT ` + seoColumns + `, created_at, updated_at
+FROM seo
+`
+
+const countSEO = `
+SELECT COUNT(*) 
+FROM seo
+`
+
+const getSEO = `
+SELECT ` + seoColumns + `, created_at, updated_at
+FROM seo
+WHERE obj_name = ?1 AND obj_pk = ?2 AND locale = ?3
+`
+
+const createSEO = `
+INSERT INTO seo (` + seoColumns + `
+) 
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15, ?16, ?17, ?18, ?19, ?20, ?21, ?22, ?23, ?24, ?25, ?26, ?27, ?28, ?29)
+ON CONFLICT (obj_name, obj_pk, locale) DO NOTHING
+RETURNING obj_name, obj_pk
+`
+
+const updateSEO = `
+UPDATE seo 
+SET 
+	title = ?1,
+	description = ?2, 
+	keywords = ?3, 
+	og_title = ?4, 
+	og_description = ?5, 
+	og_image = ?6,
+	og_type = ?7,
+	og_url = ?8,
+	og_locale = ?9,
+	og_site_name = ?10,
+	og_image_width = ?11,
+	og_image_height = ?12,
+	og_image_alt = ?13,
+	og_image_type = ?14,
+	article_published_time = ?15,
+	article_modified_time = ?16,
+	article_author = ?17,
+	article_section = ?18,
+	article_tag = ?19,
+	twitter_card = ?20,
+	twitter_site = ?21,
+	twitter_creator = ?22,
+	twitter_image_alt = ?23,
+	obj_name = ?24, 
+	obj_pk = ?25,
+	locale = ?26,
+	json_ld = ?27,
+	status = ?28,
+	publish_at = ?29,
+	updated_at = ` + currentTimestamp + `
+WHERE obj_name = ?30 AND obj_pk = ?31 AND locale = ?32
+`
+
+const deleteSEO = `
+DELETE FROM seo 
+WHERE obj_name = ?1 AND obj_pk = ?2 AND locale = ?3
+`
+
+const listSEOForSitemap = `
+SELECT obj_name, obj_pk, MAX(updated_at)
+FROM seo
+WHERE obj_name IN (SELECT value FROM json_each(?1)) AND status = 'published'
+GROUP BY obj_name, obj_pk
+ORDER BY obj_name, obj_pk
+`
+
+const listSEOLocales = `
+SELECT locale, updated_at
+FROM seo
+WHERE obj_name = ?1 AND obj_pk = ?2 AND status = 'published'
+ORDER BY locale
+`
+
+const listDueSEO = `
+SELECT ` + seoColumns + `, created_at, updated_at
+FROM seo
+WHERE status = 'scheduled' AND publish_at <= ?1
+`
+
+const publishScheduledSEO = `
+UPDATE seo
+SET status = 'published', updated_at = ` + currentTimestamp + `
+WHERE status = 'scheduled' AND publish_at <= ?1
+RETURNING obj_name, obj_pk, locale
+`
@@ -0,0 +1,149 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListSEOTemplates(ctx context.Context) ([]*md.SEOTemplate, error) {
+	const op = "template.ListSEOTemplates.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listSEOTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.SEOTemplate, 0)
+	for rows.Next() {
+		tpl, err := scanSEOTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, tpl)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetSEOTemplate(ctx context.Context, name, locale string) (*md.SEOTemplate, error) {
+	const op = "template.GetSEOTemplate.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanSEOTemplate(r.conn.QueryRowContext(ctx, getSEOTemplate, name, locale))
+	if err == sql.ErrNoRows {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SaveSEOTemplate creates or replaces the template and reports whether it was
+// created.
+func (r *Repository) SaveSEOTemplate(ctx context.Context, req *md.SEOTemplate) (bool, error) {
+	const op = "template.SaveSEOTemplate.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	before, err := scanSEOTemplate(tx.QueryRowContext(ctx, getSEOTemplate, req.OBJName, req.Locale))
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
+
+	after, err := scanSEOTemplate(
+		tx.QueryRowContext(
+			ctx, saveSEOTemplate,
+			req.OBJName,
+			req.Locale,
+			req.Title,
+			req.Description,
+			req.Keywords,
+			req.OGTitle,
+			req.OGDescription,
+			req.OGImage,
+			req.OGType,
+			req.OGURL,
+		),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	event := md.EventSEOTemplateUpdated
+	if before == nil {
+		event = md.EventSEOTemplateCreated
+	}
+	if err = writeOutbox(ctx, tx, event, seoTemplateOutboxKey(req.OBJName, req.Locale), before, after); err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, err
+	}
+	return before == nil, nil
+}
+
+func (r *Repository) DeleteSEOTemplate(ctx context.Context, name, locale string) error {
+	const op = "template.DeleteSEOTemplate.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := scanSEOTemplate(tx.QueryRowContext(ctx, deleteSEOTemplate, name, locale))
+	if err == sql.ErrNoRows {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err = writeOutbox(ctx, tx, md.EventSEOTemplateDeleted, seoTemplateOutboxKey(name, locale), before, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func scanSEOTemplate(row scanner) (*md.SEOTemplate, error) {
+	res := &md.SEOTemplate{}
+	err := row.Scan(
+		&res.OBJName,
+		&res.Locale,
+		&res.Title,
+		&res.Description,
+		&res.Keywords,
+		&res.OGTitle,
+		&res.OGDescription,
+		&res.OGImage,
+		&res.OGType,
+		&res.OGURL,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
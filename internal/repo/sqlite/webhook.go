@@ -0,0 +1,267 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"github.com/JMURv/seo/internal/config"
+	md "github.com/JMURv/seo/internal/models"
+	"github.com/JMURv/seo/internal/repo"
+	ot "github.com/opentracing/opentracing-go"
+	"time"
+)
+
+func (r *Repository) ListWebhooks(ctx context.Context) ([]*md.Webhook, error) {
+	const op = "webhook.ListWebhooks.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listWebhooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.Webhook, 0)
+	for rows.Next() {
+		wh, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, wh)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetWebhook(ctx context.Context, id uint64) (*md.Webhook, error) {
+	const op = "webhook.GetWebhook.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanWebhook(r.conn.QueryRowContext(ctx, getWebhook, id))
+	if err == sql.ErrNoRows {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateWebhook(ctx context.Context, req *md.Webhook) (uint64, error) {
+	const op = "webhook.CreateWebhook.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var id uint64
+	err := r.conn.QueryRowContext(
+		ctx, createWebhook, req.URL, req.Secret, stringArray(req.Events), req.Disabled,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateWebhook replaces the subscription; an empty Secret keeps the stored one.
+func (r *Repository) UpdateWebhook(ctx context.Context, id uint64, req *md.Webhook) error {
+	const op = "webhook.UpdateWebhook.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(
+		ctx, updateWebhook, req.URL, req.Secret, stringArray(req.Events), req.Disabled, id,
+	)
+	if err != nil {
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteWebhook(ctx context.Context, id uint64) error {
+	const op = "webhook.DeleteWebhook.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, deleteWebhook, id)
+	if err != nil {
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries due by now,
+// with the target URL and secret, and hides them from other workers until
+// now+lease.
+func (r *Repository) ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*md.WebhookDelivery, error) {
+	const op = "webhook.ClaimWebhookDeliveries.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, claimWebhookDeliveries, ts(now), ts(now.Add(lease)), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.WebhookDelivery, 0, limit)
+	for rows.Next() {
+		d := &md.WebhookDelivery{Status: md.DeliveryPending}
+		if err = rows.Scan(
+			&d.ID,
+			&d.WebhookID,
+			&d.Event,
+			(*[]byte)(&d.Payload),
+			&d.Attempts,
+			&d.CreatedAt,
+			&d.URL,
+			&d.Secret,
+		); err != nil {
+			return nil, err
+		}
+		res = append(res, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FinishWebhookDelivery stores the outcome of an attempt.
+func (r *Repository) FinishWebhookDelivery(ctx context.Context, d *md.WebhookDelivery) error {
+	const op = "webhook.FinishWebhookDelivery.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	_, err := r.conn.ExecContext(
+		ctx, finishWebhookDelivery,
+		d.Status, d.Attempts, d.ResponseCode, d.LastError, ts(d.NextAttemptAt), nullTS(d.DeliveredAt), d.ID,
+	)
+	return err
+}
+
+// RetryWebhookDelivery puts a delivery back in the queue with a fresh attempt
+// budget, whatever its current status.
+func (r *Repository) RetryWebhookDelivery(ctx context.Context, id uint64) error {
+	const op = "webhook.RetryWebhookDelivery.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, retryWebhookDelivery, id)
+	if err != nil {
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries returns the delivery log of a webhook, newest first.
+func (r *Repository) ListWebhookDeliveries(ctx context.Context, f *md.WebhookDeliveryFilter) (*md.WebhookDeliveryList, error) {
+	const op = "webhook.ListWebhookDeliveries.repo.sqlite"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	w := &where{}
+	w.add("webhook_id = " + w.arg(f.WebhookID))
+	if f.Status != "" {
+		w.add("status = " + w.arg(f.Status))
+	}
+
+	res := &md.WebhookDeliveryList{}
+	if err := r.conn.QueryRowContext(ctx, countWebhookDeliveries+w.String(), w.args...).Scan(&res.Total); err != nil {
+		return nil, err
+	}
+
+	page, size := f.Page, f.Size
+	if page <= 0 {
+		page = config.DefaultPage
+	}
+	if size <= 0 {
+		size = config.DefaultSize
+	}
+
+	q := listWebhookDeliveries + w.String() + " ORDER BY id DESC LIMIT " + w.arg(size) + " OFFSET " + w.arg((page-1)*size)
+	rows, err := r.conn.QueryContext(ctx, q, w.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res.Deliveries = make([]*md.WebhookDelivery, 0, size)
+	for rows.Next() {
+		d := &md.WebhookDelivery{}
+		if err = rows.Scan(
+			&d.ID,
+			&d.WebhookID,
+			&d.Event,
+			(*[]byte)(&d.Payload),
+			&d.Status,
+			&d.Attempts,
+			&d.ResponseCode,
+			&d.LastError,
+			&d.NextAttemptAt,
+			&d.DeliveredAt,
+			&d.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		res.Deliveries = append(res.Deliveries, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanWebhook(row scanner) (*md.Webhook, error) {
+	res := &md.Webhook{}
+	if err := row.Scan(
+		&res.ID,
+		&res.URL,
+		(*stringArray)(&res.Events),
+		&res.Disabled,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package sqlite
+
+const listWebhooks = `
+SELECT id, url, events, disabled, created_at, updated_at
+FROM webhook
+ORDER BY id
+`
+
+const getWebhook = `
+SELECT id, url, events, disabled, created_at, updated_at
+FROM webhook
+WHERE id = ?1
+`
+
+const createWebhook = `
+INSERT INTO webhook (url, secret, events, disabled)
+VALUES (?1, ?2, ?3, ?4)
+RETURNING id
+`
+
+const updateWebhook = `
+UPDATE webhook
+SET url = ?1, secret = COALESCE(NULLIF(?2, ''), secret), events = ?3, disabled = ?4, updated_at = ` + currentTimestamp + `
+WHERE id = ?5
+`
+
+const deleteWebhook = `
+DELETE FROM webhook
+WHERE id = ?1
+`
+
+const enqueueWebhookEvent = `
+INSERT INTO webhook_delivery (webhook_id, event, payload)
+SELECT id, ?1, ?2
+FROM webhook
+WHERE NOT disabled AND EXISTS (SELECT 1 FROM json_each(events) WHERE value IN (?1, '*'))
+`
+
+// claimWebhookDeliveries leases due deliveries by pushing next_attempt_at to
+// ?2, so a worker that dies mid-send only delays them. RETURNING cannot read
+// the joined webhook, hence the subqueries.
+const claimWebhookDeliveries = `
+UPDATE webhook_delivery
+SET next_attempt_at = ?2
+WHERE id IN (
+    SELECT id FROM webhook_delivery
+    WHERE status = 'pending' AND next_attempt_at <= ?1
+    ORDER BY next_attempt_at, id
+    LIMIT ?3
+)
+RETURNING id, webhook_id, event, payload, attempts, created_at,
+    (SELECT url FROM webhook WHERE webhook.id = webhook_id),
+    (SELECT secret FROM webhook WHERE webhook.id = webhook_id)
+`
+
+const finishWebhookDelivery = `
+UPDATE webhook_delivery
+SET status = ?1, attempts = ?2, response_code = ?3, last_error = ?4, next_attempt_at = ?5, delivered_at = ?6
+WHERE id = ?7
+`
+
+const retryWebhookDelivery = `
+UPDATE webhook_delivery
+SET status = 'pending', attempts = 0, next_attempt_at = ` + currentTimestamp + `
+WHERE id = ?1
+`
+
+const countWebhookDeliveries = `SELECT COUNT(*) FROM webhook_delivery`
+
+const listWebhookDeliveries = `
+SELECT id, webhook_id, event, payload, status, attempts, response_code, last_error, next_attempt_at, delivered_at, created_at
+FROM webhook_delivery
+`
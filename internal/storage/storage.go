@@ -0,0 +1,61 @@
+// Package storage builds the repository and cache a configuration asks for,
+// so the service and its end-to-end tests run on the same storage.
+package storage
+
+import (
+	memcache "github.com/JMURv/seo/internal/cache/memory"
+	"github.com/JMURv/seo/internal/cache/redis"
+	"github.com/JMURv/seo/internal/cache/tiered"
+	"github.com/JMURv/seo/internal/config"
+	"github.com/JMURv/seo/internal/ctrl"
+	"github.com/JMURv/seo/internal/repo/db"
+	memrepo "github.com/JMURv/seo/internal/repo/memory"
+	"github.com/JMURv/seo/internal/repo/sqlite"
+	"io"
+)
+
+// Memory is the storage value that keeps data and cache in process.
+const Memory = "memory"
+
+// Repo is a repository its owner closes on shutdown.
+type Repo interface {
+	ctrl.AppRepo
+	io.Closer
+}
+
+// MustNew keeps data and cache in process with `storage: memory`, otherwise
+// data lives in the database picked by db.driver and the cache in Redis.
+// "postgres" is the name db had before SQLite was supported.
+func MustNew(conf *config.Config) (Repo, ctrl.CacheService) {
+	switch conf.Storage {
+	case Memory:
+		return memrepo.New(), memcache.New()
+	case "", "db", "postgres":
+		if conf.DB == nil {
+			panic("storage: db requires a db section")
+		}
+		return mustNewRepo(conf.DB), mustNewCache(conf)
+	default:
+		panic("unknown storage: " + conf.Storage)
+	}
+}
+
+func mustNewRepo(conf *config.DBConfig) Repo {
+	switch conf.Driver {
+	case "", "postgres":
+		return db.New(conf)
+	case "sqlite":
+		return sqlite.New(conf)
+	default:
+		panic("unknown db driver: " + conf.Driver)
+	}
+}
+
+// mustNewCache puts the in-process L1 in front of Redis when configured.
+func mustNewCache(conf *config.Config) ctrl.CacheService {
+	l2 := redis.New(conf.Redis)
+	if conf.Cache == nil || conf.Cache.L1 == nil {
+		return l2
+	}
+	return tiered.New(l2, conf.Cache.L1)
+}
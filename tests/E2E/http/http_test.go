@@ -3,19 +3,21 @@ package http
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	pb "github.com/JMURv/protos/par-pro"
-	"github.com/JMURv/seo/internal/cache/redis"
 	"github.com/JMURv/seo/internal/config"
 	"github.com/JMURv/seo/internal/ctrl"
 	"github.com/JMURv/seo/internal/ctrl/sso"
 	hdl "github.com/JMURv/seo/internal/hdl/http"
-	"github.com/JMURv/seo/internal/repo/db"
+	"github.com/JMURv/seo/internal/storage"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"io/fs"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"strings"
 )
 
@@ -30,60 +32,34 @@ func setupTestServer() (*httptest.Server, func(ctx context.Context, email, passw
 	zap.ReplaceGlobals(zap.Must(zap.NewDevelopment()))
 	conf := config.MustLoad(configPath)
 
-	repo := db.New(conf.DB)
-	cache := redis.New(conf.Redis)
-	svc := ctrl.New(repo, cache)
+	repo, cache := storage.MustNew(conf)
+	svc := ctrl.New(repo, cache, conf)
 	h := hdl.New(svc, sso.New(conf.Services))
 
 	mux := http.NewServeMux()
 	hdl.RegisterSEORoutes(mux, h)
 	hdl.RegisterPageRoutes(mux, h)
+	hdl.RegisterSitemapRoutes(mux, h)
+	hdl.RegisterRobotsRoutes(mux, h)
+	hdl.RegisterRedirectRoutes(mux, h)
 
+	// In-memory data goes away with the repository; a database is emptied.
 	cleanupFunc := func() {
-		conn, err := sql.Open(
-			"postgres", fmt.Sprintf(
-				"postgres://%s:%s@%s:%d/%s?sslmode=disable",
-				conf.DB.User,
-				conf.DB.Password,
-				conf.DB.Host,
-				conf.DB.Port,
-				conf.DB.Database,
-			),
-		)
-		if err != nil {
-			zap.L().Fatal("Failed to connect to the database", zap.Error(err))
+		if err := cache.Close(); err != nil {
+			zap.L().Debug("Error while closing cache", zap.Error(err))
 		}
-
-		if err = conn.Ping(); err != nil {
-			zap.L().Fatal("Failed to ping the database", zap.Error(err))
+		if err := repo.Close(); err != nil {
+			zap.L().Debug("Error while closing repository", zap.Error(err))
 		}
 
-		rows, err := conn.Query(getTables)
-		if err != nil {
-			zap.L().Fatal("Failed to fetch table names", zap.Error(err))
-		}
-		defer func(rows *sql.Rows) {
-			if err := rows.Close(); err != nil {
-				zap.L().Debug("Error while closing rows", zap.Error(err))
-			}
-		}(rows)
-
-		var tables []string
-		for rows.Next() {
-			var name string
-			if err := rows.Scan(&name); err != nil {
-				zap.L().Fatal("Failed to scan table name", zap.Error(err))
+		switch {
+		case conf.Storage == storage.Memory:
+		case conf.DB.Driver == "sqlite":
+			if err := os.Remove(conf.DB.Database); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				zap.L().Fatal("Failed to remove the database", zap.Error(err))
 			}
-			tables = append(tables, name)
-		}
-
-		if len(tables) == 0 {
-			return
-		}
-
-		_, err = conn.Exec(fmt.Sprintf("TRUNCATE TABLE %v RESTART IDENTITY CASCADE;", strings.Join(tables, ", ")))
-		if err != nil {
-			zap.L().Fatal("Failed to truncate tables", zap.Error(err))
+		default:
+			truncateTables(conf.DB)
 		}
 	}
 
@@ -120,3 +96,56 @@ func setupTestServer() (*httptest.Server, func(ctx context.Context, email, passw
 
 	return httptest.NewServer(mux), sendAuthRequest, cleanupFunc
 }
+
+func truncateTables(conf *config.DBConfig) {
+	conn, err := sql.Open(
+		"postgres", fmt.Sprintf(
+			"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+			conf.User,
+			conf.Password,
+			conf.Host,
+			conf.Port,
+			conf.Database,
+		),
+	)
+	if err != nil {
+		zap.L().Fatal("Failed to connect to the database", zap.Error(err))
+	}
+	defer func(conn *sql.DB) {
+		if err := conn.Close(); err != nil {
+			zap.L().Debug("Error while closing connection", zap.Error(err))
+		}
+	}(conn)
+
+	if err = conn.Ping(); err != nil {
+		zap.L().Fatal("Failed to ping the database", zap.Error(err))
+	}
+
+	rows, err := conn.Query(getTables)
+	if err != nil {
+		zap.L().Fatal("Failed to fetch table names", zap.Error(err))
+	}
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Debug("Error while closing rows", zap.Error(err))
+		}
+	}(rows)
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			zap.L().Fatal("Failed to scan table name", zap.Error(err))
+		}
+		tables = append(tables, name)
+	}
+
+	if len(tables) == 0 {
+		return
+	}
+
+	_, err = conn.Exec(fmt.Sprintf("TRUNCATE TABLE %v RESTART IDENTITY CASCADE;", strings.Join(tables, ", ")))
+	if err != nil {
+		zap.L().Fatal("Failed to truncate tables", zap.Error(err))
+	}
+}
@@ -37,9 +37,11 @@ func TestPages(t *testing.T) {
 
 		require.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var pages []model.Page
-		require.Nil(t, json.NewDecoder(resp.Body).Decode(&pages))
-		return pages
+		var res struct {
+			Data []model.Page `json:"data"`
+		}
+		require.Nil(t, json.NewDecoder(resp.Body).Decode(&res))
+		return res.Data
 	}
 
 	getPage := func(slug string) *model.Page {
@@ -2,6 +2,7 @@
 
 # Конфигурация
 BASE_URL="http://localhost:8080"
+METRICS_URL="http://localhost:8085/metrics"
 RATE="100/s"        # RPS
 DURATION="30s"      # Длительность теста
 
@@ -84,8 +85,14 @@ vegeta attack \
 echo "Генерируем отчеты..."
 vegeta report $ROOT_DIR/results.bin > $ROOT_DIR/report.txt
 
+# Счетчики кэша: hit, miss, stale (устаревшая запись во время обновления),
+# coalesced (промах, дождавшийся чужой загрузки из БД)
+echo "Собираем метрики кэша..."
+curl -s "$METRICS_URL" | grep '^svc_cache_requests_total' > $ROOT_DIR/cache_report.txt
+
 echo "Тестирование завершено!"
 echo "Результаты:"
 echo " - Текстовый отчет: report.txt"
+echo " - Метрики кэша: cache_report.txt"
 
 rm -f $ROOT_DIR/targets.txt $ROOT_DIR/results.bin
\ No newline at end of file
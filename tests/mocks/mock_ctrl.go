@@ -43,263 +43,1523 @@ func (m *MockAppRepo) EXPECT() *MockAppRepoMockRecorder {
 	return m.recorder
 }
 
+// ClaimOutboxEvents mocks base method.
+func (m *MockAppRepo) ClaimOutboxEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOutboxEvents", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]*models.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOutboxEvents indicates an expected call of ClaimOutboxEvents.
+func (mr *MockAppRepoMockRecorder) ClaimOutboxEvents(ctx, now, lease, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOutboxEvents", reflect.TypeOf((*MockAppRepo)(nil).ClaimOutboxEvents), ctx, now, lease, limit)
+}
+
+// ClaimWebhookDeliveries mocks base method.
+func (m *MockAppRepo) ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookDeliveries", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWebhookDeliveries indicates an expected call of ClaimWebhookDeliveries.
+func (mr *MockAppRepoMockRecorder) ClaimWebhookDeliveries(ctx, now, lease, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookDeliveries", reflect.TypeOf((*MockAppRepo)(nil).ClaimWebhookDeliveries), ctx, now, lease, limit)
+}
+
+// CountSEODuplicates mocks base method.
+func (m *MockAppRepo) CountSEODuplicates(ctx context.Context, req *models.SEO) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSEODuplicates", ctx, req)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountSEODuplicates indicates an expected call of CountSEODuplicates.
+func (mr *MockAppRepoMockRecorder) CountSEODuplicates(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSEODuplicates", reflect.TypeOf((*MockAppRepo)(nil).CountSEODuplicates), ctx, req)
+}
+
 // CreatePage mocks base method.
 func (m *MockAppRepo) CreatePage(ctx context.Context, req *models.Page) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePage", ctx, req)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "CreatePage", ctx, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePage indicates an expected call of CreatePage.
+func (mr *MockAppRepoMockRecorder) CreatePage(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePage", reflect.TypeOf((*MockAppRepo)(nil).CreatePage), ctx, req)
+}
+
+// CreateRedirect mocks base method.
+func (m *MockAppRepo) CreateRedirect(ctx context.Context, req *models.Redirect, check func([]*models.Redirect) error) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRedirect", ctx, req, check)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRedirect indicates an expected call of CreateRedirect.
+func (mr *MockAppRepoMockRecorder) CreateRedirect(ctx, req, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRedirect", reflect.TypeOf((*MockAppRepo)(nil).CreateRedirect), ctx, req, check)
+}
+
+// CreateRobotsGroup mocks base method.
+func (m *MockAppRepo) CreateRobotsGroup(ctx context.Context, req *models.RobotsGroup) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRobotsGroup", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRobotsGroup indicates an expected call of CreateRobotsGroup.
+func (mr *MockAppRepoMockRecorder) CreateRobotsGroup(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRobotsGroup", reflect.TypeOf((*MockAppRepo)(nil).CreateRobotsGroup), ctx, req)
+}
+
+// CreateRobotsSitemap mocks base method.
+func (m *MockAppRepo) CreateRobotsSitemap(ctx context.Context, url string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRobotsSitemap", ctx, url)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRobotsSitemap indicates an expected call of CreateRobotsSitemap.
+func (mr *MockAppRepoMockRecorder) CreateRobotsSitemap(ctx, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRobotsSitemap", reflect.TypeOf((*MockAppRepo)(nil).CreateRobotsSitemap), ctx, url)
+}
+
+// CreateSEO mocks base method.
+func (m *MockAppRepo) CreateSEO(ctx context.Context, req *models.SEO) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSEO", ctx, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSEO indicates an expected call of CreateSEO.
+func (mr *MockAppRepoMockRecorder) CreateSEO(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSEO", reflect.TypeOf((*MockAppRepo)(nil).CreateSEO), ctx, req)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockAppRepo) CreateWebhook(ctx context.Context, req *models.Webhook) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockAppRepoMockRecorder) CreateWebhook(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockAppRepo)(nil).CreateWebhook), ctx, req)
+}
+
+// DeletePage mocks base method.
+func (m *MockAppRepo) DeletePage(ctx context.Context, slug, strategy string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePage", ctx, slug, strategy)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePage indicates an expected call of DeletePage.
+func (mr *MockAppRepoMockRecorder) DeletePage(ctx, slug, strategy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePage", reflect.TypeOf((*MockAppRepo)(nil).DeletePage), ctx, slug, strategy)
+}
+
+// DeleteRedirect mocks base method.
+func (m *MockAppRepo) DeleteRedirect(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRedirect", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRedirect indicates an expected call of DeleteRedirect.
+func (mr *MockAppRepoMockRecorder) DeleteRedirect(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRedirect", reflect.TypeOf((*MockAppRepo)(nil).DeleteRedirect), ctx, id)
+}
+
+// DeleteRobotsGroup mocks base method.
+func (m *MockAppRepo) DeleteRobotsGroup(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRobotsGroup", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRobotsGroup indicates an expected call of DeleteRobotsGroup.
+func (mr *MockAppRepoMockRecorder) DeleteRobotsGroup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRobotsGroup", reflect.TypeOf((*MockAppRepo)(nil).DeleteRobotsGroup), ctx, id)
+}
+
+// DeleteRobotsSitemap mocks base method.
+func (m *MockAppRepo) DeleteRobotsSitemap(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRobotsSitemap", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRobotsSitemap indicates an expected call of DeleteRobotsSitemap.
+func (mr *MockAppRepoMockRecorder) DeleteRobotsSitemap(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRobotsSitemap", reflect.TypeOf((*MockAppRepo)(nil).DeleteRobotsSitemap), ctx, id)
+}
+
+// DeleteSEO mocks base method.
+func (m *MockAppRepo) DeleteSEO(ctx context.Context, name, pk, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSEO", ctx, name, pk, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSEO indicates an expected call of DeleteSEO.
+func (mr *MockAppRepoMockRecorder) DeleteSEO(ctx, name, pk, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSEO", reflect.TypeOf((*MockAppRepo)(nil).DeleteSEO), ctx, name, pk, locale)
+}
+
+// DeleteSEOTemplate mocks base method.
+func (m *MockAppRepo) DeleteSEOTemplate(ctx context.Context, name, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSEOTemplate", ctx, name, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSEOTemplate indicates an expected call of DeleteSEOTemplate.
+func (mr *MockAppRepoMockRecorder) DeleteSEOTemplate(ctx, name, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSEOTemplate", reflect.TypeOf((*MockAppRepo)(nil).DeleteSEOTemplate), ctx, name, locale)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockAppRepo) DeleteWebhook(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockAppRepoMockRecorder) DeleteWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockAppRepo)(nil).DeleteWebhook), ctx, id)
+}
+
+// FinishWebhookDelivery mocks base method.
+func (m *MockAppRepo) FinishWebhookDelivery(ctx context.Context, d *models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishWebhookDelivery", ctx, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishWebhookDelivery indicates an expected call of FinishWebhookDelivery.
+func (mr *MockAppRepoMockRecorder) FinishWebhookDelivery(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishWebhookDelivery", reflect.TypeOf((*MockAppRepo)(nil).FinishWebhookDelivery), ctx, d)
+}
+
+// GetPage mocks base method.
+func (m *MockAppRepo) GetPage(ctx context.Context, slug string) (*models.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPage", ctx, slug)
+	ret0, _ := ret[0].(*models.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPage indicates an expected call of GetPage.
+func (mr *MockAppRepoMockRecorder) GetPage(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockAppRepo)(nil).GetPage), ctx, slug)
+}
+
+// GetPageByHref mocks base method.
+func (m *MockAppRepo) GetPageByHref(ctx context.Context, path string) (*models.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPageByHref", ctx, path)
+	ret0, _ := ret[0].(*models.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPageByHref indicates an expected call of GetPageByHref.
+func (mr *MockAppRepoMockRecorder) GetPageByHref(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPageByHref", reflect.TypeOf((*MockAppRepo)(nil).GetPageByHref), ctx, path)
+}
+
+// GetRedirect mocks base method.
+func (m *MockAppRepo) GetRedirect(ctx context.Context, id uint64) (*models.Redirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedirect", ctx, id)
+	ret0, _ := ret[0].(*models.Redirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedirect indicates an expected call of GetRedirect.
+func (mr *MockAppRepoMockRecorder) GetRedirect(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedirect", reflect.TypeOf((*MockAppRepo)(nil).GetRedirect), ctx, id)
+}
+
+// GetRobotsGroup mocks base method.
+func (m *MockAppRepo) GetRobotsGroup(ctx context.Context, id uint64) (*models.RobotsGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRobotsGroup", ctx, id)
+	ret0, _ := ret[0].(*models.RobotsGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRobotsGroup indicates an expected call of GetRobotsGroup.
+func (mr *MockAppRepoMockRecorder) GetRobotsGroup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRobotsGroup", reflect.TypeOf((*MockAppRepo)(nil).GetRobotsGroup), ctx, id)
+}
+
+// GetSEO mocks base method.
+func (m *MockAppRepo) GetSEO(ctx context.Context, name, pk, locale string) (*models.SEO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEO", ctx, name, pk, locale)
+	ret0, _ := ret[0].(*models.SEO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEO indicates an expected call of GetSEO.
+func (mr *MockAppRepoMockRecorder) GetSEO(ctx, name, pk, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEO", reflect.TypeOf((*MockAppRepo)(nil).GetSEO), ctx, name, pk, locale)
+}
+
+// GetSEORevision mocks base method.
+func (m *MockAppRepo) GetSEORevision(ctx context.Context, name, pk string, id uint64) (*models.SEORevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEORevision", ctx, name, pk, id)
+	ret0, _ := ret[0].(*models.SEORevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEORevision indicates an expected call of GetSEORevision.
+func (mr *MockAppRepoMockRecorder) GetSEORevision(ctx, name, pk, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEORevision", reflect.TypeOf((*MockAppRepo)(nil).GetSEORevision), ctx, name, pk, id)
+}
+
+// GetSEOTemplate mocks base method.
+func (m *MockAppRepo) GetSEOTemplate(ctx context.Context, name, locale string) (*models.SEOTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEOTemplate", ctx, name, locale)
+	ret0, _ := ret[0].(*models.SEOTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEOTemplate indicates an expected call of GetSEOTemplate.
+func (mr *MockAppRepoMockRecorder) GetSEOTemplate(ctx, name, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEOTemplate", reflect.TypeOf((*MockAppRepo)(nil).GetSEOTemplate), ctx, name, locale)
+}
+
+// GetWebhook mocks base method.
+func (m *MockAppRepo) GetWebhook(ctx context.Context, id uint64) (*models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", ctx, id)
+	ret0, _ := ret[0].(*models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockAppRepoMockRecorder) GetWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockAppRepo)(nil).GetWebhook), ctx, id)
+}
+
+// ImportSEO mocks base method.
+func (m *MockAppRepo) ImportSEO(ctx context.Context, rows []*models.SEO, upsert bool) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSEO", ctx, rows, upsert)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ImportSEO indicates an expected call of ImportSEO.
+func (mr *MockAppRepoMockRecorder) ImportSEO(ctx, rows, upsert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSEO", reflect.TypeOf((*MockAppRepo)(nil).ImportSEO), ctx, rows, upsert)
+}
+
+// LastOutboxEventID mocks base method.
+func (m *MockAppRepo) LastOutboxEventID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastOutboxEventID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastOutboxEventID indicates an expected call of LastOutboxEventID.
+func (mr *MockAppRepoMockRecorder) LastOutboxEventID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastOutboxEventID", reflect.TypeOf((*MockAppRepo)(nil).LastOutboxEventID), ctx)
+}
+
+// ListOutboxEvents mocks base method.
+func (m *MockAppRepo) ListOutboxEvents(ctx context.Context, after uint64, limit int) ([]*models.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutboxEvents", ctx, after, limit)
+	ret0, _ := ret[0].([]*models.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutboxEvents indicates an expected call of ListOutboxEvents.
+func (mr *MockAppRepoMockRecorder) ListOutboxEvents(ctx, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutboxEvents", reflect.TypeOf((*MockAppRepo)(nil).ListOutboxEvents), ctx, after, limit)
+}
+
+// ListPageAncestors mocks base method.
+func (m *MockAppRepo) ListPageAncestors(ctx context.Context, slug string) ([]*models.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPageAncestors", ctx, slug)
+	ret0, _ := ret[0].([]*models.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPageAncestors indicates an expected call of ListPageAncestors.
+func (mr *MockAppRepoMockRecorder) ListPageAncestors(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPageAncestors", reflect.TypeOf((*MockAppRepo)(nil).ListPageAncestors), ctx, slug)
+}
+
+// ListPageTree mocks base method.
+func (m *MockAppRepo) ListPageTree(ctx context.Context) ([]*models.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPageTree", ctx)
+	ret0, _ := ret[0].([]*models.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPageTree indicates an expected call of ListPageTree.
+func (mr *MockAppRepoMockRecorder) ListPageTree(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPageTree", reflect.TypeOf((*MockAppRepo)(nil).ListPageTree), ctx)
+}
+
+// ListPages mocks base method.
+func (m *MockAppRepo) ListPages(ctx context.Context, f *models.PageFilter) (*models.PageList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPages", ctx, f)
+	ret0, _ := ret[0].(*models.PageList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPages indicates an expected call of ListPages.
+func (mr *MockAppRepoMockRecorder) ListPages(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPages", reflect.TypeOf((*MockAppRepo)(nil).ListPages), ctx, f)
+}
+
+// ListRedirects mocks base method.
+func (m *MockAppRepo) ListRedirects(ctx context.Context) ([]*models.Redirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRedirects", ctx)
+	ret0, _ := ret[0].([]*models.Redirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRedirects indicates an expected call of ListRedirects.
+func (mr *MockAppRepoMockRecorder) ListRedirects(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRedirects", reflect.TypeOf((*MockAppRepo)(nil).ListRedirects), ctx)
+}
+
+// ListRobotsGroups mocks base method.
+func (m *MockAppRepo) ListRobotsGroups(ctx context.Context) ([]*models.RobotsGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRobotsGroups", ctx)
+	ret0, _ := ret[0].([]*models.RobotsGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRobotsGroups indicates an expected call of ListRobotsGroups.
+func (mr *MockAppRepoMockRecorder) ListRobotsGroups(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRobotsGroups", reflect.TypeOf((*MockAppRepo)(nil).ListRobotsGroups), ctx)
+}
+
+// ListRobotsSitemaps mocks base method.
+func (m *MockAppRepo) ListRobotsSitemaps(ctx context.Context) ([]*models.RobotsSitemap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRobotsSitemaps", ctx)
+	ret0, _ := ret[0].([]*models.RobotsSitemap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRobotsSitemaps indicates an expected call of ListRobotsSitemaps.
+func (mr *MockAppRepoMockRecorder) ListRobotsSitemaps(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRobotsSitemaps", reflect.TypeOf((*MockAppRepo)(nil).ListRobotsSitemaps), ctx)
+}
+
+// ListSEO mocks base method.
+func (m *MockAppRepo) ListSEO(ctx context.Context, f *models.SEOFilter) (*models.SEOList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSEO", ctx, f)
+	ret0, _ := ret[0].(*models.SEOList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSEO indicates an expected call of ListSEO.
+func (mr *MockAppRepoMockRecorder) ListSEO(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSEO", reflect.TypeOf((*MockAppRepo)(nil).ListSEO), ctx, f)
+}
+
+// ListSEOForSitemap mocks base method.
+func (m *MockAppRepo) ListSEOForSitemap(ctx context.Context, names []string) ([]*models.SEO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSEOForSitemap", ctx, names)
+	ret0, _ := ret[0].([]*models.SEO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSEOForSitemap indicates an expected call of ListSEOForSitemap.
+func (mr *MockAppRepoMockRecorder) ListSEOForSitemap(ctx, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSEOForSitemap", reflect.TypeOf((*MockAppRepo)(nil).ListSEOForSitemap), ctx, names)
+}
+
+// ListSEOLocales mocks base method.
+func (m *MockAppRepo) ListSEOLocales(ctx context.Context, name, pk string) ([]*models.SEO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSEOLocales", ctx, name, pk)
+	ret0, _ := ret[0].([]*models.SEO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSEOLocales indicates an expected call of ListSEOLocales.
+func (mr *MockAppRepoMockRecorder) ListSEOLocales(ctx, name, pk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSEOLocales", reflect.TypeOf((*MockAppRepo)(nil).ListSEOLocales), ctx, name, pk)
+}
+
+// ListSEORevisions mocks base method.
+func (m *MockAppRepo) ListSEORevisions(ctx context.Context, name, pk string) ([]*models.SEORevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSEORevisions", ctx, name, pk)
+	ret0, _ := ret[0].([]*models.SEORevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSEORevisions indicates an expected call of ListSEORevisions.
+func (mr *MockAppRepoMockRecorder) ListSEORevisions(ctx, name, pk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSEORevisions", reflect.TypeOf((*MockAppRepo)(nil).ListSEORevisions), ctx, name, pk)
+}
+
+// ListSEOTemplates mocks base method.
+func (m *MockAppRepo) ListSEOTemplates(ctx context.Context) ([]*models.SEOTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSEOTemplates", ctx)
+	ret0, _ := ret[0].([]*models.SEOTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSEOTemplates indicates an expected call of ListSEOTemplates.
+func (mr *MockAppRepoMockRecorder) ListSEOTemplates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSEOTemplates", reflect.TypeOf((*MockAppRepo)(nil).ListSEOTemplates), ctx)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockAppRepo) ListWebhookDeliveries(ctx context.Context, f *models.WebhookDeliveryFilter) (*models.WebhookDeliveryList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, f)
+	ret0, _ := ret[0].(*models.WebhookDeliveryList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockAppRepoMockRecorder) ListWebhookDeliveries(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockAppRepo)(nil).ListWebhookDeliveries), ctx, f)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockAppRepo) ListWebhooks(ctx context.Context) ([]*models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx)
+	ret0, _ := ret[0].([]*models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockAppRepoMockRecorder) ListWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockAppRepo)(nil).ListWebhooks), ctx)
+}
+
+// MarkOutboxPublished mocks base method.
+func (m *MockAppRepo) MarkOutboxPublished(ctx context.Context, ids []uint64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxPublished", ctx, ids, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxPublished indicates an expected call of MarkOutboxPublished.
+func (mr *MockAppRepoMockRecorder) MarkOutboxPublished(ctx, ids, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxPublished", reflect.TypeOf((*MockAppRepo)(nil).MarkOutboxPublished), ctx, ids, now)
+}
+
+// PublishScheduledPages mocks base method.
+func (m *MockAppRepo) PublishScheduledPages(ctx context.Context, now time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduledPages", ctx, now)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduledPages indicates an expected call of PublishScheduledPages.
+func (mr *MockAppRepoMockRecorder) PublishScheduledPages(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledPages", reflect.TypeOf((*MockAppRepo)(nil).PublishScheduledPages), ctx, now)
+}
+
+// PublishScheduledSEO mocks base method.
+func (m *MockAppRepo) PublishScheduledSEO(ctx context.Context, now time.Time) ([]*models.SEO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduledSEO", ctx, now)
+	ret0, _ := ret[0].([]*models.SEO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduledSEO indicates an expected call of PublishScheduledSEO.
+func (mr *MockAppRepoMockRecorder) PublishScheduledSEO(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledSEO", reflect.TypeOf((*MockAppRepo)(nil).PublishScheduledSEO), ctx, now)
+}
+
+// PurgeOutbox mocks base method.
+func (m *MockAppRepo) PurgeOutbox(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeOutbox", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeOutbox indicates an expected call of PurgeOutbox.
+func (mr *MockAppRepoMockRecorder) PurgeOutbox(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeOutbox", reflect.TypeOf((*MockAppRepo)(nil).PurgeOutbox), ctx, before)
+}
+
+// RetryWebhookDelivery mocks base method.
+func (m *MockAppRepo) RetryWebhookDelivery(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryWebhookDelivery", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryWebhookDelivery indicates an expected call of RetryWebhookDelivery.
+func (mr *MockAppRepoMockRecorder) RetryWebhookDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryWebhookDelivery", reflect.TypeOf((*MockAppRepo)(nil).RetryWebhookDelivery), ctx, id)
+}
+
+// RollbackSEO mocks base method.
+func (m *MockAppRepo) RollbackSEO(ctx context.Context, name, pk string, id uint64) (*models.SEO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSEO", ctx, name, pk, id)
+	ret0, _ := ret[0].(*models.SEO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackSEO indicates an expected call of RollbackSEO.
+func (mr *MockAppRepoMockRecorder) RollbackSEO(ctx, name, pk, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSEO", reflect.TypeOf((*MockAppRepo)(nil).RollbackSEO), ctx, name, pk, id)
+}
+
+// SaveSEOTemplate mocks base method.
+func (m *MockAppRepo) SaveSEOTemplate(ctx context.Context, req *models.SEOTemplate) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSEOTemplate", ctx, req)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSEOTemplate indicates an expected call of SaveSEOTemplate.
+func (mr *MockAppRepoMockRecorder) SaveSEOTemplate(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSEOTemplate", reflect.TypeOf((*MockAppRepo)(nil).SaveSEOTemplate), ctx, req)
+}
+
+// UpdatePage mocks base method.
+func (m *MockAppRepo) UpdatePage(ctx context.Context, slug string, req *models.Page) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePage", ctx, slug, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePage indicates an expected call of UpdatePage.
+func (mr *MockAppRepoMockRecorder) UpdatePage(ctx, slug, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePage", reflect.TypeOf((*MockAppRepo)(nil).UpdatePage), ctx, slug, req)
+}
+
+// UpdateRedirect mocks base method.
+func (m *MockAppRepo) UpdateRedirect(ctx context.Context, id uint64, req *models.Redirect, check func([]*models.Redirect) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRedirect", ctx, id, req, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRedirect indicates an expected call of UpdateRedirect.
+func (mr *MockAppRepoMockRecorder) UpdateRedirect(ctx, id, req, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRedirect", reflect.TypeOf((*MockAppRepo)(nil).UpdateRedirect), ctx, id, req, check)
+}
+
+// UpdateRobotsGroup mocks base method.
+func (m *MockAppRepo) UpdateRobotsGroup(ctx context.Context, id uint64, req *models.RobotsGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRobotsGroup", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRobotsGroup indicates an expected call of UpdateRobotsGroup.
+func (mr *MockAppRepoMockRecorder) UpdateRobotsGroup(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRobotsGroup", reflect.TypeOf((*MockAppRepo)(nil).UpdateRobotsGroup), ctx, id, req)
+}
+
+// UpdateSEO mocks base method.
+func (m *MockAppRepo) UpdateSEO(ctx context.Context, req *models.SEO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSEO", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSEO indicates an expected call of UpdateSEO.
+func (mr *MockAppRepoMockRecorder) UpdateSEO(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSEO", reflect.TypeOf((*MockAppRepo)(nil).UpdateSEO), ctx, req)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockAppRepo) UpdateWebhook(ctx context.Context, id uint64, req *models.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockAppRepoMockRecorder) UpdateWebhook(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockAppRepo)(nil).UpdateWebhook), ctx, id, req)
+}
+
+// MockAppCtrl is a mock of AppCtrl interface.
+type MockAppCtrl struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppCtrlMockRecorder
+	isgomock struct{}
+}
+
+// MockAppCtrlMockRecorder is the mock recorder for MockAppCtrl.
+type MockAppCtrlMockRecorder struct {
+	mock *MockAppCtrl
+}
+
+// NewMockAppCtrl creates a new mock instance.
+func NewMockAppCtrl(ctrl *gomock.Controller) *MockAppCtrl {
+	mock := &MockAppCtrl{ctrl: ctrl}
+	mock.recorder = &MockAppCtrlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppCtrl) EXPECT() *MockAppCtrlMockRecorder {
+	return m.recorder
+}
+
+// AuditReport mocks base method.
+func (m *MockAppCtrl) AuditReport(ctx context.Context, name string) (*dto.AuditReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditReport", ctx, name)
+	ret0, _ := ret[0].(*dto.AuditReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditReport indicates an expected call of AuditReport.
+func (mr *MockAppCtrlMockRecorder) AuditReport(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditReport", reflect.TypeOf((*MockAppCtrl)(nil).AuditReport), ctx, name)
+}
+
+// AuditSEO mocks base method.
+func (m *MockAppCtrl) AuditSEO(ctx context.Context, name, pk, locale string) (*dto.SEOAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditSEO", ctx, name, pk, locale)
+	ret0, _ := ret[0].(*dto.SEOAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditSEO indicates an expected call of AuditSEO.
+func (mr *MockAppCtrlMockRecorder) AuditSEO(ctx, name, pk, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditSEO", reflect.TypeOf((*MockAppCtrl)(nil).AuditSEO), ctx, name, pk, locale)
+}
+
+// CreatePage mocks base method.
+func (m *MockAppCtrl) CreatePage(ctx context.Context, req *models.Page) (*dto.CreatePageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePage", ctx, req)
+	ret0, _ := ret[0].(*dto.CreatePageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePage indicates an expected call of CreatePage.
+func (mr *MockAppCtrlMockRecorder) CreatePage(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePage", reflect.TypeOf((*MockAppCtrl)(nil).CreatePage), ctx, req)
+}
+
+// CreateRedirect mocks base method.
+func (m *MockAppCtrl) CreateRedirect(ctx context.Context, req *models.Redirect) (*dto.CreateRedirectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRedirect", ctx, req)
+	ret0, _ := ret[0].(*dto.CreateRedirectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRedirect indicates an expected call of CreateRedirect.
+func (mr *MockAppCtrlMockRecorder) CreateRedirect(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRedirect", reflect.TypeOf((*MockAppCtrl)(nil).CreateRedirect), ctx, req)
+}
+
+// CreateRobotsGroup mocks base method.
+func (m *MockAppCtrl) CreateRobotsGroup(ctx context.Context, req *models.RobotsGroup) (*dto.CreateRobotsGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRobotsGroup", ctx, req)
+	ret0, _ := ret[0].(*dto.CreateRobotsGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRobotsGroup indicates an expected call of CreateRobotsGroup.
+func (mr *MockAppCtrlMockRecorder) CreateRobotsGroup(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRobotsGroup", reflect.TypeOf((*MockAppCtrl)(nil).CreateRobotsGroup), ctx, req)
+}
+
+// CreateRobotsSitemap mocks base method.
+func (m *MockAppCtrl) CreateRobotsSitemap(ctx context.Context, url string) (*dto.CreateRobotsSitemapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRobotsSitemap", ctx, url)
+	ret0, _ := ret[0].(*dto.CreateRobotsSitemapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRobotsSitemap indicates an expected call of CreateRobotsSitemap.
+func (mr *MockAppCtrlMockRecorder) CreateRobotsSitemap(ctx, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRobotsSitemap", reflect.TypeOf((*MockAppCtrl)(nil).CreateRobotsSitemap), ctx, url)
+}
+
+// CreateSEO mocks base method.
+func (m *MockAppCtrl) CreateSEO(ctx context.Context, req *models.SEO) (*dto.CreateSEOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSEO", ctx, req)
+	ret0, _ := ret[0].(*dto.CreateSEOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSEO indicates an expected call of CreateSEO.
+func (mr *MockAppCtrlMockRecorder) CreateSEO(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSEO", reflect.TypeOf((*MockAppCtrl)(nil).CreateSEO), ctx, req)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockAppCtrl) CreateWebhook(ctx context.Context, req *models.Webhook) (*dto.CreateWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, req)
+	ret0, _ := ret[0].(*dto.CreateWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockAppCtrlMockRecorder) CreateWebhook(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockAppCtrl)(nil).CreateWebhook), ctx, req)
+}
+
+// DeletePage mocks base method.
+func (m *MockAppCtrl) DeletePage(ctx context.Context, slug, strategy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePage", ctx, slug, strategy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePage indicates an expected call of DeletePage.
+func (mr *MockAppCtrlMockRecorder) DeletePage(ctx, slug, strategy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePage", reflect.TypeOf((*MockAppCtrl)(nil).DeletePage), ctx, slug, strategy)
+}
+
+// DeleteRedirect mocks base method.
+func (m *MockAppCtrl) DeleteRedirect(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRedirect", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRedirect indicates an expected call of DeleteRedirect.
+func (mr *MockAppCtrlMockRecorder) DeleteRedirect(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRedirect", reflect.TypeOf((*MockAppCtrl)(nil).DeleteRedirect), ctx, id)
+}
+
+// DeleteRobotsGroup mocks base method.
+func (m *MockAppCtrl) DeleteRobotsGroup(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRobotsGroup", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRobotsGroup indicates an expected call of DeleteRobotsGroup.
+func (mr *MockAppCtrlMockRecorder) DeleteRobotsGroup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRobotsGroup", reflect.TypeOf((*MockAppCtrl)(nil).DeleteRobotsGroup), ctx, id)
+}
+
+// DeleteRobotsSitemap mocks base method.
+func (m *MockAppCtrl) DeleteRobotsSitemap(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRobotsSitemap", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRobotsSitemap indicates an expected call of DeleteRobotsSitemap.
+func (mr *MockAppCtrlMockRecorder) DeleteRobotsSitemap(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRobotsSitemap", reflect.TypeOf((*MockAppCtrl)(nil).DeleteRobotsSitemap), ctx, id)
+}
+
+// DeleteSEO mocks base method.
+func (m *MockAppCtrl) DeleteSEO(ctx context.Context, name, pk, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSEO", ctx, name, pk, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSEO indicates an expected call of DeleteSEO.
+func (mr *MockAppCtrlMockRecorder) DeleteSEO(ctx, name, pk, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSEO", reflect.TypeOf((*MockAppCtrl)(nil).DeleteSEO), ctx, name, pk, locale)
+}
+
+// DeleteSEOTemplate mocks base method.
+func (m *MockAppCtrl) DeleteSEOTemplate(ctx context.Context, name, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSEOTemplate", ctx, name, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSEOTemplate indicates an expected call of DeleteSEOTemplate.
+func (mr *MockAppCtrlMockRecorder) DeleteSEOTemplate(ctx, name, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSEOTemplate", reflect.TypeOf((*MockAppCtrl)(nil).DeleteSEOTemplate), ctx, name, locale)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockAppCtrl) DeleteWebhook(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockAppCtrlMockRecorder) DeleteWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockAppCtrl)(nil).DeleteWebhook), ctx, id)
+}
+
+// DiffSEORevisions mocks base method.
+func (m *MockAppCtrl) DiffSEORevisions(ctx context.Context, name, pk string, from, to uint64) ([]*dto.FieldDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffSEORevisions", ctx, name, pk, from, to)
+	ret0, _ := ret[0].([]*dto.FieldDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffSEORevisions indicates an expected call of DiffSEORevisions.
+func (mr *MockAppCtrlMockRecorder) DiffSEORevisions(ctx, name, pk, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffSEORevisions", reflect.TypeOf((*MockAppCtrl)(nil).DiffSEORevisions), ctx, name, pk, from, to)
+}
+
+// ExportRedirects mocks base method.
+func (m *MockAppCtrl) ExportRedirects(ctx context.Context, format string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportRedirects", ctx, format)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportRedirects indicates an expected call of ExportRedirects.
+func (mr *MockAppCtrlMockRecorder) ExportRedirects(ctx, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRedirects", reflect.TypeOf((*MockAppCtrl)(nil).ExportRedirects), ctx, format)
+}
+
+// ExportSEO mocks base method.
+func (m *MockAppCtrl) ExportSEO(ctx context.Context, f *models.SEOFilter, fn func(*models.SEO) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSEO", ctx, f, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportSEO indicates an expected call of ExportSEO.
+func (mr *MockAppCtrlMockRecorder) ExportSEO(ctx, f, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSEO", reflect.TypeOf((*MockAppCtrl)(nil).ExportSEO), ctx, f, fn)
+}
+
+// GetBreadcrumbs mocks base method.
+func (m *MockAppCtrl) GetBreadcrumbs(ctx context.Context, slug string) (*dto.Breadcrumbs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBreadcrumbs", ctx, slug)
+	ret0, _ := ret[0].(*dto.Breadcrumbs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBreadcrumbs indicates an expected call of GetBreadcrumbs.
+func (mr *MockAppCtrlMockRecorder) GetBreadcrumbs(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBreadcrumbs", reflect.TypeOf((*MockAppCtrl)(nil).GetBreadcrumbs), ctx, slug)
+}
+
+// GetPage mocks base method.
+func (m *MockAppCtrl) GetPage(ctx context.Context, slug string) (*models.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPage", ctx, slug)
+	ret0, _ := ret[0].(*models.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPage indicates an expected call of GetPage.
+func (mr *MockAppCtrlMockRecorder) GetPage(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockAppCtrl)(nil).GetPage), ctx, slug)
+}
+
+// GetPageTree mocks base method.
+func (m *MockAppCtrl) GetPageTree(ctx context.Context) ([]*dto.PageNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPageTree", ctx)
+	ret0, _ := ret[0].([]*dto.PageNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPageTree indicates an expected call of GetPageTree.
+func (mr *MockAppCtrlMockRecorder) GetPageTree(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPageTree", reflect.TypeOf((*MockAppCtrl)(nil).GetPageTree), ctx)
+}
+
+// GetRedirect mocks base method.
+func (m *MockAppCtrl) GetRedirect(ctx context.Context, id uint64) (*models.Redirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedirect", ctx, id)
+	ret0, _ := ret[0].(*models.Redirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedirect indicates an expected call of GetRedirect.
+func (mr *MockAppCtrlMockRecorder) GetRedirect(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedirect", reflect.TypeOf((*MockAppCtrl)(nil).GetRedirect), ctx, id)
+}
+
+// GetRobotsGroup mocks base method.
+func (m *MockAppCtrl) GetRobotsGroup(ctx context.Context, id uint64) (*models.RobotsGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRobotsGroup", ctx, id)
+	ret0, _ := ret[0].(*models.RobotsGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePage indicates an expected call of CreatePage.
-func (mr *MockAppRepoMockRecorder) CreatePage(ctx, req any) *gomock.Call {
+// GetRobotsGroup indicates an expected call of GetRobotsGroup.
+func (mr *MockAppCtrlMockRecorder) GetRobotsGroup(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePage", reflect.TypeOf((*MockAppRepo)(nil).CreatePage), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRobotsGroup", reflect.TypeOf((*MockAppCtrl)(nil).GetRobotsGroup), ctx, id)
 }
 
-// CreateSEO mocks base method.
-func (m *MockAppRepo) CreateSEO(ctx context.Context, req *models.SEO) (string, string, error) {
+// GetRobotsTxt mocks base method.
+func (m *MockAppCtrl) GetRobotsTxt(ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSEO", ctx, req)
+	ret := m.ctrl.Call(m, "GetRobotsTxt", ctx)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(string)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateSEO indicates an expected call of CreateSEO.
-func (mr *MockAppRepoMockRecorder) CreateSEO(ctx, req any) *gomock.Call {
+// GetRobotsTxt indicates an expected call of GetRobotsTxt.
+func (mr *MockAppCtrlMockRecorder) GetRobotsTxt(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSEO", reflect.TypeOf((*MockAppRepo)(nil).CreateSEO), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRobotsTxt", reflect.TypeOf((*MockAppCtrl)(nil).GetRobotsTxt), ctx)
 }
 
-// DeletePage mocks base method.
-func (m *MockAppRepo) DeletePage(ctx context.Context, slug string) error {
+// GetSEO mocks base method.
+func (m *MockAppCtrl) GetSEO(ctx context.Context, name, pk, locale string) (*models.SEO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePage", ctx, slug)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetSEO", ctx, name, pk, locale)
+	ret0, _ := ret[0].(*models.SEO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeletePage indicates an expected call of DeletePage.
-func (mr *MockAppRepoMockRecorder) DeletePage(ctx, slug any) *gomock.Call {
+// GetSEO indicates an expected call of GetSEO.
+func (mr *MockAppCtrlMockRecorder) GetSEO(ctx, name, pk, locale any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePage", reflect.TypeOf((*MockAppRepo)(nil).DeletePage), ctx, slug)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEO", reflect.TypeOf((*MockAppCtrl)(nil).GetSEO), ctx, name, pk, locale)
 }
 
-// DeleteSEO mocks base method.
-func (m *MockAppRepo) DeleteSEO(ctx context.Context, name, pk string) error {
+// GetSEOAlternates mocks base method.
+func (m *MockAppCtrl) GetSEOAlternates(ctx context.Context, name, pk string) ([]*dto.SEOAlternate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSEO", ctx, name, pk)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetSEOAlternates", ctx, name, pk)
+	ret0, _ := ret[0].([]*dto.SEOAlternate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteSEO indicates an expected call of DeleteSEO.
-func (mr *MockAppRepoMockRecorder) DeleteSEO(ctx, name, pk any) *gomock.Call {
+// GetSEOAlternates indicates an expected call of GetSEOAlternates.
+func (mr *MockAppCtrlMockRecorder) GetSEOAlternates(ctx, name, pk any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSEO", reflect.TypeOf((*MockAppRepo)(nil).DeleteSEO), ctx, name, pk)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEOAlternates", reflect.TypeOf((*MockAppCtrl)(nil).GetSEOAlternates), ctx, name, pk)
 }
 
-// GetPage mocks base method.
-func (m *MockAppRepo) GetPage(ctx context.Context, slug string) (*models.Page, error) {
+// GetSEOHead mocks base method.
+func (m *MockAppCtrl) GetSEOHead(ctx context.Context, name, pk, locale string, vars map[string]string) ([]models.HeadTag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPage", ctx, slug)
-	ret0, _ := ret[0].(*models.Page)
+	ret := m.ctrl.Call(m, "GetSEOHead", ctx, name, pk, locale, vars)
+	ret0, _ := ret[0].([]models.HeadTag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPage indicates an expected call of GetPage.
-func (mr *MockAppRepoMockRecorder) GetPage(ctx, slug any) *gomock.Call {
+// GetSEOHead indicates an expected call of GetSEOHead.
+func (mr *MockAppCtrlMockRecorder) GetSEOHead(ctx, name, pk, locale, vars any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockAppRepo)(nil).GetPage), ctx, slug)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEOHead", reflect.TypeOf((*MockAppCtrl)(nil).GetSEOHead), ctx, name, pk, locale, vars)
 }
 
-// GetSEO mocks base method.
-func (m *MockAppRepo) GetSEO(ctx context.Context, name, pk string) (*models.SEO, error) {
+// GetSEORevision mocks base method.
+func (m *MockAppCtrl) GetSEORevision(ctx context.Context, name, pk string, id uint64) (*models.SEORevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEORevision", ctx, name, pk, id)
+	ret0, _ := ret[0].(*models.SEORevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEORevision indicates an expected call of GetSEORevision.
+func (mr *MockAppCtrlMockRecorder) GetSEORevision(ctx, name, pk, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEORevision", reflect.TypeOf((*MockAppCtrl)(nil).GetSEORevision), ctx, name, pk, id)
+}
+
+// GetSEOTemplate mocks base method.
+func (m *MockAppCtrl) GetSEOTemplate(ctx context.Context, name, locale string) (*models.SEOTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEOTemplate", ctx, name, locale)
+	ret0, _ := ret[0].(*models.SEOTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEOTemplate indicates an expected call of GetSEOTemplate.
+func (mr *MockAppCtrlMockRecorder) GetSEOTemplate(ctx, name, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEOTemplate", reflect.TypeOf((*MockAppCtrl)(nil).GetSEOTemplate), ctx, name, locale)
+}
+
+// GetSEOWithVars mocks base method.
+func (m *MockAppCtrl) GetSEOWithVars(ctx context.Context, name, pk, locale string, vars map[string]string) (*models.SEO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSEO", ctx, name, pk)
+	ret := m.ctrl.Call(m, "GetSEOWithVars", ctx, name, pk, locale, vars)
 	ret0, _ := ret[0].(*models.SEO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSEO indicates an expected call of GetSEO.
-func (mr *MockAppRepoMockRecorder) GetSEO(ctx, name, pk any) *gomock.Call {
+// GetSEOWithVars indicates an expected call of GetSEOWithVars.
+func (mr *MockAppCtrlMockRecorder) GetSEOWithVars(ctx, name, pk, locale, vars any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEOWithVars", reflect.TypeOf((*MockAppCtrl)(nil).GetSEOWithVars), ctx, name, pk, locale, vars)
+}
+
+// GetSitemap mocks base method.
+func (m *MockAppCtrl) GetSitemap(ctx context.Context, idx int, gz bool) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSitemap", ctx, idx, gz)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSitemap indicates an expected call of GetSitemap.
+func (mr *MockAppCtrlMockRecorder) GetSitemap(ctx, idx, gz any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSitemap", reflect.TypeOf((*MockAppCtrl)(nil).GetSitemap), ctx, idx, gz)
+}
+
+// GetWebhook mocks base method.
+func (m *MockAppCtrl) GetWebhook(ctx context.Context, id uint64) (*models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", ctx, id)
+	ret0, _ := ret[0].(*models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockAppCtrlMockRecorder) GetWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockAppCtrl)(nil).GetWebhook), ctx, id)
+}
+
+// ImportSEO mocks base method.
+func (m *MockAppCtrl) ImportSEO(ctx context.Context, rows []*models.SEO, mode string) (*dto.ImportSEOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSEO", ctx, rows, mode)
+	ret0, _ := ret[0].(*dto.ImportSEOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportSEO indicates an expected call of ImportSEO.
+func (mr *MockAppCtrlMockRecorder) ImportSEO(ctx, rows, mode any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEO", reflect.TypeOf((*MockAppRepo)(nil).GetSEO), ctx, name, pk)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSEO", reflect.TypeOf((*MockAppCtrl)(nil).ImportSEO), ctx, rows, mode)
 }
 
 // ListPages mocks base method.
-func (m *MockAppRepo) ListPages(ctx context.Context) ([]*models.Page, error) {
+func (m *MockAppCtrl) ListPages(ctx context.Context, f *models.PageFilter) (*dto.PaginatedPages, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPages", ctx)
-	ret0, _ := ret[0].([]*models.Page)
+	ret := m.ctrl.Call(m, "ListPages", ctx, f)
+	ret0, _ := ret[0].(*dto.PaginatedPages)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPages indicates an expected call of ListPages.
-func (mr *MockAppRepoMockRecorder) ListPages(ctx any) *gomock.Call {
+func (mr *MockAppCtrlMockRecorder) ListPages(ctx, f any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPages", reflect.TypeOf((*MockAppRepo)(nil).ListPages), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPages", reflect.TypeOf((*MockAppCtrl)(nil).ListPages), ctx, f)
 }
 
-// UpdatePage mocks base method.
-func (m *MockAppRepo) UpdatePage(ctx context.Context, slug string, req *models.Page) error {
+// ListRedirects mocks base method.
+func (m *MockAppCtrl) ListRedirects(ctx context.Context) ([]*models.Redirect, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePage", ctx, slug, req)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListRedirects", ctx)
+	ret0, _ := ret[0].([]*models.Redirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdatePage indicates an expected call of UpdatePage.
-func (mr *MockAppRepoMockRecorder) UpdatePage(ctx, slug, req any) *gomock.Call {
+// ListRedirects indicates an expected call of ListRedirects.
+func (mr *MockAppCtrlMockRecorder) ListRedirects(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePage", reflect.TypeOf((*MockAppRepo)(nil).UpdatePage), ctx, slug, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRedirects", reflect.TypeOf((*MockAppCtrl)(nil).ListRedirects), ctx)
 }
 
-// UpdateSEO mocks base method.
-func (m *MockAppRepo) UpdateSEO(ctx context.Context, req *models.SEO) error {
+// ListRobotsGroups mocks base method.
+func (m *MockAppCtrl) ListRobotsGroups(ctx context.Context) ([]*models.RobotsGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSEO", ctx, req)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListRobotsGroups", ctx)
+	ret0, _ := ret[0].([]*models.RobotsGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateSEO indicates an expected call of UpdateSEO.
-func (mr *MockAppRepoMockRecorder) UpdateSEO(ctx, req any) *gomock.Call {
+// ListRobotsGroups indicates an expected call of ListRobotsGroups.
+func (mr *MockAppCtrlMockRecorder) ListRobotsGroups(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSEO", reflect.TypeOf((*MockAppRepo)(nil).UpdateSEO), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRobotsGroups", reflect.TypeOf((*MockAppCtrl)(nil).ListRobotsGroups), ctx)
 }
 
-// MockAppCtrl is a mock of AppCtrl interface.
-type MockAppCtrl struct {
-	ctrl     *gomock.Controller
-	recorder *MockAppCtrlMockRecorder
-	isgomock struct{}
+// ListRobotsSitemaps mocks base method.
+func (m *MockAppCtrl) ListRobotsSitemaps(ctx context.Context) ([]*models.RobotsSitemap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRobotsSitemaps", ctx)
+	ret0, _ := ret[0].([]*models.RobotsSitemap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockAppCtrlMockRecorder is the mock recorder for MockAppCtrl.
-type MockAppCtrlMockRecorder struct {
-	mock *MockAppCtrl
+// ListRobotsSitemaps indicates an expected call of ListRobotsSitemaps.
+func (mr *MockAppCtrlMockRecorder) ListRobotsSitemaps(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRobotsSitemaps", reflect.TypeOf((*MockAppCtrl)(nil).ListRobotsSitemaps), ctx)
 }
 
-// NewMockAppCtrl creates a new mock instance.
-func NewMockAppCtrl(ctrl *gomock.Controller) *MockAppCtrl {
-	mock := &MockAppCtrl{ctrl: ctrl}
-	mock.recorder = &MockAppCtrlMockRecorder{mock}
-	return mock
+// ListSEO mocks base method.
+func (m *MockAppCtrl) ListSEO(ctx context.Context, f *models.SEOFilter) (*dto.PaginatedSEO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSEO", ctx, f)
+	ret0, _ := ret[0].(*dto.PaginatedSEO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAppCtrl) EXPECT() *MockAppCtrlMockRecorder {
-	return m.recorder
+// ListSEO indicates an expected call of ListSEO.
+func (mr *MockAppCtrlMockRecorder) ListSEO(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSEO", reflect.TypeOf((*MockAppCtrl)(nil).ListSEO), ctx, f)
 }
 
-// CreatePage mocks base method.
-func (m *MockAppCtrl) CreatePage(ctx context.Context, req *models.Page) (*dto.CreatePageResponse, error) {
+// ListSEORevisions mocks base method.
+func (m *MockAppCtrl) ListSEORevisions(ctx context.Context, name, pk string) ([]*models.SEORevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePage", ctx, req)
-	ret0, _ := ret[0].(*dto.CreatePageResponse)
+	ret := m.ctrl.Call(m, "ListSEORevisions", ctx, name, pk)
+	ret0, _ := ret[0].([]*models.SEORevision)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePage indicates an expected call of CreatePage.
-func (mr *MockAppCtrlMockRecorder) CreatePage(ctx, req any) *gomock.Call {
+// ListSEORevisions indicates an expected call of ListSEORevisions.
+func (mr *MockAppCtrlMockRecorder) ListSEORevisions(ctx, name, pk any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePage", reflect.TypeOf((*MockAppCtrl)(nil).CreatePage), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSEORevisions", reflect.TypeOf((*MockAppCtrl)(nil).ListSEORevisions), ctx, name, pk)
 }
 
-// CreateSEO mocks base method.
-func (m *MockAppCtrl) CreateSEO(ctx context.Context, req *models.SEO) (*dto.CreateSEOResponse, error) {
+// ListSEOTemplates mocks base method.
+func (m *MockAppCtrl) ListSEOTemplates(ctx context.Context) ([]*models.SEOTemplate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSEO", ctx, req)
-	ret0, _ := ret[0].(*dto.CreateSEOResponse)
+	ret := m.ctrl.Call(m, "ListSEOTemplates", ctx)
+	ret0, _ := ret[0].([]*models.SEOTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSEO indicates an expected call of CreateSEO.
-func (mr *MockAppCtrlMockRecorder) CreateSEO(ctx, req any) *gomock.Call {
+// ListSEOTemplates indicates an expected call of ListSEOTemplates.
+func (mr *MockAppCtrlMockRecorder) ListSEOTemplates(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSEO", reflect.TypeOf((*MockAppCtrl)(nil).CreateSEO), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSEOTemplates", reflect.TypeOf((*MockAppCtrl)(nil).ListSEOTemplates), ctx)
 }
 
-// DeletePage mocks base method.
-func (m *MockAppCtrl) DeletePage(ctx context.Context, slug string) error {
+// ListWebhookDeliveries mocks base method.
+func (m *MockAppCtrl) ListWebhookDeliveries(ctx context.Context, f *models.WebhookDeliveryFilter) (*dto.PaginatedWebhookDeliveries, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePage", ctx, slug)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, f)
+	ret0, _ := ret[0].(*dto.PaginatedWebhookDeliveries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeletePage indicates an expected call of DeletePage.
-func (mr *MockAppCtrlMockRecorder) DeletePage(ctx, slug any) *gomock.Call {
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockAppCtrlMockRecorder) ListWebhookDeliveries(ctx, f any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePage", reflect.TypeOf((*MockAppCtrl)(nil).DeletePage), ctx, slug)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockAppCtrl)(nil).ListWebhookDeliveries), ctx, f)
 }
 
-// DeleteSEO mocks base method.
-func (m *MockAppCtrl) DeleteSEO(ctx context.Context, name, pk string) error {
+// ListWebhooks mocks base method.
+func (m *MockAppCtrl) ListWebhooks(ctx context.Context) ([]*models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx)
+	ret0, _ := ret[0].([]*models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockAppCtrlMockRecorder) ListWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockAppCtrl)(nil).ListWebhooks), ctx)
+}
+
+// ResolvePath mocks base method.
+func (m *MockAppCtrl) ResolvePath(ctx context.Context, path, locale string) (*dto.ResolvePathResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePath", ctx, path, locale)
+	ret0, _ := ret[0].(*dto.ResolvePathResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePath indicates an expected call of ResolvePath.
+func (mr *MockAppCtrlMockRecorder) ResolvePath(ctx, path, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePath", reflect.TypeOf((*MockAppCtrl)(nil).ResolvePath), ctx, path, locale)
+}
+
+// ResolveRedirect mocks base method.
+func (m *MockAppCtrl) ResolveRedirect(ctx context.Context, path string) (*dto.ResolveRedirectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveRedirect", ctx, path)
+	ret0, _ := ret[0].(*dto.ResolveRedirectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveRedirect indicates an expected call of ResolveRedirect.
+func (mr *MockAppCtrlMockRecorder) ResolveRedirect(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveRedirect", reflect.TypeOf((*MockAppCtrl)(nil).ResolveRedirect), ctx, path)
+}
+
+// RetryWebhookDelivery mocks base method.
+func (m *MockAppCtrl) RetryWebhookDelivery(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSEO", ctx, name, pk)
+	ret := m.ctrl.Call(m, "RetryWebhookDelivery", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteSEO indicates an expected call of DeleteSEO.
-func (mr *MockAppCtrlMockRecorder) DeleteSEO(ctx, name, pk any) *gomock.Call {
+// RetryWebhookDelivery indicates an expected call of RetryWebhookDelivery.
+func (mr *MockAppCtrlMockRecorder) RetryWebhookDelivery(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSEO", reflect.TypeOf((*MockAppCtrl)(nil).DeleteSEO), ctx, name, pk)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryWebhookDelivery", reflect.TypeOf((*MockAppCtrl)(nil).RetryWebhookDelivery), ctx, id)
 }
 
-// GetPage mocks base method.
-func (m *MockAppCtrl) GetPage(ctx context.Context, slug string) (*models.Page, error) {
+// RollbackSEO mocks base method.
+func (m *MockAppCtrl) RollbackSEO(ctx context.Context, name, pk string, id uint64) (*models.SEO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPage", ctx, slug)
-	ret0, _ := ret[0].(*models.Page)
+	ret := m.ctrl.Call(m, "RollbackSEO", ctx, name, pk, id)
+	ret0, _ := ret[0].(*models.SEO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPage indicates an expected call of GetPage.
-func (mr *MockAppCtrlMockRecorder) GetPage(ctx, slug any) *gomock.Call {
+// RollbackSEO indicates an expected call of RollbackSEO.
+func (mr *MockAppCtrlMockRecorder) RollbackSEO(ctx, name, pk, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockAppCtrl)(nil).GetPage), ctx, slug)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSEO", reflect.TypeOf((*MockAppCtrl)(nil).RollbackSEO), ctx, name, pk, id)
 }
 
-// GetSEO mocks base method.
-func (m *MockAppCtrl) GetSEO(ctx context.Context, name, pk string) (*models.SEO, error) {
+// SaveSEOTemplate mocks base method.
+func (m *MockAppCtrl) SaveSEOTemplate(ctx context.Context, req *models.SEOTemplate) (*dto.SaveSEOTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSEO", ctx, name, pk)
-	ret0, _ := ret[0].(*models.SEO)
+	ret := m.ctrl.Call(m, "SaveSEOTemplate", ctx, req)
+	ret0, _ := ret[0].(*dto.SaveSEOTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSEO indicates an expected call of GetSEO.
-func (mr *MockAppCtrlMockRecorder) GetSEO(ctx, name, pk any) *gomock.Call {
+// SaveSEOTemplate indicates an expected call of SaveSEOTemplate.
+func (mr *MockAppCtrlMockRecorder) SaveSEOTemplate(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEO", reflect.TypeOf((*MockAppCtrl)(nil).GetSEO), ctx, name, pk)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSEOTemplate", reflect.TypeOf((*MockAppCtrl)(nil).SaveSEOTemplate), ctx, req)
 }
 
-// ListPages mocks base method.
-func (m *MockAppCtrl) ListPages(ctx context.Context) ([]*models.Page, error) {
+// TestRobots mocks base method.
+func (m *MockAppCtrl) TestRobots(ctx context.Context, agent, path string) (*dto.RobotsTestResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPages", ctx)
-	ret0, _ := ret[0].([]*models.Page)
+	ret := m.ctrl.Call(m, "TestRobots", ctx, agent, path)
+	ret0, _ := ret[0].(*dto.RobotsTestResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPages indicates an expected call of ListPages.
-func (mr *MockAppCtrlMockRecorder) ListPages(ctx any) *gomock.Call {
+// TestRobots indicates an expected call of TestRobots.
+func (mr *MockAppCtrlMockRecorder) TestRobots(ctx, agent, path any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPages", reflect.TypeOf((*MockAppCtrl)(nil).ListPages), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRobots", reflect.TypeOf((*MockAppCtrl)(nil).TestRobots), ctx, agent, path)
 }
 
 // UpdatePage mocks base method.
@@ -316,6 +1576,34 @@ func (mr *MockAppCtrlMockRecorder) UpdatePage(ctx, slug, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePage", reflect.TypeOf((*MockAppCtrl)(nil).UpdatePage), ctx, slug, req)
 }
 
+// UpdateRedirect mocks base method.
+func (m *MockAppCtrl) UpdateRedirect(ctx context.Context, id uint64, req *models.Redirect) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRedirect", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRedirect indicates an expected call of UpdateRedirect.
+func (mr *MockAppCtrlMockRecorder) UpdateRedirect(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRedirect", reflect.TypeOf((*MockAppCtrl)(nil).UpdateRedirect), ctx, id, req)
+}
+
+// UpdateRobotsGroup mocks base method.
+func (m *MockAppCtrl) UpdateRobotsGroup(ctx context.Context, id uint64, req *models.RobotsGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRobotsGroup", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRobotsGroup indicates an expected call of UpdateRobotsGroup.
+func (mr *MockAppCtrlMockRecorder) UpdateRobotsGroup(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRobotsGroup", reflect.TypeOf((*MockAppCtrl)(nil).UpdateRobotsGroup), ctx, id, req)
+}
+
 // UpdateSEO mocks base method.
 func (m *MockAppCtrl) UpdateSEO(ctx context.Context, req *models.SEO) error {
 	m.ctrl.T.Helper()
@@ -330,6 +1618,34 @@ func (mr *MockAppCtrlMockRecorder) UpdateSEO(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSEO", reflect.TypeOf((*MockAppCtrl)(nil).UpdateSEO), ctx, req)
 }
 
+// UpdateWebhook mocks base method.
+func (m *MockAppCtrl) UpdateWebhook(ctx context.Context, id uint64, req *models.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockAppCtrlMockRecorder) UpdateWebhook(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockAppCtrl)(nil).UpdateWebhook), ctx, id, req)
+}
+
+// Watch mocks base method.
+func (m *MockAppCtrl) Watch(ctx context.Context, f *models.ChangeFilter, after uint64, fn func(*models.OutboxEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, f, after, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockAppCtrlMockRecorder) Watch(ctx, f, after, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockAppCtrl)(nil).Watch), ctx, f, after, fn)
+}
+
 // MockCacheService is a mock of CacheService interface.
 type MockCacheService struct {
 	ctrl     *gomock.Controller
@@ -417,3 +1733,55 @@ func (mr *MockCacheServiceMockRecorder) Set(ctx, t, key, val any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheService)(nil).Set), ctx, t, key, val)
 }
+
+// MockPublisher is a mock of Publisher interface.
+type MockPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockPublisherMockRecorder is the mock recorder for MockPublisher.
+type MockPublisherMockRecorder struct {
+	mock *MockPublisher
+}
+
+// NewMockPublisher creates a new mock instance.
+func NewMockPublisher(ctrl *gomock.Controller) *MockPublisher {
+	mock := &MockPublisher{ctrl: ctrl}
+	mock.recorder = &MockPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublisher) EXPECT() *MockPublisherMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockPublisher) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockPublisherMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPublisher)(nil).Close))
+}
+
+// Publish mocks base method.
+func (m *MockPublisher) Publish(ctx context.Context, e *models.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockPublisherMockRecorder) Publish(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockPublisher)(nil).Publish), ctx, e)
+}